@@ -81,8 +81,10 @@ func (m *Request) XXX_DiscardUnknown() {
 var xxx_messageInfo_Request proto.InternalMessageInfo
 
 type Metadata struct {
-	NodeID               uint64   `protobuf:"varint,1,opt,name=NodeID" json:"NodeID"`
-	ClusterID            uint64   `protobuf:"varint,2,opt,name=ClusterID" json:"ClusterID"`
+	NodeID    uint64 `protobuf:"varint,1,opt,name=NodeID" json:"NodeID"`
+	ClusterID uint64 `protobuf:"varint,2,opt,name=ClusterID" json:"ClusterID"`
+	// Witness is set if the member is a witness, which keeps no database file.
+	Witness              bool     `protobuf:"varint,3,opt,name=Witness" json:"Witness"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -129,31 +131,32 @@ func init() {
 func init() { proto.RegisterFile("etcdserver.proto", fileDescriptor_09ffbeb3bebbce7e) }
 
 var fileDescriptor_09ffbeb3bebbce7e = []byte{
-	// 380 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0xd2, 0xdd, 0x6e, 0xda, 0x30,
-	0x14, 0x07, 0x70, 0x0c, 0xe1, 0xcb, 0x63, 0x1b, 0xb3, 0xd0, 0x74, 0x84, 0xa6, 0x2c, 0x42, 0xbb,
-	0xc8, 0xd5, 0xf6, 0x0e, 0x2c, 0x5c, 0x44, 0x2a, 0x15, 0x0d, 0x15, 0xbd, 0x76, 0xc9, 0x29, 0x58,
-	0x02, 0x4c, 0x1d, 0x07, 0xf1, 0x06, 0x7d, 0x85, 0x3e, 0x12, 0x97, 0x7d, 0x82, 0xaa, 0xa5, 0x2f,
-	0x52, 0x39, 0x24, 0xc4, 0xed, 0x5d, 0xf4, 0xfb, 0x9f, 0x1c, 0x1f, 0x7f, 0xd0, 0x2e, 0xea, 0x79,
-	0x9c, 0xa0, 0xda, 0xa1, 0xfa, 0xbb, 0x55, 0x52, 0x4b, 0xd6, 0x29, 0x65, 0x7b, 0xdb, 0xef, 0x2d,
-	0xe4, 0x42, 0x66, 0xc1, 0x3f, 0xf3, 0x75, 0xaa, 0x19, 0x3c, 0x38, 0xb4, 0x19, 0xe1, 0x7d, 0x8a,
-	0x89, 0x66, 0x3d, 0x5a, 0x0d, 0x03, 0x20, 0x1e, 0xf1, 0x9d, 0xa1, 0x73, 0x78, 0xfe, 0x5d, 0x89,
-	0xaa, 0x61, 0xc0, 0x7e, 0xd1, 0xc6, 0x18, 0xf5, 0x52, 0xc6, 0x50, 0xf5, 0x88, 0xdf, 0xce, 0x93,
-	0xdc, 0x18, 0x50, 0x67, 0xc2, 0xf5, 0x12, 0x6a, 0x56, 0x96, 0x09, 0xfb, 0x49, 0x6b, 0x33, 0xbe,
-	0x02, 0xc7, 0x0a, 0x0c, 0x18, 0x0f, 0x84, 0x82, 0xba, 0x47, 0xfc, 0x56, 0xe1, 0x81, 0x50, 0x6c,
-	0x40, 0xdb, 0x13, 0x85, 0xbb, 0x19, 0x5f, 0xa5, 0x08, 0x0d, 0xeb, 0xaf, 0x92, 0x8b, 0x9a, 0x70,
-	0x13, 0xe3, 0x1e, 0x9a, 0xd6, 0xa0, 0x25, 0x17, 0x35, 0xa3, 0xbd, 0x48, 0x34, 0xb4, 0xce, 0xab,
-	0x90, 0xa8, 0x64, 0xf6, 0x87, 0xd2, 0xd1, 0x7e, 0x2b, 0x14, 0xd7, 0x42, 0x6e, 0xa0, 0xed, 0x11,
-	0xbf, 0x96, 0x37, 0xb2, 0xdc, 0xec, 0xed, 0x86, 0x0b, 0x0d, 0xd4, 0x1a, 0x35, 0x13, 0xd6, 0xa7,
-	0xf5, 0xa9, 0xd8, 0xcc, 0x11, 0xbe, 0x58, 0x33, 0x9c, 0xc8, 0xac, 0x1f, 0xe1, 0x3c, 0x55, 0x89,
-	0xd8, 0x21, 0x74, 0xac, 0x5f, 0x4b, 0x36, 0x67, 0x3a, 0x95, 0x4a, 0x63, 0x0c, 0x5f, 0xad, 0x82,
-	0xdc, 0x4c, 0x7a, 0x95, 0x4a, 0x95, 0xae, 0xe1, 0x9b, 0x9d, 0x9e, 0xcc, 0x4c, 0x75, 0x2d, 0xd6,
-	0x08, 0xdf, 0xad, 0xa9, 0x33, 0xc9, 0xba, 0x6a, 0x85, 0x7c, 0x0d, 0xdd, 0x0f, 0x5d, 0x33, 0x63,
-	0xae, 0xb9, 0xe8, 0x3b, 0x85, 0xc9, 0x12, 0x7e, 0x58, 0xa7, 0x52, 0xe0, 0xe0, 0x82, 0xb6, 0xc6,
-	0xa8, 0x79, 0xcc, 0x35, 0x37, 0x9d, 0x2e, 0x65, 0x8c, 0x9f, 0x5e, 0x43, 0x6e, 0x66, 0x87, 0xff,
-	0x57, 0x69, 0xa2, 0x51, 0x85, 0x41, 0xf6, 0x28, 0xce, 0xb7, 0x70, 0xe6, 0x61, 0xef, 0xf0, 0xea,
-	0x56, 0x0e, 0x47, 0x97, 0x3c, 0x1d, 0x5d, 0xf2, 0x72, 0x74, 0xc9, 0xe3, 0x9b, 0x5b, 0x79, 0x0f,
-	0x00, 0x00, 0xff, 0xff, 0xee, 0x40, 0xba, 0xd6, 0xa4, 0x02, 0x00, 0x00,
+	// 388 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0xd2, 0x41, 0x8e, 0xd3, 0x30,
+	0x14, 0x06, 0xe0, 0x38, 0xc9, 0xcc, 0xb4, 0x66, 0x80, 0xc1, 0xaa, 0xd0, 0xd3, 0x08, 0x85, 0xa8,
+	0x62, 0x91, 0x15, 0xdc, 0xa1, 0xa4, 0x8b, 0x2c, 0x8a, 0x4a, 0x8a, 0xda, 0xb5, 0x69, 0x1e, 0xad,
+	0xa5, 0x34, 0x2e, 0xb6, 0x53, 0xf5, 0x06, 0x5c, 0x81, 0x23, 0x75, 0xc9, 0x09, 0x10, 0x94, 0x8b,
+	0x20, 0xa7, 0x49, 0x63, 0xd8, 0x55, 0xdf, 0xff, 0xfc, 0xde, 0xb3, 0x1b, 0xfa, 0x80, 0x66, 0x5d,
+	0x68, 0x54, 0x07, 0x54, 0x6f, 0xf7, 0x4a, 0x1a, 0xc9, 0xee, 0x7b, 0xd9, 0x7f, 0x7e, 0x1c, 0x6d,
+	0xe4, 0x46, 0x36, 0xc1, 0x3b, 0xfb, 0xeb, 0x52, 0x33, 0xfe, 0x16, 0xd2, 0xbb, 0x1c, 0xbf, 0xd6,
+	0xa8, 0x0d, 0x1b, 0x51, 0x3f, 0x4b, 0x81, 0xc4, 0x24, 0x09, 0x27, 0xe1, 0xe9, 0xe7, 0x6b, 0x2f,
+	0xf7, 0xb3, 0x94, 0xbd, 0xa2, 0xb7, 0x33, 0x34, 0x5b, 0x59, 0x80, 0x1f, 0x93, 0x64, 0xd8, 0x26,
+	0xad, 0x31, 0xa0, 0xe1, 0x9c, 0x9b, 0x2d, 0x04, 0x4e, 0xd6, 0x08, 0x7b, 0x49, 0x83, 0x25, 0x2f,
+	0x21, 0x74, 0x02, 0x0b, 0xd6, 0x53, 0xa1, 0xe0, 0x26, 0x26, 0xc9, 0xa0, 0xf3, 0x54, 0x28, 0x36,
+	0xa6, 0xc3, 0xb9, 0xc2, 0xc3, 0x92, 0x97, 0x35, 0xc2, 0xad, 0x73, 0xaa, 0xe7, 0xae, 0x26, 0xab,
+	0x0a, 0x3c, 0xc2, 0x9d, 0xb3, 0x68, 0xcf, 0x5d, 0xcd, 0xf4, 0x28, 0xb4, 0x81, 0xc1, 0x75, 0x0a,
+	0xc9, 0x7b, 0x66, 0x6f, 0x28, 0x9d, 0x1e, 0xf7, 0x42, 0x71, 0x23, 0x64, 0x05, 0xc3, 0x98, 0x24,
+	0x41, 0xdb, 0xc8, 0x71, 0x7b, 0xb7, 0x15, 0x17, 0x06, 0xa8, 0xb3, 0x6a, 0x23, 0xec, 0x91, 0xde,
+	0x2c, 0x44, 0xb5, 0x46, 0x78, 0xe2, 0xec, 0x70, 0x21, 0x3b, 0x3f, 0xc7, 0x75, 0xad, 0xb4, 0x38,
+	0x20, 0xdc, 0x3b, 0x47, 0x7b, 0xb6, 0x6f, 0xba, 0x90, 0xca, 0x60, 0x01, 0x4f, 0x9d, 0x82, 0xd6,
+	0x6c, 0xfa, 0xb1, 0x96, 0xaa, 0xde, 0xc1, 0x33, 0x37, 0xbd, 0x98, 0xdd, 0xea, 0x93, 0xd8, 0x21,
+	0x3c, 0x77, 0xb6, 0x6e, 0xa4, 0xe9, 0x6a, 0x14, 0xf2, 0x1d, 0x3c, 0xfc, 0xd3, 0xb5, 0x31, 0x16,
+	0xd9, 0x3f, 0xfa, 0x8b, 0x42, 0xbd, 0x85, 0x17, 0xce, 0xab, 0x74, 0x38, 0x2e, 0xe9, 0x60, 0x86,
+	0x86, 0x17, 0xdc, 0x70, 0xdb, 0xe9, 0x83, 0x2c, 0xf0, 0xbf, 0xaf, 0xa1, 0x35, 0x7b, 0xc3, 0xf7,
+	0x65, 0xad, 0x0d, 0xaa, 0x2c, 0x05, 0xdf, 0x29, 0xe8, 0xd9, 0x4e, 0x5b, 0x09, 0x53, 0xa1, 0xd6,
+	0x10, 0x5c, 0xa7, 0x79, 0x79, 0x87, 0x93, 0xd1, 0xe9, 0x77, 0xe4, 0x9d, 0xce, 0x11, 0xf9, 0x71,
+	0x8e, 0xc8, 0xaf, 0x73, 0x44, 0xbe, 0xff, 0x89, 0xbc, 0xbf, 0x03, 0x00, 0x8d, 0x35, 0xb4, 0x43,
+	0xc4, 0x02, 0x00, 0x00,
 }
 
 func (m *Request) Marshal() (dAtA []byte, err error) {
@@ -314,6 +317,14 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	i--
+	if m.Witness {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
 	i = encodeVarintEtcdserver(dAtA, i, uint64(m.ClusterID))
 	i--
 	dAtA[i] = 0x10
@@ -379,6 +390,7 @@ func (m *Metadata) Size() (n int) {
 	_ = l
 	n += 1 + sovEtcdserver(uint64(m.NodeID))
 	n += 1 + sovEtcdserver(uint64(m.ClusterID))
+	n += 2
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -894,6 +906,26 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Witness", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEtcdserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Witness = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEtcdserver(dAtA[iNdEx:])
@@ -97,11 +97,13 @@ type InternalRaftRequest struct {
 	AuthUserRevokeRole       *AuthUserRevokeRoleRequest                `protobuf:"bytes,1105,opt,name=auth_user_revoke_role,json=authUserRevokeRole,proto3" json:"auth_user_revoke_role,omitempty"`
 	AuthUserList             *AuthUserListRequest                      `protobuf:"bytes,1106,opt,name=auth_user_list,json=authUserList,proto3" json:"auth_user_list,omitempty"`
 	AuthRoleList             *AuthRoleListRequest                      `protobuf:"bytes,1107,opt,name=auth_role_list,json=authRoleList,proto3" json:"auth_role_list,omitempty"`
+	AuthUserSetRateLimit     *AuthUserSetRateLimitRequest              `protobuf:"bytes,1108,opt,name=auth_user_set_rate_limit,json=authUserSetRateLimit,proto3" json:"auth_user_set_rate_limit,omitempty"`
 	AuthRoleAdd              *AuthRoleAddRequest                       `protobuf:"bytes,1200,opt,name=auth_role_add,json=authRoleAdd,proto3" json:"auth_role_add,omitempty"`
 	AuthRoleDelete           *AuthRoleDeleteRequest                    `protobuf:"bytes,1201,opt,name=auth_role_delete,json=authRoleDelete,proto3" json:"auth_role_delete,omitempty"`
 	AuthRoleGet              *AuthRoleGetRequest                       `protobuf:"bytes,1202,opt,name=auth_role_get,json=authRoleGet,proto3" json:"auth_role_get,omitempty"`
 	AuthRoleGrantPermission  *AuthRoleGrantPermissionRequest           `protobuf:"bytes,1203,opt,name=auth_role_grant_permission,json=authRoleGrantPermission,proto3" json:"auth_role_grant_permission,omitempty"`
 	AuthRoleRevokePermission *AuthRoleRevokePermissionRequest          `protobuf:"bytes,1204,opt,name=auth_role_revoke_permission,json=authRoleRevokePermission,proto3" json:"auth_role_revoke_permission,omitempty"`
+	AuthRoleSetRateLimit     *AuthRoleSetRateLimitRequest              `protobuf:"bytes,1205,opt,name=auth_role_set_rate_limit,json=authRoleSetRateLimit,proto3" json:"auth_role_set_rate_limit,omitempty"`
 	ClusterVersionSet        *membershippb.ClusterVersionSetRequest    `protobuf:"bytes,1300,opt,name=cluster_version_set,json=clusterVersionSet,proto3" json:"cluster_version_set,omitempty"`
 	ClusterMemberAttrSet     *membershippb.ClusterMemberAttrSetRequest `protobuf:"bytes,1301,opt,name=cluster_member_attr_set,json=clusterMemberAttrSet,proto3" json:"cluster_member_attr_set,omitempty"`
 	DowngradeInfoSet         *membershippb.DowngradeInfoSetRequest     `protobuf:"bytes,1302,opt,name=downgrade_info_set,json=downgradeInfoSet,proto3" json:"downgrade_info_set,omitempty"`
@@ -238,73 +240,77 @@ func init() {
 func init() { proto.RegisterFile("raft_internal.proto", fileDescriptor_b4c9a9be0cfca103) }
 
 var fileDescriptor_b4c9a9be0cfca103 = []byte{
-	// 1054 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x56, 0x5d, 0x6f, 0x1b, 0x45,
-	0x14, 0xad, 0xd3, 0x34, 0x89, 0xc7, 0x49, 0x9a, 0x4e, 0x52, 0x3a, 0x38, 0x92, 0x71, 0x03, 0x2d,
-	0x01, 0x8a, 0x53, 0x1c, 0x78, 0xe1, 0x05, 0x5c, 0x3b, 0x4a, 0x83, 0x4a, 0x15, 0x6d, 0x0b, 0xaa,
-	0x84, 0xd0, 0x32, 0xde, 0xbd, 0xb1, 0xb7, 0x59, 0xef, 0x2e, 0x33, 0x63, 0x37, 0x7d, 0xe5, 0x91,
-	0x67, 0x40, 0xfc, 0x0c, 0x3e, 0xff, 0x43, 0x85, 0xf8, 0x28, 0xf0, 0x07, 0x20, 0xbc, 0xf0, 0x0e,
-	0xbc, 0xa3, 0xf9, 0xd8, 0x5d, 0xaf, 0x3d, 0xce, 0xdb, 0xfa, 0xde, 0x73, 0xcf, 0x39, 0x33, 0x73,
-	0xef, 0x78, 0xd0, 0x3a, 0xa3, 0x47, 0xc2, 0x0d, 0x22, 0x01, 0x2c, 0xa2, 0x61, 0x23, 0x61, 0xb1,
-	0x88, 0xf1, 0x32, 0x08, 0xcf, 0xe7, 0xc0, 0x46, 0xc0, 0x92, 0x6e, 0x75, 0xa3, 0x17, 0xf7, 0x62,
-	0x95, 0xd8, 0x91, 0x5f, 0x1a, 0x53, 0x5d, 0xcb, 0x31, 0x26, 0x52, 0x66, 0x89, 0x67, 0x3e, 0xeb,
-	0x32, 0xb9, 0x43, 0x93, 0x60, 0x67, 0x04, 0x8c, 0x07, 0x71, 0x94, 0x74, 0xd3, 0x2f, 0x83, 0xb8,
-	0x9e, 0x21, 0x06, 0x30, 0xe8, 0x02, 0xe3, 0xfd, 0x20, 0x49, 0xba, 0x63, 0x3f, 0x34, 0x6e, 0x8b,
-	0xa1, 0x15, 0x07, 0x3e, 0x1e, 0x02, 0x17, 0xb7, 0x81, 0xfa, 0xc0, 0xf0, 0x2a, 0x9a, 0x3b, 0xe8,
-	0x90, 0x52, 0xbd, 0xb4, 0x3d, 0xef, 0xcc, 0x1d, 0x74, 0x70, 0x15, 0x2d, 0x0d, 0xb9, 0x34, 0x3f,
-	0x00, 0x32, 0x57, 0x2f, 0x6d, 0x97, 0x9d, 0xec, 0x37, 0xbe, 0x81, 0x56, 0xe8, 0x50, 0xf4, 0x5d,
-	0x06, 0xa3, 0x40, 0x6a, 0x93, 0xf3, 0xb2, 0xec, 0xd6, 0xe2, 0xa7, 0xdf, 0x93, 0xf3, 0xbb, 0x8d,
-	0xd7, 0x9c, 0x65, 0x99, 0x75, 0x4c, 0xf2, 0xcd, 0xc5, 0x4f, 0x54, 0xf8, 0xe6, 0xd6, 0x0f, 0x18,
-	0xad, 0x1f, 0x98, 0x1d, 0x71, 0xe8, 0x91, 0x30, 0x06, 0xf0, 0x2e, 0x5a, 0xe8, 0x2b, 0x13, 0xc4,
-	0xaf, 0x97, 0xb6, 0x2b, 0xcd, 0xcd, 0xc6, 0xf8, 0x3e, 0x35, 0x0a, 0x3e, 0x1d, 0x03, 0x9d, 0xf2,
-	0x7b, 0x0d, 0xcd, 0x8d, 0x9a, 0xca, 0x69, 0xa5, 0x79, 0xd9, 0x4a, 0xe0, 0xcc, 0x8d, 0x9a, 0xf8,
-	0x26, 0xba, 0xc0, 0x68, 0xd4, 0x03, 0x65, 0xb9, 0xd2, 0xac, 0x4e, 0x20, 0x65, 0x2a, 0x85, 0x6b,
-	0x20, 0x7e, 0x19, 0x9d, 0x4f, 0x86, 0x82, 0xcc, 0x2b, 0x3c, 0x29, 0xe2, 0x0f, 0x87, 0xe9, 0x22,
-	0x1c, 0x09, 0xc2, 0x6d, 0xb4, 0xec, 0x43, 0x08, 0x02, 0x5c, 0x2d, 0x72, 0x41, 0x15, 0xd5, 0x8b,
-	0x45, 0x1d, 0x85, 0x28, 0x48, 0x55, 0xfc, 0x3c, 0x26, 0x05, 0xc5, 0x49, 0x44, 0x16, 0x6c, 0x82,
-	0xf7, 0x4f, 0xa2, 0x4c, 0x50, 0x9c, 0x44, 0xf8, 0x2d, 0x84, 0xbc, 0x78, 0x90, 0x50, 0x4f, 0xc8,
-	0x63, 0x58, 0x54, 0x25, 0xcf, 0x15, 0x4b, 0xda, 0x59, 0x3e, 0xad, 0x1c, 0x2b, 0xc1, 0x6f, 0xa3,
-	0x4a, 0x08, 0x94, 0x83, 0xdb, 0x63, 0x34, 0x12, 0x64, 0xc9, 0xc6, 0x70, 0x47, 0x02, 0xf6, 0x65,
-	0x3e, 0x63, 0x08, 0xb3, 0x90, 0x5c, 0xb3, 0x66, 0x60, 0x30, 0x8a, 0x8f, 0x81, 0x94, 0x6d, 0x6b,
-	0x56, 0x14, 0x8e, 0x02, 0x64, 0x6b, 0x0e, 0xf3, 0x98, 0x3c, 0x16, 0x1a, 0x52, 0x36, 0x20, 0xc8,
-	0x76, 0x2c, 0x2d, 0x99, 0xca, 0x8e, 0x45, 0x01, 0xf1, 0x03, 0xb4, 0xa6, 0x65, 0xbd, 0x3e, 0x78,
-	0xc7, 0x49, 0x1c, 0x44, 0x82, 0x54, 0x54, 0xf1, 0x0b, 0x16, 0xe9, 0x76, 0x06, 0x32, 0x34, 0x69,
-	0xb3, 0xbe, 0xee, 0x5c, 0x0c, 0x8b, 0x00, 0xdc, 0x42, 0x15, 0xd5, 0xdd, 0x10, 0xd1, 0x6e, 0x08,
-	0xe4, 0x6f, 0xeb, 0xae, 0xb6, 0x86, 0xa2, 0xbf, 0xa7, 0x00, 0xd9, 0x9e, 0xd0, 0x2c, 0x84, 0x3b,
-	0x48, 0x8d, 0x80, 0xeb, 0x07, 0x5c, 0x71, 0xfc, 0xb3, 0x68, 0xdb, 0x14, 0xc9, 0xd1, 0xd1, 0x88,
-	0x6c, 0x53, 0x68, 0x1e, 0xc3, 0xef, 0x18, 0x23, 0x5c, 0x50, 0x31, 0xe4, 0xe4, 0xbf, 0x99, 0x46,
-	0xee, 0x29, 0xc0, 0xc4, 0xca, 0xde, 0xd0, 0x8e, 0x74, 0x0e, 0xdf, 0xd5, 0x8e, 0x20, 0x12, 0x81,
-	0x47, 0x05, 0x90, 0x7f, 0x35, 0xd9, 0x4b, 0x45, 0xb2, 0x74, 0x3a, 0x5b, 0x63, 0xd0, 0xd4, 0x5a,
-	0xa1, 0x1e, 0xef, 0x99, 0x2b, 0x40, 0xde, 0x09, 0x2e, 0xf5, 0x7d, 0xf2, 0xe3, 0xd2, 0xac, 0x25,
-	0xbe, 0xc7, 0x81, 0xb5, 0x7c, 0xbf, 0xb0, 0x44, 0x13, 0xc3, 0x77, 0xd1, 0x5a, 0x4e, 0xa3, 0x87,
-	0x80, 0xfc, 0xa4, 0x99, 0x9e, 0xb7, 0x33, 0x99, 0xe9, 0x31, 0x64, 0xab, 0xb4, 0x10, 0x2e, 0xda,
-	0xea, 0x81, 0x20, 0x3f, 0x9f, 0x69, 0x6b, 0x1f, 0xc4, 0x94, 0xad, 0x7d, 0x10, 0xb8, 0x87, 0x9e,
-	0xcd, 0x69, 0xbc, 0xbe, 0x1c, 0x4b, 0x37, 0xa1, 0x9c, 0x3f, 0x8a, 0x99, 0x4f, 0x7e, 0xd1, 0x94,
-	0xaf, 0xd8, 0x29, 0xdb, 0x0a, 0x7d, 0x68, 0xc0, 0x29, 0xfb, 0x33, 0xd4, 0x9a, 0xc6, 0x0f, 0xd0,
-	0xc6, 0x98, 0x5f, 0x39, 0x4f, 0x2e, 0x8b, 0x43, 0x20, 0x4f, 0xb5, 0xc6, 0xf5, 0x19, 0xb6, 0xd5,
-	0x2c, 0xc6, 0x79, 0xdb, 0x5c, 0xa2, 0x93, 0x19, 0xfc, 0x01, 0xba, 0x9c, 0x33, 0xeb, 0xd1, 0xd4,
-	0xd4, 0xbf, 0x6a, 0xea, 0x17, 0xed, 0xd4, 0x66, 0x46, 0xc7, 0xb8, 0x31, 0x9d, 0x4a, 0xe1, 0xdb,
-	0x68, 0x35, 0x27, 0x0f, 0x03, 0x2e, 0xc8, 0x6f, 0x9a, 0xf5, 0xaa, 0x9d, 0xf5, 0x4e, 0xc0, 0x45,
-	0xa1, 0x8f, 0xd2, 0x60, 0xc6, 0x24, 0xad, 0x69, 0xa6, 0xdf, 0x67, 0x32, 0x49, 0xe9, 0x29, 0xa6,
-	0x34, 0x98, 0x1d, 0xbd, 0x62, 0x92, 0x1d, 0xf9, 0x55, 0x79, 0xd6, 0xd1, 0xcb, 0x9a, 0xc9, 0x8e,
-	0x34, 0xb1, 0xac, 0x23, 0x15, 0x8d, 0xe9, 0xc8, 0xaf, 0xcb, 0xb3, 0x3a, 0x52, 0x56, 0x59, 0x3a,
-	0x32, 0x0f, 0x17, 0x6d, 0xc9, 0x8e, 0xfc, 0xe6, 0x4c, 0x5b, 0x93, 0x1d, 0x69, 0x62, 0xf8, 0x21,
-	0xaa, 0x8e, 0xd1, 0xa8, 0x46, 0x49, 0x80, 0x0d, 0x02, 0xae, 0xfe, 0x7f, 0xbf, 0xd5, 0x9c, 0x37,
-	0x66, 0x70, 0x4a, 0xf8, 0x61, 0x86, 0x4e, 0xf9, 0xaf, 0x50, 0x7b, 0x1e, 0x0f, 0xd0, 0x66, 0xae,
-	0x65, 0x5a, 0x67, 0x4c, 0xec, 0x3b, 0x2d, 0xf6, 0xaa, 0x5d, 0x4c, 0x77, 0xc9, 0xb4, 0x1a, 0xa1,
-	0x33, 0x00, 0xf8, 0x23, 0xb4, 0xee, 0x85, 0x43, 0x2e, 0x80, 0xb9, 0xe6, 0x2d, 0xe3, 0x72, 0x10,
-	0xe4, 0x33, 0x64, 0x46, 0x60, 0xfc, 0x21, 0xd3, 0x68, 0x6b, 0xe4, 0xfb, 0x1a, 0x78, 0x0f, 0xc4,
-	0xd4, 0xad, 0x77, 0xc9, 0x9b, 0x84, 0xe0, 0x87, 0xe8, 0x4a, 0xaa, 0xa0, 0xc9, 0x5c, 0x2a, 0x04,
-	0x53, 0x2a, 0x9f, 0x23, 0x73, 0x0f, 0xda, 0x54, 0xde, 0x55, 0xb1, 0x96, 0x10, 0xcc, 0x26, 0xb4,
-	0xe1, 0x59, 0x50, 0xf8, 0x43, 0x84, 0xfd, 0xf8, 0x51, 0xd4, 0x63, 0xd4, 0x07, 0x37, 0x88, 0x8e,
-	0x62, 0x25, 0xf3, 0x85, 0x96, 0xb9, 0x56, 0x94, 0xe9, 0xa4, 0xc0, 0x83, 0xe8, 0x28, 0xb6, 0x49,
-	0xac, 0xf9, 0x13, 0x88, 0xfc, 0x31, 0x75, 0x11, 0xad, 0xec, 0x0d, 0x12, 0xf1, 0xd8, 0x01, 0x9e,
-	0xc4, 0x11, 0x87, 0xad, 0xc7, 0x68, 0xf3, 0x8c, 0xeb, 0x1b, 0x63, 0x34, 0xaf, 0xde, 0x72, 0x25,
-	0xf5, 0x96, 0x53, 0xdf, 0xf2, 0x8d, 0x97, 0xdd, 0x6a, 0xe6, 0x8d, 0x97, 0xfe, 0xc6, 0x57, 0xd1,
-	0x32, 0x0f, 0x06, 0x49, 0x08, 0xae, 0x88, 0x8f, 0x41, 0x3f, 0xf1, 0xca, 0x4e, 0x45, 0xc7, 0xee,
-	0xcb, 0x50, 0xe6, 0xe5, 0xd6, 0xc6, 0x93, 0x3f, 0x6b, 0xe7, 0x9e, 0x9c, 0xd6, 0x4a, 0x4f, 0x4f,
-	0x6b, 0xa5, 0x3f, 0x4e, 0x6b, 0xa5, 0x2f, 0xff, 0xaa, 0x9d, 0xeb, 0x2e, 0xa8, 0x97, 0xe6, 0xee,
-	0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x30, 0x36, 0x53, 0xc6, 0x0b, 0x0b, 0x00, 0x00,
+	// 1105 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x56, 0x4d, 0x53, 0x1c, 0x45,
+	0x18, 0xce, 0x12, 0x02, 0x6c, 0x2f, 0x10, 0xd2, 0x6c, 0x4c, 0x0b, 0x55, 0x48, 0xd0, 0x44, 0xd4,
+	0x08, 0x11, 0xd4, 0x83, 0x17, 0xdd, 0xb0, 0x14, 0xc1, 0xc2, 0x14, 0x35, 0x44, 0x2b, 0x55, 0x96,
+	0x35, 0xf6, 0xce, 0xbc, 0xec, 0x4e, 0x98, 0x9d, 0x19, 0xbb, 0x7b, 0x37, 0xe4, 0xea, 0xd1, 0xb3,
+	0x5a, 0xfe, 0x00, 0x7f, 0x80, 0x5f, 0xf9, 0x0f, 0x39, 0xf8, 0x11, 0x3f, 0x7e, 0x80, 0xe2, 0xc5,
+	0xbb, 0x7a, 0xb7, 0xfa, 0x63, 0x3e, 0xe9, 0xc5, 0xdb, 0xec, 0xfb, 0x3e, 0xfd, 0x3c, 0x4f, 0xf7,
+	0x3c, 0xef, 0x6c, 0xa3, 0x79, 0x46, 0x0f, 0x85, 0x1b, 0x44, 0x02, 0x58, 0x44, 0xc3, 0xb5, 0x84,
+	0xc5, 0x22, 0xc6, 0xd3, 0x20, 0x3c, 0x9f, 0x03, 0x1b, 0x02, 0x4b, 0x3a, 0x0b, 0xcd, 0x6e, 0xdc,
+	0x8d, 0x55, 0x63, 0x5d, 0x3e, 0x69, 0xcc, 0xc2, 0x5c, 0x8e, 0x31, 0x95, 0x3a, 0x4b, 0x3c, 0xf3,
+	0xb8, 0x2c, 0x9b, 0xeb, 0x34, 0x09, 0xd6, 0x87, 0xc0, 0x78, 0x10, 0x47, 0x49, 0x27, 0x7d, 0x32,
+	0x88, 0xeb, 0x19, 0xa2, 0x0f, 0xfd, 0x0e, 0x30, 0xde, 0x0b, 0x92, 0xa4, 0x53, 0xf8, 0xa1, 0x71,
+	0x2b, 0x0c, 0xcd, 0x38, 0xf0, 0xd1, 0x00, 0xb8, 0xb8, 0x0d, 0xd4, 0x07, 0x86, 0x67, 0xd1, 0xd8,
+	0x6e, 0x9b, 0xd4, 0x96, 0x6b, 0xab, 0xe3, 0xce, 0xd8, 0x6e, 0x1b, 0x2f, 0xa0, 0xa9, 0x01, 0x97,
+	0xe6, 0xfb, 0x40, 0xc6, 0x96, 0x6b, 0xab, 0x75, 0x27, 0xfb, 0x8d, 0x6f, 0xa0, 0x19, 0x3a, 0x10,
+	0x3d, 0x97, 0xc1, 0x30, 0x90, 0xda, 0xe4, 0xbc, 0x5c, 0x76, 0x6b, 0xf2, 0x93, 0x47, 0xe4, 0xfc,
+	0xe6, 0xda, 0x2b, 0xce, 0xb4, 0xec, 0x3a, 0xa6, 0xf9, 0xc6, 0xe4, 0xc7, 0xaa, 0x7c, 0x73, 0xe5,
+	0xcb, 0x26, 0x9a, 0xdf, 0x35, 0x27, 0xe2, 0xd0, 0x43, 0x61, 0x0c, 0xe0, 0x4d, 0x34, 0xd1, 0x53,
+	0x26, 0x88, 0xbf, 0x5c, 0x5b, 0x6d, 0x6c, 0x2c, 0xae, 0x15, 0xcf, 0x69, 0xad, 0xe4, 0xd3, 0x99,
+	0xe8, 0xd9, 0xfd, 0x5e, 0x43, 0x63, 0xc3, 0x0d, 0xe5, 0xb4, 0xb1, 0x71, 0xd9, 0x4a, 0xe0, 0x8c,
+	0x0d, 0x37, 0xf0, 0x4d, 0x74, 0x81, 0xd1, 0xa8, 0x0b, 0xca, 0x72, 0x63, 0x63, 0xa1, 0x82, 0x94,
+	0xad, 0x14, 0xae, 0x81, 0xf8, 0x45, 0x74, 0x3e, 0x19, 0x08, 0x32, 0xae, 0xf0, 0xa4, 0x8c, 0xdf,
+	0x1f, 0xa4, 0x9b, 0x70, 0x24, 0x08, 0x6f, 0xa1, 0x69, 0x1f, 0x42, 0x10, 0xe0, 0x6a, 0x91, 0x0b,
+	0x6a, 0xd1, 0x72, 0x79, 0x51, 0x5b, 0x21, 0x4a, 0x52, 0x0d, 0x3f, 0xaf, 0x49, 0x41, 0x71, 0x1c,
+	0x91, 0x09, 0x9b, 0xe0, 0xdd, 0xe3, 0x28, 0x13, 0x14, 0xc7, 0x11, 0x7e, 0x13, 0x21, 0x2f, 0xee,
+	0x27, 0xd4, 0x13, 0xf2, 0x35, 0x4c, 0xaa, 0x25, 0xcf, 0x94, 0x97, 0x6c, 0x65, 0xfd, 0x74, 0x65,
+	0x61, 0x09, 0x7e, 0x0b, 0x35, 0x42, 0xa0, 0x1c, 0xdc, 0x2e, 0xa3, 0x91, 0x20, 0x53, 0x36, 0x86,
+	0x3d, 0x09, 0xd8, 0x91, 0xfd, 0x8c, 0x21, 0xcc, 0x4a, 0x72, 0xcf, 0x9a, 0x81, 0xc1, 0x30, 0x3e,
+	0x02, 0x52, 0xb7, 0xed, 0x59, 0x51, 0x38, 0x0a, 0x90, 0xed, 0x39, 0xcc, 0x6b, 0xf2, 0xb5, 0xd0,
+	0x90, 0xb2, 0x3e, 0x41, 0xb6, 0xd7, 0xd2, 0x92, 0xad, 0xec, 0xb5, 0x28, 0x20, 0xbe, 0x87, 0xe6,
+	0xb4, 0xac, 0xd7, 0x03, 0xef, 0x28, 0x89, 0x83, 0x48, 0x90, 0x86, 0x5a, 0xfc, 0x9c, 0x45, 0x7a,
+	0x2b, 0x03, 0x19, 0x9a, 0x34, 0xac, 0xaf, 0x3a, 0x17, 0xc3, 0x32, 0x00, 0xb7, 0x50, 0x43, 0xa5,
+	0x1b, 0x22, 0xda, 0x09, 0x81, 0xfc, 0x65, 0x3d, 0xd5, 0xd6, 0x40, 0xf4, 0xb6, 0x15, 0x20, 0x3b,
+	0x13, 0x9a, 0x95, 0x70, 0x1b, 0xa9, 0x11, 0x70, 0xfd, 0x80, 0x2b, 0x8e, 0xbf, 0x27, 0x6d, 0x87,
+	0x22, 0x39, 0xda, 0x01, 0x2f, 0x92, 0x34, 0x68, 0x5e, 0xc3, 0x6f, 0x1b, 0x23, 0x5c, 0x50, 0x31,
+	0xe0, 0xe4, 0xdf, 0x91, 0x46, 0x0e, 0x14, 0xa0, 0xb2, 0xb3, 0xd7, 0xb4, 0x23, 0xdd, 0xc3, 0x77,
+	0xb4, 0x23, 0x88, 0x44, 0xe0, 0x51, 0x01, 0xe4, 0x1f, 0x4d, 0xf6, 0x42, 0x99, 0x2c, 0x9d, 0xce,
+	0x56, 0x01, 0x9a, 0x5a, 0x2b, 0xad, 0xc7, 0xdb, 0xe6, 0x13, 0x30, 0xe0, 0xc0, 0x5c, 0xea, 0xfb,
+	0xe4, 0xfb, 0xa9, 0x51, 0x5b, 0x7c, 0x97, 0x03, 0x6b, 0xf9, 0x7e, 0x69, 0x8b, 0xa6, 0x86, 0xef,
+	0xa0, 0xb9, 0x9c, 0x46, 0x0f, 0x01, 0xf9, 0x41, 0x33, 0x3d, 0x6b, 0x67, 0x32, 0xd3, 0x63, 0xc8,
+	0x66, 0x69, 0xa9, 0x5c, 0xb6, 0xd5, 0x05, 0x41, 0x7e, 0x3c, 0xd3, 0xd6, 0x0e, 0x88, 0x53, 0xb6,
+	0x76, 0x40, 0xe0, 0x2e, 0x7a, 0x3a, 0xa7, 0xf1, 0x7a, 0x72, 0x2c, 0xdd, 0x84, 0x72, 0xfe, 0x20,
+	0x66, 0x3e, 0xf9, 0x49, 0x53, 0xbe, 0x64, 0xa7, 0xdc, 0x52, 0xe8, 0x7d, 0x03, 0x4e, 0xd9, 0x9f,
+	0xa2, 0xd6, 0x36, 0xbe, 0x87, 0x9a, 0x05, 0xbf, 0x72, 0x9e, 0x5c, 0x16, 0x87, 0x40, 0x9e, 0x68,
+	0x8d, 0xeb, 0x23, 0x6c, 0xab, 0x59, 0x8c, 0xf3, 0xd8, 0x5c, 0xa2, 0xd5, 0x0e, 0x7e, 0x1f, 0x5d,
+	0xce, 0x99, 0xf5, 0x68, 0x6a, 0xea, 0x9f, 0x35, 0xf5, 0xf3, 0x76, 0x6a, 0x33, 0xa3, 0x05, 0x6e,
+	0x4c, 0x4f, 0xb5, 0xf0, 0x6d, 0x34, 0x9b, 0x93, 0x87, 0x01, 0x17, 0xe4, 0x17, 0xcd, 0x7a, 0xd5,
+	0xce, 0xba, 0x17, 0x70, 0x51, 0xca, 0x51, 0x5a, 0xcc, 0x98, 0xa4, 0x35, 0xcd, 0xf4, 0xeb, 0x48,
+	0x26, 0x29, 0x7d, 0x8a, 0x29, 0x2d, 0xe2, 0x23, 0x44, 0x72, 0x4f, 0x1c, 0x84, 0xcb, 0xa8, 0x90,
+	0x94, 0xfd, 0x40, 0x90, 0xdf, 0xa6, 0x6c, 0x69, 0x4f, 0xdd, 0x1d, 0x80, 0x70, 0xa8, 0x80, 0x3d,
+	0x09, 0xad, 0x0c, 0xd1, 0xeb, 0x4e, 0x93, 0x5a, 0x50, 0x59, 0xce, 0x94, 0x6d, 0x19, 0xff, 0xaf,
+	0xea, 0xa3, 0x72, 0x26, 0x0d, 0x56, 0xe3, 0x6f, 0x6a, 0x59, 0xfc, 0x15, 0x8d, 0x89, 0xff, 0xd7,
+	0xf5, 0x51, 0xf1, 0x97, 0xab, 0x2c, 0xf1, 0xcf, 0xcb, 0x65, 0x5b, 0x32, 0xfe, 0xdf, 0x9c, 0x69,
+	0xab, 0x1a, 0x7f, 0x53, 0xc3, 0xf7, 0xd1, 0x42, 0x81, 0x46, 0xa5, 0x32, 0x01, 0xd6, 0x0f, 0xb8,
+	0xfa, 0xb3, 0xff, 0x56, 0x73, 0xde, 0x18, 0xc1, 0x29, 0xe1, 0xfb, 0x19, 0x3a, 0xe5, 0xbf, 0x42,
+	0xed, 0x7d, 0xdc, 0x47, 0x8b, 0xb9, 0x96, 0xc9, 0x69, 0x41, 0xec, 0x3b, 0x2d, 0xf6, 0xb2, 0x5d,
+	0x4c, 0x47, 0xf2, 0xb4, 0x1a, 0xa1, 0x23, 0x00, 0x59, 0x4a, 0x94, 0x5c, 0x25, 0x25, 0x8f, 0xea,
+	0xa3, 0x52, 0x22, 0xa9, 0xfe, 0x3f, 0x25, 0x55, 0x14, 0xfe, 0x10, 0xcd, 0x7b, 0xe1, 0x80, 0x0b,
+	0x60, 0xae, 0xb9, 0xa5, 0x49, 0x49, 0xf2, 0x29, 0x32, 0xc3, 0x5d, 0xbc, 0xa2, 0xad, 0x6d, 0x69,
+	0xe4, 0x7b, 0x1a, 0x78, 0x00, 0xe2, 0xd4, 0xf7, 0xfc, 0x92, 0x57, 0x85, 0xe0, 0xfb, 0xe8, 0x4a,
+	0xaa, 0xa0, 0xc9, 0x5c, 0x2a, 0x84, 0x8a, 0x3f, 0xf9, 0x0c, 0x99, 0xdd, 0xd8, 0x54, 0xde, 0x51,
+	0xb5, 0x96, 0x10, 0xcc, 0x26, 0xd4, 0xf4, 0x2c, 0x28, 0xfc, 0x01, 0xc2, 0x7e, 0xfc, 0x20, 0xea,
+	0x32, 0xea, 0x83, 0x1b, 0x44, 0x87, 0xb1, 0x92, 0xf9, 0x5c, 0xcb, 0x5c, 0x2b, 0xcb, 0xb4, 0x53,
+	0xe0, 0x6e, 0x74, 0x18, 0xdb, 0x24, 0xe6, 0xfc, 0x0a, 0x22, 0xbf, 0x26, 0x5e, 0x44, 0x33, 0xdb,
+	0xfd, 0x44, 0x3c, 0x74, 0x80, 0x27, 0x71, 0xc4, 0x61, 0xe5, 0x21, 0x5a, 0x3c, 0xe3, 0x8f, 0x09,
+	0x63, 0x34, 0xae, 0x6e, 0xa9, 0x35, 0x75, 0x4b, 0x55, 0xcf, 0xf2, 0xf6, 0x9a, 0x7d, 0xaf, 0xcd,
+	0xed, 0x35, 0xfd, 0x8d, 0xaf, 0xa2, 0x69, 0x1e, 0xf4, 0x93, 0x10, 0x5c, 0x11, 0x1f, 0x81, 0xbe,
+	0xbc, 0xd6, 0x9d, 0x86, 0xae, 0xdd, 0x95, 0xa5, 0xcc, 0xcb, 0xad, 0xe6, 0xe3, 0x3f, 0x96, 0xce,
+	0x3d, 0x3e, 0x59, 0xaa, 0x3d, 0x39, 0x59, 0xaa, 0xfd, 0x7e, 0xb2, 0x54, 0xfb, 0xe2, 0xcf, 0xa5,
+	0x73, 0x9d, 0x09, 0x75, 0x87, 0xde, 0xfc, 0x6f, 0x00, 0x32, 0x06, 0x7e, 0xcf, 0xe5, 0x0b, 0x00,
+	0x00,
 }
 
 func (m *RequestHeader) Marshal() (dAtA []byte, err error) {
@@ -417,6 +423,20 @@ func (m *InternalRaftRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xa2
 	}
+	if m.AuthRoleSetRateLimit != nil {
+		{
+			size, err := m.AuthRoleSetRateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4b
+		i--
+		dAtA[i] = 0xaa
+	}
 	if m.AuthRoleRevokePermission != nil {
 		{
 			size, err := m.AuthRoleRevokePermission.MarshalToSizedBuffer(dAtA[:i])
@@ -487,6 +507,20 @@ func (m *InternalRaftRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x82
 	}
+	if m.AuthUserSetRateLimit != nil {
+		{
+			size, err := m.AuthUserSetRateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x45
+		i--
+		dAtA[i] = 0xa2
+	}
 	if m.AuthRoleList != nil {
 		{
 			size, err := m.AuthRoleList.MarshalToSizedBuffer(dAtA[:i])
@@ -1006,6 +1040,10 @@ func (m *InternalRaftRequest) Size() (n int) {
 		l = m.AuthRoleList.Size()
 		n += 2 + l + sovRaftInternal(uint64(l))
 	}
+	if m.AuthUserSetRateLimit != nil {
+		l = m.AuthUserSetRateLimit.Size()
+		n += 2 + l + sovRaftInternal(uint64(l))
+	}
 	if m.AuthRoleAdd != nil {
 		l = m.AuthRoleAdd.Size()
 		n += 2 + l + sovRaftInternal(uint64(l))
@@ -1026,6 +1064,10 @@ func (m *InternalRaftRequest) Size() (n int) {
 		l = m.AuthRoleRevokePermission.Size()
 		n += 2 + l + sovRaftInternal(uint64(l))
 	}
+	if m.AuthRoleSetRateLimit != nil {
+		l = m.AuthRoleSetRateLimit.Size()
+		n += 2 + l + sovRaftInternal(uint64(l))
+	}
 	if m.ClusterVersionSet != nil {
 		l = m.ClusterVersionSet.Size()
 		n += 2 + l + sovRaftInternal(uint64(l))
@@ -2083,6 +2125,42 @@ func (m *InternalRaftRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 1108:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthUserSetRateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthUserSetRateLimit == nil {
+				m.AuthUserSetRateLimit = &AuthUserSetRateLimitRequest{}
+			}
+			if err := m.AuthUserSetRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 1200:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AuthRoleAdd", wireType)
@@ -2263,6 +2341,42 @@ func (m *InternalRaftRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 1205:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthRoleSetRateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthRoleSetRateLimit == nil {
+				m.AuthRoleSetRateLimit = &AuthRoleSetRateLimitRequest{}
+			}
+			if err := m.AuthRoleSetRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 1300:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ClusterVersionSet", wireType)
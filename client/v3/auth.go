@@ -42,6 +42,8 @@ type (
 	AuthRoleDeleteResponse           pb.AuthRoleDeleteResponse
 	AuthUserListResponse             pb.AuthUserListResponse
 	AuthRoleListResponse             pb.AuthRoleListResponse
+	AuthUserSetRateLimitResponse     pb.AuthUserSetRateLimitResponse
+	AuthRoleSetRateLimitResponse     pb.AuthRoleSetRateLimitResponse
 
 	PermissionType authpb.Permission_Type
 	Permission     authpb.Permission
@@ -55,6 +57,10 @@ const (
 
 type UserAddOptions authpb.UserAddOptions
 
+// RateLimit is a token bucket limit on the requests a user or role may send
+// to each member.
+type RateLimit authpb.RateLimit
+
 type Auth interface {
 	// Authenticate login and get token
 	Authenticate(ctx context.Context, name string, password string) (*AuthenticateResponse, error)
@@ -92,6 +98,9 @@ type Auth interface {
 	// UserRevokeRole revokes a role of a user.
 	UserRevokeRole(ctx context.Context, name string, role string) (*AuthUserRevokeRoleResponse, error)
 
+	// UserSetRateLimit sets the request rate limit of a user. A nil limit removes it.
+	UserSetRateLimit(ctx context.Context, name string, limit *RateLimit) (*AuthUserSetRateLimitResponse, error)
+
 	// RoleAdd adds a new role to an etcd cluster.
 	RoleAdd(ctx context.Context, name string) (*AuthRoleAddResponse, error)
 
@@ -109,6 +118,10 @@ type Auth interface {
 
 	// RoleDelete deletes a role.
 	RoleDelete(ctx context.Context, role string) (*AuthRoleDeleteResponse, error)
+
+	// RoleSetRateLimit sets the request rate limit shared by the users of a role.
+	// A nil limit removes it.
+	RoleSetRateLimit(ctx context.Context, role string, limit *RateLimit) (*AuthRoleSetRateLimitResponse, error)
 }
 
 type authClient struct {
@@ -192,6 +205,11 @@ func (auth *authClient) UserRevokeRole(ctx context.Context, name string, role st
 	return (*AuthUserRevokeRoleResponse)(resp), toErr(ctx, err)
 }
 
+func (auth *authClient) UserSetRateLimit(ctx context.Context, name string, limit *RateLimit) (*AuthUserSetRateLimitResponse, error) {
+	resp, err := auth.remote.UserSetRateLimit(ctx, &pb.AuthUserSetRateLimitRequest{Name: name, RateLimit: (*authpb.RateLimit)(limit)}, auth.callOpts...)
+	return (*AuthUserSetRateLimitResponse)(resp), toErr(ctx, err)
+}
+
 func (auth *authClient) RoleAdd(ctx context.Context, name string) (*AuthRoleAddResponse, error) {
 	resp, err := auth.remote.RoleAdd(ctx, &pb.AuthRoleAddRequest{Name: name}, auth.callOpts...)
 	return (*AuthRoleAddResponse)(resp), toErr(ctx, err)
@@ -227,6 +245,11 @@ func (auth *authClient) RoleDelete(ctx context.Context, role string) (*AuthRoleD
 	return (*AuthRoleDeleteResponse)(resp), toErr(ctx, err)
 }
 
+func (auth *authClient) RoleSetRateLimit(ctx context.Context, role string, limit *RateLimit) (*AuthRoleSetRateLimitResponse, error) {
+	resp, err := auth.remote.RoleSetRateLimit(ctx, &pb.AuthRoleSetRateLimitRequest{Role: role, RateLimit: (*authpb.RateLimit)(limit)}, auth.callOpts...)
+	return (*AuthRoleSetRateLimitResponse)(resp), toErr(ctx, err)
+}
+
 func StrToPermissionType(s string) (PermissionType, error) {
 	val, ok := authpb.Permission_Type_value[strings.ToUpper(s)]
 	if ok {
@@ -290,6 +290,14 @@ func (mc *mockCluster) MemberAddAsLearner(ctx context.Context, peerAddrs []strin
 	return nil, nil
 }
 
+func (mc *mockCluster) MemberAddAsAutoPromotingLearner(ctx context.Context, peerAddrs []string) (*MemberAddResponse, error) {
+	return nil, nil
+}
+
+func (mc *mockCluster) MemberAddAsWitness(ctx context.Context, peerAddrs []string) (*MemberAddResponse, error) {
+	return nil, nil
+}
+
 func (mc *mockCluster) MemberRemove(ctx context.Context, id uint64) (*MemberRemoveResponse, error) {
 	return nil, nil
 }
@@ -301,3 +309,11 @@ func (mc *mockCluster) MemberUpdate(ctx context.Context, id uint64, peerAddrs []
 func (mc *mockCluster) MemberPromote(ctx context.Context, id uint64) (*MemberPromoteResponse, error) {
 	return nil, nil
 }
+
+func (mc *mockCluster) MemberSetLeaderPriority(ctx context.Context, id uint64, priority uint64) (*MemberSetLeaderPriorityResponse, error) {
+	return nil, nil
+}
+
+func (mc *mockCluster) MemberReconfigure(ctx context.Context, ops ...ReconfigureOp) (*MemberReconfigureResponse, error) {
+	return nil, nil
+}
@@ -24,12 +24,14 @@ import (
 )
 
 type (
-	Member                pb.Member
-	MemberListResponse    pb.MemberListResponse
-	MemberAddResponse     pb.MemberAddResponse
-	MemberRemoveResponse  pb.MemberRemoveResponse
-	MemberUpdateResponse  pb.MemberUpdateResponse
-	MemberPromoteResponse pb.MemberPromoteResponse
+	Member                          pb.Member
+	MemberListResponse              pb.MemberListResponse
+	MemberAddResponse               pb.MemberAddResponse
+	MemberRemoveResponse            pb.MemberRemoveResponse
+	MemberUpdateResponse            pb.MemberUpdateResponse
+	MemberPromoteResponse           pb.MemberPromoteResponse
+	MemberSetLeaderPriorityResponse pb.MemberSetLeaderPriorityResponse
+	MemberReconfigureResponse       pb.MemberReconfigureResponse
 )
 
 type Cluster interface {
@@ -42,6 +44,14 @@ type Cluster interface {
 	// MemberAddAsLearner adds a new learner member into the cluster.
 	MemberAddAsLearner(ctx context.Context, peerAddrs []string) (*MemberAddResponse, error)
 
+	// MemberAddAsAutoPromotingLearner adds a new learner member into the cluster that the
+	// leader promotes to a voting member once it has caught up.
+	MemberAddAsAutoPromotingLearner(ctx context.Context, peerAddrs []string) (*MemberAddResponse, error)
+
+	// MemberAddAsWitness adds a new witness member into the cluster. A witness
+	// votes in elections and commits but stores no key-value data.
+	MemberAddAsWitness(ctx context.Context, peerAddrs []string) (*MemberAddResponse, error)
+
 	// MemberRemove removes an existing member from the cluster.
 	MemberRemove(ctx context.Context, id uint64) (*MemberRemoveResponse, error)
 
@@ -50,6 +60,49 @@ type Cluster interface {
 
 	// MemberPromote promotes a member from raft learner (non-voting) to raft voting member.
 	MemberPromote(ctx context.Context, id uint64) (*MemberPromoteResponse, error)
+
+	// MemberSetLeaderPriority sets the leader priority of a voting member. The leader
+	// moves leadership to the healthy, caught-up voting member with the highest priority.
+	MemberSetLeaderPriority(ctx context.Context, id uint64, priority uint64) (*MemberSetLeaderPriorityResponse, error)
+
+	// MemberReconfigure atomically applies the given member additions, removals and
+	// promotions through a joint consensus configuration. It is rejected until the
+	// cluster version reaches v3.6.
+	MemberReconfigure(ctx context.Context, ops ...ReconfigureOp) (*MemberReconfigureResponse, error)
+}
+
+// ReconfigureOp is a single member change applied by MemberReconfigure.
+type ReconfigureOp func(*pb.MemberReconfigureRequest)
+
+// ReconfigureAdd adds a new voting member with the given peer addresses.
+func ReconfigureAdd(peerAddrs []string) ReconfigureOp {
+	return func(r *pb.MemberReconfigureRequest) {
+		r.Add = append(r.Add, &pb.MemberAddRequest{PeerURLs: peerAddrs})
+	}
+}
+
+// ReconfigureAddAsLearner adds a new learner member with the given peer addresses.
+func ReconfigureAddAsLearner(peerAddrs []string) ReconfigureOp {
+	return func(r *pb.MemberReconfigureRequest) {
+		r.Add = append(r.Add, &pb.MemberAddRequest{PeerURLs: peerAddrs, IsLearner: true})
+	}
+}
+
+// ReconfigureAddAsWitness adds a new witness member with the given peer addresses.
+func ReconfigureAddAsWitness(peerAddrs []string) ReconfigureOp {
+	return func(r *pb.MemberReconfigureRequest) {
+		r.Add = append(r.Add, &pb.MemberAddRequest{PeerURLs: peerAddrs, IsWitness: true})
+	}
+}
+
+// ReconfigureRemove removes the member with the given ID.
+func ReconfigureRemove(id uint64) ReconfigureOp {
+	return func(r *pb.MemberReconfigureRequest) { r.Remove = append(r.Remove, id) }
+}
+
+// ReconfigurePromote promotes the learner member with the given ID to a voting member.
+func ReconfigurePromote(id uint64) ReconfigureOp {
+	return func(r *pb.MemberReconfigureRequest) { r.Promote = append(r.Promote, id) }
 }
 
 type cluster struct {
@@ -74,23 +127,27 @@ func NewClusterFromClusterClient(remote pb.ClusterClient, c *Client) Cluster {
 }
 
 func (c *cluster) MemberAdd(ctx context.Context, peerAddrs []string) (*MemberAddResponse, error) {
-	return c.memberAdd(ctx, peerAddrs, false)
+	return c.memberAdd(ctx, &pb.MemberAddRequest{PeerURLs: peerAddrs})
 }
 
 func (c *cluster) MemberAddAsLearner(ctx context.Context, peerAddrs []string) (*MemberAddResponse, error) {
-	return c.memberAdd(ctx, peerAddrs, true)
+	return c.memberAdd(ctx, &pb.MemberAddRequest{PeerURLs: peerAddrs, IsLearner: true})
+}
+
+func (c *cluster) MemberAddAsAutoPromotingLearner(ctx context.Context, peerAddrs []string) (*MemberAddResponse, error) {
+	return c.memberAdd(ctx, &pb.MemberAddRequest{PeerURLs: peerAddrs, IsLearner: true, AutoPromote: true})
+}
+
+func (c *cluster) MemberAddAsWitness(ctx context.Context, peerAddrs []string) (*MemberAddResponse, error) {
+	return c.memberAdd(ctx, &pb.MemberAddRequest{PeerURLs: peerAddrs, IsWitness: true})
 }
 
-func (c *cluster) memberAdd(ctx context.Context, peerAddrs []string, isLearner bool) (*MemberAddResponse, error) {
+func (c *cluster) memberAdd(ctx context.Context, r *pb.MemberAddRequest) (*MemberAddResponse, error) {
 	// fail-fast before panic in rafthttp
-	if _, err := types.NewURLs(peerAddrs); err != nil {
+	if _, err := types.NewURLs(r.PeerURLs); err != nil {
 		return nil, err
 	}
 
-	r := &pb.MemberAddRequest{
-		PeerURLs:  peerAddrs,
-		IsLearner: isLearner,
-	}
 	resp, err := c.remote.MemberAdd(ctx, r, c.callOpts...)
 	if err != nil {
 		return nil, toErr(ctx, err)
@@ -139,3 +196,32 @@ func (c *cluster) MemberPromote(ctx context.Context, id uint64) (*MemberPromoteR
 	}
 	return (*MemberPromoteResponse)(resp), nil
 }
+
+func (c *cluster) MemberSetLeaderPriority(ctx context.Context, id uint64, priority uint64) (*MemberSetLeaderPriorityResponse, error) {
+	// it is safe to retry on setting the priority.
+	r := &pb.MemberSetLeaderPriorityRequest{ID: id, LeaderPriority: priority}
+	resp, err := c.remote.MemberSetLeaderPriority(ctx, r, c.callOpts...)
+	if err != nil {
+		return nil, toErr(ctx, err)
+	}
+	return (*MemberSetLeaderPriorityResponse)(resp), nil
+}
+
+func (c *cluster) MemberReconfigure(ctx context.Context, ops ...ReconfigureOp) (*MemberReconfigureResponse, error) {
+	r := &pb.MemberReconfigureRequest{}
+	for _, op := range ops {
+		op(r)
+	}
+	// fail-fast before panic in rafthttp
+	for _, a := range r.Add {
+		if _, err := types.NewURLs(a.PeerURLs); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := c.remote.MemberReconfigure(ctx, r, c.callOpts...)
+	if err != nil {
+		return nil, toErr(ctx, err)
+	}
+	return (*MemberReconfigureResponse)(resp), nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrRangeNotContinuable = errors.New("etcdclient: range is not sorted by ascending key and cannot be paginated")
+
+// RangeIterator pages through the keys of a range using continue tokens.
+// All pages are read at the revision of the first page.
+//
+//	it := clientv3.NewRangeIterator(cli, "foo", 100, clientv3.WithPrefix())
+//	for it.Next(ctx) {
+//		for _, kv := range it.Response().Kvs {
+//			...
+//		}
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type RangeIterator struct {
+	kv       KV
+	key      string
+	pageSize int64
+	opts     []OpOption
+
+	resp  *GetResponse
+	token []byte
+	done  bool
+	err   error
+}
+
+// NewRangeIterator returns an iterator over the keys given by key and opts that
+// fetches up to pageSize keys per page. The options must not sort the keys in
+// any other order than ascending by key.
+func NewRangeIterator(kv KV, key string, pageSize int64, opts ...OpOption) *RangeIterator {
+	return &RangeIterator{kv: kv, key: key, pageSize: pageSize, opts: opts}
+}
+
+// Next fetches the next page. It returns false when there are no more pages
+// or the page could not be fetched, in which case Err returns the error.
+func (it *RangeIterator) Next(ctx context.Context) bool {
+	if it.done || it.err != nil {
+		return false
+	}
+	opts := make([]OpOption, 0, len(it.opts)+2)
+	opts = append(opts, it.opts...)
+	opts = append(opts, WithLimit(it.pageSize), WithContinueToken(it.token))
+	resp, err := it.kv.Get(ctx, it.key, opts...)
+	if err != nil {
+		it.err = err
+		return false
+	}
+	if resp.More && len(resp.ContinueToken) == 0 {
+		it.err = ErrRangeNotContinuable
+		return false
+	}
+	it.resp, it.token, it.done = resp, resp.ContinueToken, !resp.More
+	return true
+}
+
+// Response returns the page fetched by the last call to Next.
+func (it *RangeIterator) Response() *GetResponse { return it.resp }
+
+// Err returns the error that stopped the iteration, if any.
+func (it *RangeIterator) Err() error { return it.err }
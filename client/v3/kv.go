@@ -16,6 +16,8 @@ package clientv3
 
 import (
 	"context"
+	"io"
+
 	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
@@ -46,8 +48,19 @@ type KV interface {
 	// if the required revision is compacted, the request will fail with ErrCompacted .
 	// When passed WithLimit(limit), the number of returned keys is bounded by limit.
 	// When passed WithSort(), the keys will be sorted.
+	// When passed WithContinueToken(token), Get returns the page following the
+	// response the token was taken from; if the revision of that response is
+	// compacted, the request will fail with ErrExpiredContinueToken.
 	Get(ctx context.Context, key string, opts ...OpOption) (*GetResponse, error)
 
+	// GetStream retrieves keys like Get, but the server sends them back in
+	// chunks read at a single revision instead of in one response. This
+	// bounds the memory used on both ends when fetching very large ranges.
+	// Only the last chunk has More and Count set. WithSort() is only allowed
+	// in ascending key order, otherwise the stream fails with
+	// ErrInvalidSortOption.
+	GetStream(ctx context.Context, key string, opts ...OpOption) (RangeStream, error)
+
 	// Delete deletes a key, or optionally using WithRange(end), [key, end).
 	Delete(ctx context.Context, key string, opts ...OpOption) (*DeleteResponse, error)
 
@@ -65,6 +78,13 @@ type KV interface {
 	Txn(ctx context.Context) Txn
 }
 
+// RangeStream receives the chunks of a range requested with GetStream.
+type RangeStream interface {
+	// Recv returns the next chunk of the range. It returns io.EOF
+	// after the last chunk was received.
+	Recv() (*GetResponse, error)
+}
+
 type OpResponse struct {
 	put *PutResponse
 	get *GetResponse
@@ -121,6 +141,34 @@ func (kv *kv) Get(ctx context.Context, key string, opts ...OpOption) (*GetRespon
 	return r.get, toErr(ctx, err)
 }
 
+func (kv *kv) GetStream(ctx context.Context, key string, opts ...OpOption) (RangeStream, error) {
+	op := OpGet(key, opts...)
+	if !op.IsSortOptionValid() {
+		return nil, rpctypes.ErrInvalidSortOption
+	}
+	stream, err := kv.remote.RangeStream(ctx, op.toRangeRequest(), kv.callOpts...)
+	if err != nil {
+		return nil, toErr(ctx, err)
+	}
+	return &rangeStream{ctx: ctx, stream: stream}, nil
+}
+
+type rangeStream struct {
+	ctx    context.Context
+	stream pb.KV_RangeStreamClient
+}
+
+func (rs *rangeStream) Recv() (*GetResponse, error) {
+	resp, err := rs.stream.Recv()
+	if err != nil {
+		if err == io.EOF {
+			return nil, err
+		}
+		return nil, toErr(rs.ctx, err)
+	}
+	return (*GetResponse)(resp.RangeResponse), nil
+}
+
 func (kv *kv) Delete(ctx context.Context, key string, opts ...OpOption) (*DeleteResponse, error) {
 	r, err := kv.Do(ctx, OpDelete(key, opts...))
 	return r.del, toErr(ctx, err)
@@ -157,7 +205,7 @@ func (kv *kv) Do(ctx context.Context, op Op) (OpResponse, error) {
 		}
 	case tPut:
 		var resp *pb.PutResponse
-		r := &pb.PutRequest{Key: op.key, Value: op.val, Lease: int64(op.leaseID), PrevKv: op.prevKV, IgnoreValue: op.ignoreValue, IgnoreLease: op.ignoreLease}
+		r := &pb.PutRequest{Key: op.key, Value: op.val, Lease: int64(op.leaseID), PrevKv: op.prevKV, IgnoreValue: op.ignoreValue, IgnoreLease: op.ignoreLease, Ttl: op.ttl}
 		resp, err = kv.remote.Put(ctx, r, kv.callOpts...)
 		if err == nil {
 			return OpResponse{put: (*PutResponse)(resp)}, nil
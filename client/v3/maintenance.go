@@ -34,6 +34,9 @@ type (
 	MoveLeaderResponse pb.MoveLeaderResponse
 	DowngradeResponse  pb.DowngradeResponse
 
+	PrefixQuotaStatusResponse pb.PrefixQuotaStatusResponse
+	CorruptionCheckResponse   pb.CorruptionCheckResponse
+
 	DowngradeAction pb.DowngradeRequest_DowngradeAction
 )
 
@@ -57,7 +60,8 @@ type Maintenance interface {
 	// at the same time.
 	// To defragment multiple members in the cluster, user need to call defragment multiple
 	// times with different endpoints.
-	Defragment(ctx context.Context, endpoint string) (*DefragmentResponse, error)
+	// WithOnlineDefragment makes the member serve requests while it defragments.
+	Defragment(ctx context.Context, endpoint string, opts ...DefragmentOption) (*DefragmentResponse, error)
 
 	// Status gets the status of the endpoint.
 	Status(ctx context.Context, endpoint string) (*StatusResponse, error)
@@ -86,6 +90,17 @@ type Maintenance interface {
 	// on the cluster version.
 	// Supported since etcd 3.5.
 	Downgrade(ctx context.Context, action DowngradeAction, version string) (*DowngradeResponse, error)
+
+	// PrefixQuotaStatus gets the limits and the current usage of the prefix
+	// quotas configured on the endpoint.
+	// Supported since etcd 3.6.
+	PrefixQuotaStatus(ctx context.Context, endpoint string) (*PrefixQuotaStatusResponse, error)
+
+	// CorruptionCheck compares the keyspaces of the cluster members at the
+	// given revision, or the current one if zero, and returns the keys they
+	// differ on, up to limit keys. If limit is zero, the default is used.
+	// Supported since etcd 3.6.
+	CorruptionCheck(ctx context.Context, rev int64, limit int64) (*CorruptionCheckResponse, error)
 }
 
 // SnapshotResponse is aggregated response from the snapshot stream.
@@ -198,13 +213,27 @@ func (m *maintenance) AlarmDisarm(ctx context.Context, am *AlarmMember) (*AlarmR
 	return nil, toErr(ctx, err)
 }
 
-func (m *maintenance) Defragment(ctx context.Context, endpoint string) (*DefragmentResponse, error) {
+// DefragmentOption configures defragment operation.
+type DefragmentOption func(*pb.DefragmentRequest)
+
+// WithOnlineDefragment makes the member defragment its backend in bounded
+// batches, serving reads and writes in between, instead of blocking them
+// for the whole defragmentation.
+func WithOnlineDefragment() DefragmentOption {
+	return func(r *pb.DefragmentRequest) { r.Mode = pb.DefragmentRequest_ONLINE }
+}
+
+func (m *maintenance) Defragment(ctx context.Context, endpoint string, opts ...DefragmentOption) (*DefragmentResponse, error) {
 	remote, cancel, err := m.dial(endpoint)
 	if err != nil {
 		return nil, toErr(ctx, err)
 	}
 	defer cancel()
-	resp, err := remote.Defragment(ctx, &pb.DefragmentRequest{}, m.callOpts...)
+	req := &pb.DefragmentRequest{}
+	for _, opt := range opts {
+		opt(req)
+	}
+	resp, err := remote.Defragment(ctx, req, m.callOpts...)
 	if err != nil {
 		return nil, toErr(ctx, err)
 	}
@@ -224,6 +253,27 @@ func (m *maintenance) Status(ctx context.Context, endpoint string) (*StatusRespo
 	return (*StatusResponse)(resp), nil
 }
 
+func (m *maintenance) PrefixQuotaStatus(ctx context.Context, endpoint string) (*PrefixQuotaStatusResponse, error) {
+	remote, cancel, err := m.dial(endpoint)
+	if err != nil {
+		return nil, toErr(ctx, err)
+	}
+	defer cancel()
+	resp, err := remote.PrefixQuotaStatus(ctx, &pb.PrefixQuotaStatusRequest{}, m.callOpts...)
+	if err != nil {
+		return nil, toErr(ctx, err)
+	}
+	return (*PrefixQuotaStatusResponse)(resp), nil
+}
+
+func (m *maintenance) CorruptionCheck(ctx context.Context, rev int64, limit int64) (*CorruptionCheckResponse, error) {
+	resp, err := m.remote.CorruptionCheck(ctx, &pb.CorruptionCheckRequest{Revision: rev, Limit: limit}, m.callOpts...)
+	if err != nil {
+		return nil, toErr(ctx, err)
+	}
+	return (*CorruptionCheckResponse)(resp), nil
+}
+
 func (m *maintenance) HashKV(ctx context.Context, endpoint string, rev int64) (*HashKVResponse, error) {
 	remote, cancel, err := m.dial(endpoint)
 	if err != nil {
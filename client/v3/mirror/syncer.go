@@ -17,8 +17,12 @@ package mirror
 
 import (
 	"context"
+	"io"
 
 	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -74,7 +78,7 @@ func (s *syncer) SyncBase(ctx context.Context) (<-chan clientv3.GetResponse, cha
 
 		var key string
 
-		opts := []clientv3.OpOption{clientv3.WithLimit(batchLimit), clientv3.WithRev(s.rev),
+		opts := []clientv3.OpOption{clientv3.WithRev(s.rev),
 			clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend)}
 
 		if len(s.prefix) == 0 {
@@ -90,26 +94,69 @@ func (s *syncer) SyncBase(ctx context.Context) (<-chan clientv3.GetResponse, cha
 			key = s.prefix
 		}
 
-		for {
-			resp, err := s.c.Get(ctx, key, opts...)
-			if err != nil {
-				errchan <- err
-				return
-			}
-
-			respchan <- *resp
-
-			if !resp.More {
-				return
-			}
-			// move to next key
-			key = string(append(resp.Kvs[len(resp.Kvs)-1].Key, 0))
+		err := s.streamBase(ctx, key, opts, respchan)
+		if status.Code(err) == codes.Unimplemented {
+			// the server does not support RangeStream; fetch the base in batches
+			err = s.batchBase(ctx, key, opts, respchan)
+		}
+		if err != nil {
+			errchan <- err
 		}
 	}()
 
 	return respchan, errchan
 }
 
+// streamBase sends the key-values of the range through respchan in the
+// chunks they are streamed in by the server.
+func (s *syncer) streamBase(ctx context.Context, key string, opts []clientv3.OpOption, respchan chan<- clientv3.GetResponse) error {
+	rs, err := s.c.GetStream(ctx, key, opts...)
+	if err != nil {
+		return err
+	}
+	var prev *clientv3.GetResponse
+	for {
+		resp, err := rs.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if prev != nil {
+			// only the last chunk of a stream has More unset, just like
+			// the last batch of a batched range
+			prev.More = true
+			respchan <- *prev
+		}
+		prev = resp
+	}
+	if prev != nil {
+		respchan <- *prev
+	}
+	return nil
+}
+
+// batchBase sends the key-values of the range through respchan, fetching
+// them in batches of batchLimit keys.
+func (s *syncer) batchBase(ctx context.Context, key string, opts []clientv3.OpOption, respchan chan<- clientv3.GetResponse) error {
+	opts = append(opts, clientv3.WithLimit(batchLimit))
+	for {
+		resp, err := s.c.Get(ctx, key, opts...)
+		if err != nil {
+			return err
+		}
+
+		respchan <- *resp
+
+		if !resp.More {
+			return nil
+		}
+		// move to next key
+		key = string(append(resp.Kvs[len(resp.Kvs)-1].Key, 0))
+	}
+}
+
 func (s *syncer) SyncUpdates(ctx context.Context) clientv3.WatchChan {
 	if s.rev == 0 {
 		panic("unexpected revision = 0. Calling SyncUpdates before SyncBase finishes?")
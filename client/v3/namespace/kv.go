@@ -64,6 +64,34 @@ func (kv *kvPrefix) Get(ctx context.Context, key string, opts ...clientv3.OpOpti
 	return get, nil
 }
 
+func (kv *kvPrefix) GetStream(ctx context.Context, key string, opts ...clientv3.OpOption) (clientv3.RangeStream, error) {
+	if len(key) == 0 && !(clientv3.IsOptsWithFromKey(opts) || clientv3.IsOptsWithPrefix(opts)) {
+		return nil, rpctypes.ErrEmptyKey
+	}
+	getOp := kv.prefixOp(clientv3.OpGet(key, opts...))
+	// the prefixed range overrides the one the options set on the prefixed key
+	popts := append(append([]clientv3.OpOption{}, opts...), clientv3.WithRange(string(getOp.RangeBytes())))
+	rs, err := kv.KV.GetStream(ctx, string(getOp.KeyBytes()), popts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rangeStreamPrefix{rs, kv}, nil
+}
+
+type rangeStreamPrefix struct {
+	clientv3.RangeStream
+	kv *kvPrefix
+}
+
+func (rs *rangeStreamPrefix) Recv() (*clientv3.GetResponse, error) {
+	resp, err := rs.RangeStream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	rs.kv.unprefixGetResponse(resp)
+	return resp, nil
+}
+
 func (kv *kvPrefix) Delete(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.DeleteResponse, error) {
 	if len(key) == 0 && !(clientv3.IsOptsWithFromKey(opts) || clientv3.IsOptsWithPrefix(opts)) {
 		return nil, rpctypes.ErrEmptyKey
@@ -35,15 +35,18 @@ type Op struct {
 	end []byte
 
 	// for range
-	limit        int64
-	sort         *SortOption
-	serializable bool
-	keysOnly     bool
-	countOnly    bool
-	minModRev    int64
-	maxModRev    int64
-	minCreateRev int64
-	maxCreateRev int64
+	limit         int64
+	sort          *SortOption
+	serializable  bool
+	keysOnly      bool
+	countOnly     bool
+	minModRev     int64
+	maxModRev     int64
+	minCreateRev  int64
+	maxCreateRev  int64
+	valueFilter   *pb.ValueFilter
+	valueFields   []string
+	continueToken []byte
 
 	// for range, watch
 	rev int64
@@ -66,12 +69,16 @@ type Op struct {
 	// createdNotify is for created event
 	createdNotify bool
 	// filters for watchers
-	filterPut    bool
-	filterDelete bool
+	filterPut         bool
+	filterDelete      bool
+	filterValuePrefix []byte
+	filterLease       LeaseID
+	filterKeyRegex    string
 
 	// for put
 	val     []byte
 	leaseID LeaseID
+	ttl     int64
 
 	// txn
 	cmps    []Cmp
@@ -136,6 +143,15 @@ func (op Op) MinCreateRev() int64 { return op.minCreateRev }
 // MaxCreateRev returns the operation's maximum create revision.
 func (op Op) MaxCreateRev() int64 { return op.maxCreateRev }
 
+// ValueFilter returns the operation's value filter, if any.
+func (op Op) ValueFilter() *pb.ValueFilter { return op.valueFilter }
+
+// ValueFields returns the JSON fields the operation projects values onto, if any.
+func (op Op) ValueFields() []string { return op.valueFields }
+
+// ContinueToken returns the token the operation resumes a paginated range from, if any.
+func (op Op) ContinueToken() []byte { return op.continueToken }
+
 // WithRangeBytes sets the byte slice for the Op's range end.
 func (op *Op) WithRangeBytes(end []byte) { op.end = end }
 
@@ -161,6 +177,11 @@ func (op Op) toRangeRequest() *pb.RangeRequest {
 		MaxModRevision:    op.maxModRev,
 		MinCreateRevision: op.minCreateRev,
 		MaxCreateRevision: op.maxCreateRev,
+		ValueFilter:       op.valueFilter,
+		ContinueToken:     op.continueToken,
+	}
+	if len(op.valueFields) != 0 {
+		r.ValueProjection = &pb.ValueProjection{JsonPaths: op.valueFields}
 	}
 	if op.sort != nil {
 		r.SortOrder = pb.RangeRequest_SortOrder(op.sort.Order)
@@ -190,7 +211,7 @@ func (op Op) toRequestOp() *pb.RequestOp {
 	case tRange:
 		return &pb.RequestOp{Request: &pb.RequestOp_RequestRange{RequestRange: op.toRangeRequest()}}
 	case tPut:
-		r := &pb.PutRequest{Key: op.key, Value: op.val, Lease: int64(op.leaseID), PrevKv: op.prevKV, IgnoreValue: op.ignoreValue, IgnoreLease: op.ignoreLease}
+		r := &pb.PutRequest{Key: op.key, Value: op.val, Lease: int64(op.leaseID), PrevKv: op.prevKV, IgnoreValue: op.ignoreValue, IgnoreLease: op.ignoreLease, Ttl: op.ttl}
 		return &pb.RequestOp{Request: &pb.RequestOp_RequestPut{RequestPut: r}}
 	case tDeleteRange:
 		r := &pb.DeleteRangeRequest{Key: op.key, RangeEnd: op.end, PrevKv: op.prevKV}
@@ -245,6 +266,8 @@ func OpDelete(key string, opts ...OpOption) Op {
 	switch {
 	case ret.leaseID != 0:
 		panic("unexpected lease in delete")
+	case ret.ttl != 0:
+		panic("unexpected ttl in delete")
 	case ret.limit != 0:
 		panic("unexpected limit in delete")
 	case ret.rev != 0:
@@ -259,7 +282,11 @@ func OpDelete(key string, opts ...OpOption) Op {
 		panic("unexpected mod revision filter in delete")
 	case ret.minCreateRev != 0, ret.maxCreateRev != 0:
 		panic("unexpected create revision filter in delete")
-	case ret.filterDelete, ret.filterPut:
+	case ret.valueFilter != nil, len(ret.valueFields) != 0:
+		panic("unexpected value filter in delete")
+	case ret.continueToken != nil:
+		panic("unexpected continue token in delete")
+	case ret.filterDelete, ret.filterPut, ret.filterValuePrefix != nil, ret.filterLease != 0, ret.filterKeyRegex != "":
 		panic("unexpected filter in delete")
 	case ret.createdNotify:
 		panic("unexpected createdNotify in delete")
@@ -288,7 +315,11 @@ func OpPut(key, val string, opts ...OpOption) Op {
 		panic("unexpected mod revision filter in put")
 	case ret.minCreateRev != 0, ret.maxCreateRev != 0:
 		panic("unexpected create revision filter in put")
-	case ret.filterDelete, ret.filterPut:
+	case ret.valueFilter != nil, len(ret.valueFields) != 0:
+		panic("unexpected value filter in put")
+	case ret.continueToken != nil:
+		panic("unexpected continue token in put")
+	case ret.filterDelete, ret.filterPut, ret.filterValuePrefix != nil, ret.filterLease != 0, ret.filterKeyRegex != "":
 		panic("unexpected filter in put")
 	case ret.createdNotify:
 		panic("unexpected createdNotify in put")
@@ -307,6 +338,8 @@ func opWatch(key string, opts ...OpOption) Op {
 	switch {
 	case ret.leaseID != 0:
 		panic("unexpected lease in watch")
+	case ret.ttl != 0:
+		panic("unexpected ttl in watch")
 	case ret.limit != 0:
 		panic("unexpected limit in watch")
 	case ret.sort != nil:
@@ -319,6 +352,10 @@ func opWatch(key string, opts ...OpOption) Op {
 		panic("unexpected mod revision filter in watch")
 	case ret.minCreateRev != 0, ret.maxCreateRev != 0:
 		panic("unexpected create revision filter in watch")
+	case ret.valueFilter != nil, len(ret.valueFields) != 0:
+		panic("unexpected value filter in watch")
+	case ret.continueToken != nil:
+		panic("unexpected continue token in watch")
 	}
 	return ret
 }
@@ -337,6 +374,14 @@ func WithLease(leaseID LeaseID) OpOption {
 	return func(op *Op) { op.leaseID = leaseID }
 }
 
+// WithTTL sets the time-to-live in seconds of a key in 'Put' request. The
+// server attaches the key to a lease of its own, so the key expires without
+// the caller granting or keeping alive a lease. The option can not be
+// combined with WithLease or WithIgnoreLease.
+func WithTTL(ttl int64) OpOption {
+	return func(op *Op) { op.ttl = ttl }
+}
+
 // WithLimit limits the number of results to return from 'Get' request.
 // If WithLimit is given a 0 limit, it is treated as no limit.
 func WithLimit(n int64) OpOption { return func(op *Op) { op.limit = n } }
@@ -448,6 +493,47 @@ func WithMinCreateRev(rev int64) OpOption { return func(op *Op) { op.minCreateRe
 // WithMaxCreateRev filters out keys for Get with creation revisions greater than the given revision.
 func WithMaxCreateRev(rev int64) OpOption { return func(op *Op) { op.maxCreateRev = rev } }
 
+// WithValuePrefix filters out keys for Get whose values do not begin with the given prefix.
+func WithValuePrefix(prefix string) OpOption {
+	return withValueFilter(pb.ValueFilter_PREFIX, prefix, "")
+}
+
+// WithValueEqual filters out keys for Get whose values are not equal to the given value.
+func WithValueEqual(val string) OpOption {
+	return withValueFilter(pb.ValueFilter_EQUAL, val, "")
+}
+
+// WithValueRegex filters out keys for Get whose values do not match the given
+// RE2 regular expression.
+func WithValueRegex(expr string) OpOption {
+	return withValueFilter(pb.ValueFilter_REGEX, expr, "")
+}
+
+// WithValueJSONField filters out keys for Get whose values are not JSON objects
+// holding the given value at the dot separated field path (e.g., "metadata.name").
+func WithValueJSONField(path, val string) OpOption {
+	return withValueFilter(pb.ValueFilter_JSON_FIELD, val, path)
+}
+
+func withValueFilter(t pb.ValueFilter_FilterType, pattern, path string) OpOption {
+	return func(op *Op) {
+		op.valueFilter = &pb.ValueFilter{Type: t, Pattern: []byte(pattern), JsonPath: path}
+	}
+}
+
+// WithValueFields makes the 'Get' request return only the given dot separated
+// fields of JSON object values. Values that are not JSON objects are returned whole.
+func WithValueFields(paths ...string) OpOption {
+	return func(op *Op) { op.valueFields = paths }
+}
+
+// WithContinueToken makes 'Get' resume a paginated range from the continue
+// token of a previous response. The page is read at the revision of the
+// response the token was taken from. Use with WithLimit to set the page size.
+func WithContinueToken(token []byte) OpOption {
+	return func(op *Op) { op.continueToken = token }
+}
+
 // WithFirstCreate gets the key with the oldest creation revision in the request range.
 func WithFirstCreate() []OpOption { return withTop(SortByCreateRevision, SortAscend) }
 
@@ -497,6 +583,25 @@ func WithFilterDelete() OpOption {
 	return func(op *Op) { op.filterDelete = true }
 }
 
+// WithFilterValuePrefix discards PUT events whose values do not begin with
+// the given prefix from the watcher.
+func WithFilterValuePrefix(prefix string) OpOption {
+	return func(op *Op) { op.filterValuePrefix = []byte(prefix) }
+}
+
+// WithFilterLease discards events on keys that are not attached to the
+// given lease from the watcher. DELETE events are discarded if the deleted
+// key was not attached to the lease.
+func WithFilterLease(leaseID LeaseID) OpOption {
+	return func(op *Op) { op.filterLease = leaseID }
+}
+
+// WithFilterKeyRegex discards events on keys that do not match the given
+// RE2 regular expression from the watcher.
+func WithFilterKeyRegex(expr string) OpOption {
+	return func(op *Op) { op.filterKeyRegex = expr }
+}
+
 // WithPrevKV gets the previous key-value pair before the event happens. If the previous KV is already compacted,
 // nothing will be returned.
 func WithPrevKV() OpOption {
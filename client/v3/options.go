@@ -54,6 +54,10 @@ var (
 
 	// client-side retry backoff default jitter fraction.
 	defaultBackoffJitterFraction = 0.10
+
+	// client-side upper bound of the wait between the retries of a request
+	// rejected by the rate limit of its user.
+	maxRateLimitBackoff = 2 * time.Second
 )
 
 // defaultCallOpts defines a list of default "gRPC.CallOption".
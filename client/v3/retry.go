@@ -51,6 +51,10 @@ func (rp retryPolicy) String() string {
 // Returning "false" means retry should stop, since client cannot
 // handle itself even with retries.
 func isSafeRetryImmutableRPC(err error) bool {
+	if isRateLimitError(err) {
+		// the rate limit refills, so the request may be served later
+		return true
+	}
 	eErr := rpctypes.Error(err)
 	if serverErr, ok := eErr.(rpctypes.EtcdError); ok && serverErr.Code() != codes.Unavailable {
 		// interrupted by non-transient server-side or gRPC-side error
@@ -105,6 +109,12 @@ func (rkv *retryKVClient) Range(ctx context.Context, in *pb.RangeRequest, opts .
 	return rkv.kc.Range(ctx, in, append(opts, withRetryPolicy(repeatable))...)
 }
 
+// RangeStream is not retried, since retrying a broken stream would resend
+// the chunks that were already received.
+func (rkv *retryKVClient) RangeStream(ctx context.Context, in *pb.RangeRequest, opts ...grpc.CallOption) (stream pb.KV_RangeStreamClient, err error) {
+	return rkv.kc.RangeStream(ctx, in, opts...)
+}
+
 func (rkv *retryKVClient) Put(ctx context.Context, in *pb.PutRequest, opts ...grpc.CallOption) (resp *pb.PutResponse, err error) {
 	return rkv.kc.Put(ctx, in, opts...)
 }
@@ -183,6 +193,14 @@ func (rcc *retryClusterClient) MemberPromote(ctx context.Context, in *pb.MemberP
 	return rcc.cc.MemberPromote(ctx, in, opts...)
 }
 
+func (rcc *retryClusterClient) MemberSetLeaderPriority(ctx context.Context, in *pb.MemberSetLeaderPriorityRequest, opts ...grpc.CallOption) (resp *pb.MemberSetLeaderPriorityResponse, err error) {
+	return rcc.cc.MemberSetLeaderPriority(ctx, in, append(opts, withRetryPolicy(repeatable))...)
+}
+
+func (rcc *retryClusterClient) MemberReconfigure(ctx context.Context, in *pb.MemberReconfigureRequest, opts ...grpc.CallOption) (resp *pb.MemberReconfigureResponse, err error) {
+	return rcc.cc.MemberReconfigure(ctx, in, opts...)
+}
+
 type retryMaintenanceClient struct {
 	mc pb.MaintenanceClient
 }
@@ -222,6 +240,14 @@ func (rmc *retryMaintenanceClient) Defragment(ctx context.Context, in *pb.Defrag
 	return rmc.mc.Defragment(ctx, in, opts...)
 }
 
+func (rmc *retryMaintenanceClient) PrefixQuotaStatus(ctx context.Context, in *pb.PrefixQuotaStatusRequest, opts ...grpc.CallOption) (resp *pb.PrefixQuotaStatusResponse, err error) {
+	return rmc.mc.PrefixQuotaStatus(ctx, in, append(opts, withRetryPolicy(repeatable))...)
+}
+
+func (rmc *retryMaintenanceClient) CorruptionCheck(ctx context.Context, in *pb.CorruptionCheckRequest, opts ...grpc.CallOption) (resp *pb.CorruptionCheckResponse, err error) {
+	return rmc.mc.CorruptionCheck(ctx, in, append(opts, withRetryPolicy(repeatable))...)
+}
+
 func (rmc *retryMaintenanceClient) Downgrade(ctx context.Context, in *pb.DowngradeRequest, opts ...grpc.CallOption) (resp *pb.DowngradeResponse, err error) {
 	return rmc.mc.Downgrade(ctx, in, opts...)
 }
@@ -285,6 +311,10 @@ func (rac *retryAuthClient) UserRevokeRole(ctx context.Context, in *pb.AuthUserR
 	return rac.ac.UserRevokeRole(ctx, in, opts...)
 }
 
+func (rac *retryAuthClient) UserSetRateLimit(ctx context.Context, in *pb.AuthUserSetRateLimitRequest, opts ...grpc.CallOption) (resp *pb.AuthUserSetRateLimitResponse, err error) {
+	return rac.ac.UserSetRateLimit(ctx, in, opts...)
+}
+
 func (rac *retryAuthClient) RoleAdd(ctx context.Context, in *pb.AuthRoleAddRequest, opts ...grpc.CallOption) (resp *pb.AuthRoleAddResponse, err error) {
 	return rac.ac.RoleAdd(ctx, in, opts...)
 }
@@ -301,6 +331,10 @@ func (rac *retryAuthClient) RoleRevokePermission(ctx context.Context, in *pb.Aut
 	return rac.ac.RoleRevokePermission(ctx, in, opts...)
 }
 
+func (rac *retryAuthClient) RoleSetRateLimit(ctx context.Context, in *pb.AuthRoleSetRateLimitRequest, opts ...grpc.CallOption) (resp *pb.AuthRoleSetRateLimitResponse, err error) {
+	return rac.ac.RoleSetRateLimit(ctx, in, opts...)
+}
+
 func (rac *retryAuthClient) Authenticate(ctx context.Context, in *pb.AuthenticateRequest, opts ...grpc.CallOption) (resp *pb.AuthenticateResponse, err error) {
 	return rac.ac.Authenticate(ctx, in, opts...)
 }
@@ -48,7 +48,7 @@ func (c *Client) unaryClientInterceptor(optFuncs ...retryOption) grpc.UnaryClien
 		}
 		var lastErr error
 		for attempt := uint(0); attempt < callOpts.max; attempt++ {
-			if err := waitRetryBackoff(ctx, attempt, callOpts); err != nil {
+			if err := waitRetryBackoff(ctx, attempt, callOpts, lastErr); err != nil {
 				return err
 			}
 			c.GetLogger().Debug(
@@ -221,7 +221,7 @@ func (s *serverStreamingRetryingStream) RecvMsg(m interface{}) error {
 
 	// We start off from attempt 1, because zeroth was already made on normal SendMsg().
 	for attempt := uint(1); attempt < s.callOpts.max; attempt++ {
-		if err := waitRetryBackoff(s.ctx, attempt, s.callOpts); err != nil {
+		if err := waitRetryBackoff(s.ctx, attempt, s.callOpts, lastErr); err != nil {
 			return err
 		}
 		newStream, err := s.reestablishStreamAndResendBuffer(s.ctx)
@@ -295,11 +295,18 @@ func (s *serverStreamingRetryingStream) reestablishStreamAndResendBuffer(callCtx
 	return newStream, nil
 }
 
-func waitRetryBackoff(ctx context.Context, attempt uint, callOpts *options) error {
+func waitRetryBackoff(ctx context.Context, attempt uint, callOpts *options, lastErr error) error {
 	waitTime := time.Duration(0)
 	if attempt > 0 {
 		waitTime = callOpts.backoffFunc(attempt)
 	}
+	if isRateLimitError(lastErr) {
+		// give the rate limit of the user time to refill, even when the
+		// backoff would move on to the next endpoint right away.
+		if rw := rateLimitBackoff(attempt); rw > waitTime {
+			waitTime = rw
+		}
+	}
 	if waitTime > 0 {
 		timer := time.NewTimer(waitTime)
 		select {
@@ -327,6 +334,10 @@ func isSafeRetry(c *Client, err error, callOpts *options) bool {
 	if errors.Is(err, rpctypes.ErrGRPCNotSupportedForLearner) && len(c.Endpoints()) > 1 {
 		return true
 	}
+	// The same holds for witnesses, which serve no key-value data at all.
+	if errors.Is(err, rpctypes.ErrGRPCNotSupportedForWitness) && len(c.Endpoints()) > 1 {
+		return true
+	}
 
 	switch callOpts.retryPolicy {
 	case repeatable:
@@ -339,6 +350,23 @@ func isSafeRetry(c *Client, err error, callOpts *options) bool {
 	}
 }
 
+func isRateLimitError(err error) bool {
+	return err != nil && rpctypes.Error(err) == rpctypes.ErrRateLimitExceeded
+}
+
+// rateLimitBackoff doubles the wait between the retries of a rate limited
+// request, up to maxRateLimitBackoff.
+func rateLimitBackoff(attempt uint) time.Duration {
+	wait := defaultBackoffWaitBetween
+	for i := uint(0); i < attempt && wait < maxRateLimitBackoff; i++ {
+		wait *= 2
+	}
+	if wait > maxRateLimitBackoff {
+		wait = maxRateLimitBackoff
+	}
+	return jitterUp(wait, defaultBackoffJitterFraction)
+}
+
 func isContextError(err error) bool {
 	return status.Code(err) == codes.DeadlineExceeded || status.Code(err) == codes.Canceled
 }
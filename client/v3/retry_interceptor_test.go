@@ -3,8 +3,10 @@ package clientv3
 import (
 	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	"go.etcd.io/etcd/client/v3/credentials"
+	"go.uber.org/zap"
 	grpccredentials "google.golang.org/grpc/credentials"
 	"testing"
+	"time"
 )
 
 type dummyAuthTokenBundle struct{}
@@ -122,3 +124,28 @@ func TestClientShouldRefreshToken(t *testing.T) {
 		})
 	}
 }
+
+func TestRetryRateLimited(t *testing.T) {
+	c := &Client{lg: zap.NewNop()}
+	if !isSafeRetry(c, rpctypes.ErrGRPCRateLimitExceeded, &options{retryPolicy: repeatable}) {
+		t.Errorf("expected rate limited repeatable request to be retried")
+	}
+	if isSafeRetry(c, rpctypes.ErrGRPCRateLimitExceeded, &options{retryPolicy: nonRepeatable}) {
+		t.Errorf("expected rate limited non-repeatable request not to be retried")
+	}
+	if isSafeRetry(c, rpctypes.ErrGRPCPrefixQuotaExceeded, &options{retryPolicy: repeatable}) {
+		t.Errorf("expected request over quota not to be retried")
+	}
+
+	prev := time.Duration(0)
+	for attempt := uint(0); attempt < 10; attempt++ {
+		wait := rateLimitBackoff(attempt)
+		if wait > time.Duration(float64(maxRateLimitBackoff)*(1+defaultBackoffJitterFraction)) {
+			t.Fatalf("attempt %d: backoff %v exceeds the maximum", attempt, wait)
+		}
+		if wait < time.Duration(float64(prev)*(1-defaultBackoffJitterFraction)/(1+defaultBackoffJitterFraction)) {
+			t.Errorf("attempt %d: backoff %v is shorter than the previous %v", attempt, wait, prev)
+		}
+		prev = wait
+	}
+}
@@ -0,0 +1,418 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	"go.etcd.io/etcd/client/pkg/v3/fileutil"
+	"go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+)
+
+// A continuous backup is a directory holding a base snapshot and the
+// segment files of the changes committed after it. A segment is named
+// after the first revision it may hold, and is a sequence of records of
+// one revision each, so that a record torn by a crash never leaves part of
+// a txn behind:
+//
+//	uint32 length of the payload, little endian
+//	uint32 CRC-32C of the payload, little endian
+//	payload: int64 receive time in unix nanoseconds, then for each
+//	mvccpb.Event of the revision, its uint32 length and the event
+const (
+	backupSnapshotName = "snapshot.db"
+	backupSegmentExt   = ".seg"
+
+	backupRecordHeaderSize = 8
+)
+
+var (
+	// backupSegmentBytes is the size a segment is cut at.
+	backupSegmentBytes int64 = 64 * 1024 * 1024
+
+	crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+	// ErrBackupCorrupt is returned when a segment of a backup fails its
+	// checksum, or a segment other than the last one is torn.
+	ErrBackupCorrupt = errors.New("snapshot: backup segment is corrupt")
+	// ErrBackupEncryptedAtRest is returned when a continuous backup is
+	// requested to a member that encrypts its data at rest, since the
+	// segments would hold its changes in plaintext.
+	ErrBackupEncryptedAtRest = errors.New("snapshot: continuous backups are not encrypted and cannot be taken of a member encrypting its data at rest")
+)
+
+// BackupRecord is a revision saved by a continuous backup.
+type BackupRecord struct {
+	// Time is when the backup received the revision.
+	Time time.Time
+	// Revision is the revision the events were committed at.
+	Revision int64
+	// Events are the changes of the revision, in the order they were
+	// committed.
+	Events []*mvccpb.Event
+}
+
+// BackupSnapshotPath returns the path of the base snapshot of the backup
+// in dir.
+func BackupSnapshotPath(dir string) string {
+	return filepath.Join(dir, backupSnapshotName)
+}
+
+// StreamBackup saves a base snapshot into dir, and then appends the changes
+// committed after it to segment files in dir, until the context "ctx" is
+// canceled or the watch of the changes fails, e.g. because the revisions to
+// continue from have been compacted. A backup left in dir by an earlier
+// StreamBackup is continued. Make sure to specify only one endpoint in
+// client configuration, the base snapshot and the changes are requested to
+// the selected node. The segments are not encrypted, so ErrBackupEncryptedAtRest
+// is returned if the node encrypts its data at rest.
+func StreamBackup(ctx context.Context, lg *zap.Logger, cfg clientv3.Config, dir string) error {
+	if len(cfg.Endpoints) != 1 {
+		return fmt.Errorf("backup must be requested to one selected node, not multiple %v", cfg.Endpoints)
+	}
+	if err := fileutil.TouchDirAll(lg, dir); err != nil {
+		return err
+	}
+	cfg.Logger = lg.Named("client")
+	cli, err := clientv3.New(cfg)
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	resp, err := cli.Status(ctx, cfg.Endpoints[0])
+	if err != nil {
+		return err
+	}
+	if resp.EncryptedAtRest {
+		return ErrBackupEncryptedAtRest
+	}
+
+	segs, err := backupSegments(dir)
+	if err != nil {
+		return err
+	}
+	snapPath := BackupSnapshotPath(dir)
+	var next int64
+	if !fileutil.Exist(snapPath) {
+		// the segments, if any, were created by a backup that failed to
+		// save its base snapshot
+		for _, seg := range segs {
+			if err = os.Remove(seg.path); err != nil {
+				return err
+			}
+		}
+		// the snapshot is taken at this revision or later, restore skips the
+		// changes it already holds
+		next = resp.Header.Revision + 1
+		sf, err := createBackupSegment(dir, next)
+		if err != nil {
+			return err
+		}
+		sf.Close()
+		if _, err = SaveWithVersion(ctx, lg, cfg, snapPath); err != nil {
+			return err
+		}
+		segs, err = backupSegments(dir)
+		if err != nil {
+			return err
+		}
+	} else if len(segs) == 0 {
+		return fmt.Errorf("backup %q has a snapshot but no segments", dir)
+	}
+
+	last := segs[len(segs)-1]
+	f, next, err := openLastBackupSegment(last)
+	if err != nil {
+		return err
+	}
+	defer func() { f.Close() }()
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	lg.Info("streaming backup", zap.String("dir", dir), zap.Int64("from-revision", next))
+
+	wch := cli.Watch(clientv3.WithRequireLeader(ctx), "", clientv3.WithPrefix(), clientv3.WithRev(next))
+	for wresp := range wch {
+		if err = wresp.Err(); err != nil {
+			if errors.Is(err, rpctypes.ErrCompacted) {
+				return fmt.Errorf("revision %d to continue the backup from has been compacted, start a new backup (%v)", next, err)
+			}
+			return err
+		}
+		if len(wresp.Events) == 0 {
+			continue
+		}
+		if size >= backupSegmentBytes {
+			// cut a new segment at the revision of the response
+			rev := wresp.Events[0].Kv.ModRevision
+			if err = f.Close(); err != nil {
+				return err
+			}
+			if f, err = createBackupSegment(dir, rev); err != nil {
+				return err
+			}
+			size = 0
+			lg.Info("cut backup segment", zap.String("path", f.Name()), zap.Int64("revision", rev))
+		}
+		// a watch response holds whole revisions, as long as it is not
+		// fragmented
+		if rev := wresp.Events[0].Kv.ModRevision; rev < next {
+			return fmt.Errorf("watch response continues revision %d, which has been saved", rev)
+		}
+		now := time.Now()
+		w := bufio.NewWriter(f)
+		for evs := wresp.Events; len(evs) > 0; {
+			rev := evs[0].Kv.ModRevision
+			i := 1
+			for i < len(evs) && evs[i].Kv.ModRevision == rev {
+				i++
+			}
+			rec := BackupRecord{Time: now, Revision: rev, Events: make([]*mvccpb.Event, i)}
+			for j := range rec.Events {
+				rec.Events[j] = (*mvccpb.Event)(evs[j])
+			}
+			n, err := writeBackupRecord(w, rec)
+			if err != nil {
+				return err
+			}
+			size += int64(n)
+			next = rev + 1
+			evs = evs[i:]
+		}
+		if err = w.Flush(); err != nil {
+			return err
+		}
+		if err = fileutil.Fsync(f); err != nil {
+			return err
+		}
+	}
+	return ctx.Err()
+}
+
+// ReadBackup calls fn with the revisions saved in the segments of the
+// backup in dir, in order. A torn record at the end of the last segment,
+// left by a backup that was killed while writing it, is ignored with the
+// whole revision it held. It stops at
+// the first error fn returns, and returns it.
+func ReadBackup(dir string, fn func(BackupRecord) error) error {
+	segs, err := backupSegments(dir)
+	if err != nil {
+		return err
+	}
+	for i, seg := range segs {
+		f, err := os.Open(seg.path)
+		if err != nil {
+			return err
+		}
+		_, err = readBackupSegment(f, fn)
+		f.Close()
+		if err == io.ErrUnexpectedEOF {
+			if i == len(segs)-1 {
+				return nil
+			}
+			return ErrBackupCorrupt
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type backupSegment struct {
+	path string
+	// rev is the first revision the segment may hold.
+	rev int64
+}
+
+// backupSegments returns the segments of the backup in dir in revision
+// order.
+func backupSegments(dir string) ([]backupSegment, error) {
+	names, err := fileutil.ReadDir(dir, fileutil.WithExt(backupSegmentExt))
+	if err != nil {
+		return nil, err
+	}
+	var segs []backupSegment
+	for _, name := range names {
+		rev, err := strconv.ParseInt(strings.TrimSuffix(name, backupSegmentExt), 16, 64)
+		if err != nil {
+			return nil, fmt.Errorf("bad backup segment name %q (%v)", name, err)
+		}
+		segs = append(segs, backupSegment{path: filepath.Join(dir, name), rev: rev})
+	}
+	sort.Slice(segs, func(i, j int) bool { return segs[i].rev < segs[j].rev })
+	return segs, nil
+}
+
+func createBackupSegment(dir string, rev int64) (*os.File, error) {
+	p := filepath.Join(dir, fmt.Sprintf("%016x%s", rev, backupSegmentExt))
+	f, err := os.OpenFile(p, os.O_WRONLY|os.O_CREATE|os.O_EXCL, fileutil.PrivateFileMode)
+	if err != nil {
+		return nil, err
+	}
+	if err = fileutil.Fsync(f); err != nil {
+		f.Close()
+		return nil, err
+	}
+	// make the new segment durable in the directory
+	d, err := fileutil.OpenDir(dir)
+	if err == nil {
+		err = fileutil.Fsync(d)
+		d.Close()
+	}
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return f, nil
+}
+
+// openLastBackupSegment opens the last segment for appending, dropping a
+// torn record at its end, and returns the revision to continue from, which
+// is the revision of the torn record if any.
+func openLastBackupSegment(seg backupSegment) (*os.File, int64, error) {
+	f, err := os.OpenFile(seg.path, os.O_RDWR, fileutil.PrivateFileMode)
+	if err != nil {
+		return nil, 0, err
+	}
+	next := seg.rev
+	off, err := readBackupSegment(f, func(r BackupRecord) error {
+		next = r.Revision + 1
+		return nil
+	})
+	if err != nil && err != io.ErrUnexpectedEOF {
+		f.Close()
+		return nil, 0, err
+	}
+	if err = f.Truncate(off); err != nil {
+		f.Close()
+		return nil, 0, err
+	}
+	if _, err = f.Seek(off, io.SeekStart); err != nil {
+		f.Close()
+		return nil, 0, err
+	}
+	return f, next, nil
+}
+
+func writeBackupRecord(w io.Writer, rec BackupRecord) (int, error) {
+	payload := make([]byte, 8, 8+len(rec.Events)*64)
+	binary.LittleEndian.PutUint64(payload, uint64(rec.Time.UnixNano()))
+	for _, ev := range rec.Events {
+		data, err := ev.Marshal()
+		if err != nil {
+			return 0, err
+		}
+		var n [4]byte
+		binary.LittleEndian.PutUint32(n[:], uint32(len(data)))
+		payload = append(append(payload, n[:]...), data...)
+	}
+
+	var hdr [backupRecordHeaderSize]byte
+	binary.LittleEndian.PutUint32(hdr[:4], uint32(len(payload)))
+	binary.LittleEndian.PutUint32(hdr[4:], crc32.Checksum(payload, crcTable))
+	if _, err := w.Write(hdr[:]); err != nil {
+		return 0, err
+	}
+	if _, err := w.Write(payload); err != nil {
+		return 0, err
+	}
+	return len(hdr) + len(payload), nil
+}
+
+// readBackupSegment calls fn with the records of the segment read from r.
+// It returns the offset past the last whole record, and
+// io.ErrUnexpectedEOF if a torn record follows it.
+func readBackupSegment(r io.Reader, fn func(BackupRecord) error) (int64, error) {
+	br := bufio.NewReader(r)
+	var off int64
+	for {
+		var hdr [backupRecordHeaderSize]byte
+		if _, err := io.ReadFull(br, hdr[:]); err != nil {
+			if err == io.EOF {
+				return off, nil
+			}
+			return off, err
+		}
+		n := binary.LittleEndian.Uint32(hdr[:4])
+		crc := binary.LittleEndian.Uint32(hdr[4:])
+		if n < 8 {
+			return off, ErrBackupCorrupt
+		}
+		payload := make([]byte, n)
+		if _, err := io.ReadFull(br, payload); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return off, err
+		}
+		if crc32.Checksum(payload, crcTable) != crc {
+			return off, ErrBackupCorrupt
+		}
+		rec, err := decodeBackupRecord(payload)
+		if err != nil {
+			return off, err
+		}
+		if err = fn(rec); err != nil {
+			return off, err
+		}
+		off += int64(backupRecordHeaderSize) + int64(n)
+	}
+}
+
+func decodeBackupRecord(payload []byte) (BackupRecord, error) {
+	rec := BackupRecord{Time: time.Unix(0, int64(binary.LittleEndian.Uint64(payload)))}
+	for data := payload[8:]; len(data) > 0; {
+		if len(data) < 4 {
+			return rec, ErrBackupCorrupt
+		}
+		n := binary.LittleEndian.Uint32(data)
+		data = data[4:]
+		if uint64(n) > uint64(len(data)) {
+			return rec, ErrBackupCorrupt
+		}
+		ev := &mvccpb.Event{}
+		if err := ev.Unmarshal(data[:n]); err != nil || ev.Kv == nil {
+			return rec, ErrBackupCorrupt
+		}
+		if len(rec.Events) > 0 && ev.Kv.ModRevision != rec.Revision {
+			return rec, ErrBackupCorrupt
+		}
+		rec.Revision = ev.Kv.ModRevision
+		rec.Events = append(rec.Events, ev)
+		data = data[n:]
+	}
+	if len(rec.Events) == 0 {
+		return rec, ErrBackupCorrupt
+	}
+	return rec, nil
+}
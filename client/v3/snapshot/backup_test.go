@@ -0,0 +1,91 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"io"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+)
+
+func TestBackupSegmentTornRevision(t *testing.T) {
+	dir := t.TempDir()
+	f, err := createBackupSegment(dir, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	put := func(k string, rev int64) *mvccpb.Event {
+		return &mvccpb.Event{Type: mvccpb.PUT, Kv: &mvccpb.KeyValue{Key: []byte(k), Value: []byte("bar"), ModRevision: rev}}
+	}
+	recs := []BackupRecord{
+		{Time: time.Unix(0, 1), Revision: 2, Events: []*mvccpb.Event{put("foo", 2)}},
+		// a txn of two puts
+		{Time: time.Unix(0, 2), Revision: 3, Events: []*mvccpb.Event{put("foo1", 3), put("foo2", 3)}},
+	}
+	var size int64
+	for i, rec := range recs {
+		n, err := writeBackupRecord(f, rec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i == 0 {
+			size = int64(n)
+		}
+	}
+	// tear the txn in the middle of its second put
+	off, err := f.Seek(-4, io.SeekEnd)
+	if err == nil {
+		err = f.Truncate(off)
+	}
+	f.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []BackupRecord
+	if err = ReadBackup(dir, func(r BackupRecord) error {
+		got = append(got, r)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || !reflect.DeepEqual(got[0].Events, recs[0].Events) {
+		t.Fatalf("records = %v, want %v", got, recs[:1])
+	}
+
+	segs, err := backupSegments(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, next, err := openLastBackupSegment(segs[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if next != 3 {
+		t.Errorf("next revision = %d, want 3", next)
+	}
+	fi, err := os.Stat(segs[0].path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi.Size() != size {
+		t.Errorf("segment size = %d, want %d", fi.Size(), size)
+	}
+}
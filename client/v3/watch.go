@@ -209,6 +209,11 @@ type watchRequest struct {
 
 	// filters is the list of events to filter out
 	filters []pb.WatchCreateRequest_FilterType
+	// valuePrefix, lease and keyRegex are the arguments of the
+	// VALUE_PREFIX, LEASE and KEY_REGEX filters
+	valuePrefix []byte
+	lease       int64
+	keyRegex    string
 	// get the previous key-value pair before the event happens
 	prevKV bool
 	// retc receives a chan WatchResponse once the watcher is established
@@ -300,6 +305,15 @@ func (w *watcher) Watch(ctx context.Context, key string, opts ...OpOption) Watch
 	if ow.filterDelete {
 		filters = append(filters, pb.WatchCreateRequest_NODELETE)
 	}
+	if ow.filterValuePrefix != nil {
+		filters = append(filters, pb.WatchCreateRequest_VALUE_PREFIX)
+	}
+	if ow.filterLease != NoLease {
+		filters = append(filters, pb.WatchCreateRequest_LEASE)
+	}
+	if ow.filterKeyRegex != "" {
+		filters = append(filters, pb.WatchCreateRequest_KEY_REGEX)
+	}
 
 	wr := &watchRequest{
 		ctx:            ctx,
@@ -310,6 +324,9 @@ func (w *watcher) Watch(ctx context.Context, key string, opts ...OpOption) Watch
 		progressNotify: ow.progressNotify,
 		fragment:       ow.fragment,
 		filters:        filters,
+		valuePrefix:    ow.filterValuePrefix,
+		lease:          int64(ow.filterLease),
+		keyRegex:       ow.filterKeyRegex,
 		prevKV:         ow.prevKV,
 		retc:           make(chan chan WatchResponse, 1),
 	}
@@ -1019,6 +1036,9 @@ func (wr *watchRequest) toPB() *pb.WatchRequest {
 		Filters:        wr.filters,
 		PrevKv:         wr.prevKV,
 		Fragment:       wr.fragment,
+		ValuePrefix:    wr.valuePrefix,
+		Lease:          wr.lease,
+		KeyRegex:       wr.keyRegex,
 	}
 	cr := &pb.WatchRequest_CreateRequest{CreateRequest: req}
 	return &pb.WatchRequest{RequestUnion: cr}
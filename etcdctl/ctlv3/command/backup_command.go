@@ -0,0 +1,88 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"go.etcd.io/etcd/client/pkg/v3/logutil"
+	snapshot "go.etcd.io/etcd/client/v3/snapshot"
+	"go.etcd.io/etcd/pkg/v3/cobrautl"
+	"go.uber.org/zap"
+)
+
+// NewBackupCommand returns the cobra command for "backup".
+func NewBackupCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backup <subcommand>",
+		Short: "Manages continuous backups of an etcd node",
+	}
+	cmd.AddCommand(NewBackupStreamCommand())
+	return cmd
+}
+
+func NewBackupStreamCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "stream <directory>",
+		Short: "Streams a base snapshot and the changes after it to a backup directory",
+		Long: `Saves a base snapshot to the given directory, and then appends every change
+committed after it to segment files in the directory, until interrupted. Running
+it again on the same directory continues the backup. The backup is restored with
+"etcdutl snapshot restore <directory>" to any revision or time it holds. The
+segment files are not encrypted, so a member encrypting its data at rest is not
+backed up.
+`,
+		Run: backupStreamCommandFunc,
+	}
+}
+
+func backupStreamCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		err := fmt.Errorf("backup stream expects one argument")
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, err)
+	}
+
+	lg, err := logutil.CreateDefaultZapLogger(zap.InfoLevel)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+	cfg := mustClientCfgFromCmd(cmd)
+
+	// if user does not specify "--command-timeout" flag, the backup is streamed until interrupted
+	ctx, cancel := context.WithCancel(context.Background())
+	if isCommandTimeoutFlagSet(cmd) {
+		ctx, cancel = commandCtx(cmd)
+	}
+	defer cancel()
+
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigc
+		cancel()
+	}()
+
+	dir := args[0]
+	err = snapshot.StreamBackup(ctx, lg, *cfg, dir)
+	if err != nil && err != context.Canceled && err != context.DeadlineExceeded {
+		cobrautl.ExitWithError(cobrautl.ExitInterrupted, err)
+	}
+	fmt.Printf("Backup saved at %s\n", dir)
+}
@@ -42,6 +42,8 @@ var (
 	checkDatascalePrefix string
 	autoCompact          bool
 	autoDefrag           bool
+	checkCorruptionRev   int64
+	checkCorruptionLimit int64
 )
 
 type checkPerfCfg struct {
@@ -113,6 +115,7 @@ func NewCheckCommand() *cobra.Command {
 
 	cc.AddCommand(NewCheckPerfCommand())
 	cc.AddCommand(NewCheckDatascaleCommand())
+	cc.AddCommand(NewCheckCorruptionCommand())
 
 	return cc
 }
@@ -438,3 +441,34 @@ func newCheckDatascaleCommand(cmd *cobra.Command, args []string) {
 		fmt.Println(fmt.Sprintf("PASS: Approximate system memory used : %v MB.", strconv.FormatFloat(mbUsed, 'f', 2, 64)))
 	}
 }
+
+// NewCheckCorruptionCommand returns the cobra command for "check corruption".
+func NewCheckCorruptionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "corruption [options]",
+		Short: "Compare the keyspaces of the cluster members and find the keys they diverge on.",
+		Run:   newCheckCorruptionCommand,
+	}
+
+	cmd.Flags().Int64Var(&checkCorruptionRev, "rev", 0, "Revision to compare the members at. Defaults to the current revision.")
+	cmd.Flags().Int64Var(&checkCorruptionLimit, "limit", 0, "Maximum number of divergent keys to drill down to. Defaults to 100.")
+
+	return cmd
+}
+
+// newCheckCorruptionCommand executes the "check corruption" command.
+func newCheckCorruptionCommand(cmd *cobra.Command, args []string) {
+	if len(args) != 0 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("check corruption command accepts no arguments"))
+	}
+	ctx, cancel := commandCtx(cmd)
+	resp, err := mustClientFromCmd(cmd).CorruptionCheck(ctx, checkCorruptionRev, checkCorruptionLimit)
+	cancel()
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+	display.CorruptionCheck(*resp)
+	if len(resp.Ranges) > 0 {
+		cobrautl.ExitWithError(cobrautl.ExitError, fmt.Errorf("found %d divergent key ranges", len(resp.Ranges)))
+	}
+}
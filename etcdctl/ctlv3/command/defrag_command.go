@@ -20,9 +20,12 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/pkg/v3/cobrautl"
 )
 
+var defragOnline bool
+
 // NewDefragCommand returns the cobra command for "Defrag".
 func NewDefragCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -31,6 +34,7 @@ func NewDefragCommand() *cobra.Command {
 		Run:   defragCommandFunc,
 	}
 	cmd.PersistentFlags().BoolVar(&epClusterEndpoints, "cluster", false, "use all endpoints from the cluster member list")
+	cmd.Flags().BoolVar(&defragOnline, "online", false, "defragment in bounded batches while serving requests, resuming an interrupted online defragmentation")
 	return cmd
 }
 
@@ -38,10 +42,14 @@ func defragCommandFunc(cmd *cobra.Command, args []string) {
 
 	failures := 0
 	c := mustClientFromCmd(cmd)
+	var opts []clientv3.DefragmentOption
+	if defragOnline {
+		opts = append(opts, clientv3.WithOnlineDefragment())
+	}
 	for _, ep := range endpointsFromCluster(cmd) {
 		ctx, cancel := commandCtx(cmd)
 		start := time.Now()
-		_, err := c.Defragment(ctx, ep)
+		_, err := c.Defragment(ctx, ep, opts...)
 		d := time.Now().Sub(start)
 		cancel()
 		if err != nil {
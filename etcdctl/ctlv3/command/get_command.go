@@ -16,6 +16,7 @@ package command
 
 import (
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -34,6 +35,13 @@ var (
 	getKeysOnly    bool
 	getCountOnly   bool
 	printValueOnly bool
+	getValuePrefix string
+	getValueEqual  string
+	getValueRegex  string
+	getJSONField   string
+	getValueFields []string
+	getPageSize    int64
+	getStream      bool
 )
 
 // NewGetCommand returns the cobra command for "get".
@@ -54,6 +62,13 @@ func NewGetCommand() *cobra.Command {
 	cmd.Flags().BoolVar(&getKeysOnly, "keys-only", false, "Get only the keys")
 	cmd.Flags().BoolVar(&getCountOnly, "count-only", false, "Get only the count")
 	cmd.Flags().BoolVar(&printValueOnly, "print-value-only", false, `Only write values when using the "simple" output format`)
+	cmd.Flags().StringVar(&getValuePrefix, "value-prefix", "", "Get only the keys whose values begin with the given prefix")
+	cmd.Flags().StringVar(&getValueEqual, "value-equal", "", "Get only the keys whose values are equal to the given value")
+	cmd.Flags().StringVar(&getValueRegex, "value-regex", "", "Get only the keys whose values match the given regular expression")
+	cmd.Flags().StringVar(&getJSONField, "value-json-field", "", "Get only the keys whose JSON values hold the given field value, in the form <path>=<value> (e.g. metadata.name=foo)")
+	cmd.Flags().StringSliceVar(&getValueFields, "value-fields", nil, "Return only the given dot separated fields of JSON values")
+	cmd.Flags().Int64Var(&getPageSize, "page-size", 0, "Fetch and print the results in pages of the given size, all read at the revision of the first page")
+	cmd.Flags().BoolVar(&getStream, "stream", false, "Stream the results from the server in chunks and print them as they arrive, for ranges too large for a single response")
 
 	cmd.RegisterFlagCompletionFunc("consistency", func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
 		return []string{"l", "s"}, cobra.ShellCompDirectiveDefault
@@ -71,12 +86,6 @@ func NewGetCommand() *cobra.Command {
 // getCommandFunc executes the "get" command.
 func getCommandFunc(cmd *cobra.Command, args []string) {
 	key, opts := getGetOp(args)
-	ctx, cancel := commandCtx(cmd)
-	resp, err := mustClientFromCmd(cmd).Get(ctx, key, opts...)
-	cancel()
-	if err != nil {
-		cobrautl.ExitWithError(cobrautl.ExitError, err)
-	}
 
 	if getCountOnly {
 		if _, fields := display.(*fieldsPrinter); !fields {
@@ -91,9 +100,63 @@ func getCommandFunc(cmd *cobra.Command, args []string) {
 		}
 		dp.valueOnly = true
 	}
+
+	if getPageSize > 0 {
+		getPages(cmd, key, opts)
+		return
+	}
+
+	if getStream {
+		getStreamed(cmd, key, opts)
+		return
+	}
+
+	ctx, cancel := commandCtx(cmd)
+	resp, err := mustClientFromCmd(cmd).Get(ctx, key, opts...)
+	cancel()
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
 	display.Get(*resp)
 }
 
+// getPages prints the range page by page as the pages are fetched.
+func getPages(cmd *cobra.Command, key string, opts []clientv3.OpOption) {
+	it := clientv3.NewRangeIterator(mustClientFromCmd(cmd), key, getPageSize, opts...)
+	for {
+		ctx, cancel := commandCtx(cmd)
+		ok := it.Next(ctx)
+		cancel()
+		if !ok {
+			break
+		}
+		display.Get(*it.Response())
+	}
+	if err := it.Err(); err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+}
+
+// getStreamed prints the range chunk by chunk as the chunks are received.
+func getStreamed(cmd *cobra.Command, key string, opts []clientv3.OpOption) {
+	ctx, cancel := commandCtx(cmd)
+	defer cancel()
+	rs, err := mustClientFromCmd(cmd).GetStream(ctx, key, opts...)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+	for {
+		resp, err := rs.Recv()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitError, err)
+		}
+		display.Get(*resp)
+	}
+}
+
 func getGetOp(args []string) (string, []clientv3.OpOption) {
 	if len(args) == 0 {
 		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("get command needs one argument as key and an optional argument as range_end"))
@@ -107,6 +170,23 @@ func getGetOp(args []string) (string, []clientv3.OpOption) {
 		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("`--keys-only` and `--count-only` cannot be set at the same time, choose one"))
 	}
 
+	if getPageSize < 0 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("`--page-size` must be a positive number"))
+	}
+
+	if getPageSize > 0 && getStream {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("`--page-size` and `--stream` cannot be set at the same time, choose one"))
+	}
+
+	if getPageSize > 0 {
+		if getLimit != 0 || getCountOnly {
+			cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("`--page-size` cannot be set with `--limit` or `--count-only`"))
+		}
+		if strings.ToUpper(getSortOrder) == "DESCEND" || (getSortTarget != "" && strings.ToUpper(getSortTarget) != "KEY") {
+			cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("`--page-size` only supports results sorted by ascending key"))
+		}
+	}
+
 	opts := []clientv3.OpOption{}
 	switch getConsistency {
 	case "s":
@@ -187,5 +267,38 @@ func getGetOp(args []string) (string, []clientv3.OpOption) {
 		opts = append(opts, clientv3.WithCountOnly())
 	}
 
+	opts = append(opts, getValueFilterOps()...)
+
 	return key, opts
 }
+
+func getValueFilterOps() []clientv3.OpOption {
+	var opts []clientv3.OpOption
+	if getValuePrefix != "" {
+		opts = append(opts, clientv3.WithValuePrefix(getValuePrefix))
+	}
+	if getValueEqual != "" {
+		opts = append(opts, clientv3.WithValueEqual(getValueEqual))
+	}
+	if getValueRegex != "" {
+		opts = append(opts, clientv3.WithValueRegex(getValueRegex))
+	}
+	if getJSONField != "" {
+		kv := strings.SplitN(getJSONField, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("`--value-json-field` must be in the form <path>=<value>"))
+		}
+		opts = append(opts, clientv3.WithValueJSONField(kv[0], kv[1]))
+	}
+	if len(opts) > 1 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("`--value-prefix`, `--value-equal`, `--value-regex` and `--value-json-field` cannot be set at the same time, choose one"))
+	}
+
+	if len(getValueFields) != 0 {
+		if getKeysOnly || getCountOnly {
+			cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("`--value-fields` cannot be set with `--keys-only` or `--count-only`"))
+		}
+		opts = append(opts, clientv3.WithValueFields(getValueFields...))
+	}
+	return opts
+}
@@ -28,6 +28,14 @@ import (
 var (
 	memberPeerURLs string
 	isLearner      bool
+	isWitness      bool
+	autoPromote    bool
+
+	reconfigureAdd        []string
+	reconfigureAddLearner []string
+	reconfigureAddWitness []string
+	reconfigureRemove     []string
+	reconfigurePromote    []string
 )
 
 // NewMemberCommand returns the cobra command for "member".
@@ -42,6 +50,8 @@ func NewMemberCommand() *cobra.Command {
 	mc.AddCommand(NewMemberUpdateCommand())
 	mc.AddCommand(NewMemberListCommand())
 	mc.AddCommand(NewMemberPromoteCommand())
+	mc.AddCommand(NewMemberSetPriorityCommand())
+	mc.AddCommand(NewMemberReconfigureCommand())
 
 	return mc
 }
@@ -57,6 +67,8 @@ func NewMemberAddCommand() *cobra.Command {
 
 	cc.Flags().StringVar(&memberPeerURLs, "peer-urls", "", "comma separated peer URLs for the new member.")
 	cc.Flags().BoolVar(&isLearner, "learner", false, "indicates if the new member is raft learner")
+	cc.Flags().BoolVar(&isWitness, "witness", false, "indicates if the new member is a witness, which votes but stores no key-value data")
+	cc.Flags().BoolVar(&autoPromote, "auto-promote", false, "indicates if the new learner member is promoted by the leader once it has caught up")
 
 	return cc
 }
@@ -116,6 +128,42 @@ func NewMemberPromoteCommand() *cobra.Command {
 	return cc
 }
 
+// NewMemberSetPriorityCommand returns the cobra command for "member set-priority".
+func NewMemberSetPriorityCommand() *cobra.Command {
+	cc := &cobra.Command{
+		Use:   "set-priority <memberID> <priority>",
+		Short: "Sets the leader priority of a member in the cluster",
+		Long: `Sets the leader priority of a member in the cluster.
+The leader hands leadership over to the caught up voting member with the highest priority.
+`,
+
+		Run: memberSetPriorityCommandFunc,
+	}
+
+	return cc
+}
+
+// NewMemberReconfigureCommand returns the cobra command for "member reconfigure".
+func NewMemberReconfigureCommand() *cobra.Command {
+	cc := &cobra.Command{
+		Use:   "reconfigure [options]",
+		Short: "Atomically adds, removes and promotes members in the cluster",
+		Long: `Atomically adds, removes and promotes members in the cluster through a joint consensus configuration.
+Either all of the changes are applied or none of them is.
+`,
+
+		Run: memberReconfigureCommandFunc,
+	}
+
+	cc.Flags().StringArrayVar(&reconfigureAdd, "add", nil, "comma separated peer URLs of a voting member to add. Can be repeated.")
+	cc.Flags().StringArrayVar(&reconfigureAddLearner, "add-learner", nil, "comma separated peer URLs of a learner member to add. Can be repeated.")
+	cc.Flags().StringArrayVar(&reconfigureAddWitness, "add-witness", nil, "comma separated peer URLs of a witness member to add. Can be repeated.")
+	cc.Flags().StringSliceVar(&reconfigureRemove, "remove", nil, "comma separated IDs in Hex of the members to remove.")
+	cc.Flags().StringSliceVar(&reconfigurePromote, "promote", nil, "comma separated IDs in Hex of the learner members to promote.")
+
+	return cc
+}
+
 // memberAddCommandFunc executes the "member add" command.
 func memberAddCommandFunc(cmd *cobra.Command, args []string) {
 	if len(args) < 1 {
@@ -135,6 +183,12 @@ func memberAddCommandFunc(cmd *cobra.Command, args []string) {
 	if len(memberPeerURLs) == 0 {
 		cobrautl.ExitWithError(cobrautl.ExitBadArgs, errors.New("member peer urls not provided"))
 	}
+	if isLearner && isWitness {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, errors.New("a member cannot be both a learner and a witness"))
+	}
+	if autoPromote && !isLearner {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, errors.New("only a learner member can be auto-promoted"))
+	}
 
 	urls := strings.Split(memberPeerURLs, ",")
 	ctx, cancel := commandCtx(cmd)
@@ -143,9 +197,14 @@ func memberAddCommandFunc(cmd *cobra.Command, args []string) {
 		resp *clientv3.MemberAddResponse
 		err  error
 	)
-	if isLearner {
+	switch {
+	case isLearner && autoPromote:
+		resp, err = cli.MemberAddAsAutoPromotingLearner(ctx, urls)
+	case isLearner:
 		resp, err = cli.MemberAddAsLearner(ctx, urls)
-	} else {
+	case isWitness:
+		resp, err = cli.MemberAddAsWitness(ctx, urls)
+	default:
 		resp, err = cli.MemberAdd(ctx, urls)
 	}
 	cancel()
@@ -254,3 +313,76 @@ func memberPromoteCommandFunc(cmd *cobra.Command, args []string) {
 	}
 	display.MemberPromote(id, *resp)
 }
+
+// memberSetPriorityCommandFunc executes the "member set-priority" command.
+func memberSetPriorityCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 2 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("member ID and priority are not provided"))
+	}
+
+	id, err := strconv.ParseUint(args[0], 16, 64)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("bad member ID arg (%v), expecting ID in Hex", err))
+	}
+	priority, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("bad priority arg (%v)", err))
+	}
+
+	ctx, cancel := commandCtx(cmd)
+	resp, err := mustClientFromCmd(cmd).MemberSetLeaderPriority(ctx, id, priority)
+	cancel()
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+	display.MemberSetLeaderPriority(id, *resp)
+}
+
+// memberReconfigureCommandFunc executes the "member reconfigure" command.
+func memberReconfigureCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 0 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, errors.New("too many arguments"))
+	}
+
+	var ops []clientv3.ReconfigureOp
+	for _, urls := range reconfigureAdd {
+		ops = append(ops, clientv3.ReconfigureAdd(strings.Split(urls, ",")))
+	}
+	for _, urls := range reconfigureAddLearner {
+		ops = append(ops, clientv3.ReconfigureAddAsLearner(strings.Split(urls, ",")))
+	}
+	for _, urls := range reconfigureAddWitness {
+		ops = append(ops, clientv3.ReconfigureAddAsWitness(strings.Split(urls, ",")))
+	}
+	remove := mustParseMemberIDs(reconfigureRemove)
+	for _, id := range remove {
+		ops = append(ops, clientv3.ReconfigureRemove(id))
+	}
+	promote := mustParseMemberIDs(reconfigurePromote)
+	for _, id := range promote {
+		ops = append(ops, clientv3.ReconfigurePromote(id))
+	}
+	if len(ops) == 0 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, errors.New("no member change provided"))
+	}
+
+	ctx, cancel := commandCtx(cmd)
+	resp, err := mustClientFromCmd(cmd).MemberReconfigure(ctx, ops...)
+	cancel()
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+	display.MemberReconfigure(remove, promote, *resp)
+}
+
+func mustParseMemberIDs(args []string) []uint64 {
+	ids := make([]uint64, 0, len(args))
+	for _, arg := range args {
+		id, err := strconv.ParseUint(arg, 16, 64)
+		if err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("bad member ID arg (%v), expecting ID in Hex", err))
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
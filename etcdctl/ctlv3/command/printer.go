@@ -43,6 +43,8 @@ type printer interface {
 	MemberRemove(id uint64, r v3.MemberRemoveResponse)
 	MemberUpdate(id uint64, r v3.MemberUpdateResponse)
 	MemberPromote(id uint64, r v3.MemberPromoteResponse)
+	MemberSetLeaderPriority(id uint64, r v3.MemberSetLeaderPriorityResponse)
+	MemberReconfigure(remove, promote []uint64, r v3.MemberReconfigureResponse)
 	MemberList(v3.MemberListResponse)
 
 	EndpointHealth([]epHealth)
@@ -55,6 +57,7 @@ type printer interface {
 	DowngradeCancel(r v3.DowngradeResponse)
 
 	Alarm(v3.AlarmResponse)
+	CorruptionCheck(v3.CorruptionCheckResponse)
 
 	RoleAdd(role string, r v3.AuthRoleAddResponse)
 	RoleGet(role string, r v3.AuthRoleGetResponse)
@@ -117,8 +120,17 @@ func (p *printerRPC) MemberUpdate(id uint64, r v3.MemberUpdateResponse) {
 func (p *printerRPC) MemberPromote(id uint64, r v3.MemberPromoteResponse) {
 	p.p((*pb.MemberPromoteResponse)(&r))
 }
+func (p *printerRPC) MemberSetLeaderPriority(id uint64, r v3.MemberSetLeaderPriorityResponse) {
+	p.p((*pb.MemberSetLeaderPriorityResponse)(&r))
+}
+func (p *printerRPC) MemberReconfigure(remove, promote []uint64, r v3.MemberReconfigureResponse) {
+	p.p((*pb.MemberReconfigureResponse)(&r))
+}
 func (p *printerRPC) MemberList(r v3.MemberListResponse) { p.p((*pb.MemberListResponse)(&r)) }
 func (p *printerRPC) Alarm(r v3.AlarmResponse)           { p.p((*pb.AlarmResponse)(&r)) }
+func (p *printerRPC) CorruptionCheck(r v3.CorruptionCheckResponse) {
+	p.p((*pb.CorruptionCheckResponse)(&r))
+}
 func (p *printerRPC) MoveLeader(leader, target uint64, r v3.MoveLeaderResponse) {
 	p.p((*pb.MoveLeaderResponse)(&r))
 }
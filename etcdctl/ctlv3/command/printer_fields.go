@@ -55,8 +55,11 @@ func (p *fieldsPrinter) Del(r v3.DeleteResponse) {
 
 func (p *fieldsPrinter) Get(r v3.GetResponse) {
 	p.hdr(r.Header)
-	for _, kv := range r.Kvs {
+	for i, kv := range r.Kvs {
 		p.kv("", kv)
+		if r.RemainingTtls != nil {
+			fmt.Printf("\"RemainingTTL\" : %d\n", r.RemainingTtls[i])
+		}
 	}
 	fmt.Println(`"More" :`, r.More)
 	fmt.Println(`"Count" :`, r.Count)
@@ -142,6 +145,9 @@ func (p *fieldsPrinter) MemberList(r v3.MemberListResponse) {
 			fmt.Printf("\"ClientURL\" : %q\n", u)
 		}
 		fmt.Println(`"IsLearner" :`, m.IsLearner)
+		fmt.Println(`"LeaderPriority" :`, m.LeaderPriority)
+		fmt.Println(`"IsWitness" :`, m.IsWitness)
+		fmt.Println(`"AutoPromote" :`, m.AutoPromote)
 		fmt.Println()
 	}
 }
@@ -125,6 +125,27 @@ func (s *simplePrinter) Alarm(resp v3.AlarmResponse) {
 	}
 }
 
+func (s *simplePrinter) CorruptionCheck(r v3.CorruptionCheckResponse) {
+	if len(r.Ranges) == 0 {
+		fmt.Printf("No divergence between %d members at revision %d\n", len(r.Members), r.Revision)
+		return
+	}
+	for _, dr := range r.Ranges {
+		var hashes []string
+		for _, h := range dr.Hashes {
+			hashes = append(hashes, fmt.Sprintf("%x=%016x(%d keys)", h.MemberID, h.Hash, h.Count))
+		}
+		rng := fmt.Sprintf("key %q", dr.Key)
+		switch {
+		case string(dr.RangeEnd) == "\x00":
+			rng = fmt.Sprintf("range [%q, end)", dr.Key)
+		case len(dr.RangeEnd) > 0:
+			rng = fmt.Sprintf("range [%q, %q)", dr.Key, dr.RangeEnd)
+		}
+		fmt.Printf("%s diverges at revision %d: %s\n", rng, r.Revision, strings.Join(hashes, ", "))
+	}
+}
+
 func (s *simplePrinter) MemberAdd(r v3.MemberAddResponse) {
 	fmt.Printf("Member %16x added to cluster %16x\n", r.Member.ID, r.Header.ClusterId)
 }
@@ -141,6 +162,22 @@ func (s *simplePrinter) MemberPromote(id uint64, r v3.MemberPromoteResponse) {
 	fmt.Printf("Member %16x promoted in cluster %16x\n", id, r.Header.ClusterId)
 }
 
+func (s *simplePrinter) MemberSetLeaderPriority(id uint64, r v3.MemberSetLeaderPriorityResponse) {
+	fmt.Printf("Member %16x leader priority set in cluster %16x\n", id, r.Header.ClusterId)
+}
+
+func (s *simplePrinter) MemberReconfigure(remove, promote []uint64, r v3.MemberReconfigureResponse) {
+	for _, m := range r.Added {
+		fmt.Printf("Member %16x added to cluster %16x\n", m.ID, r.Header.ClusterId)
+	}
+	for _, id := range remove {
+		fmt.Printf("Member %16x removed from cluster %16x\n", id, r.Header.ClusterId)
+	}
+	for _, id := range promote {
+		fmt.Printf("Member %16x promoted in cluster %16x\n", id, r.Header.ClusterId)
+	}
+}
+
 func (s *simplePrinter) MemberList(resp v3.MemberListResponse) {
 	_, rows := makeMemberListTable(resp)
 	for _, row := range rows {
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"strconv"
 	"strings"
 
 	clientv3 "go.etcd.io/etcd/client/v3"
@@ -42,6 +43,10 @@ var (
 	watchInteractive bool
 	watchPrevKey     bool
 	progressNotify   bool
+
+	watchFilterValuePrefix string
+	watchFilterLease       string
+	watchFilterKeyRegex    string
 )
 
 // NewWatchCommand returns the cobra command for "watch".
@@ -57,6 +62,9 @@ func NewWatchCommand() *cobra.Command {
 	cmd.Flags().Int64Var(&watchRev, "rev", 0, "Revision to start watching")
 	cmd.Flags().BoolVar(&watchPrevKey, "prev-kv", false, "get the previous key-value pair before the event happens")
 	cmd.Flags().BoolVar(&progressNotify, "progress-notify", false, "get periodic watch progress notification from server")
+	cmd.Flags().StringVar(&watchFilterValuePrefix, "filter-value-prefix", "", "only receive put events whose values begin with the given prefix")
+	cmd.Flags().StringVar(&watchFilterLease, "filter-lease", "", "only receive events on keys attached to the given lease ID (in hexadecimal), delete events are filtered by the lease of the deleted key")
+	cmd.Flags().StringVar(&watchFilterKeyRegex, "filter-key-regex", "", "only receive events on keys matching the given regular expression")
 
 	return cmd
 }
@@ -160,6 +168,19 @@ func getWatchChan(c *clientv3.Client, args []string) (clientv3.WatchChan, error)
 	if progressNotify {
 		opts = append(opts, clientv3.WithProgressNotify())
 	}
+	if watchFilterValuePrefix != "" {
+		opts = append(opts, clientv3.WithFilterValuePrefix(watchFilterValuePrefix))
+	}
+	if watchFilterLease != "" {
+		id, err := strconv.ParseInt(watchFilterLease, 16, 64)
+		if err != nil {
+			return nil, fmt.Errorf("bad lease ID (%v), expecting ID in Hex", err)
+		}
+		opts = append(opts, clientv3.WithFilterLease(clientv3.LeaseID(id)))
+	}
+	if watchFilterKeyRegex != "" {
+		opts = append(opts, clientv3.WithFilterKeyRegex(watchFilterKeyRegex))
+	}
 	return c.Watch(clientv3.WithRequireLeader(context.Background()), key, opts...), nil
 }
 
@@ -334,6 +355,18 @@ func parseWatchArgs(osArgs, commandArgs []string, envKey, envRange string, inter
 		if err != nil {
 			return nil, nil, err
 		}
+		watchFilterValuePrefix, err = flagset.GetString("filter-value-prefix")
+		if err != nil {
+			return nil, nil, err
+		}
+		watchFilterLease, err = flagset.GetString("filter-lease")
+		if err != nil {
+			return nil, nil, err
+		}
+		watchFilterKeyRegex, err = flagset.GetString("filter-key-regex")
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 
 	// "ETCDCTL_WATCH_KEY=foo watch -- echo hello"
@@ -90,6 +90,7 @@ func init() {
 		command.NewLeaseCommand(),
 		command.NewMemberCommand(),
 		command.NewSnapshotCommand(),
+		command.NewBackupCommand(),
 		command.NewMakeMirrorCommand(),
 		command.NewLockCommand(),
 		command.NewElectCommand(),
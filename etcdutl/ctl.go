@@ -35,6 +35,8 @@ var (
 
 func init() {
 	rootCmd.PersistentFlags().StringVarP(&etcdutl.OutputFormat, "write-out", "w", "simple", "set the output format (fields, json, protobuf, simple, table)")
+	rootCmd.PersistentFlags().StringVar(&etcdutl.EncryptionKeyFile, "encryption-key-file", "", "path to the file of key encryption keys of an encrypted data dir or snapshot")
+	rootCmd.PersistentFlags().StringVar(&etcdutl.EncryptionKMSSocket, "encryption-kms-socket", "", "path to the unix socket of the KMS plugin of an encrypted data dir or snapshot")
 	rootCmd.RegisterFlagCompletionFunc("write-out", func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
 		return []string{"fields", "json", "protobuf", "simple", "table"}, cobra.ShellCompDirectiveDefault
 	})
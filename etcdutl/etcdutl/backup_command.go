@@ -32,6 +32,7 @@ import (
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v2store"
 	"go.etcd.io/etcd/server/v3/storage/backend"
 	"go.etcd.io/etcd/server/v3/storage/datadir"
+	"go.etcd.io/etcd/server/v3/storage/encryption"
 	"go.etcd.io/etcd/server/v3/storage/schema"
 	"go.etcd.io/etcd/server/v3/storage/wal"
 	"go.etcd.io/etcd/server/v3/storage/wal/walpb"
@@ -131,8 +132,13 @@ func HandleBackup(withV3 bool, srcDir string, destDir string, srcWAL string, des
 	srcDbPath := datadir.ToBackendFileName(srcDir)
 	desired := newDesiredCluster()
 
+	kr, err := readKeyring(srcDbPath)
+	if err != nil {
+		lg.Fatal("failed to read encryption keys", zap.Error(err))
+	}
+
 	walsnap := saveSnap(lg, destSnap, srcSnap, &desired)
-	metadata, state, ents := translateWAL(lg, srcWAL, walsnap)
+	metadata, state, ents := translateWAL(lg, srcWAL, walsnap, kr)
 	saveDB(lg, destDbPath, srcDbPath, state.Commit, state.Term, &desired)
 
 	neww, err := wal.Create(lg, destWAL, pbutil.MustMarshal(&metadata))
@@ -140,6 +146,10 @@ func HandleBackup(withV3 bool, srcDir string, destDir string, srcWAL string, des
 		lg.Fatal("wal.Create failed", zap.Error(err))
 	}
 	defer neww.Close()
+	// the backup db is a copy of the source one, along with its keys.
+	if kr != nil {
+		neww.SetEncryptor(kr)
+	}
 	if err := neww.Save(state, ents); err != nil {
 		lg.Fatal("wal.Save failed ", zap.Error(err))
 	}
@@ -194,12 +204,15 @@ func mustTranslateV2store(lg *zap.Logger, storeData []byte, desired *desiredClus
 	return outputData
 }
 
-func translateWAL(lg *zap.Logger, srcWAL string, walsnap walpb.Snapshot) (etcdserverpb.Metadata, raftpb.HardState, []raftpb.Entry) {
+func translateWAL(lg *zap.Logger, srcWAL string, walsnap walpb.Snapshot, kr *encryption.Keyring) (etcdserverpb.Metadata, raftpb.HardState, []raftpb.Entry) {
 	w, err := wal.OpenForRead(lg, srcWAL, walsnap)
 	if err != nil {
 		lg.Fatal("wal.OpenForRead failed", zap.Error(err))
 	}
 	defer w.Close()
+	if kr != nil {
+		w.SetEncryptor(kr)
+	}
 	wmetadata, state, ents, err := w.ReadAll()
 	switch err {
 	case nil:
@@ -17,10 +17,19 @@ package etcdutl
 import (
 	"go.etcd.io/etcd/client/pkg/v3/logutil"
 	"go.etcd.io/etcd/pkg/v3/cobrautl"
+	"go.etcd.io/etcd/server/v3/storage"
+	"go.etcd.io/etcd/server/v3/storage/encryption"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
 
+var (
+	// EncryptionKeyFile and EncryptionKMSSocket set the key encryption keys
+	// of encrypted data dirs and snapshots.
+	EncryptionKeyFile   string
+	EncryptionKMSSocket string
+)
+
 func GetLogger() *zap.Logger {
 	config := logutil.DefaultZapLoggerConfig
 	config.Encoding = "console"
@@ -31,3 +40,24 @@ func GetLogger() *zap.Logger {
 	}
 	return lg
 }
+
+// keyProvider returns the provider of the key encryption keys set by the
+// flags, or nil if none is set.
+func keyProvider() (encryption.KeyProvider, error) {
+	return storage.NewKeyProvider(EncryptionKeyFile, EncryptionKMSSocket)
+}
+
+// readKeyring returns the keyring holding the data encryption keys of the
+// given database file, or nil if the file is not encrypted.
+func readKeyring(dbPath string) (*encryption.Keyring, error) {
+	kp, err := keyProvider()
+	if err != nil {
+		return nil, err
+	}
+	kr := storage.NewKeyring(kp)
+	ok, err := storage.ReadKeyring(dbPath, kr)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return kr, nil
+}
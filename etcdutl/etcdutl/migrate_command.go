@@ -92,6 +92,10 @@ func (o *migrateOptions) Config() (*migrateConfig, error) {
 	}
 
 	dbPath := datadir.ToBackendFileName(o.dataDir)
+	kr, err := readKeyring(dbPath)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to read encryption keys: %v`, err)
+	}
 	c.be = backend.NewDefaultBackend(GetLogger(), dbPath)
 
 	walPath := datadir.ToWalDir(o.dataDir)
@@ -100,6 +104,9 @@ func (o *migrateOptions) Config() (*migrateConfig, error) {
 		return nil, fmt.Errorf(`failed to open wal: %v`, err)
 	}
 	defer w.Close()
+	if kr != nil {
+		w.SetEncryptor(kr)
+	}
 	c.walVersion, err = wal.ReadWALVersion(w)
 	if err != nil {
 		return nil, fmt.Errorf(`failed to read wal: %v`, err)
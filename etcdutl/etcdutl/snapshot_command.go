@@ -17,6 +17,7 @@ package etcdutl
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"go.etcd.io/etcd/etcdutl/v3/snapshot"
 	"go.etcd.io/etcd/pkg/v3/cobrautl"
@@ -38,6 +39,8 @@ var (
 	restorePeerURLs     string
 	restoreName         string
 	skipHashCheck       bool
+	restoreToRevision   int64
+	restoreToTime       string
 )
 
 // NewSnapshotCommand returns the cobra command for "snapshot".
@@ -64,9 +67,15 @@ The items in the lists are hash, revision, total keys, total size.
 
 func NewSnapshotRestoreCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "restore <filename> --data-dir {output dir} [options]",
+		Use:   "restore <filename or backup dir> --data-dir {output dir} [options]",
 		Short: "Restores an etcd member snapshot to an etcd directory",
-		Run:   snapshotRestoreCommandFunc,
+		Long: `Restores an etcd member snapshot, or a continuous backup directory written by
+"etcdctl backup stream", to an etcd directory. A continuous backup is restored
+from its base snapshot, replaying the changes saved after it up to --to-revision
+or --to-time, or all of them if neither is given. The keys put after the base
+snapshot are restored without a lease.
+`,
+		Run: snapshotRestoreCommandFunc,
 	}
 	cmd.Flags().StringVar(&restoreDataDir, "data-dir", "", "Path to the output data directory")
 	cmd.Flags().StringVar(&restoreWalDir, "wal-dir", "", "Path to the WAL directory (use --data-dir if none given)")
@@ -75,6 +84,8 @@ func NewSnapshotRestoreCommand() *cobra.Command {
 	cmd.Flags().StringVar(&restorePeerURLs, "initial-advertise-peer-urls", defaultInitialAdvertisePeerURLs, "List of this member's peer URLs to advertise to the rest of the cluster")
 	cmd.Flags().StringVar(&restoreName, "name", defaultName, "Human-readable name for this member")
 	cmd.Flags().BoolVar(&skipHashCheck, "skip-hash-check", false, "Ignore snapshot integrity hash value (required if copied from data directory)")
+	cmd.Flags().Int64Var(&restoreToRevision, "to-revision", 0, "Revision to replay a continuous backup to (0 to replay all of it)")
+	cmd.Flags().StringVar(&restoreToTime, "to-time", "", "Time in RFC3339 format to replay a continuous backup to, as received by the backup")
 
 	cmd.MarkFlagDirname("data-dir")
 	cmd.MarkFlagDirname("wal-dir")
@@ -99,8 +110,15 @@ func SnapshotStatusCommandFunc(cmd *cobra.Command, args []string) {
 }
 
 func snapshotRestoreCommandFunc(_ *cobra.Command, args []string) {
-	SnapshotRestoreCommandFunc(restoreCluster, restoreClusterToken, restoreDataDir, restoreWalDir,
-		restorePeerURLs, restoreName, skipHashCheck, args)
+	var toTime time.Time
+	if restoreToTime != "" {
+		var err error
+		if toTime, err = time.Parse(time.RFC3339, restoreToTime); err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("invalid --to-time %q (%v)", restoreToTime, err))
+		}
+	}
+	snapshotRestore(restoreCluster, restoreClusterToken, restoreDataDir, restoreWalDir,
+		restorePeerURLs, restoreName, skipHashCheck, restoreToRevision, toTime, args)
 }
 
 func SnapshotRestoreCommandFunc(restoreCluster string,
@@ -111,6 +129,20 @@ func SnapshotRestoreCommandFunc(restoreCluster string,
 	restoreName string,
 	skipHashCheck bool,
 	args []string) {
+	snapshotRestore(restoreCluster, restoreClusterToken, restoreDataDir, restoreWalDir,
+		restorePeerURLs, restoreName, skipHashCheck, 0, time.Time{}, args)
+}
+
+func snapshotRestore(restoreCluster string,
+	restoreClusterToken string,
+	restoreDataDir string,
+	restoreWalDir string,
+	restorePeerURLs string,
+	restoreName string,
+	skipHashCheck bool,
+	toRevision int64,
+	toTime time.Time,
+	args []string) {
 	if len(args) != 1 {
 		err := fmt.Errorf("snapshot restore requires exactly one argument")
 		cobrautl.ExitWithError(cobrautl.ExitBadArgs, err)
@@ -126,6 +158,11 @@ func SnapshotRestoreCommandFunc(restoreCluster string,
 		walDir = datadir.ToWalDir(dataDir)
 	}
 
+	kp, err := keyProvider()
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, err)
+	}
+
 	lg := GetLogger()
 	sp := snapshot.NewV3(lg)
 
@@ -138,6 +175,9 @@ func SnapshotRestoreCommandFunc(restoreCluster string,
 		InitialCluster:      restoreCluster,
 		InitialClusterToken: restoreClusterToken,
 		SkipHashCheck:       skipHashCheck,
+		ToRevision:          toRevision,
+		ToTime:              toTime,
+		KeyProvider:         kp,
 	}); err != nil {
 		cobrautl.ExitWithError(cobrautl.ExitError, err)
 	}
@@ -25,6 +25,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"strings"
+	"time"
 
 	bolt "go.etcd.io/bbolt"
 	"go.etcd.io/etcd/api/v3/etcdserverpb"
@@ -40,7 +41,9 @@ import (
 	"go.etcd.io/etcd/server/v3/etcdserver/api/snap"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v2store"
 	"go.etcd.io/etcd/server/v3/etcdserver/cindex"
+	serverstorage "go.etcd.io/etcd/server/v3/storage"
 	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/encryption"
 	"go.etcd.io/etcd/server/v3/storage/schema"
 	"go.etcd.io/etcd/server/v3/storage/wal"
 	"go.etcd.io/etcd/server/v3/storage/wal/walpb"
@@ -83,6 +86,11 @@ type v3Manager struct {
 	cl        *membership.RaftCluster
 
 	skipHashCheck bool
+
+	// keyProvider wraps the data encryption keys of keyring, which encrypts
+	// the restored backend and WAL if set.
+	keyProvider encryption.KeyProvider
+	keyring     *encryption.Keyring
 }
 
 // hasChecksum returns "true" if the file size "n"
@@ -175,7 +183,8 @@ func (s *v3Manager) Status(dbPath string) (ds Status, err error) {
 
 // RestoreConfig configures snapshot restore operation.
 type RestoreConfig struct {
-	// SnapshotPath is the path of snapshot file to restore from.
+	// SnapshotPath is the path of snapshot file to restore from, or of
+	// the directory of a continuous backup.
 	SnapshotPath string
 
 	// Name is the human-readable name of this member.
@@ -202,6 +211,18 @@ type RestoreConfig struct {
 	// SkipHashCheck is "true" to ignore snapshot integrity hash value
 	// (required if copied from data directory).
 	SkipHashCheck bool
+
+	// ToRevision is the revision to replay the changes of a continuous
+	// backup to. 0 replays all of them.
+	ToRevision int64
+	// ToTime is the time to replay the changes of a continuous backup to,
+	// compared to when the backup received them. Zero replays all of them.
+	ToTime time.Time
+
+	// KeyProvider wraps the data encryption keys of the restored data dir.
+	// It is required to restore an encrypted snapshot; if set, the restored
+	// backend and WAL are encrypted at rest.
+	KeyProvider encryption.KeyProvider
 }
 
 // Restore restores a new etcd data directory from given snapshot file.
@@ -249,9 +270,17 @@ func (s *v3Manager) Restore(cfg RestoreConfig) error {
 
 	s.name = cfg.Name
 	s.srcDbPath = cfg.SnapshotPath
+	backupDir := ""
+	if fi, err := os.Stat(cfg.SnapshotPath); err == nil && fi.IsDir() {
+		backupDir = cfg.SnapshotPath
+		s.srcDbPath = snapshot.BackupSnapshotPath(backupDir)
+	} else if cfg.ToRevision != 0 || !cfg.ToTime.IsZero() {
+		return fmt.Errorf("%q is not a continuous backup directory to restore to a revision or time from", cfg.SnapshotPath)
+	}
 	s.walDir = walDir
 	s.snapDir = filepath.Join(dataDir, "member", "snap")
 	s.skipHashCheck = cfg.SkipHashCheck
+	s.keyProvider = cfg.KeyProvider
 
 	s.lg.Info(
 		"restoring snapshot",
@@ -264,6 +293,11 @@ func (s *v3Manager) Restore(cfg RestoreConfig) error {
 	if err = s.saveDB(); err != nil {
 		return err
 	}
+	if backupDir != "" {
+		if err = s.replayBackup(backupDir, cfg.ToRevision, cfg.ToTime); err != nil {
+			return err
+		}
+	}
 	hardstate, err := s.saveWALAndSnap()
 	if err != nil {
 		return err
@@ -307,9 +341,25 @@ func (s *v3Manager) saveDB() error {
 		return err
 	}
 
+	kr := serverstorage.NewKeyring(s.keyProvider)
+	if err = serverstorage.LoadKeyring(be, kr); err != nil {
+		return err
+	}
+	s.keyring = kr
 	return nil
 }
 
+// openBackend opens the restored backend, encrypted by the restored keyring
+// if any.
+func (s *v3Manager) openBackend() backend.Backend {
+	bcfg := backend.DefaultBackendConfig(s.lg)
+	bcfg.Path = s.outDbPath()
+	if s.keyring != nil {
+		bcfg.Encryptor = s.keyring
+	}
+	return backend.New(bcfg)
+}
+
 func (s *v3Manager) copyAndVerifyDB() error {
 	srcf, ferr := os.Open(s.srcDbPath)
 	if ferr != nil {
@@ -397,7 +447,7 @@ func (s *v3Manager) saveWALAndSnap() (*raftpb.HardState, error) {
 	// add members again to persist them to the store we create.
 	st := v2store.New(etcdserver.StoreClusterPrefix, etcdserver.StoreKeysPrefix)
 	s.cl.SetStore(st)
-	be := backend.NewDefaultBackend(s.lg, s.outDbPath())
+	be := s.openBackend()
 	defer be.Close()
 	s.cl.SetBackend(schema.NewMembershipBackend(s.lg, be))
 	for _, m := range s.cl.Members() {
@@ -415,6 +465,9 @@ func (s *v3Manager) saveWALAndSnap() (*raftpb.HardState, error) {
 		return nil, walerr
 	}
 	defer w.Close()
+	if s.keyring != nil {
+		w.SetEncryptor(s.keyring)
+	}
 
 	peers := make([]raft.Peer, len(s.cl.MemberIDs()))
 	for i, id := range s.cl.MemberIDs() {
@@ -480,7 +533,7 @@ func (s *v3Manager) saveWALAndSnap() (*raftpb.HardState, error) {
 }
 
 func (s *v3Manager) updateCIndex(commit uint64, term uint64) error {
-	be := backend.NewDefaultBackend(s.lg, s.outDbPath())
+	be := s.openBackend()
 	defer be.Close()
 
 	cindex.UpdateConsistentIndexForce(be.BatchTx(), commit, term)
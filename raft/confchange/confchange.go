@@ -115,6 +115,7 @@ func (c Changer) LeaveJoint() (tracker.Config, tracker.ProgressMap, error) {
 
 		if !isVoter && !isLearner {
 			delete(prs, id)
+			nilAwareDelete(&cfg.Witnesses, id)
 		}
 	}
 	*outgoingPtr(&cfg.Voters) = nil
@@ -163,6 +164,8 @@ func (c Changer) apply(cfg *tracker.Config, prs tracker.ProgressMap, ccs ...pb.C
 			c.makeVoter(cfg, prs, cc.NodeID)
 		case pb.ConfChangeAddLearnerNode:
 			c.makeLearner(cfg, prs, cc.NodeID)
+		case pb.ConfChangeAddWitnessNode:
+			c.makeWitness(cfg, prs, cc.NodeID)
 		case pb.ConfChangeRemoveNode:
 			c.remove(cfg, prs, cc.NodeID)
 		case pb.ConfChangeUpdateNode:
@@ -186,11 +189,22 @@ func (c Changer) makeVoter(cfg *tracker.Config, prs tracker.ProgressMap, id uint
 	}
 
 	pr.IsLearner = false
+	pr.IsWitness = false
 	nilAwareDelete(&cfg.Learners, id)
 	nilAwareDelete(&cfg.LearnersNext, id)
+	nilAwareDelete(&cfg.Witnesses, id)
 	incoming(cfg.Voters)[id] = struct{}{}
 }
 
+// makeWitness adds or turns the given ID into a witness in the incoming
+// majority config. A witness is a voter that never campaigns; whether it can
+// do without the state a regular voter keeps is up to the application.
+func (c Changer) makeWitness(cfg *tracker.Config, prs tracker.ProgressMap, id uint64) {
+	c.makeVoter(cfg, prs, id)
+	prs[id].IsWitness = true
+	nilAwareAdd(&cfg.Witnesses, id)
+}
+
 // makeLearner makes the given ID a learner or stages it to be a learner once
 // an active joint configuration is exited.
 //
@@ -213,6 +227,9 @@ func (c Changer) makeLearner(cfg *tracker.Config, prs tracker.ProgressMap, id ui
 	if pr.IsLearner {
 		return
 	}
+	// Learners are never witnesses.
+	pr.IsWitness = false
+	nilAwareDelete(&cfg.Witnesses, id)
 	// Remove any existing voter in the incoming config...
 	c.remove(cfg, prs, id)
 	// ... but save the Progress.
@@ -243,6 +260,7 @@ func (c Changer) remove(cfg *tracker.Config, prs tracker.ProgressMap, id uint64)
 	// If the peer is still a voter in the outgoing config, keep the Progress.
 	if _, onRight := outgoing(cfg.Voters)[id]; !onRight {
 		delete(prs, id)
+		nilAwareDelete(&cfg.Witnesses, id)
 	}
 }
 
@@ -264,7 +282,7 @@ func (c Changer) initProgress(cfg *tracker.Config, prs tracker.ProgressMap, id u
 		// making the first index the better choice).
 		Next:      c.LastIndex,
 		Match:     0,
-		Inflights: tracker.NewInflights(c.Tracker.MaxInflight),
+		Inflights: tracker.NewInflights(c.Tracker.MaxInflight, c.Tracker.MaxInflightBytes),
 		IsLearner: isLearner,
 		// When a node is first added, we should mark it as recently active.
 		// Otherwise, CheckQuorum may cause us to step down if it is invoked
@@ -287,6 +305,7 @@ func checkInvariants(cfg tracker.Config, prs tracker.ProgressMap) error {
 		cfg.Voters.IDs(),
 		cfg.Learners,
 		cfg.LearnersNext,
+		cfg.Witnesses,
 	} {
 		for id := range ids {
 			if _, ok := prs[id]; !ok {
@@ -318,6 +337,21 @@ func checkInvariants(cfg tracker.Config, prs tracker.ProgressMap) error {
 		}
 	}
 
+	// Witnesses are voters, and marked as such.
+	for id := range cfg.Witnesses {
+		if _, ok := cfg.Voters.IDs()[id]; !ok {
+			return fmt.Errorf("%d is in Witnesses, but not in Voters", id)
+		}
+		if !prs[id].IsWitness {
+			return fmt.Errorf("%d is in Witnesses, but is not marked as witness", id)
+		}
+	}
+	for id, pr := range prs {
+		if _, ok := cfg.Witnesses[id]; pr.IsWitness && !ok {
+			return fmt.Errorf("%d is marked as witness, but is not in Witnesses", id)
+		}
+	}
+
 	if !joint(cfg) {
 		// We enforce that empty maps are nil instead of zero.
 		if outgoing(cfg.Voters) != nil {
@@ -28,7 +28,7 @@ import (
 
 func TestConfChangeDataDriven(t *testing.T) {
 	datadriven.Walk(t, "testdata", func(t *testing.T, path string) {
-		tr := tracker.MakeProgressTracker(10)
+		tr := tracker.MakeProgressTracker(10, 0)
 		c := Changer{
 			Tracker:   tr,
 			LastIndex: 0, // incremented in this test with each cmd
@@ -63,6 +63,8 @@ func TestConfChangeDataDriven(t *testing.T) {
 					cc.Type = pb.ConfChangeAddNode
 				case 'l':
 					cc.Type = pb.ConfChangeAddLearnerNode
+				case 'w':
+					cc.Type = pb.ConfChangeAddWitnessNode
 				case 'r':
 					cc.Type = pb.ConfChangeRemoveNode
 				case 'u':
@@ -52,13 +52,21 @@ func toConfChangeSingle(cs pb.ConfState) (out []pb.ConfChangeSingle, in []pb.Con
 	//
 	// as desired.
 
+	witnesses := make(map[uint64]struct{}, len(cs.Witnesses))
+	for _, id := range cs.Witnesses {
+		witnesses[id] = struct{}{}
+	}
+	addVoter := func(id uint64) pb.ConfChangeSingle {
+		if _, ok := witnesses[id]; ok {
+			return pb.ConfChangeSingle{Type: pb.ConfChangeAddWitnessNode, NodeID: id}
+		}
+		return pb.ConfChangeSingle{Type: pb.ConfChangeAddNode, NodeID: id}
+	}
+
 	for _, id := range cs.VotersOutgoing {
 		// If there are outgoing voters, first add them one by one so that the
 		// (non-joint) config has them all.
-		out = append(out, pb.ConfChangeSingle{
-			Type:   pb.ConfChangeAddNode,
-			NodeID: id,
-		})
+		out = append(out, addVoter(id))
 
 	}
 
@@ -74,10 +82,7 @@ func toConfChangeSingle(cs pb.ConfState) (out []pb.ConfChangeSingle, in []pb.Con
 	}
 	// Then we'll add the incoming voters and learners.
 	for _, id := range cs.Voters {
-		in = append(in, pb.ConfChangeSingle{
-			Type:   pb.ConfChangeAddNode,
-			NodeID: id,
-		})
+		in = append(in, addVoter(id))
 	}
 	for _, id := range cs.Learners {
 		in = append(in, pb.ConfChangeSingle{
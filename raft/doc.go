@@ -169,6 +169,27 @@ given ID MUST be used only once even if the old node has been removed.
 This means that for example IP addresses make poor node IDs since they
 may be reused. Node IDs must be non-zero.
 
+Asynchronous storage writes
+
+The Ready handling loop above writes to stable storage before sending
+messages and before applying committed entries, and the next Ready is only
+handed out after Advance. With Config.AsyncStorageWrites set, the storage
+work is instead described by messages in Ready.Messages:
+
+ 1. A 'MsgStorageAppend' to LocalAppendThread carries the entries, HardState
+    and snapshot to write, in order. Once they are durable, the messages in
+    its Responses field must be delivered. These include the node's own
+    acknowledgements and the responses to other nodes that depend on them.
+
+ 2. A 'MsgStorageApply' to LocalApplyThread carries the committed entries to
+    apply, in order. Once they are applied, the messages in its Responses
+    field must be stepped back into the node.
+
+All the other messages can be sent right away, and Advance must not be called;
+the next Ready may be requested before the storage work of the previous one
+is done. Committed entries are only handed to the apply thread once they are
+durable on the local node.
+
 Implementation notes
 
 This implementation is up to date with the final Raft thesis
@@ -296,5 +317,20 @@ stale log entries:
 	indicating 'MsgApp' is lost. When follower's progress state is replicate,
 	the leader sets it back to probe.
 
+	'MsgQuiesce' is only used with Config.QuiesceTicks. A leader that has been
+	idle for QuiesceTicks and whose followers have all caught up sends it in
+	place of 'MsgHeartbeat'. A follower whose log matches the one of the leader
+	commits up to the index in the message and stops counting towards an
+	election timeout. Any other message, including 'MsgUnreachable', wakes a
+	quiesced node up again, and a leader that wakes up heartbeats right away.
+
+	'MsgStorageAppend' and 'MsgStorageApply' are only used with
+	Config.AsyncStorageWrites. They are sent by a node to its local append
+	and apply threads (LocalAppendThread and LocalApplyThread) in place of
+	the Entries, HardState, Snapshot and CommittedEntries of a Ready. Once
+	the work of such a message is done, the messages in its Responses field
+	are delivered, and 'MsgStorageAppendResp' and 'MsgStorageApplyResp' tell
+	the node which entries are durable and which are applied.
+
 */
 package raft
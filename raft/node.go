@@ -42,10 +42,12 @@ var (
 type SoftState struct {
 	Lead      uint64 // must use atomic operations to access; keep 64-bit aligned.
 	RaftState StateType
+	// Quiesced is set while the raft group is quiesced, see Config.QuiesceTicks.
+	Quiesced bool
 }
 
 func (a *SoftState) equal(b *SoftState) bool {
-	return a.Lead == b.Lead && a.RaftState == b.RaftState
+	return a.Lead == b.Lead && a.RaftState == b.RaftState && a.Quiesced == b.Quiesced
 }
 
 // Ready encapsulates the entries and messages that are ready to read,
@@ -91,6 +93,10 @@ type Ready struct {
 	MustSync bool
 }
 
+// With AsyncStorageWrites, the Entries, HardState and Snapshot of a Ready are
+// also carried by a MsgStorageAppend message in Messages, and its
+// CommittedEntries by a MsgStorageApply message. See Config.AsyncStorageWrites.
+
 func isHardStateEqual(a, b pb.HardState) bool {
 	return a.Term == b.Term && a.Vote == b.Vote && a.Commit == b.Commit
 }
@@ -170,6 +176,9 @@ type Node interface {
 	// commands. For example. when the last Ready contains a snapshot, the application might take
 	// a long time to apply the snapshot data. To continue receiving Ready without blocking raft
 	// progress, it can call Advance before finishing applying the last ready.
+	//
+	// NOTE: Advance must not be called when using AsyncStorageWrites. Response messages from
+	// the local append and apply threads take its place.
 	// 数据完成状态转换后调用
 	Advance()
 	// ApplyConfChange applies a config change (previously passed to
@@ -185,6 +194,9 @@ type Node interface {
 	// TransferLeadership attempts to transfer leadership to the given transferee.
 	TransferLeadership(ctx context.Context, lead, transferee uint64)
 
+	// SetPriority changes the election priority of the node. See Config.Priority.
+	SetPriority(p uint64)
+
 	// ReadIndex request a read state. The read state will be set in the ready.
 	// Read state has a read index. Once the application advances further than the read
 	// index, any linearizable read requests issued before the read request can be
@@ -273,6 +285,7 @@ type node struct {
 	done       chan struct{}
 	stop       chan struct{}
 	status     chan chan Status
+	priorityc  chan uint64
 
 	rn *RawNode
 }
@@ -288,11 +301,12 @@ func newNode(rn *RawNode) node {
 		// make tickc a buffered chan, so raft node can buffer some ticks when the node
 		// is busy processing raft messages. Raft node will resume process buffered
 		// ticks when it becomes idle.
-		tickc:  make(chan struct{}, 128),
-		done:   make(chan struct{}),
-		stop:   make(chan struct{}),
-		status: make(chan chan Status),
-		rn:     rn,
+		tickc:     make(chan struct{}, 128),
+		done:      make(chan struct{}),
+		stop:      make(chan struct{}),
+		status:    make(chan chan Status),
+		priorityc: make(chan uint64),
+		rn:        rn,
 	}
 }
 
@@ -363,7 +377,7 @@ func (n *node) run() {
 			}
 		case m := <-n.recvc: // 接收节点间的消息
 			// filter out response message from unknown From.
-			if pr := r.prs.Progress[m.From]; pr != nil || !IsResponseMsg(m.Type) {
+			if pr := r.prs.Progress[m.From]; pr != nil || !IsResponseMsg(m.Type) || IsLocalMsgTarget(m.From) {
 				r.Step(m)
 			}
 		case cc := <-n.confc: // 接收客户端配置变更的消息
@@ -403,13 +417,22 @@ func (n *node) run() {
 			n.rn.Tick()
 		case readyc <- rd:
 			n.rn.acceptReady(rd)
-			advancec = n.advancec
+			if !n.rn.raft.asyncStorageWrites {
+				advancec = n.advancec
+			} else {
+				// there is no Advance, the next Ready can be handed out
+				// right away
+				rd = Ready{}
+				readyc = nil
+			}
 		case <-advancec:
 			n.rn.Advance(rd)
 			rd = Ready{}
 			advancec = nil
 		case c := <-n.status:
 			c <- getStatus(r)
+		case p := <-n.priorityc:
+			n.rn.SetPriority(p)
 		case <-n.stop:
 			close(n.done)
 			return
@@ -436,7 +459,7 @@ func (n *node) Propose(ctx context.Context, data []byte) error {
 
 func (n *node) Step(ctx context.Context, m pb.Message) error {
 	// ignore unexpected local messages receiving over network
-	if IsLocalMsg(m.Type) {
+	if IsLocalMsg(m.Type) && !IsLocalMsgTarget(m.From) {
 		// TODO: return an error?
 		return nil
 	}
@@ -565,6 +588,13 @@ func (n *node) TransferLeadership(ctx context.Context, lead, transferee uint64)
 	}
 }
 
+func (n *node) SetPriority(p uint64) {
+	select {
+	case n.priorityc <- p:
+	case <-n.done:
+	}
+}
+
 func (n *node) ReadIndex(ctx context.Context, rctx []byte) error {
 	return n.step(ctx, pb.Message{Type: pb.MsgReadIndex, Entries: []pb.Entry{{Data: rctx}}})
 }
@@ -576,22 +606,172 @@ func newReady(r *raft, prevSoftSt *SoftState, prevHardSt pb.HardState) Ready {
 		CommittedEntries: r.raftLog.nextEnts(),
 		Messages:         r.msgs,
 	}
+	if r.asyncStorageWrites {
+		// the entries and snapshot already handed out are being written
+		// by the append thread, and the committed entries are only
+		// applied once they are stable
+		rd.Entries = r.raftLog.nextUnstableEnts()
+		rd.CommittedEntries = r.raftLog.nextCommittedEnts(false)
+	}
 	if softSt := r.softState(); !softSt.equal(prevSoftSt) {
 		rd.SoftState = softSt
 	}
 	if hardSt := r.hardState(); !isHardStateEqual(hardSt, prevHardSt) {
 		rd.HardState = hardSt
 	}
-	if r.raftLog.unstable.snapshot != nil {
+	if r.asyncStorageWrites {
+		if snap := r.raftLog.nextUnstableSnapshot(); snap != nil {
+			rd.Snapshot = *snap
+		}
+	} else if r.raftLog.unstable.snapshot != nil {
 		rd.Snapshot = *r.raftLog.unstable.snapshot
 	}
 	if len(r.readStates) != 0 {
 		rd.ReadStates = r.readStates
 	}
 	rd.MustSync = MustSync(r.hardState(), prevHardSt, len(rd.Entries))
+
+	if r.asyncStorageWrites {
+		// Enqueue the messages to the local storage threads. The full slice
+		// expression makes append copy r.msgs rather than write past its
+		// end, as r.msgs keeps growing until the Ready is accepted.
+		msgs := rd.Messages[:len(rd.Messages):len(rd.Messages)]
+		if needStorageAppendMsg(r, rd) {
+			msgs = append(msgs, newStorageAppendMsg(r, rd))
+		}
+		if len(rd.CommittedEntries) > 0 {
+			msgs = append(msgs, newStorageApplyMsg(r, rd))
+		}
+		rd.Messages = msgs
+	}
 	return rd
 }
 
+// needStorageAppendMsg returns true if the Ready has something for the local
+// append thread to write, or responses to send once the writes handed out
+// before are durable.
+func needStorageAppendMsg(r *raft, rd Ready) bool {
+	return len(rd.Entries) > 0 ||
+		!IsEmptyHardState(rd.HardState) ||
+		!IsEmptySnap(rd.Snapshot) ||
+		len(r.msgsAfterAppend) > 0
+}
+
+// needStorageAppendRespMsg returns true if the raft node needs to learn when
+// the writes of the MsgStorageAppend for the Ready are durable.
+func needStorageAppendRespMsg(r *raft, rd Ready) bool {
+	// Return true if raft needs to hear about stabilized entries or an applied
+	// snapshot. See the comment in newStorageAppendRespMsg, which explains
+	// why we check hasNextOrInProgressUnstableEnts instead of len(rd.Entries)
+	// > 0.
+	return r.raftLog.hasNextOrInProgressUnstableEnts() ||
+		!IsEmptySnap(rd.Snapshot)
+}
+
+// newStorageAppendMsg creates the message that should be sent to the local
+// append thread to instruct it to append log entries, write an updated hard
+// state, and apply a snapshot. The message also carries a set of responses
+// that should be delivered after the rest of the message is processed. Used
+// with AsyncStorageWrites.
+func newStorageAppendMsg(r *raft, rd Ready) pb.Message {
+	m := pb.Message{
+		Type:     pb.MsgStorageAppend,
+		To:       LocalAppendThread,
+		From:     r.id,
+		Entries:  rd.Entries,
+		Snapshot: rd.Snapshot,
+	}
+	if !IsEmptyHardState(rd.HardState) {
+		// If the Ready includes a HardState update, assign each of its fields
+		// to the corresponding fields in the Message. This allows clients to
+		// reconstruct the HardState and save it to stable storage.
+		hs := rd.HardState
+		m.Term = hs.Term
+		m.Vote = hs.Vote
+		m.Commit = hs.Commit
+	}
+	// Attach all messages in msgsAfterAppend as responses to be delivered after
+	// the message is processed, along with a self-directed MsgStorageAppendResp
+	// to acknowledge the entry stability.
+	//
+	// NB: it is important for performance that MsgStorageAppendResp message be
+	// handled after self-directed MsgAppResp messages on the leader (which will
+	// be contained in msgsAfterAppend). This ordering allows the MsgAppResp
+	// handling to use a fast-path in r.raftLog.term() before the newly appended
+	// entries are removed from the unstable log.
+	m.Responses = r.msgsAfterAppend
+	if needStorageAppendRespMsg(r, rd) {
+		m.Responses = append(m.Responses, newStorageAppendRespMsg(r, rd))
+	}
+	return m
+}
+
+// newStorageAppendRespMsg creates the message that should be returned to node
+// after the unstable log entries, hard state, and snapshot in the current Ready
+// (along with those in all prior Ready structs) have been saved to stable
+// storage.
+func newStorageAppendRespMsg(r *raft, rd Ready) pb.Message {
+	m := pb.Message{
+		Type: pb.MsgStorageAppendResp,
+		To:   r.id,
+		From: LocalAppendThread,
+		// Dropped after term change, see below.
+		Term: r.Term,
+	}
+	if r.raftLog.hasNextOrInProgressUnstableEnts() {
+		// If the raft log has unstable entries, attach the last index and term
+		// of the append to the response message. This (index, term) tuple will
+		// be handed back and consulted when the stability of those log entries
+		// is signaled to the unstable. If the (index, term) match the unstable
+		// log by the time the response is received (unstable.stableTo), the
+		// unstable log can be truncated.
+		//
+		// The term is checked as well, because an entry at the index may have
+		// been overwritten by a new leader while the append was in progress,
+		// and appended again at the same term, in which case the (index, term)
+		// tuple would match without the new entry being durable. The term of
+		// the message is set to the current term, and responses from an older
+		// term are dropped before they reach the unstable log, so that such a
+		// match cannot happen. The entries are then truncated from the
+		// unstable log by the next response of the current term, which covers
+		// them as the appends are processed in order.
+		//
+		// We use the last index of the unstable log, rather than of rd.Entries,
+		// because the entries of a Ready that got a response dropped are only
+		// truncated by a later response, possibly of a Ready with no entries.
+		m.Index = r.raftLog.lastIndex()
+		m.LogTerm = r.raftLog.lastTerm()
+	}
+	if !IsEmptySnap(rd.Snapshot) {
+		m.Snapshot = rd.Snapshot
+	}
+	return m
+}
+
+// newStorageApplyMsg creates the message that should be sent to the local
+// apply thread to instruct it to apply committed log entries. The message
+// also carries a response that should be delivered after the rest of the
+// message is processed. Used with AsyncStorageWrites.
+func newStorageApplyMsg(r *raft, rd Ready) pb.Message {
+	ents := rd.CommittedEntries
+	return pb.Message{
+		Type:    pb.MsgStorageApply,
+		To:      LocalApplyThread,
+		From:    r.id,
+		Term:    0, // committed entries don't apply under a specific term
+		Entries: ents,
+		Responses: []pb.Message{
+			{
+				Type:    pb.MsgStorageApplyResp,
+				To:      r.id,
+				From:    LocalApplyThread,
+				Term:    0, // committed entries don't apply under a specific term
+				Entries: ents,
+			},
+		},
+	}
+}
+
 // MustSync returns true if the hard state and count of Raft entries indicate
 // that a synchronous write to persistent storage is required.
 func MustSync(st, prevst pb.HardState, entsnum int) bool {
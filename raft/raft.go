@@ -33,6 +33,17 @@ import (
 
 // None is a placeholder node ID used when there is no leader.
 const None uint64 = 0
+
+// LocalAppendThread is a reference to a local thread that saves unstable
+// log entries and snapshots to stable storage. The identifier is used as a
+// target for MsgStorageAppend messages when AsyncStorageWrites is enabled.
+const LocalAppendThread uint64 = math.MaxUint64
+
+// LocalApplyThread is a reference to a local thread that applies committed
+// log entries to the local state machine. The identifier is used as a
+// target for MsgStorageApply messages when AsyncStorageWrites is enabled.
+const LocalApplyThread uint64 = math.MaxUint64 - 1
+
 const noLimit = math.MaxUint64
 
 // Possible values for StateType.
@@ -160,6 +171,13 @@ type Config struct {
 	// overflowing that sending buffer. TODO (xiangli): feedback to application to
 	// limit the proposal rate?
 	MaxInflightMsgs int
+	// MaxInflightBytes limits the total byte size of the entries in in-flight
+	// append messages to a single follower, in addition to MaxInflightMsgs.
+	// This bounds the memory used by the transport and the follower when a few
+	// large entries are being replicated. The limit is soft: a message is sent
+	// as long as the limit has not yet been reached, so the actual total may
+	// exceed it by up to MaxSizePerMsg. Note: 0 for no limit.
+	MaxInflightBytes uint64
 
 	// CheckQuorum specifies if the leader should check quorum activity. Leader
 	// steps down when quorum is not active for an electionTimeout.
@@ -196,6 +214,65 @@ type Config struct {
 	// logical clock from assigning the timestamp and then forwarding the data
 	// to the leader.
 	DisableProposalForwarding bool
+
+	// AsyncStorageWrites configures the raft node to write to its local storage
+	// (raft log and state machine) using a request/response message passing
+	// interface instead of the default Ready/Advance function call interface.
+	// Local storage messages can be pipelined and processed asynchronously
+	// (with respect to Ready iteration), facilitating reduced interference
+	// between Raft proposals and increased batching of log appends and state
+	// machine application. As a result, use of asynchronous storage writes can
+	// reduce end-to-end commit latency and increase maximum throughput.
+	//
+	// When true, the Ready.Messages slice will include MsgStorageAppend and
+	// MsgStorageApply messages. The messages will target a LocalAppendThread
+	// and a LocalApplyThread, respectively. Messages to the same target must be
+	// reliably processed in order. In other words, they can't be dropped (like
+	// messages over the network) and those targeted at the same thread can't
+	// be reordered. Messages to different targets can be processed in any
+	// order.
+	//
+	// MsgStorageAppend carries Raft log entries to append, election votes /
+	// term changes / updated commit indexes to persist, and snapshots to apply.
+	// All writes performed in service of a MsgStorageAppend must be durable
+	// before response messages are delivered. However, if the MsgStorageAppend
+	// carries no response messages, durability is not required. The message
+	// assumes the role of the Entries, HardState, and Snapshot fields in Ready.
+	//
+	// MsgStorageApply carries committed entries to apply. Writes performed in
+	// service of a MsgStorageApply need not be durable before response
+	// messages are delivered. The message assumes the role of the
+	// CommittedEntries field in Ready.
+	//
+	// Local messages each carry one or more response messages which should be
+	// delivered after the corresponding storage write has been completed.
+	// These responses may target the same node or may target other nodes. The
+	// storage threads are not responsible for understanding the response
+	// messages, only for delivering them to the correct target after
+	// performing the storage write. Responses targeting the local node are
+	// stepped with Node.Step or RawNode.Step.
+	//
+	// Advance must not be called when AsyncStorageWrites is enabled.
+	AsyncStorageWrites bool
+
+	// Priority is the election priority of this node. A node only votes for a
+	// candidate whose log is exactly as up-to-date as its own if the
+	// candidate's priority is not lower than its own, so that among the nodes
+	// with the most up-to-date log the one with the highest priority is
+	// elected. Leadership transfers are not affected. All nodes have priority
+	// zero by default, which keeps the plain raft election rules. The priority
+	// can be changed later with SetPriority.
+	Priority uint64
+
+	// QuiesceTicks is the number of ticks without proposals after which a
+	// leader whose followers have all caught up quiesces the group: instead of
+	// heartbeating, it sends a final MsgQuiesce after which neither the leader
+	// nor the followers count towards a timeout. Any proposal or message wakes
+	// the group up again. As a quiesced follower does not notice a failed
+	// leader and a quiesced leader does not run CheckQuorum, the application
+	// should report peers it lost the connection to with ReportUnreachable,
+	// which wakes the group up as well. 0 disables quiescence.
+	QuiesceTicks int
 }
 
 func (c *Config) validate() error {
@@ -229,6 +306,10 @@ func (c *Config) validate() error {
 		return errors.New("max inflight messages must be greater than 0")
 	}
 
+	if c.MaxInflightBytes != 0 && c.MaxInflightBytes < c.MaxSizePerMsg {
+		return errors.New("max inflight bytes must be >= max message size")
+	}
+
 	if c.Logger == nil {
 		c.Logger = getLogger()
 	}
@@ -261,7 +342,21 @@ type raft struct {
 	// isLearner is true if the local raft node is a learner.
 	isLearner bool
 
+	// msgs contains the list of messages that should be sent out immediately to
+	// other nodes.
+	//
+	// Messages in this list must target other nodes.
 	msgs []pb.Message
+	// msgsAfterAppend contains the list of messages that should be sent after
+	// the accumulated unstable state (e.g. term, vote, []entry, and snapshot)
+	// has been persisted to durable storage. This includes waiting for any
+	// unstable state that is already in the process of being persisted (i.e.
+	// has already been handed out in a prior Ready struct) to complete.
+	//
+	// Messages in this list may target other nodes or may target this node.
+	// It is only used with AsyncStorageWrites, without it the messages are
+	// all in msgs, which are sent after the Ready is persisted.
+	msgsAfterAppend []pb.Message
 
 	// the leader id
 	lead uint64
@@ -292,8 +387,19 @@ type raft struct {
 	// only leader keeps heartbeatElapsed.
 	heartbeatElapsed int
 
-	checkQuorum bool
-	preVote     bool
+	// number of ticks since the last proposal or wake up.
+	// only leader keeps idleElapsed.
+	idleElapsed  int
+	quiesceTicks int
+	// quiesced is set while the group is quiesced; a quiesced node does not
+	// tick towards an election or heartbeat timeout.
+	quiesced bool
+
+	checkQuorum        bool
+	preVote            bool
+	asyncStorageWrites bool
+
+	priority uint64
 
 	heartbeatTimeout int
 	electionTimeout  int
@@ -332,7 +438,7 @@ func newRaft(c *Config) *raft {
 		raftLog:                   raftlog,
 		maxMsgSize:                c.MaxSizePerMsg,
 		maxUncommittedSize:        c.MaxUncommittedEntriesSize,
-		prs:                       tracker.MakeProgressTracker(c.MaxInflightMsgs),
+		prs:                       tracker.MakeProgressTracker(c.MaxInflightMsgs, c.MaxInflightBytes),
 		electionTimeout:           c.ElectionTick,
 		heartbeatTimeout:          c.HeartbeatTick,
 		logger:                    c.Logger,
@@ -340,6 +446,9 @@ func newRaft(c *Config) *raft {
 		preVote:                   c.PreVote,
 		readOnly:                  newReadOnly(c.ReadOnlyOption),
 		disableProposalForwarding: c.DisableProposalForwarding,
+		asyncStorageWrites:        c.AsyncStorageWrites,
+		priority:                  c.Priority,
+		quiesceTicks:              c.QuiesceTicks,
 	}
 
 	cfg, prs, err := confchange.Restore(confchange.Changer{
@@ -371,7 +480,9 @@ func newRaft(c *Config) *raft {
 
 func (r *raft) hasLeader() bool { return r.lead != None }
 
-func (r *raft) softState() *SoftState { return &SoftState{Lead: r.lead, RaftState: r.state} }
+func (r *raft) softState() *SoftState {
+	return &SoftState{Lead: r.lead, RaftState: r.state, Quiesced: r.quiesced}
+}
 
 func (r *raft) hardState() pb.HardState {
 	return pb.HardState{
@@ -415,7 +526,18 @@ func (r *raft) send(m pb.Message) {
 			m.Term = r.Term
 		}
 	}
-	r.msgs = append(r.msgs, m)
+	if r.asyncStorageWrites && (m.Type == pb.MsgAppResp || m.Type == pb.MsgVoteResp || m.Type == pb.MsgPreVoteResp) {
+		// With async storage writes, the messages in msgs may be sent out
+		// before the unstable state (log entries and election votes) has
+		// been durably synced to the local disk. These responses acknowledge
+		// that state, so they are held back until it is persisted, and sent
+		// as responses of the MsgStorageAppend carrying it. This includes the
+		// acknowledgements a node sends to itself, see appendEntry and
+		// campaign.
+		r.msgsAfterAppend = append(r.msgsAfterAppend, m)
+	} else {
+		r.msgs = append(r.msgs, m)
+	}
 }
 
 // sendAppend sends an append RPC with new entries (if any) and the
@@ -479,7 +601,7 @@ func (r *raft) maybeSendAppend(to uint64, sendIfEmpty bool) bool {
 			case tracker.StateReplicate:
 				last := m.Entries[n-1].Index
 				pr.OptimisticUpdate(last)
-				pr.Inflights.Add(last)
+				pr.Inflights.Add(last, payloadsSize(m.Entries))
 			case tracker.StateProbe:
 				pr.ProbeSent = true
 			default:
@@ -548,26 +670,7 @@ func (r *raft) advance(rd Ready) {
 	// new Commit index, this does not mean that we're also applying
 	// all of the new entries due to commit pagination by size.
 	if newApplied := rd.appliedCursor(); newApplied > 0 {
-		oldApplied := r.raftLog.applied
-		r.raftLog.appliedTo(newApplied)
-
-		if r.prs.Config.AutoLeave && oldApplied <= r.pendingConfIndex && newApplied >= r.pendingConfIndex && r.state == StateLeader {
-			// If the current (and most recent, at least for this leader's term)
-			// configuration should be auto-left, initiate that now. We use a
-			// nil Data which unmarshals into an empty ConfChangeV2 and has the
-			// benefit that appendEntry can never refuse it based on its size
-			// (which registers as zero).
-			ent := pb.Entry{
-				Type: pb.EntryConfChangeV2,
-				Data: nil,
-			}
-			// There's no way in which this proposal should be able to be rejected.
-			if !r.appendEntry(ent) {
-				panic("refused un-refusable auto-leaving ConfChangeV2")
-			}
-			r.pendingConfIndex = r.raftLog.lastIndex()
-			r.logger.Infof("initiating automatic transition out of joint configuration %s", r.prs.Config)
-		}
+		r.appliedTo(newApplied)
 	}
 
 	if len(rd.Entries) > 0 {
@@ -579,6 +682,40 @@ func (r *raft) advance(rd Ready) {
 	}
 }
 
+// appliedTo records that the entries up to index have been applied, and
+// starts leaving a joint configuration that was waiting for it.
+func (r *raft) appliedTo(index uint64) {
+	oldApplied := r.raftLog.applied
+	newApplied := max(index, oldApplied)
+	r.raftLog.appliedTo(newApplied)
+
+	if r.prs.Config.AutoLeave && oldApplied <= r.pendingConfIndex && newApplied >= r.pendingConfIndex && r.state == StateLeader {
+		// If the current (and most recent, at least for this leader's term)
+		// configuration should be auto-left, initiate that now. We use a
+		// nil Data which unmarshals into an empty ConfChangeV2 and has the
+		// benefit that appendEntry can never refuse it based on its size
+		// (which registers as zero).
+		ent := pb.Entry{
+			Type: pb.EntryConfChangeV2,
+			Data: nil,
+		}
+		// There's no way in which this proposal should be able to be rejected.
+		if !r.appendEntry(ent) {
+			panic("refused un-refusable auto-leaving ConfChangeV2")
+		}
+		r.pendingConfIndex = r.raftLog.lastIndex()
+		r.logger.Infof("initiating automatic transition out of joint configuration %s", r.prs.Config)
+	}
+}
+
+// appliedSnap records that the snapshot has been written to storage and
+// applied.
+func (r *raft) appliedSnap(snap pb.Snapshot) {
+	index := snap.Metadata.Index
+	r.raftLog.stableSnapTo(index)
+	r.appliedTo(index)
+}
+
 // maybeCommit attempts to advance the commit index. Returns true if
 // the commit index changed (in which case the caller should call
 // r.bcastAppend).
@@ -596,6 +733,8 @@ func (r *raft) reset(term uint64) {
 
 	r.electionElapsed = 0
 	r.heartbeatElapsed = 0
+	r.idleElapsed = 0
+	r.quiesced = false
 	r.resetRandomizedElectionTimeout()
 
 	r.abortLeaderTransfer()
@@ -605,8 +744,9 @@ func (r *raft) reset(term uint64) {
 		*pr = tracker.Progress{
 			Match:     0,
 			Next:      r.raftLog.lastIndex() + 1,
-			Inflights: tracker.NewInflights(r.prs.MaxInflight),
+			Inflights: tracker.NewInflights(r.prs.MaxInflight, r.prs.MaxInflightBytes),
 			IsLearner: pr.IsLearner,
+			IsWitness: pr.IsWitness,
 		}
 		if id == r.id {
 			pr.Match = r.raftLog.lastIndex()
@@ -635,6 +775,13 @@ func (r *raft) appendEntry(es ...pb.Entry) (accepted bool) {
 	}
 	// use latest "last" index after truncate/append
 	li = r.raftLog.append(es...)
+	if r.asyncStorageWrites {
+		// The leader only counts the entries towards the commit index once
+		// they are persisted, which it learns from the MsgAppResp it sends
+		// itself after the append.
+		r.send(pb.Message{To: r.id, Type: pb.MsgAppResp, Index: li})
+		return true
+	}
 	r.prs.Progress[r.id].MaybeUpdate(li)
 	// Regardless of maybeCommit's return, our caller will call bcastAppend.
 	r.maybeCommit()
@@ -643,6 +790,9 @@ func (r *raft) appendEntry(es ...pb.Entry) (accepted bool) {
 
 // tickElection is run by followers and candidates after r.electionTimeout.
 func (r *raft) tickElection() {
+	if r.quiesced {
+		return
+	}
 	r.electionElapsed++
 
 	if r.promotable() && r.pastElectionTimeout() {
@@ -655,8 +805,12 @@ func (r *raft) tickElection() {
 
 // tickHeartbeat is run by leaders to send a MsgBeat after r.heartbeatTimeout.
 func (r *raft) tickHeartbeat() {
+	if r.quiesced {
+		return
+	}
 	r.heartbeatElapsed++
 	r.electionElapsed++
+	r.idleElapsed++
 
 	if r.electionElapsed >= r.electionTimeout {
 		r.electionElapsed = 0
@@ -677,12 +831,85 @@ func (r *raft) tickHeartbeat() {
 
 	if r.heartbeatElapsed >= r.heartbeatTimeout {
 		r.heartbeatElapsed = 0
+		if r.maybeQuiesce() {
+			return
+		}
 		if err := r.Step(pb.Message{From: r.id, Type: pb.MsgBeat}); err != nil {
 			r.logger.Debugf("error occurred during checking sending heartbeat: %v", err)
 		}
 	}
 }
 
+// maybeQuiesce quiesces the group if the leader has been idle for quiesceTicks
+// and every follower has caught up with it, in which case it sends MsgQuiesce
+// in place of the heartbeats and returns true.
+func (r *raft) maybeQuiesce() bool {
+	if r.quiesceTicks <= 0 || r.idleElapsed < r.quiesceTicks {
+		return false
+	}
+	if r.leadTransferee != None || len(r.readOnly.pendingReadIndex) != 0 || len(r.prs.Config.Voters[1]) > 0 {
+		return false
+	}
+	li := r.raftLog.lastIndex()
+	if r.raftLog.committed != li {
+		return false
+	}
+	caughtUp := true
+	r.prs.Visit(func(id uint64, pr *tracker.Progress) {
+		if pr.Match != li || (id != r.id && pr.State != tracker.StateReplicate) {
+			caughtUp = false
+		}
+	})
+	if !caughtUp {
+		return false
+	}
+
+	lt := r.raftLog.lastTerm()
+	r.prs.Visit(func(id uint64, _ *tracker.Progress) {
+		if id == r.id {
+			return
+		}
+		r.send(pb.Message{To: id, Type: pb.MsgQuiesce, Index: li, LogTerm: lt, Commit: li})
+	})
+	r.quiesced = true
+	r.logger.Debugf("%x quiesced at term %d, index %d", r.id, r.Term, li)
+	return true
+}
+
+// maybeWake wakes up a quiesced node on any message but those that keep the
+// group quiesced.
+func (r *raft) maybeWake(m pb.Message) {
+	if !r.quiesced {
+		return
+	}
+	switch m.Type {
+	case pb.MsgBeat, pb.MsgCheckQuorum, pb.MsgQuiesce,
+		pb.MsgStorageAppend, pb.MsgStorageAppendResp, pb.MsgStorageApply, pb.MsgStorageApplyResp:
+		return
+	case pb.MsgAppResp, pb.MsgHeartbeatResp:
+		// Late responses from followers which have caught up do not need the
+		// leader to wake up.
+		if pr := r.prs.Progress[m.From]; pr != nil && !m.Reject && pr.Match == r.raftLog.lastIndex() {
+			return
+		}
+	}
+	r.wake()
+}
+
+// wake brings a quiesced node back to ticking. A leader heartbeats right away
+// so that its followers wake up too.
+func (r *raft) wake() {
+	r.quiesced = false
+	r.logger.Debugf("%x woke up at term %d", r.id, r.Term)
+	if r.state != StateLeader {
+		return
+	}
+	r.idleElapsed = 0
+	r.heartbeatElapsed = 0
+	r.electionElapsed = 0
+	r.bcastHeartbeat()
+}
+
 func (r *raft) becomeFollower(term uint64, lead uint64) {
 	r.step = stepFollower
 	r.reset(term)
@@ -800,7 +1027,11 @@ func (r *raft) campaign(t CampaignType) {
 		voteMsg = pb.MsgVote
 		term = r.Term
 	}
-	if _, _, res := r.poll(r.id, voteRespMsgType(voteMsg), true); res == quorum.VoteWon {
+	if r.asyncStorageWrites {
+		// The vote for ourselves only counts once it is persisted, it is
+		// sent to ourselves with the MsgStorageAppend carrying it, see the
+		// loop below.
+	} else if _, _, res := r.poll(r.id, voteRespMsgType(voteMsg), true); res == quorum.VoteWon {
 		// We won the election after voting for ourselves (which must mean that
 		// this is a single-node cluster). Advance to the next state.
 		if t == campaignPreElection {
@@ -821,6 +1052,9 @@ func (r *raft) campaign(t CampaignType) {
 	}
 	for _, id := range ids {
 		if id == r.id {
+			if r.asyncStorageWrites {
+				r.send(pb.Message{To: id, Term: term, Type: voteRespMsgType(voteMsg)})
+			}
 			continue
 		}
 		r.logger.Infof("%x [logterm: %d, index: %d] sent %s request to %x at term %d",
@@ -830,8 +1064,27 @@ func (r *raft) campaign(t CampaignType) {
 		if t == campaignTransfer {
 			ctx = []byte(t)
 		}
-		r.send(pb.Message{Term: term, To: id, Type: voteMsg, Index: r.raftLog.lastIndex(), LogTerm: r.raftLog.lastTerm(), Context: ctx})
+		r.send(pb.Message{Term: term, To: id, Type: voteMsg, Index: r.raftLog.lastIndex(), LogTerm: r.raftLog.lastTerm(), Context: ctx, Priority: r.priority})
+	}
+}
+
+// prefersSelf returns true if the node should reject the vote request m in
+// favour of itself: its log is as up-to-date as the candidate's and its
+// priority is higher. A leadership transfer is never rejected this way, and a
+// node that cannot campaign, such as a witness, never prefers itself.
+func (r *raft) prefersSelf(m pb.Message) bool {
+	if r.priority <= m.Priority || bytes.Equal(m.Context, []byte(campaignTransfer)) || !r.promotable() {
+		return false
 	}
+	return m.LogTerm == r.raftLog.lastTerm() && m.Index == r.raftLog.lastIndex()
+}
+
+// setPriority changes the election priority of the node.
+func (r *raft) setPriority(p uint64) {
+	if r.priority != p {
+		r.logger.Infof("%x changed election priority from %d to %d", r.id, r.priority, p)
+	}
+	r.priority = p
 }
 
 func (r *raft) poll(id uint64, t pb.MessageType, v bool) (granted int, rejected int, result quorum.VoteResult) {
@@ -845,6 +1098,8 @@ func (r *raft) poll(id uint64, t pb.MessageType, v bool) (granted int, rejected
 }
 
 func (r *raft) Step(m pb.Message) error {
+	r.maybeWake(m)
+
 	// Handle the message term, which may result in our stepping down to a follower.
 	switch {
 	case m.Term == 0:
@@ -873,7 +1128,7 @@ func (r *raft) Step(m pb.Message) error {
 		default:
 			r.logger.Infof("%x [term: %d] received a %s message with higher term from %x [term: %d]",
 				r.id, r.Term, m.Type, m.From, m.Term)
-			if m.Type == pb.MsgApp || m.Type == pb.MsgHeartbeat || m.Type == pb.MsgSnap {
+			if m.Type == pb.MsgApp || m.Type == pb.MsgHeartbeat || m.Type == pb.MsgSnap || m.Type == pb.MsgQuiesce {
 				r.becomeFollower(m.Term, m.From)
 			} else {
 				r.becomeFollower(m.Term, None)
@@ -881,7 +1136,7 @@ func (r *raft) Step(m pb.Message) error {
 		}
 
 	case m.Term < r.Term:
-		if (r.checkQuorum || r.preVote) && (m.Type == pb.MsgHeartbeat || m.Type == pb.MsgApp) {
+		if (r.checkQuorum || r.preVote) && (m.Type == pb.MsgHeartbeat || m.Type == pb.MsgApp || m.Type == pb.MsgQuiesce) {
 			// We have received messages from a leader at a lower term. It is possible
 			// that these messages were simply delayed in the network, but this could
 			// also mean that this node has advanced its term number during a network
@@ -911,6 +1166,20 @@ func (r *raft) Step(m pb.Message) error {
 			r.logger.Infof("%x [logterm: %d, index: %d, vote: %x] rejected %s from %x [logterm: %d, index: %d] at term %d",
 				r.id, r.raftLog.lastTerm(), r.raftLog.lastIndex(), r.Vote, m.Type, m.From, m.LogTerm, m.Index, r.Term)
 			r.send(pb.Message{To: m.From, Term: r.Term, Type: pb.MsgPreVoteResp, Reject: true})
+		} else if m.Type == pb.MsgStorageAppendResp {
+			if m.Index != 0 {
+				// Don't consider the appended log entries to be stable because
+				// they may have been overwritten in the unstable log during a
+				// later term.
+				r.logger.Infof("%x [term: %d] ignored entry appends from a %s message with lower term [term: %d]",
+					r.id, r.Term, m.Type, m.Term)
+			}
+			if !IsEmptySnap(m.Snapshot) {
+				// Even if the snapshot applied under a different term, its
+				// application is still valid. Snapshots carry committed
+				// (term-independent) state.
+				r.appliedSnap(m.Snapshot)
+			}
 		} else {
 			// ignore other cases
 			r.logger.Infof("%x [term: %d] ignored a %s message with lower term from %x [term: %d]",
@@ -927,6 +1196,21 @@ func (r *raft) Step(m pb.Message) error {
 			r.hup(campaignElection)
 		}
 
+	case pb.MsgStorageAppendResp:
+		if m.Index != 0 {
+			r.raftLog.stableTo(m.Index, m.LogTerm)
+		}
+		if !IsEmptySnap(m.Snapshot) {
+			r.appliedSnap(m.Snapshot)
+		}
+
+	case pb.MsgStorageApplyResp:
+		if len(m.Entries) > 0 {
+			index := m.Entries[len(m.Entries)-1].Index
+			r.appliedTo(index)
+			r.reduceUncommittedSize(m.Entries)
+		}
+
 	case pb.MsgVote, pb.MsgPreVote:
 		// We can vote if this is a repeat of a vote we've already cast...
 		canVote := r.Vote == m.From ||
@@ -934,8 +1218,9 @@ func (r *raft) Step(m pb.Message) error {
 			(r.Vote == None && r.lead == None) ||
 			// ...or this is a PreVote for a future term...
 			(m.Type == pb.MsgPreVote && m.Term > r.Term)
-		// ...and we believe the candidate is up to date.
-		if canVote && r.raftLog.isUpToDate(m.Index, m.LogTerm) {
+		// ...and we believe the candidate is up to date, and we don't prefer
+		// ourselves over it.
+		if canVote && r.raftLog.isUpToDate(m.Index, m.LogTerm) && !r.prefersSelf(m) {
 			// Note: it turns out that that learners must be allowed to cast votes.
 			// This seems counter- intuitive but is necessary in the situation in which
 			// a learner has been promoted (i.e. is now a voter) but has not learned
@@ -1020,6 +1305,7 @@ func stepLeader(r *raft, m pb.Message) error {
 		if len(m.Entries) == 0 {
 			r.logger.Panicf("%x stepped empty MsgProp", r.id)
 		}
+		r.idleElapsed = 0
 		if r.prs.Progress[r.id] == nil {
 			// If we are not currently a member of the range (i.e. this node
 			// was removed from the configuration while serving as leader),
@@ -1261,7 +1547,7 @@ func stepLeader(r *raft, m pb.Message) error {
 					// to respond to pending read index requests
 					releasePendingReadIndexMessages(r)
 					r.bcastAppend()
-				} else if oldPaused {
+				} else if oldPaused && r.id != m.From {
 					// If we were paused before, this node may be missing the
 					// latest commit index, so send it.
 					r.sendAppend(m.From)
@@ -1271,8 +1557,10 @@ func stepLeader(r *raft, m pb.Message) error {
 				// at once (such as when transitioning from probe to
 				// replicate, or when freeTo() covers multiple messages). If
 				// we have more entries to send, send as many messages as we
-				// can (without sending empty messages for the commit index)
-				for r.maybeSendAppend(m.From, false) {
+				// can (without sending empty messages for the commit index).
+				// The leader acknowledging its own appends (with
+				// AsyncStorageWrites) has nothing to send itself.
+				for r.id != m.From && r.maybeSendAppend(m.From, false) {
 				}
 				// Transfer leadership is in progress.
 				if m.From == r.leadTransferee && pr.Match == r.raftLog.lastIndex() {
@@ -1341,6 +1629,10 @@ func stepLeader(r *raft, m pb.Message) error {
 			r.logger.Debugf("%x is learner. Ignored transferring leadership", r.id)
 			return nil
 		}
+		if pr.IsWitness {
+			r.logger.Debugf("%x is witness. Ignored transferring leadership", m.From)
+			return nil
+		}
 		leadTransferee := m.From
 		lastLeadTransferee := r.leadTransferee
 		if lastLeadTransferee != None {
@@ -1393,6 +1685,9 @@ func stepCandidate(r *raft, m pb.Message) error {
 	case pb.MsgHeartbeat:
 		r.becomeFollower(m.Term, m.From) // always m.Term == r.Term
 		r.handleHeartbeat(m)
+	case pb.MsgQuiesce:
+		r.becomeFollower(m.Term, m.From) // always m.Term == r.Term
+		r.handleQuiesce(m)
 	case pb.MsgSnap:
 		r.becomeFollower(m.Term, m.From) // always m.Term == r.Term
 		r.handleSnapshot(m)
@@ -1438,6 +1733,10 @@ func stepFollower(r *raft, m pb.Message) error {
 		r.electionElapsed = 0
 		r.lead = m.From
 		r.handleHeartbeat(m)
+	case pb.MsgQuiesce:
+		r.electionElapsed = 0
+		r.lead = m.From
+		r.handleQuiesce(m)
 	case pb.MsgSnap:
 		r.electionElapsed = 0
 		r.lead = m.From
@@ -1515,6 +1814,16 @@ func (r *raft) handleHeartbeat(m pb.Message) {
 	r.send(pb.Message{To: m.From, Type: pb.MsgHeartbeatResp, Context: m.Context})
 }
 
+// handleQuiesce quiesces a follower whose log matches the leader's. A follower
+// that has not caught up stays awake and eventually times out.
+func (r *raft) handleQuiesce(m pb.Message) {
+	if r.raftLog.lastIndex() != m.Index || !r.raftLog.matchTerm(m.Index, m.LogTerm) {
+		return
+	}
+	r.raftLog.commitTo(m.Commit)
+	r.quiesced = true
+}
+
 func (r *raft) handleSnapshot(m pb.Message) {
 	sindex, sterm := m.Snapshot.Metadata.Index, m.Snapshot.Metadata.Term
 	if r.restore(m.Snapshot) {
@@ -1591,7 +1900,7 @@ func (r *raft) restore(s pb.Snapshot) bool {
 	r.raftLog.restore(s)
 
 	// Reset the configuration and add the (potentially updated) peers in anew.
-	r.prs = tracker.MakeProgressTracker(r.prs.MaxInflight)
+	r.prs = tracker.MakeProgressTracker(r.prs.MaxInflight, r.prs.MaxInflightBytes)
 	cfg, prs, err := confchange.Restore(confchange.Changer{
 		Tracker:   r.prs,
 		LastIndex: r.raftLog.lastIndex(),
@@ -1614,10 +1923,11 @@ func (r *raft) restore(s pb.Snapshot) bool {
 }
 
 // promotable indicates whether state machine can be promoted to leader,
-// which is true when its own id is in progress list.
+// which is true when its own id is in progress list and it is neither a
+// learner nor a witness.
 func (r *raft) promotable() bool {
 	pr := r.prs.Progress[r.id]
-	return pr != nil && !pr.IsLearner && !r.raftLog.hasPendingSnapshot()
+	return pr != nil && !pr.IsLearner && !pr.IsWitness && !r.raftLog.hasPendingSnapshot()
 }
 
 func (r *raft) applyConfChange(cc pb.ConfChangeV2) pb.ConfState {
@@ -1688,6 +1998,9 @@ func (r *raft) switchToConfig(cfg tracker.Config, prs tracker.ProgressMap) pb.Co
 		// let them wait out a heartbeat interval (or the next incoming
 		// proposal).
 		r.prs.Visit(func(id uint64, pr *tracker.Progress) {
+			if id == r.id {
+				return
+			}
 			r.maybeSendAppend(id, false /* sendIfEmpty */)
 		})
 	}
@@ -1759,10 +2072,7 @@ func (r *raft) responseToReadIndexReq(req pb.Message, readIndex uint64) pb.Messa
 // Empty payloads are never refused. This is used both for appending an empty
 // entry at a new leader's term, as well as leaving a joint configuration.
 func (r *raft) increaseUncommittedSize(ents []pb.Entry) bool {
-	var s uint64
-	for _, e := range ents {
-		s += uint64(PayloadSize(e))
-	}
+	s := payloadsSize(ents)
 
 	if r.uncommittedSize > 0 && s > 0 && r.uncommittedSize+s > r.maxUncommittedSize {
 		// If the uncommitted tail of the Raft log is empty, allow any size
@@ -1786,10 +2096,7 @@ func (r *raft) reduceUncommittedSize(ents []pb.Entry) {
 		return
 	}
 
-	var s uint64
-	for _, e := range ents {
-		s += uint64(PayloadSize(e))
-	}
+	s := payloadsSize(ents)
 	if s > r.uncommittedSize {
 		// uncommittedSize may underestimate the size of the uncommitted Raft
 		// log tail but will never overestimate it. Saturate at 0 instead of
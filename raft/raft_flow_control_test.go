@@ -56,6 +56,53 @@ func TestMsgAppFlowControlFull(t *testing.T) {
 	}
 }
 
+// TestMsgAppFlowControlFullBytes ensures the sending window is also bounded
+// by MaxInflightBytes, and that acknowledging entries reopens it.
+func TestMsgAppFlowControlFullBytes(t *testing.T) {
+	data := []byte("somedata")
+	cfg := newTestConfig(1, 5, 1, newTestMemoryStorage(withPeers(1, 2)))
+	cfg.MaxSizePerMsg = uint64(len(data))
+	cfg.MaxInflightBytes = 3 * uint64(len(data))
+	r := newRaft(cfg)
+	r.becomeCandidate()
+	r.becomeLeader()
+
+	pr2 := r.prs.Progress[2]
+	// force the progress to be in replicate state, with the leader's empty
+	// entry already acknowledged
+	pr2.MaybeUpdate(r.raftLog.lastIndex())
+	pr2.BecomeReplicate()
+	// fill in the inflights window by bytes
+	for i := 0; i < 3; i++ {
+		r.Step(pb.Message{From: 1, To: 1, Type: pb.MsgProp, Entries: []pb.Entry{{Data: data}}})
+		ms := r.readMessages()
+		if len(ms) != 1 {
+			t.Fatalf("#%d: len(ms) = %d, want 1", i, len(ms))
+		}
+	}
+	if !pr2.Inflights.Full() {
+		t.Fatalf("inflights.full = %t, want %t", pr2.Inflights.Full(), true)
+	}
+	if n := pr2.Inflights.Count(); n >= r.prs.MaxInflight {
+		t.Fatalf("inflights.count = %d, want < %d", n, r.prs.MaxInflight)
+	}
+
+	r.Step(pb.Message{From: 1, To: 1, Type: pb.MsgProp, Entries: []pb.Entry{{Data: data}}})
+	if ms := r.readMessages(); len(ms) != 0 {
+		t.Fatalf("len(ms) = %d, want 0", len(ms))
+	}
+
+	// acknowledging the first proposal (index 2) frees room for the pending one
+	r.Step(pb.Message{From: 2, To: 1, Type: pb.MsgAppResp, Index: 2})
+	ms := r.readMessages()
+	if len(ms) != 1 || len(ms[0].Entries) != 1 || ms[0].Entries[0].Index != 5 {
+		t.Fatalf("ms = %+v, want one MsgApp with entry 5", ms)
+	}
+	if !pr2.Inflights.Full() {
+		t.Fatalf("inflights.full = %t, want %t", pr2.Inflights.Full(), true)
+	}
+}
+
 // TestMsgAppFlowControlMoveForward ensures msgAppResp can move
 // forward the sending window correctly:
 // 1. valid msgAppResp.index moves the windows to pass all smaller or equal index.
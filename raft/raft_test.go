@@ -409,6 +409,210 @@ func TestLearnerCanVote(t *testing.T) {
 	}
 }
 
+// TestWitnessElectionTimeout verifies that a witness does not start an
+// election even when it times out.
+func TestWitnessElectionTimeout(t *testing.T) {
+	n := newTestRaft(3, 10, 1, newTestMemoryStorage(withPeers(1, 2, 3), withWitnesses(3)))
+	n.becomeFollower(1, None)
+
+	setRandomizedElectionTimeout(n, n.electionTimeout)
+	for i := 0; i < n.electionTimeout; i++ {
+		n.tick()
+	}
+
+	if n.state != StateFollower {
+		t.Errorf("peer 3 state: %s, want %s", n.state, StateFollower)
+	}
+}
+
+// TestWitnessVote verifies that a witness takes part in elections, so that a
+// voter can win one with the vote of the witness alone.
+func TestWitnessVote(t *testing.T) {
+	storage := func() *MemoryStorage { return newTestMemoryStorage(withPeers(1, 2, 3), withWitnesses(3)) }
+	n1 := newTestRaft(1, 10, 1, storage())
+	n2 := newTestRaft(2, 10, 1, storage())
+	n3 := newTestRaft(3, 10, 1, storage())
+
+	nt := newNetwork(n1, n2, n3)
+	nt.isolate(2)
+	nt.send(pb.Message{From: 1, To: 1, Type: pb.MsgHup})
+
+	if n1.state != StateLeader {
+		t.Errorf("peer 1 state: %s, want %s", n1.state, StateLeader)
+	}
+	if !n1.prs.Progress[3].IsWitness {
+		t.Errorf("peer 3 is not tracked as a witness")
+	}
+}
+
+// TestLeaderTransferToWitness verifies that leadership is never transferred
+// to a witness.
+func TestLeaderTransferToWitness(t *testing.T) {
+	storage := func() *MemoryStorage { return newTestMemoryStorage(withPeers(1, 2, 3), withWitnesses(3)) }
+	n1 := newTestRaft(1, 10, 1, storage())
+	n2 := newTestRaft(2, 10, 1, storage())
+	n3 := newTestRaft(3, 10, 1, storage())
+
+	nt := newNetwork(n1, n2, n3)
+	nt.send(pb.Message{From: 1, To: 1, Type: pb.MsgHup})
+
+	nt.send(pb.Message{From: 3, To: 1, Type: pb.MsgTransferLeader})
+	checkLeaderTransferState(t, n1, StateLeader, 1)
+
+	// Nor does a witness take over when told to.
+	nt.send(pb.Message{From: 1, To: 3, Type: pb.MsgTimeoutNow})
+	if n3.state != StateFollower {
+		t.Errorf("peer 3 state: %s, want %s", n3.state, StateFollower)
+	}
+}
+
+func quiesceConfig(c *Config) {
+	c.QuiesceTicks = 5
+}
+
+// tickLeader ticks the leader of the network n times, delivering the messages
+// it sends after each tick.
+func tickLeader(nt *network, lead *raft, n int) {
+	for i := 0; i < n; i++ {
+		lead.tick()
+		nt.send(nt.filter(lead.readMessages())...)
+	}
+}
+
+// TestQuiesce verifies that an idle leader whose followers have caught up
+// quiesces the group, after which no node ticks towards a timeout.
+func TestQuiesce(t *testing.T) {
+	nt := newNetworkWithConfig(quiesceConfig, nil, nil, nil)
+	nt.send(pb.Message{From: 1, To: 1, Type: pb.MsgHup})
+	nt.send(pb.Message{From: 1, To: 1, Type: pb.MsgProp, Entries: []pb.Entry{{Data: []byte("foo")}}})
+
+	lead := nt.peers[1].(*raft)
+	tickLeader(nt, lead, lead.quiesceTicks)
+	for id, p := range nt.peers {
+		if r := p.(*raft); !r.quiesced {
+			t.Fatalf("peer %d is not quiesced", id)
+		}
+	}
+	if wcommit := lead.raftLog.lastIndex(); nt.peers[2].(*raft).raftLog.committed != wcommit {
+		t.Errorf("peer 2 committed = %d, want %d", nt.peers[2].(*raft).raftLog.committed, wcommit)
+	}
+
+	// A quiesced leader neither heartbeats nor steps down.
+	for i := 0; i < 2*lead.electionTimeout; i++ {
+		lead.tick()
+		if msgs := lead.readMessages(); len(msgs) != 0 {
+			t.Fatalf("quiesced leader sent %v", msgs)
+		}
+	}
+	// A quiesced follower does not time out.
+	for id := uint64(2); id <= 3; id++ {
+		r := nt.peers[id].(*raft)
+		for i := 0; i < 2*r.electionTimeout; i++ {
+			r.tick()
+		}
+		if r.state != StateFollower || r.lead != 1 {
+			t.Errorf("peer %d state = %s, lead = %d, want %s, 1", id, r.state, r.lead, StateFollower)
+		}
+	}
+	if lead.state != StateLeader {
+		t.Errorf("peer 1 state = %s, want %s", lead.state, StateLeader)
+	}
+}
+
+// TestQuiesceWakeOnProposal verifies that a proposal wakes up a quiesced group.
+func TestQuiesceWakeOnProposal(t *testing.T) {
+	nt := newNetworkWithConfig(quiesceConfig, nil, nil, nil)
+	nt.send(pb.Message{From: 1, To: 1, Type: pb.MsgHup})
+	lead := nt.peers[1].(*raft)
+	tickLeader(nt, lead, lead.quiesceTicks)
+	if !lead.quiesced {
+		t.Fatalf("leader is not quiesced")
+	}
+
+	nt.send(pb.Message{From: 2, To: 2, Type: pb.MsgProp, Entries: []pb.Entry{{Data: []byte("foo")}}})
+	for id, p := range nt.peers {
+		r := p.(*raft)
+		if r.quiesced {
+			t.Errorf("peer %d is still quiesced", id)
+		}
+		if r.raftLog.committed != lead.raftLog.lastIndex() {
+			t.Errorf("peer %d committed = %d, want %d", id, r.raftLog.committed, lead.raftLog.lastIndex())
+		}
+	}
+
+	// The group quiesces again once it is idle.
+	tickLeader(nt, lead, lead.quiesceTicks)
+	if !lead.quiesced {
+		t.Errorf("leader is not quiesced again")
+	}
+}
+
+// TestQuiesceWakeOnUnreachable verifies that reporting a peer unreachable
+// wakes up a quiesced node, so that a follower can replace a failed leader
+// and a leader runs CheckQuorum again.
+func TestQuiesceWakeOnUnreachable(t *testing.T) {
+	nt := newNetworkWithConfig(func(c *Config) {
+		quiesceConfig(c)
+		c.CheckQuorum = true
+	}, nil, nil, nil)
+	nt.send(pb.Message{From: 1, To: 1, Type: pb.MsgHup})
+	lead := nt.peers[1].(*raft)
+	tickLeader(nt, lead, lead.quiesceTicks)
+
+	// The leader wakes up and heartbeats right away.
+	lead.Step(pb.Message{From: 3, To: 1, Type: pb.MsgUnreachable})
+	if lead.quiesced {
+		t.Fatalf("leader is still quiesced")
+	}
+	msgs := lead.readMessages()
+	if len(msgs) != 2 || msgs[0].Type != pb.MsgHeartbeat {
+		t.Fatalf("leader sent %v, want heartbeats to both followers", msgs)
+	}
+
+	// Once the leader is gone, the followers time out and one takes over.
+	nt.isolate(1)
+	n2, n3 := nt.peers[2].(*raft), nt.peers[3].(*raft)
+	for _, r := range []*raft{n2, n3} {
+		r.Step(pb.Message{From: 1, To: r.id, Type: pb.MsgUnreachable})
+		if r.quiesced {
+			t.Fatalf("peer %d is still quiesced", r.id)
+		}
+	}
+	setRandomizedElectionTimeout(n3, 2*n3.electionTimeout-1)
+	for i := 0; i < n3.electionTimeout; i++ {
+		n3.tick()
+	}
+	setRandomizedElectionTimeout(n2, n2.electionTimeout)
+	for i := 0; i < n2.electionTimeout; i++ {
+		n2.tick()
+	}
+	nt.send(nt.filter(n2.readMessages())...)
+	if n2.state != StateLeader {
+		t.Errorf("peer 2 state = %s, want %s", n2.state, StateLeader)
+	}
+}
+
+// TestQuiesceFollowerBehind verifies that the leader does not quiesce while a
+// follower is behind.
+func TestQuiesceFollowerBehind(t *testing.T) {
+	nt := newNetworkWithConfig(quiesceConfig, nil, nil, nil)
+	nt.send(pb.Message{From: 1, To: 1, Type: pb.MsgHup})
+	nt.isolate(3)
+	nt.send(pb.Message{From: 1, To: 1, Type: pb.MsgProp, Entries: []pb.Entry{{Data: []byte("foo")}}})
+
+	lead := nt.peers[1].(*raft)
+	tickLeader(nt, lead, 2*lead.quiesceTicks)
+	if lead.quiesced {
+		t.Errorf("leader quiesced with a follower behind")
+	}
+
+	nt.recover()
+	tickLeader(nt, lead, 2*lead.quiesceTicks)
+	if !lead.quiesced {
+		t.Errorf("leader is not quiesced once the follower caught up")
+	}
+}
+
 func TestLeaderCycle(t *testing.T) {
 	testLeaderCycle(t, false)
 }
@@ -1823,6 +2027,55 @@ func TestLeaderSupersedingWithCheckQuorum(t *testing.T) {
 	}
 }
 
+// TestLeaderElectionPriority verifies that a node rejects the votes of a
+// candidate with a lower priority whose log is as up-to-date as its own, so
+// that the candidate with the highest priority is elected.
+func TestLeaderElectionPriority(t *testing.T) {
+	nt := newNetwork(nil, nil, nil)
+	for id, p := range map[uint64]uint64{1: 0, 2: 2, 3: 1} {
+		nt.peers[id].(*raft).setPriority(p)
+	}
+
+	nt.send(pb.Message{From: 1, To: 1, Type: pb.MsgHup})
+	if sm := nt.peers[1].(*raft); sm.state == StateLeader {
+		t.Errorf("state = %s, want not %s", sm.state, StateLeader)
+	}
+
+	nt.send(pb.Message{From: 2, To: 2, Type: pb.MsgHup})
+	if sm := nt.peers[2].(*raft); sm.state != StateLeader {
+		t.Errorf("state = %s, want %s", sm.state, StateLeader)
+	}
+
+	// A more up-to-date log wins over a higher priority: 3 gets an entry that
+	// 2 misses, and is elected with the vote of 2 although 1 rejects it.
+	nt = newNetwork(nil, nil, nil)
+	nt.send(pb.Message{From: 1, To: 1, Type: pb.MsgHup})
+	nt.cut(1, 2)
+	nt.send(pb.Message{From: 1, To: 1, Type: pb.MsgProp, Entries: []pb.Entry{{}}})
+	nt.recover()
+	for id, p := range map[uint64]uint64{1: 5, 2: 2, 3: 1} {
+		nt.peers[id].(*raft).setPriority(p)
+	}
+
+	nt.send(pb.Message{From: 3, To: 3, Type: pb.MsgHup})
+	if sm := nt.peers[3].(*raft); sm.state != StateLeader {
+		t.Errorf("state = %s, want %s", sm.state, StateLeader)
+	}
+}
+
+// TestLeaderTransferToLowerPriority verifies that a leadership transfer is not
+// rejected because of the priority of the transferee.
+func TestLeaderTransferToLowerPriority(t *testing.T) {
+	nt := newNetwork(nil, nil, nil)
+	for id, p := range map[uint64]uint64{1: 2, 2: 0, 3: 1} {
+		nt.peers[id].(*raft).setPriority(p)
+	}
+	nt.send(pb.Message{From: 1, To: 1, Type: pb.MsgHup})
+
+	nt.send(pb.Message{From: 2, To: 1, Type: pb.MsgTransferLeader})
+	checkLeaderTransferState(t, nt.peers[1].(*raft), StateFollower, 2)
+}
+
 func TestLeaderElectionWithCheckQuorum(t *testing.T) {
 	a := newTestRaft(1, 10, 1, newTestMemoryStorage(withPeers(1, 2, 3)))
 	b := newTestRaft(2, 10, 1, newTestMemoryStorage(withPeers(1, 2, 3)))
@@ -4675,11 +4928,13 @@ func newNetworkWithConfig(configFunc func(*Config), peers ...stateMachine) *netw
 			for i := range v.prs.Learners {
 				learners[i] = true
 			}
+			witnesses := v.prs.Witnesses
 			v.id = id
-			v.prs = tracker.MakeProgressTracker(v.prs.MaxInflight)
+			v.prs = tracker.MakeProgressTracker(v.prs.MaxInflight, v.prs.MaxInflightBytes)
 			if len(learners) > 0 {
 				v.prs.Learners = map[uint64]struct{}{}
 			}
+			v.prs.Witnesses = witnesses
 			for i := 0; i < size; i++ {
 				pr := &tracker.Progress{}
 				if _, ok := learners[peerAddrs[i]]; ok {
@@ -4688,6 +4943,9 @@ func newNetworkWithConfig(configFunc func(*Config), peers ...stateMachine) *netw
 				} else {
 					v.prs.Voters[0][peerAddrs[i]] = struct{}{}
 				}
+				if _, ok := witnesses[peerAddrs[i]]; ok {
+					pr.IsWitness = true
+				}
 				v.prs.Progress[peerAddrs[i]] = pr
 			}
 			v.reset(v.Term)
@@ -4824,6 +5082,12 @@ func withLearners(learners ...uint64) testMemoryStorageOptions {
 	}
 }
 
+func withWitnesses(witnesses ...uint64) testMemoryStorageOptions {
+	return func(ms *MemoryStorage) {
+		ms.snapshot.Metadata.ConfState.Witnesses = witnesses
+	}
+}
+
 func newTestMemoryStorage(opts ...testMemoryStorageOptions) *MemoryStorage {
 	ms := NewMemoryStorage()
 	for _, o := range opts {
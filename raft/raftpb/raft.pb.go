@@ -73,25 +73,30 @@ func (EntryType) EnumDescriptor() ([]byte, []int) {
 type MessageType int32
 
 const (
-	MsgHup            MessageType = 0
-	MsgBeat           MessageType = 1
-	MsgProp           MessageType = 2
-	MsgApp            MessageType = 3
-	MsgAppResp        MessageType = 4
-	MsgVote           MessageType = 5
-	MsgVoteResp       MessageType = 6
-	MsgSnap           MessageType = 7
-	MsgHeartbeat      MessageType = 8
-	MsgHeartbeatResp  MessageType = 9
-	MsgUnreachable    MessageType = 10
-	MsgSnapStatus     MessageType = 11
-	MsgCheckQuorum    MessageType = 12
-	MsgTransferLeader MessageType = 13
-	MsgTimeoutNow     MessageType = 14
-	MsgReadIndex      MessageType = 15
-	MsgReadIndexResp  MessageType = 16
-	MsgPreVote        MessageType = 17
-	MsgPreVoteResp    MessageType = 18
+	MsgHup               MessageType = 0
+	MsgBeat              MessageType = 1
+	MsgProp              MessageType = 2
+	MsgApp               MessageType = 3
+	MsgAppResp           MessageType = 4
+	MsgVote              MessageType = 5
+	MsgVoteResp          MessageType = 6
+	MsgSnap              MessageType = 7
+	MsgHeartbeat         MessageType = 8
+	MsgHeartbeatResp     MessageType = 9
+	MsgUnreachable       MessageType = 10
+	MsgSnapStatus        MessageType = 11
+	MsgCheckQuorum       MessageType = 12
+	MsgTransferLeader    MessageType = 13
+	MsgTimeoutNow        MessageType = 14
+	MsgReadIndex         MessageType = 15
+	MsgReadIndexResp     MessageType = 16
+	MsgPreVote           MessageType = 17
+	MsgPreVoteResp       MessageType = 18
+	MsgStorageAppend     MessageType = 19
+	MsgStorageAppendResp MessageType = 20
+	MsgStorageApply      MessageType = 21
+	MsgStorageApplyResp  MessageType = 22
+	MsgQuiesce           MessageType = 23
 )
 
 var MessageType_name = map[int32]string{
@@ -114,28 +119,38 @@ var MessageType_name = map[int32]string{
 	16: "MsgReadIndexResp",
 	17: "MsgPreVote",
 	18: "MsgPreVoteResp",
+	19: "MsgStorageAppend",
+	20: "MsgStorageAppendResp",
+	21: "MsgStorageApply",
+	22: "MsgStorageApplyResp",
+	23: "MsgQuiesce",
 }
 
 var MessageType_value = map[string]int32{
-	"MsgHup":            0,
-	"MsgBeat":           1,
-	"MsgProp":           2,
-	"MsgApp":            3,
-	"MsgAppResp":        4,
-	"MsgVote":           5,
-	"MsgVoteResp":       6,
-	"MsgSnap":           7,
-	"MsgHeartbeat":      8,
-	"MsgHeartbeatResp":  9,
-	"MsgUnreachable":    10,
-	"MsgSnapStatus":     11,
-	"MsgCheckQuorum":    12,
-	"MsgTransferLeader": 13,
-	"MsgTimeoutNow":     14,
-	"MsgReadIndex":      15,
-	"MsgReadIndexResp":  16,
-	"MsgPreVote":        17,
-	"MsgPreVoteResp":    18,
+	"MsgHup":               0,
+	"MsgBeat":              1,
+	"MsgProp":              2,
+	"MsgApp":               3,
+	"MsgAppResp":           4,
+	"MsgVote":              5,
+	"MsgVoteResp":          6,
+	"MsgSnap":              7,
+	"MsgHeartbeat":         8,
+	"MsgHeartbeatResp":     9,
+	"MsgUnreachable":       10,
+	"MsgSnapStatus":        11,
+	"MsgCheckQuorum":       12,
+	"MsgTransferLeader":    13,
+	"MsgTimeoutNow":        14,
+	"MsgReadIndex":         15,
+	"MsgReadIndexResp":     16,
+	"MsgPreVote":           17,
+	"MsgPreVoteResp":       18,
+	"MsgStorageAppend":     19,
+	"MsgStorageAppendResp": 20,
+	"MsgStorageApply":      21,
+	"MsgStorageApplyResp":  22,
+	"MsgQuiesce":           23,
 }
 
 func (x MessageType) Enum() *MessageType {
@@ -225,6 +240,7 @@ const (
 	ConfChangeRemoveNode     ConfChangeType = 1
 	ConfChangeUpdateNode     ConfChangeType = 2
 	ConfChangeAddLearnerNode ConfChangeType = 3
+	ConfChangeAddWitnessNode ConfChangeType = 4
 )
 
 var ConfChangeType_name = map[int32]string{
@@ -232,6 +248,7 @@ var ConfChangeType_name = map[int32]string{
 	1: "ConfChangeRemoveNode",
 	2: "ConfChangeUpdateNode",
 	3: "ConfChangeAddLearnerNode",
+	4: "ConfChangeAddWitnessNode",
 }
 
 var ConfChangeType_value = map[string]int32{
@@ -239,6 +256,7 @@ var ConfChangeType_value = map[string]int32{
 	"ConfChangeRemoveNode":     1,
 	"ConfChangeUpdateNode":     2,
 	"ConfChangeAddLearnerNode": 3,
+	"ConfChangeAddWitnessNode": 4,
 }
 
 func (x ConfChangeType) Enum() *ConfChangeType {
@@ -399,6 +417,17 @@ type Message struct {
 	Reject     bool     `protobuf:"varint,10,opt,name=reject" json:"reject"`
 	RejectHint uint64   `protobuf:"varint,11,opt,name=rejectHint" json:"rejectHint"`
 	Context    []byte   `protobuf:"bytes,12,opt,name=context" json:"context,omitempty"`
+	// vote is the vote cast by the local node, set on MsgStorageAppend messages
+	// together with term and commit when they carry an updated HardState.
+	Vote uint64 `protobuf:"varint,13,opt,name=vote" json:"vote"`
+	// responses are populated by a raft node to instruct storage threads on how
+	// to respond and who to respond to when the work associated with a message
+	// is complete. Populated for MsgStorageAppend and MsgStorageApply messages.
+	Responses []Message `protobuf:"bytes,14,rep,name=responses" json:"responses"`
+	// priority is the election priority of the candidate, set on MsgVote and
+	// MsgPreVote. A voter whose log is as up-to-date as the candidate's only
+	// grants its vote if its own priority is not higher.
+	Priority uint64 `protobuf:"varint,15,opt,name=priority" json:"priority"`
 }
 
 func (m *Message) Reset()         { *m = Message{} }
@@ -488,6 +517,9 @@ type ConfState struct {
 	// If set, the config is joint and Raft will automatically transition into
 	// the final config (i.e. remove the outgoing config) when this is safe.
 	AutoLeave bool `protobuf:"varint,5,opt,name=auto_leave,json=autoLeave" json:"auto_leave"`
+	// The voters (in either half of the config) that are witnesses, i.e. take
+	// part in quorum decisions but never campaign for leadership.
+	Witnesses []uint64 `protobuf:"varint,6,rep,name=witnesses" json:"witnesses,omitempty"`
 }
 
 func (m *ConfState) Reset()         { *m = ConfState{} }
@@ -696,75 +728,82 @@ func init() {
 func init() { proto.RegisterFile("raft.proto", fileDescriptor_b042552c306ae59b) }
 
 var fileDescriptor_b042552c306ae59b = []byte{
-	// 1079 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x6b, 0xe3, 0x46,
-	0x14, 0x96, 0x64, 0xc5, 0xb2, 0x9f, 0x1d, 0x67, 0x32, 0x9b, 0x2e, 0xc2, 0x2c, 0x5a, 0xd7, 0xbb,
-	0x65, 0x4d, 0xca, 0x26, 0x8b, 0xbb, 0x94, 0xb2, 0xb7, 0xfc, 0x58, 0x48, 0x4a, 0x9c, 0x6e, 0x9d,
-	0x6c, 0x0e, 0x0b, 0x25, 0x4c, 0xac, 0xb1, 0xa2, 0xd6, 0xd6, 0x88, 0xd1, 0x38, 0x4d, 0x6e, 0xa5,
-	0x97, 0x1e, 0x7a, 0x29, 0x3d, 0x95, 0x42, 0xaf, 0xbd, 0x16, 0x0a, 0xfd, 0x1f, 0x72, 0x0c, 0xf4,
-	0xd2, 0xd3, 0xd2, 0x4d, 0xfe, 0x91, 0x32, 0xa3, 0x91, 0x25, 0x3b, 0x61, 0x0f, 0xbd, 0xcd, 0x7c,
-	0xef, 0xd3, 0x7b, 0xdf, 0xfb, 0xde, 0xcc, 0x08, 0x80, 0x93, 0xa1, 0x58, 0x8b, 0x39, 0x13, 0x0c,
-	0x97, 0xe5, 0x3a, 0x3e, 0x69, 0xae, 0x04, 0x2c, 0x60, 0x0a, 0x5a, 0x97, 0xab, 0x34, 0xda, 0x6c,
-	0x51, 0x31, 0xf0, 0xd7, 0x49, 0x1c, 0xae, 0x9f, 0x51, 0x9e, 0x84, 0x2c, 0x8a, 0x4f, 0xb2, 0x55,
-	0xca, 0x68, 0xff, 0x60, 0xc2, 0xc2, 0xcb, 0x48, 0xf0, 0x0b, 0xec, 0x82, 0x7d, 0x48, 0xf9, 0xd8,
-	0xb5, 0x5a, 0x66, 0xc7, 0xde, 0xb4, 0x2f, 0xdf, 0x3e, 0x34, 0xfa, 0x0a, 0xc1, 0x4d, 0x58, 0xd8,
-	0x8d, 0x7c, 0x7a, 0xee, 0x96, 0x0a, 0xa1, 0x14, 0xc2, 0x1f, 0x83, 0x7d, 0x78, 0x11, 0x53, 0xd7,
-	0x6c, 0x99, 0x9d, 0x46, 0x77, 0x79, 0x2d, 0x95, 0xb3, 0xa6, 0x52, 0xca, 0xc0, 0x34, 0xd1, 0x45,
-	0x4c, 0x31, 0x06, 0x7b, 0x9b, 0x08, 0xe2, 0xda, 0x2d, 0xb3, 0x53, 0xef, 0xab, 0xf5, 0x0b, 0xe7,
-	0xfb, 0xbf, 0xdc, 0xd2, 0x27, 0x6b, 0xcf, 0xda, 0xdf, 0x99, 0x80, 0x0e, 0x22, 0x12, 0x27, 0xa7,
-	0x4c, 0xf4, 0xa8, 0x20, 0x3e, 0x11, 0x04, 0x7f, 0x0a, 0x30, 0x60, 0xd1, 0xf0, 0x38, 0x11, 0x44,
-	0xa4, 0x45, 0x6a, 0x79, 0x91, 0x2d, 0x16, 0x0d, 0x0f, 0x64, 0x40, 0x17, 0xa9, 0x0e, 0x32, 0x40,
-	0x4a, 0x0e, 0x95, 0xe4, 0x62, 0x37, 0x29, 0x24, 0x1b, 0x15, 0xb2, 0xd1, 0x62, 0x37, 0x0a, 0x69,
-	0xbf, 0x81, 0x4a, 0xa6, 0x40, 0x6a, 0x95, 0x0a, 0x54, 0xcd, 0x7a, 0x5f, 0xad, 0xf1, 0x0b, 0xa8,
-	0x8c, 0xb5, 0x32, 0x95, 0xb8, 0xd6, 0x75, 0x33, 0x2d, 0xf3, 0xca, 0x75, 0xde, 0x29, 0xbf, 0xfd,
-	0x5b, 0x09, 0x9c, 0x1e, 0x4d, 0x12, 0x12, 0x50, 0xfc, 0x14, 0x6c, 0x91, 0x9b, 0x76, 0x2f, 0xcb,
-	0xa1, 0xc3, 0x45, 0xdb, 0x24, 0x0d, 0xaf, 0x80, 0x25, 0xd8, 0x4c, 0x27, 0x96, 0x60, 0xb2, 0x8d,
-	0x21, 0x67, 0x73, 0x6d, 0x48, 0x64, 0xda, 0xa0, 0x3d, 0xdf, 0x20, 0xf6, 0xc0, 0x19, 0xb1, 0x40,
-	0x8d, 0x79, 0xa1, 0x10, 0xcc, 0xc0, 0xdc, 0xb6, 0xf2, 0x6d, 0xdb, 0x9e, 0x82, 0x43, 0x23, 0xc1,
-	0x43, 0x9a, 0xb8, 0x4e, 0xab, 0xd4, 0xa9, 0x75, 0x17, 0x67, 0x86, 0x9d, 0xa5, 0xd2, 0x1c, 0xfc,
-	0x00, 0xca, 0x03, 0x36, 0x1e, 0x87, 0xc2, 0xad, 0x14, 0x72, 0x69, 0x0c, 0x77, 0xa1, 0x92, 0x68,
-	0xc7, 0xdc, 0xaa, 0x72, 0x12, 0xcd, 0x3b, 0x99, 0x39, 0x98, 0xf1, 0x64, 0x46, 0x4e, 0xbf, 0xa6,
-	0x03, 0xe1, 0x42, 0xcb, 0xec, 0x54, 0xb2, 0x8c, 0x29, 0x86, 0x1f, 0x03, 0xa4, 0xab, 0x9d, 0x30,
-	0x12, 0x6e, 0xad, 0x50, 0xb3, 0x80, 0x63, 0x17, 0x9c, 0x01, 0x8b, 0x04, 0x3d, 0x17, 0x6e, 0x5d,
-	0x0d, 0x36, 0xdb, 0xb6, 0xbf, 0x82, 0xea, 0x0e, 0xe1, 0x7e, 0x7a, 0x7c, 0x32, 0x07, 0xcd, 0x5b,
-	0x0e, 0xba, 0x60, 0x9f, 0x31, 0x41, 0x67, 0x6f, 0x89, 0x44, 0x0a, 0x0d, 0x97, 0x6e, 0x37, 0xdc,
-	0xfe, 0xd3, 0x84, 0xea, 0xf4, 0xbc, 0xe2, 0xfb, 0x50, 0x96, 0xdf, 0xf0, 0xc4, 0x35, 0x5b, 0xa5,
-	0x8e, 0xdd, 0xd7, 0x3b, 0xdc, 0x84, 0xca, 0x88, 0x12, 0x1e, 0xc9, 0x88, 0xa5, 0x22, 0xd3, 0x3d,
-	0x7e, 0x02, 0x4b, 0x29, 0xeb, 0x98, 0x4d, 0x44, 0xc0, 0xc2, 0x28, 0x70, 0x4b, 0x8a, 0xd2, 0x48,
-	0xe1, 0x2f, 0x34, 0x8a, 0x1f, 0xc1, 0x62, 0xf6, 0xd1, 0x71, 0x24, 0x3b, 0xb5, 0x15, 0xad, 0x9e,
-	0x81, 0xfb, 0xf4, 0x5c, 0xe0, 0x47, 0x00, 0x64, 0x22, 0xd8, 0xf1, 0x88, 0x92, 0x33, 0xaa, 0x0e,
-	0x43, 0x66, 0x68, 0x55, 0xe2, 0x7b, 0x12, 0x6e, 0xff, 0x6e, 0x02, 0x48, 0xd1, 0x5b, 0xa7, 0x24,
-	0x0a, 0x28, 0x7e, 0xa6, 0x8f, 0xad, 0xa5, 0x8e, 0xed, 0xfd, 0xe2, 0x35, 0x4c, 0x19, 0xb7, 0x4e,
-	0xee, 0x13, 0x70, 0x22, 0xe6, 0xd3, 0xe3, 0xd0, 0xd7, 0xa6, 0x34, 0x64, 0xf0, 0xfa, 0xed, 0xc3,
-	0xf2, 0x3e, 0xf3, 0xe9, 0xee, 0x76, 0xbf, 0x2c, 0xc3, 0xbb, 0x7e, 0x71, 0x2e, 0xf6, 0xcc, 0x5c,
-	0x70, 0x13, 0xac, 0xd0, 0xd7, 0x83, 0x00, 0xfd, 0xb5, 0xb5, 0xbb, 0xdd, 0xb7, 0x42, 0x3f, 0x7f,
-	0x3b, 0xc6, 0x80, 0x72, 0x15, 0x07, 0x61, 0x14, 0x8c, 0x72, 0xb5, 0xe6, 0xff, 0x51, 0x6b, 0xbd,
-	0x4f, 0x6d, 0xfb, 0x0f, 0x13, 0xea, 0x79, 0x9e, 0xa3, 0x2e, 0xde, 0x04, 0x10, 0x9c, 0x44, 0x49,
-	0x28, 0x42, 0x16, 0xe9, 0x8a, 0x0f, 0xee, 0xa8, 0x38, 0xe5, 0x64, 0x47, 0x33, 0xff, 0x0a, 0x7f,
-	0x06, 0xce, 0x40, 0xb1, 0xd2, 0xd1, 0x17, 0xde, 0x96, 0xf9, 0xd6, 0xb2, 0xab, 0xa6, 0xe9, 0x45,
-	0xf3, 0x4a, 0x33, 0xe6, 0x65, 0x06, 0x3d, 0x5f, 0x7d, 0x03, 0xd5, 0xe9, 0x93, 0x8c, 0x97, 0xa0,
-	0xa6, 0x36, 0xfb, 0x8c, 0x8f, 0xc9, 0x08, 0x19, 0xf8, 0x1e, 0x2c, 0x29, 0x20, 0x2f, 0x84, 0x4c,
-	0xec, 0xc1, 0xf2, 0x1c, 0x78, 0xd4, 0x45, 0x56, 0xd3, 0xf9, 0x35, 0x4d, 0xd9, 0x74, 0x7e, 0x4e,
-	0xcd, 0x5f, 0xfd, 0xdb, 0x82, 0x5a, 0xe1, 0xe9, 0xc2, 0x00, 0xe5, 0x5e, 0x12, 0xec, 0x4c, 0x62,
-	0x64, 0xe0, 0x1a, 0x38, 0xbd, 0x24, 0xd8, 0xa4, 0x44, 0x20, 0x53, 0x6f, 0x5e, 0x71, 0x16, 0x23,
-	0x4b, 0xb3, 0x36, 0xe2, 0x18, 0x95, 0x70, 0x03, 0x20, 0x5d, 0xf7, 0x69, 0x12, 0x23, 0x5b, 0x13,
-	0x8f, 0x98, 0xa0, 0x68, 0x41, 0xaa, 0xd5, 0x1b, 0x15, 0x2d, 0xeb, 0xa8, 0x7c, 0x26, 0x90, 0x83,
-	0x11, 0xd4, 0x65, 0x31, 0x4a, 0xb8, 0x38, 0x91, 0x55, 0x2a, 0x78, 0x05, 0x50, 0x11, 0x51, 0x1f,
-	0x55, 0x31, 0x86, 0x46, 0x2f, 0x09, 0x5e, 0x47, 0x9c, 0x92, 0xc1, 0x29, 0x39, 0x19, 0x51, 0x04,
-	0x78, 0x19, 0x16, 0x75, 0x22, 0x79, 0x2b, 0x27, 0x09, 0xaa, 0x69, 0xda, 0xd6, 0x29, 0x1d, 0x7c,
-	0xf3, 0xe5, 0x84, 0xf1, 0xc9, 0x18, 0xd5, 0xf1, 0x07, 0xb0, 0xdc, 0x4b, 0x02, 0x35, 0xbb, 0x21,
-	0xe5, 0x7b, 0x94, 0xf8, 0x94, 0xa3, 0x45, 0xfd, 0xf5, 0x61, 0x38, 0xa6, 0x6c, 0x22, 0xf6, 0xd9,
-	0xb7, 0xa8, 0xa1, 0xc5, 0xf4, 0x29, 0xf1, 0xd5, 0xcf, 0x11, 0x2d, 0x69, 0x31, 0x53, 0x44, 0x89,
-	0x41, 0xba, 0xdf, 0x57, 0x9c, 0xaa, 0x16, 0x97, 0x75, 0x55, 0xbd, 0x57, 0x1c, 0xbc, 0xfa, 0xa3,
-	0x09, 0x2b, 0x77, 0x9d, 0x1c, 0xfc, 0x00, 0xdc, 0xbb, 0xf0, 0x8d, 0x89, 0x60, 0xc8, 0xc0, 0x1f,
-	0xc1, 0x87, 0x77, 0x45, 0x3f, 0x67, 0x61, 0x24, 0x76, 0xc7, 0xf1, 0x28, 0x1c, 0x84, 0x72, 0x14,
-	0xef, 0xa3, 0xbd, 0x3c, 0xd7, 0x34, 0x2b, 0x9b, 0xf1, 0xf3, 0xd5, 0x0b, 0x68, 0xcc, 0x5e, 0x1c,
-	0xe9, 0x4a, 0x8e, 0x6c, 0xf8, 0xbe, 0xbc, 0x22, 0xc8, 0xc0, 0x6e, 0x51, 0x75, 0x9f, 0x8e, 0xd9,
-	0x19, 0x55, 0x11, 0x73, 0x36, 0xf2, 0x3a, 0xf6, 0x89, 0x48, 0x23, 0xd6, 0x6c, 0x47, 0x1b, 0xbe,
-	0xbf, 0x97, 0x3e, 0x54, 0x2a, 0x5a, 0xda, 0x7c, 0x7c, 0xf9, 0xce, 0x33, 0xae, 0xde, 0x79, 0xc6,
-	0xe5, 0xb5, 0x67, 0x5e, 0x5d, 0x7b, 0xe6, 0xbf, 0xd7, 0x9e, 0xf9, 0xd3, 0x8d, 0x67, 0xfc, 0x72,
-	0xe3, 0x19, 0x57, 0x37, 0x9e, 0xf1, 0xcf, 0x8d, 0x67, 0xfc, 0x17, 0x00, 0x00, 0xff, 0xff, 0x96,
-	0xae, 0xd0, 0x97, 0x14, 0x09, 0x00, 0x00,
+	// 1185 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4f, 0x6f, 0xdb, 0xc6,
+	0x13, 0x15, 0x29, 0x5a, 0x94, 0x46, 0xb2, 0xb4, 0x5e, 0x2b, 0x09, 0x21, 0x18, 0x8a, 0x7e, 0x4a,
+	0x7e, 0x88, 0xe0, 0x22, 0x4e, 0xa0, 0x04, 0x45, 0x91, 0x9b, 0xff, 0x04, 0xb0, 0x0b, 0xcb, 0x4d,
+	0x64, 0xc7, 0x05, 0x02, 0x14, 0xc6, 0x5a, 0x5c, 0xd3, 0x6c, 0x25, 0x2e, 0xb1, 0x5c, 0x39, 0xf6,
+	0xad, 0xe8, 0xa5, 0x87, 0x02, 0x45, 0xd1, 0x4b, 0x8b, 0x7e, 0x80, 0x5e, 0x7b, 0xea, 0x07, 0xe8,
+	0xcd, 0x47, 0x1f, 0x7b, 0x0a, 0x1a, 0xfb, 0x8b, 0x14, 0xbb, 0x5c, 0x8a, 0x94, 0x64, 0xe4, 0xd0,
+	0x1b, 0xf7, 0xcd, 0xdb, 0x99, 0x37, 0x6f, 0x76, 0x97, 0x00, 0x9c, 0x9c, 0x88, 0xb5, 0x90, 0x33,
+	0xc1, 0x70, 0x41, 0x7e, 0x87, 0xc7, 0x8d, 0xba, 0xc7, 0x3c, 0xa6, 0xa0, 0x27, 0xf2, 0x2b, 0x8e,
+	0x36, 0x5a, 0x54, 0x0c, 0xdc, 0x27, 0x24, 0xf4, 0x9f, 0x9c, 0x51, 0x1e, 0xf9, 0x2c, 0x08, 0x8f,
+	0x93, 0xaf, 0x98, 0xd1, 0xfe, 0xde, 0x80, 0x85, 0x97, 0x81, 0xe0, 0x17, 0xd8, 0x01, 0xeb, 0x80,
+	0xf2, 0x91, 0x63, 0xb6, 0x8c, 0x8e, 0xb5, 0x61, 0x5d, 0xbe, 0xbf, 0x9f, 0xeb, 0x2b, 0x04, 0x37,
+	0x60, 0x61, 0x27, 0x70, 0xe9, 0xb9, 0x93, 0xcf, 0x84, 0x62, 0x08, 0x7f, 0x02, 0xd6, 0xc1, 0x45,
+	0x48, 0x1d, 0xa3, 0x65, 0x74, 0xaa, 0xdd, 0xa5, 0xb5, 0x58, 0xce, 0x9a, 0x4a, 0x29, 0x03, 0x93,
+	0x44, 0x17, 0x21, 0xc5, 0x18, 0xac, 0x2d, 0x22, 0x88, 0x63, 0xb5, 0x8c, 0x4e, 0xa5, 0xaf, 0xbe,
+	0x5f, 0xd8, 0xdf, 0xfd, 0xe9, 0xe4, 0x9f, 0xad, 0x3d, 0x6d, 0x7f, 0x6b, 0x00, 0xda, 0x0f, 0x48,
+	0x18, 0x9d, 0x32, 0xd1, 0xa3, 0x82, 0xb8, 0x44, 0x10, 0xfc, 0x29, 0xc0, 0x80, 0x05, 0x27, 0x47,
+	0x91, 0x20, 0x22, 0x2e, 0x52, 0x4e, 0x8b, 0x6c, 0xb2, 0xe0, 0x64, 0x5f, 0x06, 0x74, 0x91, 0xd2,
+	0x20, 0x01, 0xa4, 0x64, 0x5f, 0x49, 0xce, 0x76, 0x13, 0x43, 0xb2, 0x51, 0x21, 0x1b, 0xcd, 0x76,
+	0xa3, 0x90, 0xf6, 0x5b, 0x28, 0x26, 0x0a, 0xa4, 0x56, 0xa9, 0x40, 0xd5, 0xac, 0xf4, 0xd5, 0x37,
+	0x7e, 0x01, 0xc5, 0x91, 0x56, 0xa6, 0x12, 0x97, 0xbb, 0x4e, 0xa2, 0x65, 0x56, 0xb9, 0xce, 0x3b,
+	0xe1, 0xb7, 0x7f, 0xb4, 0xc0, 0xee, 0xd1, 0x28, 0x22, 0x1e, 0xc5, 0x8f, 0xc1, 0x12, 0xa9, 0x69,
+	0xcb, 0x49, 0x0e, 0x1d, 0xce, 0xda, 0x26, 0x69, 0xb8, 0x0e, 0xa6, 0x60, 0x53, 0x9d, 0x98, 0x82,
+	0xc9, 0x36, 0x4e, 0x38, 0x9b, 0x69, 0x43, 0x22, 0x93, 0x06, 0xad, 0xd9, 0x06, 0x71, 0x13, 0xec,
+	0x21, 0xf3, 0xd4, 0x98, 0x17, 0x32, 0xc1, 0x04, 0x4c, 0x6d, 0x2b, 0xcc, 0xdb, 0xf6, 0x18, 0x6c,
+	0x1a, 0x08, 0xee, 0xd3, 0xc8, 0xb1, 0x5b, 0xf9, 0x4e, 0xb9, 0xbb, 0x38, 0x35, 0xec, 0x24, 0x95,
+	0xe6, 0xe0, 0x15, 0x28, 0x0c, 0xd8, 0x68, 0xe4, 0x0b, 0xa7, 0x98, 0xc9, 0xa5, 0x31, 0xdc, 0x85,
+	0x62, 0xa4, 0x1d, 0x73, 0x4a, 0xca, 0x49, 0x34, 0xeb, 0x64, 0xe2, 0x60, 0xc2, 0x93, 0x19, 0x39,
+	0xfd, 0x9a, 0x0e, 0x84, 0x03, 0x2d, 0xa3, 0x53, 0x4c, 0x32, 0xc6, 0x18, 0x7e, 0x08, 0x10, 0x7f,
+	0x6d, 0xfb, 0x81, 0x70, 0xca, 0x99, 0x9a, 0x19, 0x1c, 0x3b, 0x60, 0x0f, 0x58, 0x20, 0xe8, 0xb9,
+	0x70, 0x2a, 0x6a, 0xb0, 0xc9, 0x52, 0x9a, 0x76, 0xc6, 0x04, 0x75, 0x16, 0xb3, 0xa6, 0x49, 0x04,
+	0x3f, 0x83, 0x12, 0xa7, 0x51, 0xc8, 0x82, 0x88, 0x46, 0x4e, 0x55, 0xb5, 0x5e, 0x9b, 0x19, 0x59,
+	0x72, 0x00, 0x27, 0x3c, 0xdc, 0x82, 0x62, 0xc8, 0x7d, 0xc6, 0x7d, 0x71, 0xe1, 0xd4, 0x32, 0x29,
+	0x27, 0x68, 0xfb, 0x2b, 0x28, 0x6d, 0x13, 0xee, 0xc6, 0xe7, 0x35, 0x19, 0x99, 0x31, 0x37, 0xb2,
+	0x44, 0x97, 0x39, 0xa7, 0x2b, 0x75, 0x38, 0x3f, 0xef, 0x70, 0xfb, 0xca, 0x80, 0xd2, 0xe4, 0x82,
+	0xe0, 0xbb, 0x50, 0x90, 0x7b, 0x78, 0xe4, 0x18, 0xad, 0x7c, 0xc7, 0xea, 0xeb, 0x15, 0x6e, 0x40,
+	0x71, 0x48, 0x09, 0x0f, 0x64, 0xc4, 0x54, 0x91, 0xc9, 0x1a, 0x3f, 0x82, 0x5a, 0xcc, 0x3a, 0x62,
+	0x63, 0xe1, 0x31, 0x3f, 0xf0, 0x9c, 0xbc, 0xa2, 0x54, 0x63, 0xf8, 0x0b, 0x8d, 0xe2, 0x07, 0xb0,
+	0x98, 0x6c, 0x3a, 0x0a, 0xa4, 0xb5, 0x96, 0xa2, 0x55, 0x12, 0x70, 0x4f, 0xfa, 0xfb, 0x00, 0x80,
+	0x8c, 0x05, 0x3b, 0x1a, 0x52, 0x72, 0x46, 0x9d, 0x85, 0xcc, 0x04, 0x4b, 0x12, 0xdf, 0x95, 0x30,
+	0x5e, 0x81, 0xd2, 0x3b, 0x5f, 0x04, 0x34, 0x92, 0x56, 0x17, 0x54, 0x96, 0x14, 0x68, 0xff, 0x6e,
+	0x00, 0xc8, 0x96, 0x36, 0x4f, 0x49, 0xe0, 0x51, 0xfc, 0x54, 0xdf, 0x22, 0x53, 0xdd, 0xa2, 0xbb,
+	0xd9, 0x57, 0x21, 0x66, 0xcc, 0x5d, 0xa4, 0x47, 0x60, 0x07, 0xcc, 0xa5, 0x47, 0xbe, 0xab, 0x2d,
+	0xab, 0xca, 0xe0, 0xf5, 0xfb, 0xfb, 0x85, 0x3d, 0xe6, 0xd2, 0x9d, 0xad, 0x7e, 0x41, 0x86, 0x77,
+	0xdc, 0xec, 0x31, 0xb1, 0xa6, 0x8f, 0x49, 0x03, 0x4c, 0xdf, 0xd5, 0x63, 0x02, 0xbd, 0xdb, 0xdc,
+	0xd9, 0xea, 0x9b, 0xbe, 0x9b, 0x3e, 0x65, 0x23, 0x40, 0xa9, 0x8a, 0x7d, 0x3f, 0xf0, 0x86, 0xa9,
+	0x5a, 0xe3, 0xbf, 0xa8, 0x35, 0x3f, 0xa6, 0xb6, 0xfd, 0x87, 0x01, 0x95, 0x34, 0xcf, 0x61, 0x17,
+	0x6f, 0x00, 0x08, 0x4e, 0x82, 0xc8, 0x17, 0x3e, 0x0b, 0x74, 0xc5, 0x95, 0x5b, 0x2a, 0x4e, 0x38,
+	0xc9, 0x4d, 0x49, 0x77, 0xe1, 0xcf, 0xc0, 0x1e, 0x28, 0x56, 0x7c, 0x30, 0x32, 0x4f, 0xdd, 0x6c,
+	0x6b, 0xc9, 0xcd, 0xd7, 0xf4, 0xac, 0x79, 0xf9, 0x29, 0xf3, 0x12, 0x83, 0x9e, 0xaf, 0xbe, 0x85,
+	0xd2, 0xe4, 0x0f, 0x81, 0x6b, 0x50, 0x56, 0x8b, 0x3d, 0xc6, 0x47, 0x64, 0x88, 0x72, 0x78, 0x19,
+	0x6a, 0x0a, 0x48, 0x0b, 0x21, 0x03, 0x37, 0x61, 0x69, 0x06, 0x3c, 0xec, 0x22, 0xb3, 0x61, 0xff,
+	0x16, 0xa7, 0x6c, 0xd8, 0x3f, 0xc7, 0xe6, 0xaf, 0xfe, 0x95, 0x87, 0x72, 0xe6, 0x25, 0xc5, 0x00,
+	0x85, 0x5e, 0xe4, 0x6d, 0x8f, 0x43, 0x94, 0xc3, 0x65, 0xb0, 0x7b, 0x91, 0xb7, 0x41, 0x89, 0x40,
+	0x86, 0x5e, 0xbc, 0xe2, 0x2c, 0x44, 0xa6, 0x66, 0xad, 0x87, 0x21, 0xca, 0xe3, 0x2a, 0x40, 0xfc,
+	0xdd, 0xa7, 0x51, 0x88, 0x2c, 0x4d, 0x3c, 0x64, 0x82, 0xa2, 0x05, 0xa9, 0x56, 0x2f, 0x54, 0xb4,
+	0xa0, 0xa3, 0xf2, 0xd5, 0x42, 0x36, 0x46, 0x50, 0x91, 0xc5, 0x28, 0xe1, 0xe2, 0x58, 0x56, 0x29,
+	0xe2, 0x3a, 0xa0, 0x2c, 0xa2, 0x36, 0x95, 0x30, 0x86, 0x6a, 0x2f, 0xf2, 0xde, 0x04, 0x9c, 0x92,
+	0xc1, 0x29, 0x39, 0x1e, 0x52, 0x04, 0x78, 0x09, 0x16, 0x75, 0x22, 0x79, 0x67, 0xc7, 0x11, 0x2a,
+	0x6b, 0xda, 0xe6, 0x29, 0x1d, 0x7c, 0xf3, 0x7a, 0xcc, 0xf8, 0x78, 0x84, 0x2a, 0xf8, 0x0e, 0x2c,
+	0xf5, 0x22, 0x4f, 0xcd, 0xee, 0x84, 0xf2, 0x5d, 0x4a, 0x5c, 0xca, 0xd1, 0xa2, 0xde, 0x7d, 0xe0,
+	0x8f, 0x28, 0x1b, 0x8b, 0x3d, 0xf6, 0x0e, 0x55, 0xb5, 0x98, 0x3e, 0x25, 0xae, 0xfa, 0x57, 0xa3,
+	0x9a, 0x16, 0x33, 0x41, 0x94, 0x18, 0xa4, 0xfb, 0x7d, 0xc5, 0xa9, 0x6a, 0x71, 0x49, 0x57, 0xd5,
+	0x6b, 0xc5, 0xc1, 0x7a, 0xe7, 0xbe, 0x60, 0x9c, 0x78, 0x74, 0x3d, 0x0c, 0x69, 0xe0, 0xa2, 0x65,
+	0xec, 0x40, 0x7d, 0x16, 0x55, 0xfc, 0xba, 0x9c, 0xe1, 0x54, 0x64, 0x78, 0x81, 0xee, 0xe0, 0x7b,
+	0xb0, 0x3c, 0x03, 0x2a, 0xf6, 0x5d, 0xad, 0xe0, 0xf5, 0xd8, 0xa7, 0xd1, 0x80, 0xa2, 0x7b, 0xab,
+	0x3f, 0x18, 0x50, 0xbf, 0xed, 0x9c, 0xe2, 0x15, 0x70, 0x6e, 0xc3, 0xd7, 0xc7, 0x82, 0xa1, 0x1c,
+	0xfe, 0x3f, 0xfc, 0xef, 0xb6, 0xe8, 0xe7, 0xcc, 0x0f, 0xc4, 0xce, 0x28, 0x1c, 0xfa, 0x03, 0x5f,
+	0x0e, 0xfe, 0x63, 0xb4, 0x97, 0xe7, 0x9a, 0x66, 0x26, 0x27, 0xea, 0xf9, 0xea, 0x2f, 0x06, 0x54,
+	0xa7, 0xef, 0xa9, 0x1c, 0x42, 0x8a, 0xac, 0xbb, 0xae, 0xbc, 0x91, 0x28, 0x27, 0xfd, 0x48, 0xe1,
+	0x3e, 0x1d, 0xb1, 0x33, 0xaa, 0x22, 0xc6, 0x74, 0xe4, 0x4d, 0xe8, 0x12, 0x11, 0x47, 0xcc, 0xe9,
+	0x96, 0xd6, 0x5d, 0x77, 0x37, 0x7e, 0x35, 0x55, 0x34, 0x3f, 0x17, 0xfd, 0x32, 0x7e, 0x0d, 0x55,
+	0xd4, 0xda, 0x78, 0x78, 0xf9, 0xa1, 0x99, 0xbb, 0xfa, 0xd0, 0xcc, 0x5d, 0x5e, 0x37, 0x8d, 0xab,
+	0xeb, 0xa6, 0xf1, 0xcf, 0x75, 0xd3, 0xf8, 0xe9, 0xa6, 0x99, 0xfb, 0xf5, 0xa6, 0x99, 0xbb, 0xba,
+	0x69, 0xe6, 0xfe, 0xbe, 0x69, 0xe6, 0xfe, 0x1d, 0x00, 0x12, 0x8b, 0xa4, 0x6c, 0x30, 0x0a, 0x00,
+	0x00,
 }
 
 func (m *Entry) Marshal() (dAtA []byte, err error) {
@@ -905,6 +944,26 @@ func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintRaft(dAtA, i, uint64(m.Priority))
+	i--
+	dAtA[i] = 0x78
+	if len(m.Responses) > 0 {
+		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Responses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRaft(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	i = encodeVarintRaft(dAtA, i, uint64(m.Vote))
+	i--
+	dAtA[i] = 0x68
 	if m.Context != nil {
 		i -= len(m.Context)
 		copy(dAtA[i:], m.Context)
@@ -1023,6 +1082,13 @@ func (m *ConfState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Witnesses) > 0 {
+		for iNdEx := len(m.Witnesses) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintRaft(dAtA, i, uint64(m.Witnesses[iNdEx]))
+			i--
+			dAtA[i] = 0x30
+		}
+	}
 	i--
 	if m.AutoLeave {
 		dAtA[i] = 1
@@ -1259,6 +1325,14 @@ func (m *Message) Size() (n int) {
 		l = len(m.Context)
 		n += 1 + l + sovRaft(uint64(l))
 	}
+	n += 1 + sovRaft(uint64(m.Vote))
+	if len(m.Responses) > 0 {
+		for _, e := range m.Responses {
+			l = e.Size()
+			n += 1 + l + sovRaft(uint64(l))
+		}
+	}
+	n += 1 + sovRaft(uint64(m.Priority))
 	return n
 }
 
@@ -1301,6 +1375,11 @@ func (m *ConfState) Size() (n int) {
 		}
 	}
 	n += 2
+	if len(m.Witnesses) > 0 {
+		for _, e := range m.Witnesses {
+			n += 1 + sovRaft(uint64(e))
+		}
+	}
 	return n
 }
 
@@ -2038,6 +2117,78 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 				m.Context = []byte{}
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+			}
+			m.Vote = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Vote |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Responses = append(m.Responses, Message{})
+			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaft(dAtA[iNdEx:])
@@ -2519,6 +2670,82 @@ func (m *ConfState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AutoLeave = bool(v != 0)
+		case 6:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRaft
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Witnesses = append(m.Witnesses, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRaft
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRaft
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRaft
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Witnesses) == 0 {
+					m.Witnesses = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRaft
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Witnesses = append(m.Witnesses, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Witnesses", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaft(dAtA[iNdEx:])
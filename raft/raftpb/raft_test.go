@@ -39,19 +39,19 @@ func TestProtoMemorySizes(t *testing.T) {
 	assert(unsafe.Sizeof(e), if64Bit(48, 32), "Entry")
 
 	var sm SnapshotMetadata
-	assert(unsafe.Sizeof(sm), if64Bit(120, 68), "SnapshotMetadata")
+	assert(unsafe.Sizeof(sm), if64Bit(144, 80), "SnapshotMetadata")
 
 	var s Snapshot
-	assert(unsafe.Sizeof(s), if64Bit(144, 80), "Snapshot")
+	assert(unsafe.Sizeof(s), if64Bit(168, 92), "Snapshot")
 
 	var m Message
-	assert(unsafe.Sizeof(m), if64Bit(264, 168), "Message")
+	assert(unsafe.Sizeof(m), if64Bit(328, 208), "Message")
 
 	var hs HardState
 	assert(unsafe.Sizeof(hs), 24, "HardState")
 
 	var cs ConfState
-	assert(unsafe.Sizeof(cs), if64Bit(104, 52), "ConfState")
+	assert(unsafe.Sizeof(cs), if64Bit(128, 64), "ConfState")
 
 	var cc ConfChange
 	assert(unsafe.Sizeof(cc), if64Bit(48, 32), "ConfChange")
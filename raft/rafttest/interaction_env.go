@@ -36,6 +36,12 @@ type Node struct {
 
 	Config  *raft.Config
 	History []pb.Snapshot
+
+	// AppendWork and ApplyWork hold the messages handed to the local append
+	// and apply threads of a node using AsyncStorageWrites, until they are
+	// processed by process-append-thread and process-apply-thread.
+	AppendWork []pb.Message
+	ApplyWork  []pb.Message
 }
 
 // InteractionEnv facilitates testing of complex interactions between the
@@ -28,6 +28,7 @@ import (
 func (env *InteractionEnv) handleAddNodes(t *testing.T, d datadriven.TestData) error {
 	n := firstAsInt(t, d)
 	var snap pb.Snapshot
+	var asyncStorageWrites bool
 	for _, arg := range d.CmdArgs[1:] {
 		for i := range arg.Vals {
 			switch arg.Key {
@@ -43,10 +44,12 @@ func (env *InteractionEnv) handleAddNodes(t *testing.T, d datadriven.TestData) e
 				arg.Scan(t, i, &snap.Metadata.Index)
 			case "content":
 				arg.Scan(t, i, &snap.Data)
+			case "async-storage-writes":
+				arg.Scan(t, i, &asyncStorageWrites)
 			}
 		}
 	}
-	return env.AddNodes(n, snap)
+	return env.AddNodes(n, snap, asyncStorageWrites)
 }
 
 type snapOverrideStorage struct {
@@ -64,8 +67,9 @@ func (s snapOverrideStorage) Snapshot() (pb.Snapshot, error) {
 var _ raft.Storage = snapOverrideStorage{}
 
 // AddNodes adds n new nodes initializes from the given snapshot (which may be
-// empty). They will be assigned consecutive IDs.
-func (env *InteractionEnv) AddNodes(n int, snap pb.Snapshot) error {
+// empty), optionally using AsyncStorageWrites. They will be assigned
+// consecutive IDs.
+func (env *InteractionEnv) AddNodes(n int, snap pb.Snapshot, asyncStorageWrites bool) error {
 	bootstrap := !reflect.DeepEqual(snap, pb.Snapshot{})
 	for i := 0; i < n; i++ {
 		id := uint64(1 + len(env.Nodes))
@@ -104,6 +108,7 @@ func (env *InteractionEnv) AddNodes(n int, snap pb.Snapshot) error {
 			}
 		}
 		cfg := defaultRaftConfig(id, snap.Metadata.Index, s)
+		cfg.AsyncStorageWrites = asyncStorageWrites
 		if env.Options.OnConfig != nil {
 			env.Options.OnConfig(cfg)
 			if cfg.ID != id {
@@ -43,25 +43,61 @@ func (env *InteractionEnv) handleProcessReady(t *testing.T, d datadriven.TestDat
 // ProcessReady runs Ready handling on the node with the given index.
 func (env *InteractionEnv) ProcessReady(idx int) error {
 	// TODO(tbg): Allow simulating crashes here.
-	rn, s := env.Nodes[idx].RawNode, env.Nodes[idx].Storage
-	rd := rn.Ready()
+	n := &env.Nodes[idx]
+	rd := n.Ready()
 	env.Output.WriteString(raft.DescribeReady(rd, defaultEntryFormatter))
+
+	if n.Config.AsyncStorageWrites {
+		// The storage work is left to the local threads, see
+		// process-append-thread and process-apply-thread.
+		for _, m := range rd.Messages {
+			switch m.To {
+			case raft.LocalAppendThread:
+				n.AppendWork = append(n.AppendWork, m)
+			case raft.LocalApplyThread:
+				n.ApplyWork = append(n.ApplyWork, m)
+			default:
+				env.Messages = append(env.Messages, m)
+			}
+		}
+		return nil
+	}
+
 	// TODO(tbg): the order of operations here is not necessarily safe. See:
 	// https://github.com/etcd-io/etcd/pull/10861
-	if !raft.IsEmptyHardState(rd.HardState) {
-		if err := s.SetHardState(rd.HardState); err != nil {
+	if err := processAppend(n, rd.HardState, rd.Entries, rd.Snapshot); err != nil {
+		return err
+	}
+	if err := processApply(n, rd.CommittedEntries); err != nil {
+		return err
+	}
+
+	env.Messages = append(env.Messages, rd.Messages...)
+
+	n.Advance(rd)
+	return nil
+}
+
+func processAppend(n *Node, st raftpb.HardState, ents []raftpb.Entry, snap raftpb.Snapshot) error {
+	s := n.Storage
+	if !raft.IsEmptyHardState(st) {
+		if err := s.SetHardState(st); err != nil {
 			return err
 		}
 	}
-	if err := s.Append(rd.Entries); err != nil {
+	if err := s.Append(ents); err != nil {
 		return err
 	}
-	if !raft.IsEmptySnap(rd.Snapshot) {
-		if err := s.ApplySnapshot(rd.Snapshot); err != nil {
+	if !raft.IsEmptySnap(snap) {
+		if err := s.ApplySnapshot(snap); err != nil {
 			return err
 		}
 	}
-	for _, ent := range rd.CommittedEntries {
+	return nil
+}
+
+func processApply(n *Node, ents []raftpb.Entry) error {
+	for _, ent := range ents {
 		var update []byte
 		var cs *raftpb.ConfState
 		switch ent.Type {
@@ -71,13 +107,13 @@ func (env *InteractionEnv) ProcessReady(idx int) error {
 				return err
 			}
 			update = cc.Context
-			cs = rn.ApplyConfChange(cc)
+			cs = n.RawNode.ApplyConfChange(cc)
 		case raftpb.EntryConfChangeV2:
 			var cc raftpb.ConfChangeV2
 			if err := cc.Unmarshal(ent.Data); err != nil {
 				return err
 			}
-			cs = rn.ApplyConfChange(cc)
+			cs = n.RawNode.ApplyConfChange(cc)
 			update = cc.Context
 		default:
 			update = ent.Data
@@ -85,7 +121,7 @@ func (env *InteractionEnv) ProcessReady(idx int) error {
 
 		// Record the new state by starting with the current state and applying
 		// the command.
-		lastSnap := env.Nodes[idx].History[len(env.Nodes[idx].History)-1]
+		lastSnap := n.History[len(n.History)-1]
 		var snap raftpb.Snapshot
 		snap.Data = append(snap.Data, lastSnap.Data...)
 		// NB: this hard-codes an "appender" state machine.
@@ -93,15 +129,11 @@ func (env *InteractionEnv) ProcessReady(idx int) error {
 		snap.Metadata.Index = ent.Index
 		snap.Metadata.Term = ent.Term
 		if cs == nil {
-			sl := env.Nodes[idx].History
+			sl := n.History
 			cs = &sl[len(sl)-1].Metadata.ConfState
 		}
 		snap.Metadata.ConfState = *cs
-		env.Nodes[idx].History = append(env.Nodes[idx].History, snap)
+		n.History = append(n.History, snap)
 	}
-
-	env.Messages = append(env.Messages, rd.Messages...)
-
-	rn.Advance(rd)
 	return nil
 }
@@ -0,0 +1,109 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rafttest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cockroachdb/datadriven"
+	"go.etcd.io/etcd/raft/v3"
+	"go.etcd.io/etcd/raft/v3/raftpb"
+)
+
+func (env *InteractionEnv) handleProcessAppendThread(t *testing.T, d datadriven.TestData) error {
+	return env.forEachNode(t, d, "processing append thread", env.ProcessAppendThread)
+}
+
+func (env *InteractionEnv) handleProcessApplyThread(t *testing.T, d datadriven.TestData) error {
+	return env.forEachNode(t, d, "processing apply thread", env.ProcessApplyThread)
+}
+
+func (env *InteractionEnv) forEachNode(t *testing.T, d datadriven.TestData, what string, f func(int) error) error {
+	idxs := nodeIdxs(t, d)
+	for _, idx := range idxs {
+		var err error
+		if len(idxs) > 1 {
+			fmt.Fprintf(env.Output, "> %d %s\n", idx+1, what)
+			env.withIndent(func() { err = f(idx) })
+		} else {
+			err = f(idx)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ProcessAppendThread runs the pending work of the local append thread of the
+// node with the given index, which must be using AsyncStorageWrites. The
+// responses are added to the in-flight messages.
+func (env *InteractionEnv) ProcessAppendThread(idx int) error {
+	n := &env.Nodes[idx]
+	if len(n.AppendWork) == 0 {
+		env.Output.WriteString("no append work to perform\n")
+		return nil
+	}
+	for len(n.AppendWork) > 0 {
+		m := n.AppendWork[0]
+		n.AppendWork = n.AppendWork[1:]
+		env.Output.WriteString("Processing:\n")
+		env.Output.WriteString(raft.DescribeMessage(m, defaultEntryFormatter) + "\n")
+		st := raftpb.HardState{
+			Term:   m.Term,
+			Vote:   m.Vote,
+			Commit: m.Commit,
+		}
+		if err := processAppend(n, st, m.Entries, m.Snapshot); err != nil {
+			return err
+		}
+		env.queueResponses(m)
+	}
+	return nil
+}
+
+// ProcessApplyThread runs the pending work of the local apply thread of the
+// node with the given index, which must be using AsyncStorageWrites. The
+// responses are added to the in-flight messages.
+func (env *InteractionEnv) ProcessApplyThread(idx int) error {
+	n := &env.Nodes[idx]
+	if len(n.ApplyWork) == 0 {
+		env.Output.WriteString("no apply work to perform\n")
+		return nil
+	}
+	for len(n.ApplyWork) > 0 {
+		m := n.ApplyWork[0]
+		n.ApplyWork = n.ApplyWork[1:]
+		env.Output.WriteString("Processing:\n")
+		env.Output.WriteString(raft.DescribeMessage(m, defaultEntryFormatter) + "\n")
+		if err := processApply(n, m.Entries); err != nil {
+			return err
+		}
+		env.queueResponses(m)
+	}
+	return nil
+}
+
+func (env *InteractionEnv) queueResponses(m raftpb.Message) {
+	if len(m.Responses) == 0 {
+		return
+	}
+	env.Output.WriteString("Responses:\n")
+	for _, r := range m.Responses {
+		env.Output.WriteString(raft.DescribeMessage(r, defaultEntryFormatter) + "\n")
+	}
+	env.Messages = append(env.Messages, m.Responses...)
+}
@@ -27,8 +27,9 @@ func (env *InteractionEnv) handleStabilize(t *testing.T, d datadriven.TestData)
 	return env.Stabilize(idxs...)
 }
 
-// Stabilize repeatedly runs Ready handling on and message delivery to the set
-// of nodes specified via the idxs slice until reaching a fixed point.
+// Stabilize repeatedly runs Ready handling on, the local storage threads of,
+// and message delivery to the set of nodes specified via the idxs slice until
+// reaching a fixed point.
 func (env *InteractionEnv) Stabilize(idxs ...int) error {
 	var nodes []Node
 	for _, idx := range idxs {
@@ -48,6 +49,21 @@ func (env *InteractionEnv) Stabilize(idxs ...int) error {
 				env.withIndent(func() { env.ProcessReady(idx) })
 			}
 		}
+		for _, rn := range nodes {
+			// NB: the work queues are read from env.Nodes, as nodes holds
+			// copies taken before the Ready handling above.
+			idx := int(rn.Status().ID - 1)
+			if len(env.Nodes[idx].AppendWork) > 0 {
+				done = false
+				fmt.Fprintf(env.Output, "> %d processing append thread\n", idx+1)
+				env.withIndent(func() { env.ProcessAppendThread(idx) })
+			}
+			if len(env.Nodes[idx].ApplyWork) > 0 {
+				done = false
+				fmt.Fprintf(env.Output, "> %d processing apply thread\n", idx+1)
+				env.withIndent(func() { env.ProcessApplyThread(idx) })
+			}
+		}
 		for _, rn := range nodes {
 			id := rn.Status().ID
 			// NB: we grab the messages just to see whether to print the header.
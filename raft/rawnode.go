@@ -109,11 +109,12 @@ func (rn *RawNode) ApplyConfChange(cc pb.ConfChangeI) *pb.ConfState {
 
 // Step advances the state machine using the given message.
 func (rn *RawNode) Step(m pb.Message) error {
-	// ignore unexpected local messages receiving over network
-	if IsLocalMsg(m.Type) {
+	// ignore unexpected local messages receiving over network, but accept
+	// the responses of the local storage threads
+	if IsLocalMsg(m.Type) && !IsLocalMsgTarget(m.From) {
 		return ErrStepLocalMsg
 	}
-	if pr := rn.raft.prs.Progress[m.From]; pr != nil || !IsResponseMsg(m.Type) {
+	if pr := rn.raft.prs.Progress[m.From]; pr != nil || !IsResponseMsg(m.Type) || IsLocalMsgTarget(m.From) {
 		return rn.raft.Step(m)
 	}
 	return ErrStepPeerNotFound
@@ -122,7 +123,7 @@ func (rn *RawNode) Step(m pb.Message) error {
 // Ready returns the outstanding work that the application needs to handle. This
 // includes appending and applying entries or a snapshot, updating the HardState,
 // and sending messages. The returned Ready() *must* be handled and subsequently
-// passed back via Advance().
+// passed back via Advance(), unless AsyncStorageWrites is set.
 func (rn *RawNode) Ready() Ready {
 	rd := rn.readyWithoutAccept()
 	rn.acceptReady(rd)
@@ -145,6 +146,19 @@ func (rn *RawNode) acceptReady(rd Ready) {
 	if len(rd.ReadStates) != 0 {
 		rn.raft.readStates = nil
 	}
+	if rn.raft.asyncStorageWrites {
+		// there is no Advance, the storage threads report back with
+		// response messages instead
+		if !IsEmptyHardState(rd.HardState) {
+			rn.prevHardSt = rd.HardState
+		}
+		rn.raft.msgsAfterAppend = nil
+		rn.raft.raftLog.acceptUnstable()
+		if len(rd.CommittedEntries) > 0 {
+			ents := rd.CommittedEntries
+			rn.raft.raftLog.acceptApplying(ents[len(ents)-1].Index)
+		}
+	}
 	rn.raft.msgs = nil
 }
 
@@ -158,6 +172,12 @@ func (rn *RawNode) HasReady() bool {
 	if hardSt := r.hardState(); !IsEmptyHardState(hardSt) && !isHardStateEqual(hardSt, rn.prevHardSt) {
 		return true
 	}
+	if r.asyncStorageWrites {
+		return r.raftLog.hasNextUnstableSnapshot() ||
+			len(r.msgs) > 0 || len(r.msgsAfterAppend) > 0 ||
+			r.raftLog.hasNextUnstableEnts() || r.raftLog.hasNextCommittedEnts(false) ||
+			len(r.readStates) != 0
+	}
 	if r.raftLog.hasPendingSnapshot() {
 		return true
 	}
@@ -172,7 +192,13 @@ func (rn *RawNode) HasReady() bool {
 
 // Advance notifies the RawNode that the application has applied and saved progress in the
 // last Ready results.
+//
+// NOTE: Advance must not be called when using AsyncStorageWrites. Response messages from
+// the local append and apply threads take its place.
 func (rn *RawNode) Advance(rd Ready) {
+	if rn.raft.asyncStorageWrites {
+		rn.raft.logger.Panicf("Advance must not be called when using AsyncStorageWrites")
+	}
 	if !IsEmptyHardState(rd.HardState) {
 		rn.prevHardSt = rd.HardState
 	}
@@ -233,6 +259,11 @@ func (rn *RawNode) TransferLeader(transferee uint64) {
 	_ = rn.raft.Step(pb.Message{Type: pb.MsgTransferLeader, From: transferee})
 }
 
+// SetPriority changes the election priority of the node. See Config.Priority.
+func (rn *RawNode) SetPriority(p uint64) {
+	rn.raft.setPriority(p)
+}
+
 // ReadIndex requests a read state. The read state will be set in ready.
 // Read State has a read index. Once the application advances further than the read
 // index, any linearizable read requests issued before the read request can be
@@ -23,19 +23,19 @@ func TestInflightsAdd(t *testing.T) {
 	// no rotating case
 	in := &Inflights{
 		size:   10,
-		buffer: make([]uint64, 10),
+		buffer: make([]inflight, 10),
 	}
 
 	for i := 0; i < 5; i++ {
-		in.Add(uint64(i))
+		in.Add(uint64(i), 0)
 	}
 
 	wantIn := &Inflights{
 		start: 0,
 		count: 5,
 		size:  10,
-		//               ↓------------
-		buffer: []uint64{0, 1, 2, 3, 4, 0, 0, 0, 0, 0},
+		//                               ↓------------
+		buffer: inflightsBuffer([]uint64{0, 1, 2, 3, 4, 0, 0, 0, 0, 0}),
 	}
 
 	if !reflect.DeepEqual(in, wantIn) {
@@ -43,15 +43,15 @@ func TestInflightsAdd(t *testing.T) {
 	}
 
 	for i := 5; i < 10; i++ {
-		in.Add(uint64(i))
+		in.Add(uint64(i), 0)
 	}
 
 	wantIn2 := &Inflights{
 		start: 0,
 		count: 10,
 		size:  10,
-		//               ↓---------------------------
-		buffer: []uint64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+		//                               ↓---------------------------
+		buffer: inflightsBuffer([]uint64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}),
 	}
 
 	if !reflect.DeepEqual(in, wantIn2) {
@@ -62,19 +62,19 @@ func TestInflightsAdd(t *testing.T) {
 	in2 := &Inflights{
 		start:  5,
 		size:   10,
-		buffer: make([]uint64, 10),
+		buffer: make([]inflight, 10),
 	}
 
 	for i := 0; i < 5; i++ {
-		in2.Add(uint64(i))
+		in2.Add(uint64(i), 0)
 	}
 
 	wantIn21 := &Inflights{
 		start: 5,
 		count: 5,
 		size:  10,
-		//                              ↓------------
-		buffer: []uint64{0, 0, 0, 0, 0, 0, 1, 2, 3, 4},
+		//                                              ↓------------
+		buffer: inflightsBuffer([]uint64{0, 0, 0, 0, 0, 0, 1, 2, 3, 4}),
 	}
 
 	if !reflect.DeepEqual(in2, wantIn21) {
@@ -82,15 +82,15 @@ func TestInflightsAdd(t *testing.T) {
 	}
 
 	for i := 5; i < 10; i++ {
-		in2.Add(uint64(i))
+		in2.Add(uint64(i), 0)
 	}
 
 	wantIn22 := &Inflights{
 		start: 5,
 		count: 10,
 		size:  10,
-		//               -------------- ↓------------
-		buffer: []uint64{5, 6, 7, 8, 9, 0, 1, 2, 3, 4},
+		//                               -------------- ↓------------
+		buffer: inflightsBuffer([]uint64{5, 6, 7, 8, 9, 0, 1, 2, 3, 4}),
 	}
 
 	if !reflect.DeepEqual(in2, wantIn22) {
@@ -100,9 +100,9 @@ func TestInflightsAdd(t *testing.T) {
 
 func TestInflightFreeTo(t *testing.T) {
 	// no rotating case
-	in := NewInflights(10)
+	in := NewInflights(10, 0)
 	for i := 0; i < 10; i++ {
-		in.Add(uint64(i))
+		in.Add(uint64(i), 0)
 	}
 
 	in.FreeLE(4)
@@ -111,8 +111,8 @@ func TestInflightFreeTo(t *testing.T) {
 		start: 5,
 		count: 5,
 		size:  10,
-		//                              ↓------------
-		buffer: []uint64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+		//                                              ↓------------
+		buffer: inflightsBuffer([]uint64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}),
 	}
 
 	if !reflect.DeepEqual(in, wantIn) {
@@ -125,8 +125,8 @@ func TestInflightFreeTo(t *testing.T) {
 		start: 9,
 		count: 1,
 		size:  10,
-		//                                          ↓
-		buffer: []uint64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+		//                                                          ↓
+		buffer: inflightsBuffer([]uint64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}),
 	}
 
 	if !reflect.DeepEqual(in, wantIn2) {
@@ -135,7 +135,7 @@ func TestInflightFreeTo(t *testing.T) {
 
 	// rotating case
 	for i := 10; i < 15; i++ {
-		in.Add(uint64(i))
+		in.Add(uint64(i), 0)
 	}
 
 	in.FreeLE(12)
@@ -144,8 +144,8 @@ func TestInflightFreeTo(t *testing.T) {
 		start: 3,
 		count: 2,
 		size:  10,
-		//                           ↓-----
-		buffer: []uint64{10, 11, 12, 13, 14, 5, 6, 7, 8, 9},
+		//                                           ↓-----
+		buffer: inflightsBuffer([]uint64{10, 11, 12, 13, 14, 5, 6, 7, 8, 9}),
 	}
 
 	if !reflect.DeepEqual(in, wantIn3) {
@@ -158,8 +158,8 @@ func TestInflightFreeTo(t *testing.T) {
 		start: 0,
 		count: 0,
 		size:  10,
-		//               ↓
-		buffer: []uint64{10, 11, 12, 13, 14, 5, 6, 7, 8, 9},
+		//                               ↓
+		buffer: inflightsBuffer([]uint64{10, 11, 12, 13, 14, 5, 6, 7, 8, 9}),
 	}
 
 	if !reflect.DeepEqual(in, wantIn4) {
@@ -168,9 +168,9 @@ func TestInflightFreeTo(t *testing.T) {
 }
 
 func TestInflightFreeFirstOne(t *testing.T) {
-	in := NewInflights(10)
+	in := NewInflights(10, 0)
 	for i := 0; i < 10; i++ {
-		in.Add(uint64(i))
+		in.Add(uint64(i), 0)
 	}
 
 	in.FreeFirstOne()
@@ -179,11 +179,53 @@ func TestInflightFreeFirstOne(t *testing.T) {
 		start: 1,
 		count: 9,
 		size:  10,
-		//                  ↓------------------------
-		buffer: []uint64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+		//                                  ↓------------------------
+		buffer: inflightsBuffer([]uint64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}),
 	}
 
 	if !reflect.DeepEqual(in, wantIn) {
 		t.Fatalf("in = %+v, want %+v", in, wantIn)
 	}
 }
+
+func TestInflightsFullBytes(t *testing.T) {
+	in := NewInflights(10, 1000)
+	for i := 0; i < 4; i++ {
+		if in.Full() {
+			t.Fatalf("#%d: unexpected full inflights", i)
+		}
+		in.Add(uint64(i), 300)
+	}
+	if !in.Full() {
+		t.Fatalf("full = false, want true")
+	}
+	if in.Count() != 4 || in.Bytes() != 1200 {
+		t.Fatalf("count, bytes = %d, %d, want 4, 1200", in.Count(), in.Bytes())
+	}
+
+	in.FreeLE(1)
+	if in.Full() {
+		t.Fatalf("full = true, want false")
+	}
+	if in.Count() != 2 || in.Bytes() != 600 {
+		t.Fatalf("count, bytes = %d, %d, want 2, 600", in.Count(), in.Bytes())
+	}
+
+	in.Add(4, 400)
+	if !in.Full() {
+		t.Fatalf("full = false, want true")
+	}
+
+	in.FreeLE(4)
+	if in.Count() != 0 || in.Bytes() != 0 {
+		t.Fatalf("count, bytes = %d, %d, want 0, 0", in.Count(), in.Bytes())
+	}
+}
+
+func inflightsBuffer(indices []uint64) []inflight {
+	buffer := make([]inflight, 0, len(indices))
+	for _, idx := range indices {
+		buffer = append(buffer, inflight{index: idx})
+	}
+	return buffer
+}
@@ -77,6 +77,10 @@ type Progress struct {
 
 	// IsLearner is true if this progress is tracked for a learner.
 	IsLearner bool
+
+	// IsWitness is true if this progress is tracked for a witness, i.e. a
+	// voter that never campaigns for leadership.
+	IsWitness bool
 }
 
 // ResetState moves the Progress into the specified State, resetting ProbeSent,
@@ -194,10 +198,10 @@ func (pr *Progress) MaybeDecrTo(rejected, matchHint uint64) bool {
 
 // IsPaused returns whether sending log entries to this node has been throttled.
 // This is done when a node has rejected recent MsgApps, is currently waiting
-// for a snapshot, or has reached the MaxInflightMsgs limit. In normal
-// operation, this is false. A throttled node will be contacted less frequently
-// until it has reached a state in which it's able to accept a steady stream of
-// log entries again.
+// for a snapshot, or has reached the MaxInflightMsgs or MaxInflightBytes limit.
+// In normal operation, this is false. A throttled node will be contacted less
+// frequently until it has reached a state in which it's able to accept a
+// steady stream of log entries again.
 func (pr *Progress) IsPaused() bool {
 	switch pr.State {
 	case StateProbe:
@@ -217,6 +221,9 @@ func (pr *Progress) String() string {
 	if pr.IsLearner {
 		fmt.Fprint(&buf, " learner")
 	}
+	if pr.IsWitness {
+		fmt.Fprint(&buf, " witness")
+	}
 	if pr.IsPaused() {
 		fmt.Fprint(&buf, " paused")
 	}
@@ -228,6 +235,9 @@ func (pr *Progress) String() string {
 	}
 	if n := pr.Inflights.Count(); n > 0 {
 		fmt.Fprintf(&buf, " inflight=%d", n)
+		if b := pr.Inflights.Bytes(); b > 0 {
+			fmt.Fprintf(&buf, "(%dB)", b)
+		}
 		if pr.Inflights.Full() {
 			fmt.Fprint(&buf, "[full]")
 		}
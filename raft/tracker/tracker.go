@@ -75,6 +75,13 @@ type Config struct {
 	// right away when entering the joint configuration, so that it is caught up
 	// as soon as possible.
 	LearnersNext map[uint64]struct{}
+	// Witnesses is a set of IDs corresponding to the voters (in either half of
+	// the joint config) that take part in elections and in committing entries
+	// but never become leader. Witnesses typically do not keep the application
+	// state, so there is nothing for them to lead with.
+	//
+	// Invariant: Witnesses is a subset of Voters.IDs().
+	Witnesses map[uint64]struct{}
 }
 
 func (c Config) String() string {
@@ -86,6 +93,9 @@ func (c Config) String() string {
 	if c.LearnersNext != nil {
 		fmt.Fprintf(&buf, " learners_next=%s", quorum.MajorityConfig(c.LearnersNext).String())
 	}
+	if c.Witnesses != nil {
+		fmt.Fprintf(&buf, " witnesses=%s", quorum.MajorityConfig(c.Witnesses).String())
+	}
 	if c.AutoLeave {
 		fmt.Fprintf(&buf, " autoleave")
 	}
@@ -108,6 +118,7 @@ func (c *Config) Clone() Config {
 		Voters:       quorum.JointConfig{clone(c.Voters[0]), clone(c.Voters[1])},
 		Learners:     clone(c.Learners),
 		LearnersNext: clone(c.LearnersNext),
+		Witnesses:    clone(c.Witnesses),
 	}
 }
 
@@ -122,13 +133,15 @@ type ProgressTracker struct {
 
 	Votes map[uint64]bool
 
-	MaxInflight int
+	MaxInflight      int
+	MaxInflightBytes uint64
 }
 
 // MakeProgressTracker initializes a ProgressTracker.
-func MakeProgressTracker(maxInflight int) ProgressTracker {
+func MakeProgressTracker(maxInflight int, maxBytes uint64) ProgressTracker {
 	p := ProgressTracker{
-		MaxInflight: maxInflight,
+		MaxInflight:      maxInflight,
+		MaxInflightBytes: maxBytes,
 		Config: Config{
 			Voters: quorum.JointConfig{
 				quorum.MajorityConfig{},
@@ -136,6 +149,7 @@ func MakeProgressTracker(maxInflight int) ProgressTracker {
 			},
 			Learners:     nil, // only populated when used
 			LearnersNext: nil, // only populated when used
+			Witnesses:    nil, // only populated when used
 		},
 		Votes:    map[uint64]bool{},
 		Progress: map[uint64]*Progress{},
@@ -151,6 +165,7 @@ func (p *ProgressTracker) ConfState() pb.ConfState {
 		Learners:       quorum.MajorityConfig(p.Learners).Slice(),
 		LearnersNext:   quorum.MajorityConfig(p.LearnersNext).Slice(),
 		AutoLeave:      p.AutoLeave,
+		Witnesses:      quorum.MajorityConfig(p.Witnesses).Slice(),
 	}
 }
 
@@ -249,6 +264,19 @@ func (p *ProgressTracker) LearnerNodes() []uint64 {
 	return nodes
 }
 
+// WitnessNodes returns a sorted slice of witnesses.
+func (p *ProgressTracker) WitnessNodes() []uint64 {
+	if len(p.Witnesses) == 0 {
+		return nil
+	}
+	nodes := make([]uint64, 0, len(p.Witnesses))
+	for id := range p.Witnesses {
+		nodes = append(nodes, id)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i] < nodes[j] })
+	return nodes
+}
+
 // ResetVotes prepares for a new round of vote counting via recordVote.
 func (p *ProgressTracker) ResetVotes() {
 	p.Votes = map[uint64]bool{}
@@ -43,11 +43,20 @@ func max(a, b uint64) uint64 {
 // TODO simfg local msg是什么意思
 func IsLocalMsg(msgt pb.MessageType) bool {
 	return msgt == pb.MsgHup || msgt == pb.MsgBeat || msgt == pb.MsgUnreachable ||
-		msgt == pb.MsgSnapStatus || msgt == pb.MsgCheckQuorum
+		msgt == pb.MsgSnapStatus || msgt == pb.MsgCheckQuorum ||
+		msgt == pb.MsgStorageAppend || msgt == pb.MsgStorageAppendResp ||
+		msgt == pb.MsgStorageApply || msgt == pb.MsgStorageApplyResp
 }
 
 func IsResponseMsg(msgt pb.MessageType) bool {
-	return msgt == pb.MsgAppResp || msgt == pb.MsgVoteResp || msgt == pb.MsgHeartbeatResp || msgt == pb.MsgUnreachable || msgt == pb.MsgPreVoteResp
+	return msgt == pb.MsgAppResp || msgt == pb.MsgVoteResp || msgt == pb.MsgHeartbeatResp || msgt == pb.MsgUnreachable || msgt == pb.MsgPreVoteResp ||
+		msgt == pb.MsgStorageAppendResp || msgt == pb.MsgStorageApplyResp
+}
+
+// IsLocalMsgTarget returns true if the id is one of the local storage threads
+// used with AsyncStorageWrites.
+func IsLocalMsgTarget(id uint64) bool {
+	return id == LocalAppendThread || id == LocalApplyThread
 }
 
 // voteResponseType maps vote and prevote message types to their corresponding responses.
@@ -133,7 +142,10 @@ type EntryFormatter func([]byte) string
 // Message for debugging.
 func DescribeMessage(m pb.Message, f EntryFormatter) string {
 	var buf bytes.Buffer
-	fmt.Fprintf(&buf, "%x->%x %v Term:%d Log:%d/%d", m.From, m.To, m.Type, m.Term, m.LogTerm, m.Index)
+	fmt.Fprintf(&buf, "%s->%s %v Term:%d Log:%d/%d", describeTarget(m.From), describeTarget(m.To), m.Type, m.Term, m.LogTerm, m.Index)
+	if m.Vote != 0 {
+		fmt.Fprintf(&buf, " Vote:%d", m.Vote)
+	}
 	if m.Reject {
 		fmt.Fprintf(&buf, " Rejected (Hint: %d)", m.RejectHint)
 	}
@@ -153,15 +165,45 @@ func DescribeMessage(m pb.Message, f EntryFormatter) string {
 	if !IsEmptySnap(m.Snapshot) {
 		fmt.Fprintf(&buf, " Snapshot: %s", DescribeSnapshot(m.Snapshot))
 	}
+	if len(m.Responses) > 0 {
+		fmt.Fprintf(&buf, " Responses:[")
+		for i, r := range m.Responses {
+			if i != 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(DescribeMessage(r, f))
+		}
+		fmt.Fprintf(&buf, "]")
+	}
 	return buf.String()
 }
 
+func describeTarget(id uint64) string {
+	switch id {
+	case LocalAppendThread:
+		return "AppendThread"
+	case LocalApplyThread:
+		return "ApplyThread"
+	default:
+		return fmt.Sprintf("%x", id)
+	}
+}
+
 // PayloadSize is the size of the payload of this Entry. Notably, it does not
 // depend on its Index or Term.
 func PayloadSize(e pb.Entry) int {
 	return len(e.Data)
 }
 
+// payloadsSize is the size of the payloads of the provided entries.
+func payloadsSize(ents []pb.Entry) uint64 {
+	var s uint64
+	for _, e := range ents {
+		s += uint64(PayloadSize(e))
+	}
+	return s
+}
+
 // DescribeEntry returns a concise human-readable description of an
 // Entry for debugging.
 func DescribeEntry(e pb.Entry, f EntryFormatter) string {
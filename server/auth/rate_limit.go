@@ -0,0 +1,181 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"go.etcd.io/etcd/api/v3/authpb"
+	"golang.org/x/time/rate"
+)
+
+// rateLimiters holds the token buckets of the users and roles that have a
+// rate limit. The buckets are local to the member, so the limits apply to
+// the requests each member serves.
+//
+// The buckets are invalidated by the auth store with the backend batch tx
+// locked, so mu is never held while locking the backend.
+type rateLimiters struct {
+	mu sync.Mutex
+	// gen is increased by every invalidation, so that buckets loaded from
+	// a user or role that changed meanwhile are not cached.
+	gen uint64
+	// users and roles hold the buckets by name; a nil bucket caches
+	// that the user or role has no limit.
+	users map[string]*rate.Limiter
+	roles map[string]*rate.Limiter
+	// userLimiters caches the buckets a request of each user draws from.
+	userLimiters map[string][]*rate.Limiter
+}
+
+func newRateLimiters() *rateLimiters {
+	return &rateLimiters{
+		users:        make(map[string]*rate.Limiter),
+		roles:        make(map[string]*rate.Limiter),
+		userLimiters: make(map[string][]*rate.Limiter),
+	}
+}
+
+func newRateLimiter(rl *authpb.RateLimit) *rate.Limiter {
+	if rl == nil || rl.RequestsPerSecond <= 0 {
+		return nil
+	}
+	burst := int(rl.Burst)
+	if burst == 0 {
+		burst = int(math.Ceil(rl.RequestsPerSecond))
+	}
+	return rate.NewLimiter(rate.Limit(rl.RequestsPerSecond), burst)
+}
+
+// limiters returns the buckets a request of the given user draws from.
+// The user and role buckets are loaded through tx on first use.
+func (rls *rateLimiters) limiters(tx AuthReadTx, userName string) []*rate.Limiter {
+	for {
+		rls.mu.Lock()
+		ls, ok := rls.userLimiters[userName]
+		gen := rls.gen
+		rls.mu.Unlock()
+		if ok {
+			return ls
+		}
+
+		tx.Lock()
+		user := tx.UnsafeGetUser(userName)
+		var roles []*authpb.Role
+		if user != nil {
+			for _, roleName := range user.Roles {
+				roles = append(roles, tx.UnsafeGetRole(roleName))
+			}
+		}
+		tx.Unlock()
+		if user == nil {
+			return nil
+		}
+
+		if ls, ok := rls.load(gen, user, roles); ok {
+			return ls
+		}
+		// the user or a role was changed while being read, read it again
+	}
+}
+
+// load builds the buckets of user from the user and its roles read at
+// generation gen. It returns false if the buckets were invalidated since.
+func (rls *rateLimiters) load(gen uint64, user *authpb.User, roles []*authpb.Role) ([]*rate.Limiter, bool) {
+	rls.mu.Lock()
+	defer rls.mu.Unlock()
+	if rls.gen != gen {
+		return nil, false
+	}
+
+	var ls []*rate.Limiter
+	userName := string(user.Name)
+	l, ok := rls.users[userName]
+	if !ok {
+		l = newRateLimiter(user.RateLimit)
+		rls.users[userName] = l
+	}
+	if l != nil {
+		ls = append(ls, l)
+	}
+	for i, roleName := range user.Roles {
+		l, ok := rls.roles[roleName]
+		if !ok {
+			if roles[i] != nil {
+				l = newRateLimiter(roles[i].RateLimit)
+			}
+			rls.roles[roleName] = l
+		}
+		if l != nil {
+			ls = append(ls, l)
+		}
+	}
+	rls.userLimiters[userName] = ls
+	return ls, true
+}
+
+// allow takes a token from every bucket, or from none of them if any
+// bucket is empty.
+func allow(ls []*rate.Limiter) bool {
+	now := time.Now()
+	rs := make([]*rate.Reservation, 0, len(ls))
+	for _, l := range ls {
+		r := l.ReserveN(now, 1)
+		if !r.OK() || r.DelayFrom(now) > 0 {
+			r.CancelAt(now)
+			for _, taken := range rs {
+				taken.CancelAt(now)
+			}
+			return false
+		}
+		rs = append(rs, r)
+	}
+	return true
+}
+
+// invalidateUser drops the bucket and the cached buckets of a user, e.g.
+// when the user's limit or roles change.
+func (rls *rateLimiters) invalidateUser(userName string, resetLimit bool) {
+	rls.mu.Lock()
+	defer rls.mu.Unlock()
+	rls.gen++
+	delete(rls.userLimiters, userName)
+	if resetLimit {
+		delete(rls.users, userName)
+	}
+}
+
+// invalidateRole drops the bucket of a role and the cached buckets of
+// every user, since any of them may have the role.
+func (rls *rateLimiters) invalidateRole(roleName string) {
+	rls.mu.Lock()
+	defer rls.mu.Unlock()
+	rls.gen++
+	delete(rls.roles, roleName)
+	rls.userLimiters = make(map[string][]*rate.Limiter)
+}
+
+// clear drops every bucket, e.g. when the auth store is recovered from a
+// snapshot.
+func (rls *rateLimiters) clear() {
+	rls.mu.Lock()
+	defer rls.mu.Unlock()
+	rls.gen++
+	rls.users = make(map[string]*rate.Limiter)
+	rls.roles = make(map[string]*rate.Limiter)
+	rls.userLimiters = make(map[string][]*rate.Limiter)
+}
@@ -65,6 +65,7 @@ var (
 	ErrMissingKey           = errors.New("auth: missing key data")
 	ErrKeyMismatch          = errors.New("auth: public and private keys don't match")
 	ErrVerifyOnly           = errors.New("auth: token signing attempted with verify-only key")
+	ErrRateLimitExceeded    = errors.New("auth: request rate limit exceeded")
 )
 
 const (
@@ -121,6 +122,9 @@ type AuthStore interface {
 	// UserRevokeRole revokes a role of a user
 	UserRevokeRole(r *pb.AuthUserRevokeRoleRequest) (*pb.AuthUserRevokeRoleResponse, error)
 
+	// UserSetRateLimit sets the request rate limit of a user
+	UserSetRateLimit(r *pb.AuthUserSetRateLimitRequest) (*pb.AuthUserSetRateLimitResponse, error)
+
 	// RoleAdd adds a new role
 	RoleAdd(r *pb.AuthRoleAddRequest) (*pb.AuthRoleAddResponse, error)
 
@@ -136,6 +140,9 @@ type AuthStore interface {
 	// RoleDelete gets the detailed information of a role
 	RoleDelete(r *pb.AuthRoleDeleteRequest) (*pb.AuthRoleDeleteResponse, error)
 
+	// RoleSetRateLimit sets the request rate limit shared by the users of a role
+	RoleSetRateLimit(r *pb.AuthRoleSetRateLimitRequest) (*pb.AuthRoleSetRateLimitResponse, error)
+
 	// UserList gets a list of all users
 	UserList(r *pb.AuthUserListRequest) (*pb.AuthUserListResponse, error)
 
@@ -154,6 +161,9 @@ type AuthStore interface {
 	// IsAdminPermitted checks admin permission of the user
 	IsAdminPermitted(authInfo *AuthInfo) error
 
+	// AllowRequest takes a request from the rate limits of the user and its roles
+	AllowRequest(authInfo *AuthInfo) error
+
 	// GenTokenPrefix produces a random string in a case of simple token
 	// in a case of JWT, it produces an empty string
 	GenTokenPrefix() (string, error)
@@ -236,6 +246,7 @@ type authStore struct {
 	enabledMu sync.RWMutex
 
 	rangePermCache map[string]*unifiedRangePermissions // username -> unifiedRangePermissions
+	rateLimiters   *rateLimiters
 
 	tokenProvider TokenProvider
 	bcryptCost    int // the algorithm cost / strength for hashing auth passwords
@@ -397,6 +408,8 @@ func (as *authStore) Recover(be AuthBackend) {
 
 	tx.Unlock()
 
+	as.rateLimiters.clear()
+
 	as.enabledMu.Lock()
 	as.enabled = enabled
 	if enabled {
@@ -486,6 +499,7 @@ func (as *authStore) UserDelete(r *pb.AuthUserDeleteRequest) (*pb.AuthUserDelete
 	as.commitRevision(tx)
 
 	as.invalidateCachedPerm(r.Name)
+	as.rateLimiters.invalidateUser(r.Name, true)
 	as.tokenProvider.invalidateUser(r.Name)
 
 	as.lg.Info(
@@ -520,10 +534,11 @@ func (as *authStore) UserChangePassword(r *pb.AuthUserChangePasswordRequest) (*p
 	}
 
 	updatedUser := &authpb.User{
-		Name:     []byte(r.Name),
-		Roles:    user.Roles,
-		Password: password,
-		Options:  user.Options,
+		Name:      []byte(r.Name),
+		Roles:     user.Roles,
+		Password:  password,
+		Options:   user.Options,
+		RateLimit: user.RateLimit,
 	}
 	tx.UnsafePutUser(updatedUser)
 
@@ -578,6 +593,7 @@ func (as *authStore) UserGrantRole(r *pb.AuthUserGrantRoleRequest) (*pb.AuthUser
 	tx.UnsafePutUser(user)
 
 	as.invalidateCachedPerm(r.User)
+	as.rateLimiters.invalidateUser(r.User, false)
 
 	as.commitRevision(tx)
 
@@ -602,6 +618,7 @@ func (as *authStore) UserGet(r *pb.AuthUserGetRequest) (*pb.AuthUserGetResponse,
 
 	var resp pb.AuthUserGetResponse
 	resp.Roles = append(resp.Roles, user.Roles...)
+	resp.RateLimit = user.RateLimit
 	return &resp, nil
 }
 
@@ -641,9 +658,10 @@ func (as *authStore) UserRevokeRole(r *pb.AuthUserRevokeRoleRequest) (*pb.AuthUs
 	}
 
 	updatedUser := &authpb.User{
-		Name:     user.Name,
-		Password: user.Password,
-		Options:  user.Options,
+		Name:      user.Name,
+		Password:  user.Password,
+		Options:   user.Options,
+		RateLimit: user.RateLimit,
 	}
 
 	for _, role := range user.Roles {
@@ -659,6 +677,7 @@ func (as *authStore) UserRevokeRole(r *pb.AuthUserRevokeRoleRequest) (*pb.AuthUs
 	tx.UnsafePutUser(updatedUser)
 
 	as.invalidateCachedPerm(r.Name)
+	as.rateLimiters.invalidateUser(r.Name, false)
 
 	as.commitRevision(tx)
 
@@ -687,6 +706,7 @@ func (as *authStore) RoleGet(r *pb.AuthRoleGetRequest) (*pb.AuthRoleGetResponse,
 	} else {
 		resp.Perm = append(resp.Perm, role.KeyPermission...)
 	}
+	resp.RateLimit = role.RateLimit
 	return &resp, nil
 }
 
@@ -717,7 +737,8 @@ func (as *authStore) RoleRevokePermission(r *pb.AuthRoleRevokePermissionRequest)
 	}
 
 	updatedRole := &authpb.Role{
-		Name: role.Name,
+		Name:      role.Name,
+		RateLimit: role.RateLimit,
 	}
 
 	for _, perm := range role.KeyPermission {
@@ -770,9 +791,10 @@ func (as *authStore) RoleDelete(r *pb.AuthRoleDeleteRequest) (*pb.AuthRoleDelete
 	users := tx.UnsafeGetAllUsers()
 	for _, user := range users {
 		updatedUser := &authpb.User{
-			Name:     user.Name,
-			Password: user.Password,
-			Options:  user.Options,
+			Name:      user.Name,
+			Password:  user.Password,
+			Options:   user.Options,
+			RateLimit: user.RateLimit,
 		}
 
 		for _, role := range user.Roles {
@@ -790,6 +812,8 @@ func (as *authStore) RoleDelete(r *pb.AuthRoleDeleteRequest) (*pb.AuthRoleDelete
 		as.invalidateCachedPerm(string(user.Name))
 	}
 
+	as.rateLimiters.invalidateRole(r.Role)
+
 	as.commitRevision(tx)
 
 	as.lg.Info("deleted a role", zap.String("role-name", r.Role))
@@ -825,6 +849,65 @@ func (as *authStore) RoleAdd(r *pb.AuthRoleAddRequest) (*pb.AuthRoleAddResponse,
 	return &pb.AuthRoleAddResponse{}, nil
 }
 
+func (as *authStore) UserSetRateLimit(r *pb.AuthUserSetRateLimitRequest) (*pb.AuthUserSetRateLimitResponse, error) {
+	tx := as.be.BatchTx()
+	tx.Lock()
+	defer tx.Unlock()
+
+	user := tx.UnsafeGetUser(r.Name)
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	user.RateLimit = normalizeRateLimit(r.RateLimit)
+	tx.UnsafePutUser(user)
+
+	as.rateLimiters.invalidateUser(r.Name, true)
+
+	as.commitRevision(tx)
+
+	as.lg.Info(
+		"set a rate limit of a user",
+		zap.String("user-name", r.Name),
+		zap.Stringer("rate-limit", user.RateLimit),
+	)
+	return &pb.AuthUserSetRateLimitResponse{}, nil
+}
+
+func (as *authStore) RoleSetRateLimit(r *pb.AuthRoleSetRateLimitRequest) (*pb.AuthRoleSetRateLimitResponse, error) {
+	tx := as.be.BatchTx()
+	tx.Lock()
+	defer tx.Unlock()
+
+	role := tx.UnsafeGetRole(r.Role)
+	if role == nil {
+		return nil, ErrRoleNotFound
+	}
+
+	role.RateLimit = normalizeRateLimit(r.RateLimit)
+	tx.UnsafePutRole(role)
+
+	as.rateLimiters.invalidateRole(r.Role)
+
+	as.commitRevision(tx)
+
+	as.lg.Info(
+		"set a rate limit of a role",
+		zap.String("role-name", r.Role),
+		zap.Stringer("rate-limit", role.RateLimit),
+	)
+	return &pb.AuthRoleSetRateLimitResponse{}, nil
+}
+
+// normalizeRateLimit drops a rate limit that does not limit anything, so
+// that it is not stored.
+func normalizeRateLimit(rl *authpb.RateLimit) *authpb.RateLimit {
+	if rl == nil || rl.RequestsPerSecond <= 0 {
+		return nil
+	}
+	return rl
+}
+
 func (as *authStore) authInfoFromToken(ctx context.Context, token string) (*AuthInfo, bool) {
 	return as.tokenProvider.info(ctx, token, as.Revision())
 }
@@ -979,6 +1062,16 @@ func (as *authStore) IsAdminPermitted(authInfo *AuthInfo) error {
 	return nil
 }
 
+func (as *authStore) AllowRequest(authInfo *AuthInfo) error {
+	if !as.IsAuthEnabled() || authInfo == nil || authInfo.Username == "" {
+		return nil
+	}
+	if !allow(as.rateLimiters.limiters(as.be.ReadTx(), authInfo.Username)) {
+		return ErrRateLimitExceeded
+	}
+	return nil
+}
+
 func (as *authStore) IsAuthEnabled() bool {
 	as.enabledMu.RLock()
 	defer as.enabledMu.RUnlock()
@@ -1014,6 +1107,7 @@ func NewAuthStore(lg *zap.Logger, be AuthBackend, tp TokenProvider, bcryptCost i
 		be:             be,
 		enabled:        enabled,
 		rangePermCache: make(map[string]*unifiedRangePermissions),
+		rateLimiters:   newRateLimiters(),
 		tokenProvider:  tp,
 		bcryptCost:     bcryptCost,
 	}
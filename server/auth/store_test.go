@@ -970,3 +970,79 @@ func TestUserChangePasswordWithOldLog(t *testing.T) {
 		t.Fatalf("expected %v, got %v", ErrUserNotFound, err)
 	}
 }
+
+func TestRateLimit(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	ua := &pb.AuthUserAddRequest{Name: "baz", HashedPassword: encodePassword("bar"), Options: &authpb.UserAddOptions{NoPassword: false}}
+	if _, err := as.UserAdd(ua); err != nil {
+		t.Fatal(err)
+	}
+	for _, user := range []string{"foo", "baz"} {
+		if _, err := as.UserGrantRole(&pb.AuthUserGrantRoleRequest{User: user, Role: "role-test"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	foo, baz := &AuthInfo{Username: "foo", Revision: 1}, &AuthInfo{Username: "baz", Revision: 1}
+
+	// a user limit only applies to the user
+	_, err := as.UserSetRateLimit(&pb.AuthUserSetRateLimitRequest{Name: "foo", RateLimit: &authpb.RateLimit{RequestsPerSecond: 0.001, Burst: 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if err = as.AllowRequest(foo); err != nil {
+			t.Fatalf("#%d: expected request to be allowed, got %v", i, err)
+		}
+	}
+	if err = as.AllowRequest(foo); err != ErrRateLimitExceeded {
+		t.Fatalf("expected %v, got %v", ErrRateLimitExceeded, err)
+	}
+	if err = as.AllowRequest(baz); err != nil {
+		t.Fatalf("expected request of another user to be allowed, got %v", err)
+	}
+
+	// the limit is kept when the user changes, and reported by UserGet
+	_, err = as.UserChangePassword(&pb.AuthUserChangePasswordRequest{Name: "foo", HashedPassword: encodePassword("baz")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := as.UserGet(&pb.AuthUserGetRequest{Name: "foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.RateLimit == nil || u.RateLimit.Burst != 2 {
+		t.Fatalf("expected rate limit with burst 2, got %v", u.RateLimit)
+	}
+
+	// a role limit is shared by the users of the role
+	_, err = as.UserSetRateLimit(&pb.AuthUserSetRateLimitRequest{Name: "foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = as.RoleSetRateLimit(&pb.AuthRoleSetRateLimitRequest{Role: "role-test", RateLimit: &authpb.RateLimit{RequestsPerSecond: 0.001, Burst: 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = as.AllowRequest(foo); err != nil {
+		t.Fatalf("expected request to be allowed, got %v", err)
+	}
+	if err = as.AllowRequest(baz); err != ErrRateLimitExceeded {
+		t.Fatalf("expected %v, got %v", ErrRateLimitExceeded, err)
+	}
+
+	// revoking the role lifts its limit
+	_, err = as.UserRevokeRole(&pb.AuthUserRevokeRoleRequest{Name: "baz", Role: "role-test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = as.AllowRequest(baz); err != nil {
+		t.Fatalf("expected request to be allowed, got %v", err)
+	}
+
+	_, err = as.RoleSetRateLimit(&pb.AuthRoleSetRateLimitRequest{Role: "role-nonexistent"})
+	if err != ErrRoleNotFound {
+		t.Fatalf("expected %v, got %v", ErrRoleNotFound, err)
+	}
+}
@@ -126,6 +126,11 @@ type ServerConfig struct {
 	QuotaBackendBytes       int64
 	MaxTxnOps               uint
 
+	// AutoCompactionMaxBytes and AutoCompactionMaxRevisionsPerKey are the
+	// targets of the "size" and "hybrid" auto compaction modes.
+	AutoCompactionMaxBytes           int64
+	AutoCompactionMaxRevisionsPerKey int64
+
 	// MaxRequestBytes is the maximum request size to send over raft.
 	MaxRequestBytes uint
 
@@ -179,6 +184,61 @@ type ServerConfig struct {
 
 	DowngradeCheckTime time.Duration
 
+	// LeaderPriorityCheckTime is the duration between two checks by the leader for a
+	// voting member with a higher leader priority. Zero disables the checks.
+	LeaderPriorityCheckTime time.Duration
+	// LeaderPriorityCooldown is the minimum duration between two leadership transfers
+	// made for the leader priority, and the minimum duration a member must have been
+	// connected to the leader for to be handed leadership.
+	LeaderPriorityCooldown time.Duration
+
+	// QuiesceTimeout is the duration without proposals after which the leader quiesces
+	// the raft group once all followers have caught up. Zero disables quiescence.
+	QuiesceTimeout time.Duration
+
+	// MaxInflightBytes limits the total entry size of the raft append messages sent to a
+	// follower but not yet acknowledged. Zero means no limit.
+	MaxInflightBytes uint64
+
+	// SnapshotDelegation lets the leader ask an up-to-date follower to send the
+	// snapshot a lagging member needs, instead of sending it itself.
+	SnapshotDelegation bool
+
+	// SnapshotCompression is the compression applied to the chunks of snapshots
+	// sent to peers. Empty means no compression.
+	SnapshotCompression string
+	// SnapshotSendRateLimit limits the rate, in bytes per second, at which
+	// snapshots are sent to peers. Zero means no limit.
+	SnapshotSendRateLimit uint64
+
+	// PeerCompression sets the compression of the stream and pipeline messages
+	// sent to peers.
+	PeerCompression []PeerCompression
+
+	// EncryptionKeyFile is the file holding the key encryption keys that wrap
+	// the keys encrypting the backend values and WAL entries at rest.
+	EncryptionKeyFile string
+	// EncryptionKMSSocket is the unix socket of the KMS plugin wrapping the
+	// keys encrypting the backend values and WAL entries at rest.
+	EncryptionKMSSocket string
+	// EncryptionKeyRotationInterval is the interval at which a new data
+	// encryption key is put in use. Zero disables the rotation.
+	EncryptionKeyRotationInterval time.Duration
+
+	// ValueCompressionPrefixes are the key prefixes whose values are stored
+	// compressed in the backend.
+	ValueCompressionPrefixes []string
+
+	// KeyIndexCheckpoint enables persisting checkpoints of the key index on
+	// snapshot and shutdown, so that restarts do not rebuild it from the whole
+	// key bucket.
+	KeyIndexCheckpoint bool
+
+	// KeyIndexMemoryBudget is the memory in bytes the keys of the key index
+	// may hold before the least recently used ones are paged out to disk.
+	// Zero keeps the whole key index in memory.
+	KeyIndexMemoryBudget int64
+
 	// ExperimentalMemoryMlock enables mlocking of etcd owned memory pages.
 	// The setting improves etcd tail latency in environments were:
 	//   - memory pressure might lead to swapping pages to disk
@@ -198,10 +258,48 @@ type ServerConfig struct {
 	// ExperimentalMaxLearners sets a limit to the number of learner members that can exist in the cluster membership.
 	ExperimentalMaxLearners int `json:"experimental-max-learners"`
 
+	// ExperimentalPrefixQuotas limits the bytes and keys stored under key prefixes.
+	ExperimentalPrefixQuotas []PrefixQuota `json:"experimental-prefix-quotas"`
+
 	// V2Deprecation defines a phase of v2store deprecation process.
 	V2Deprecation V2DeprecationEnum `json:"v2-deprecation"`
 }
 
+// PrefixQuota limits the storage used by the keys under a key prefix.
+type PrefixQuota struct {
+	Prefix string
+	// MaxBytes is the maximum total size of the keys and values under
+	// the prefix. Zero means no limit.
+	MaxBytes int64
+	// MaxKeys is the maximum number of keys under the prefix. Zero means
+	// no limit.
+	MaxKeys int64
+}
+
+// PeerCompression sets the compression of the messages sent to a member.
+type PeerCompression struct {
+	// Member is the name of the member. An empty name applies to the
+	// members without an entry of their own.
+	Member string
+	// Compression is the compression to apply. Empty means no compression.
+	Compression string
+}
+
+// PeerCompressionFor returns the compression of the messages sent to the
+// member of the given name.
+func (c *ServerConfig) PeerCompressionFor(name string) string {
+	var ret string
+	for _, pc := range c.PeerCompression {
+		switch pc.Member {
+		case name:
+			return pc.Compression
+		case "":
+			ret = pc.Compression
+		}
+	}
+	return ret
+}
+
 // VerifyBootstrap sanity-checks the initial config for bootstrap case
 // and returns an error for things that should never happen.
 func (c *ServerConfig) VerifyBootstrap() error {
@@ -350,3 +448,10 @@ func (c *ServerConfig) BootstrapTimeoutEffective() time.Duration {
 }
 
 func (c *ServerConfig) BackendPath() string { return datadir.ToBackendFileName(c.DataDir) }
+
+// KeyringPath is the file the data encryption keys of a witness are persisted
+// in, as a witness keeps no database file.
+func (c *ServerConfig) KeyringPath() string { return filepath.Join(c.MemberDir(), "keyring") }
+
+// KeyIndexPagesPath is the file the key index is paged out to.
+func (c *ServerConfig) KeyIndexPagesPath() string { return filepath.Join(c.SnapDir(), "keyindex") }
@@ -209,3 +209,27 @@ func TestShouldDiscover(t *testing.T) {
 		}
 	}
 }
+
+func TestPeerCompressionFor(t *testing.T) {
+	cfg := ServerConfig{
+		PeerCompression: []PeerCompression{
+			{Member: "infra2", Compression: ""},
+			{Compression: "gzip"},
+			{Member: "infra3", Compression: "gzip"},
+		},
+	}
+	tests := map[string]string{
+		"infra1": "gzip",
+		"infra2": "",
+		"infra3": "gzip",
+		"":       "gzip",
+	}
+	for name, w := range tests {
+		if g := cfg.PeerCompressionFor(name); g != w {
+			t.Errorf("name=%q: PeerCompressionFor()=%q, want=%q", name, g, w)
+		}
+	}
+	if g := (&ServerConfig{}).PeerCompressionFor("infra1"); g != "" {
+		t.Errorf("PeerCompressionFor()=%q, want no compression", g)
+	}
+}
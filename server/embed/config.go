@@ -37,6 +37,7 @@ import (
 	"go.etcd.io/etcd/server/v3/config"
 	"go.etcd.io/etcd/server/v3/etcdserver"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/rafthttp"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3compactor"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3discovery"
 
@@ -63,6 +64,8 @@ const (
 	DefaultGRPCKeepAliveInterval       = 2 * time.Hour
 	DefaultGRPCKeepAliveTimeout        = 20 * time.Second
 	DefaultDowngradeCheckTime          = 5 * time.Second
+	DefaultLeaderPriorityCheckTime     = 5 * time.Second
+	DefaultLeaderPriorityCooldown      = time.Minute
 	DefaultWaitClusterReadyTimeout     = 5 * time.Second
 
 	DefaultDiscoveryDialTimeout      = 2 * time.Second
@@ -134,6 +137,22 @@ var (
 	// revision 5000 when the current revision is 6000.
 	// This runs every 5-minute if enough of logs have proceeded.
 	CompactorModeRevision = v3compactor.ModeRevision
+
+	// CompactorModeSize is size-based compaction mode
+	// for "Config.AutoCompactionMode" field.
+	// If "AutoCompactionMode" is CompactorModeSize and
+	// "AutoCompactionMaxBytes" is 1 GiB, it compacts the oldest
+	// revisions whenever the backend holds more than 1 GiB in use.
+	// "AutoCompactionMaxRevisionsPerKey" limits the average number of
+	// historical revisions per key the same way.
+	// This runs every 5-minute.
+	CompactorModeSize = v3compactor.ModeSize
+
+	// CompactorModeHybrid is hybrid compaction mode
+	// for "Config.AutoCompactionMode" field.
+	// It compacts like CompactorModeSize, but never compacts
+	// the log of the "AutoCompactionRetention" period, e.g. "1h".
+	CompactorModeHybrid = v3compactor.ModeHybrid
 )
 
 func init() {
@@ -234,13 +253,20 @@ type Config struct {
 	StrictReconfigCheck                 bool          `json:"strict-reconfig-check"`
 	ExperimentalWaitClusterReadyTimeout time.Duration `json:"wait-cluster-ready-timeout"`
 
-	// AutoCompactionMode is either 'periodic' or 'revision'.
+	// AutoCompactionMode is 'periodic', 'revision', 'size' or 'hybrid'.
 	AutoCompactionMode string `json:"auto-compaction-mode"`
 	// AutoCompactionRetention is either duration string with time unit
 	// (e.g. '5m' for 5-minute), or revision unit (e.g. '5000').
 	// If no time unit is provided and compaction mode is 'periodic',
 	// the unit defaults to hour. For example, '5' translates into 5-hour.
 	AutoCompactionRetention string `json:"auto-compaction-retention"`
+	// AutoCompactionMaxBytes is the backend size in use the 'size' and
+	// 'hybrid' modes keep the history under. 0 means no limit.
+	AutoCompactionMaxBytes int64 `json:"auto-compaction-max-bytes"`
+	// AutoCompactionMaxRevisionsPerKey is the average number of historical
+	// revisions per key the 'size' and 'hybrid' modes keep the history
+	// under. 0 means no limit.
+	AutoCompactionMaxRevisionsPerKey int64 `json:"auto-compaction-max-revisions-per-key"`
 
 	// GRPCKeepAliveMinTime is the minimum interval that a client should
 	// wait before pinging server. When client pings "too fast", server
@@ -338,6 +364,59 @@ type Config struct {
 	ExperimentalWarningUnaryRequestDuration time.Duration `json:"experimental-warning-unary-request-duration"`
 	// ExperimentalMaxLearners sets a limit to the number of learner members that can exist in the cluster membership.
 	ExperimentalMaxLearners int `json:"experimental-max-learners"`
+	// ExperimentalPrefixQuotas is a list of "<prefix>=<max-bytes>:<max-keys>" quotas on the
+	// storage used under key prefixes. A zero limit means no limit.
+	ExperimentalPrefixQuotas []string `json:"experimental-prefix-quotas"`
+	// ExperimentalLeaderPriorityCheckTime is the duration between two checks by the leader for
+	// a voting member with a higher leader priority to hand leadership over to. Zero disables it.
+	ExperimentalLeaderPriorityCheckTime time.Duration `json:"experimental-leader-priority-check-time"`
+	// ExperimentalLeaderPriorityCooldown is the minimum duration between two leadership transfers
+	// made for the leader priority. A member must also have been connected to the leader for that
+	// long to be handed leadership.
+	ExperimentalLeaderPriorityCooldown time.Duration `json:"experimental-leader-priority-cooldown"`
+	// ExperimentalQuiesceTimeout is the duration without proposals after which the leader stops
+	// heartbeating once all followers have caught up. Zero disables quiescence.
+	ExperimentalQuiesceTimeout time.Duration `json:"experimental-quiesce-timeout"`
+	// ExperimentalMaxInflightBytes limits the total entry size of the raft append messages sent
+	// to a follower but not yet acknowledged. Zero means no limit.
+	ExperimentalMaxInflightBytes uint64 `json:"experimental-max-inflight-bytes"`
+	// ExperimentalSnapshotDelegation lets the leader ask an up-to-date follower to send the
+	// snapshot a lagging member needs, instead of sending it itself.
+	ExperimentalSnapshotDelegation bool `json:"experimental-snapshot-delegation"`
+	// ExperimentalSnapshotCompression is the compression applied to the chunks of snapshots
+	// sent to peers. Only "gzip" is supported. Empty means no compression.
+	ExperimentalSnapshotCompression string `json:"experimental-snapshot-compression"`
+	// ExperimentalSnapshotSendRateLimit limits the rate, in bytes per second, at which
+	// snapshots are sent to peers. Zero means no limit.
+	ExperimentalSnapshotSendRateLimit uint64 `json:"experimental-snapshot-send-rate-limit"`
+	// ExperimentalPeerCompression is a list of "[<member-name>=]<compression>" compressions of the
+	// messages sent to peers. An entry without a member name applies to all other members.
+	// Only "gzip" and "none" are supported.
+	ExperimentalPeerCompression []string `json:"experimental-peer-compression"`
+	// ExperimentalEncryptionKeyFile is the file holding the key encryption keys, one
+	// "<id>:<base64 key>" per line with the current one first, that wrap the keys
+	// encrypting the backend values and WAL entries at rest. Every member must
+	// have the same keys, since the snapshots sent between members carry data
+	// encryption keys wrapped with them; a member rejects a snapshot whose keys
+	// it cannot unwrap.
+	ExperimentalEncryptionKeyFile string `json:"experimental-encryption-key-file"`
+	// ExperimentalEncryptionKMSSocket is the unix socket of the KMS plugin wrapping the keys
+	// encrypting the backend values and WAL entries at rest. Every member must be able to
+	// unwrap the keys wrapped by any other member.
+	ExperimentalEncryptionKMSSocket string `json:"experimental-encryption-kms-socket"`
+	// ExperimentalEncryptionKeyRotationInterval is the interval at which a new data
+	// encryption key is put in use. Zero disables the rotation.
+	ExperimentalEncryptionKeyRotationInterval time.Duration `json:"experimental-encryption-key-rotation-interval"`
+	// ExperimentalValueCompressionPrefixes is a list of key prefixes whose values are stored
+	// compressed in the backend. Compressed values cannot be read by versions before v3.6.
+	ExperimentalValueCompressionPrefixes []string `json:"experimental-value-compression-prefixes"`
+	// ExperimentalKeyIndexCheckpoint enables persisting checkpoints of the key index in the
+	// backend, from which it is restored on restart instead of being rebuilt.
+	ExperimentalKeyIndexCheckpoint bool `json:"experimental-key-index-checkpoint"`
+	// ExperimentalKeyIndexMemoryBudget is the memory in bytes the keys of the key index may
+	// hold before the least recently used ones are paged out to disk. Zero keeps the whole
+	// key index in memory.
+	ExperimentalKeyIndexMemoryBudget int64 `json:"experimental-key-index-memory-budget"`
 
 	// ForceNewCluster starts a new cluster even if previously started; unsafe.
 	ForceNewCluster bool `json:"force-new-cluster"`
@@ -513,6 +592,8 @@ func NewConfig() *Config {
 		ExperimentalMemoryMlock:                  false,
 		ExperimentalTxnModeWriteWithSharedBuffer: true,
 		ExperimentalMaxLearners:                  membership.DefaultMaxLearners,
+		ExperimentalLeaderPriorityCheckTime:      DefaultLeaderPriorityCheckTime,
+		ExperimentalLeaderPriorityCooldown:       DefaultLeaderPriorityCooldown,
 
 		V2Deprecation: config.V2_DEPR_DEFAULT,
 
@@ -733,6 +814,17 @@ func (cfg *Config) Validate() error {
 	switch cfg.AutoCompactionMode {
 	case "":
 	case CompactorModeRevision, CompactorModePeriodic:
+	case CompactorModeSize:
+		if cfg.AutoCompactionMaxBytes <= 0 && cfg.AutoCompactionMaxRevisionsPerKey <= 0 {
+			return fmt.Errorf("auto-compaction-mode %q requires auto-compaction-max-bytes or auto-compaction-max-revisions-per-key", cfg.AutoCompactionMode)
+		}
+	case CompactorModeHybrid:
+		if cfg.AutoCompactionMaxBytes <= 0 {
+			return fmt.Errorf("auto-compaction-mode %q requires auto-compaction-max-bytes", cfg.AutoCompactionMode)
+		}
+		if r, err := parseCompactionRetention(cfg.AutoCompactionMode, cfg.AutoCompactionRetention); err != nil || r <= 0 {
+			return fmt.Errorf("auto-compaction-mode %q requires auto-compaction-retention, use %q without it", cfg.AutoCompactionMode, CompactorModeSize)
+		}
 	default:
 		return fmt.Errorf("unknown auto-compaction-mode %q", cfg.AutoCompactionMode)
 	}
@@ -752,6 +844,30 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("setting experimental-enable-lease-checkpoint-persist requires experimental-enable-lease-checkpoint")
 	}
 
+	if _, err := parsePrefixQuotas(cfg.ExperimentalPrefixQuotas); err != nil {
+		return err
+	}
+
+	if _, err := parsePeerCompression(cfg.ExperimentalPeerCompression); err != nil {
+		return err
+	}
+
+	switch cfg.ExperimentalSnapshotCompression {
+	case "", rafthttp.CompressionGzip:
+	default:
+		return fmt.Errorf("unsupported experimental-snapshot-compression %q", cfg.ExperimentalSnapshotCompression)
+	}
+
+	if cfg.ExperimentalEncryptionKeyFile != "" && cfg.ExperimentalEncryptionKMSSocket != "" {
+		return fmt.Errorf("cannot set both experimental-encryption-key-file and experimental-encryption-kms-socket")
+	}
+	if cfg.ExperimentalEncryptionKeyRotationInterval < 0 {
+		return fmt.Errorf("experimental-encryption-key-rotation-interval must be >= 0, got %v", cfg.ExperimentalEncryptionKeyRotationInterval)
+	}
+	if cfg.ExperimentalKeyIndexMemoryBudget < 0 {
+		return fmt.Errorf("experimental-key-index-memory-budget must be >= 0, got %d", cfg.ExperimentalKeyIndexMemoryBudget)
+	}
+
 	return nil
 }
 
@@ -181,6 +181,21 @@ func TestAutoCompactionModeInvalid(t *testing.T) {
 	}
 }
 
+func TestAutoCompactionModeHybridNoRetention(t *testing.T) {
+	cfg := NewConfig()
+	cfg.Logger = "zap"
+	cfg.LogOutputs = []string{"/dev/null"}
+	cfg.AutoCompactionMode = CompactorModeHybrid
+	cfg.AutoCompactionMaxBytes = 1024
+	if err := cfg.Validate(); err == nil {
+		t.Errorf("expected non-nil error, got %v", err)
+	}
+	cfg.AutoCompactionRetention = "1h"
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("expected nil error, got %v", err)
+	}
+}
+
 func TestAutoCompactionModeParse(t *testing.T) {
 	tests := []struct {
 		mode      string
@@ -162,6 +162,16 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 
 	backendFreelistType := parseBackendFreelistType(cfg.BackendFreelistType)
 
+	prefixQuotas, err := parsePrefixQuotas(cfg.ExperimentalPrefixQuotas)
+	if err != nil {
+		return e, err
+	}
+
+	peerCompression, err := parsePeerCompression(cfg.ExperimentalPeerCompression)
+	if err != nil {
+		return e, err
+	}
+
 	srvcfg := config.ServerConfig{
 		Name:                                     cfg.Name,
 		ClientURLs:                               cfg.ACUrls,
@@ -185,6 +195,8 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 		InitialElectionTickAdvance:               cfg.InitialElectionTickAdvance,
 		AutoCompactionRetention:                  autoCompactionRetention,
 		AutoCompactionMode:                       cfg.AutoCompactionMode,
+		AutoCompactionMaxBytes:                   cfg.AutoCompactionMaxBytes,
+		AutoCompactionMaxRevisionsPerKey:         cfg.AutoCompactionMaxRevisionsPerKey,
 		QuotaBackendBytes:                        cfg.QuotaBackendBytes,
 		BackendBatchLimit:                        cfg.BackendBatchLimit,
 		BackendFreelistType:                      backendFreelistType,
@@ -219,6 +231,21 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 		ExperimentalTxnModeWriteWithSharedBuffer: cfg.ExperimentalTxnModeWriteWithSharedBuffer,
 		ExperimentalBootstrapDefragThresholdMegabytes: cfg.ExperimentalBootstrapDefragThresholdMegabytes,
 		ExperimentalMaxLearners:                       cfg.ExperimentalMaxLearners,
+		ExperimentalPrefixQuotas:                      prefixQuotas,
+		LeaderPriorityCheckTime:                       cfg.ExperimentalLeaderPriorityCheckTime,
+		LeaderPriorityCooldown:                        cfg.ExperimentalLeaderPriorityCooldown,
+		QuiesceTimeout:                                cfg.ExperimentalQuiesceTimeout,
+		MaxInflightBytes:                              cfg.ExperimentalMaxInflightBytes,
+		SnapshotDelegation:                            cfg.ExperimentalSnapshotDelegation,
+		SnapshotCompression:                           cfg.ExperimentalSnapshotCompression,
+		SnapshotSendRateLimit:                         cfg.ExperimentalSnapshotSendRateLimit,
+		PeerCompression:                               peerCompression,
+		EncryptionKeyFile:                             cfg.ExperimentalEncryptionKeyFile,
+		EncryptionKMSSocket:                           cfg.ExperimentalEncryptionKMSSocket,
+		EncryptionKeyRotationInterval:                 cfg.ExperimentalEncryptionKeyRotationInterval,
+		ValueCompressionPrefixes:                      cfg.ExperimentalValueCompressionPrefixes,
+		KeyIndexCheckpoint:                            cfg.ExperimentalKeyIndexCheckpoint,
+		KeyIndexMemoryBudget:                          cfg.ExperimentalKeyIndexMemoryBudget,
 		V2Deprecation:                                 cfg.V2DeprecationEffective(),
 	}
 
@@ -249,8 +276,8 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 	e.errc = make(chan error, len(e.Peers)+len(e.Clients)+2*len(e.sctxs))
 
 	// newly started member ("memberInitialized==false")
-	// does not need corruption check
-	if memberInitialized && srvcfg.InitialCorruptCheck {
+	// and witnesses, which keep no key-value data, do not need corruption check
+	if memberInitialized && srvcfg.InitialCorruptCheck && !e.Server.IsWitness() {
 		if err = etcdserver.NewCorruptionMonitor(e.cfg.logger, e.Server).InitialCheck(); err != nil {
 			// set "EtcdServer" to nil, so that it does not block on "EtcdServer.Close()"
 			// (nothing to close since rafthttp transports have not been started)
@@ -343,6 +370,8 @@ func print(lg *zap.Logger, ec Config, sc config.ServerConfig, memberInitialized
 		zap.String("auto-compaction-mode", sc.AutoCompactionMode),
 		zap.Duration("auto-compaction-retention", sc.AutoCompactionRetention),
 		zap.String("auto-compaction-interval", sc.AutoCompactionRetention.String()),
+		zap.Int64("auto-compaction-max-bytes", sc.AutoCompactionMaxBytes),
+		zap.Int64("auto-compaction-max-revisions-per-key", sc.AutoCompactionMaxRevisionsPerKey),
 		zap.String("discovery-url", sc.DiscoveryURL),
 		zap.String("discovery-proxy", sc.DiscoveryProxy),
 
@@ -361,6 +390,20 @@ func print(lg *zap.Logger, ec Config, sc config.ServerConfig, memberInitialized
 
 		zap.String("downgrade-check-interval", sc.DowngradeCheckTime.String()),
 		zap.Int("max-learners", sc.ExperimentalMaxLearners),
+		zap.Int("prefix-quotas", len(sc.ExperimentalPrefixQuotas)),
+		zap.String("leader-priority-check-interval", sc.LeaderPriorityCheckTime.String()),
+		zap.String("leader-priority-cooldown", sc.LeaderPriorityCooldown.String()),
+		zap.String("quiesce-timeout", sc.QuiesceTimeout.String()),
+		zap.Uint64("max-inflight-bytes", sc.MaxInflightBytes),
+		zap.Bool("snapshot-delegation", sc.SnapshotDelegation),
+		zap.String("snapshot-compression", sc.SnapshotCompression),
+		zap.Uint64("snapshot-send-rate-limit", sc.SnapshotSendRateLimit),
+		zap.Int("peer-compression", len(sc.PeerCompression)),
+		zap.Bool("encryption-at-rest", sc.EncryptionKeyFile != "" || sc.EncryptionKMSSocket != ""),
+		zap.String("encryption-key-rotation-interval", sc.EncryptionKeyRotationInterval.String()),
+		zap.Strings("value-compression-prefixes", sc.ValueCompressionPrefixes),
+		zap.Bool("key-index-checkpoint", sc.KeyIndexCheckpoint),
+		zap.Int64("key-index-memory-budget", sc.KeyIndexMemoryBudget),
 	)
 }
 
@@ -788,13 +831,69 @@ func (e *Etcd) GetLogger() *zap.Logger {
 	return l
 }
 
+func parsePrefixQuotas(quotas []string) ([]config.PrefixQuota, error) {
+	var ret []config.PrefixQuota
+	for _, q := range quotas {
+		i := strings.LastIndex(q, "=")
+		if i <= 0 {
+			return nil, fmt.Errorf("invalid prefix quota %q (expected <prefix>=<max-bytes>:<max-keys>)", q)
+		}
+		limits := strings.Split(q[i+1:], ":")
+		if len(limits) != 2 {
+			return nil, fmt.Errorf("invalid prefix quota %q (expected <prefix>=<max-bytes>:<max-keys>)", q)
+		}
+		maxBytes, err := strconv.ParseInt(limits[0], 10, 64)
+		if err != nil || maxBytes < 0 {
+			return nil, fmt.Errorf("invalid max bytes in prefix quota %q", q)
+		}
+		maxKeys, err := strconv.ParseInt(limits[1], 10, 64)
+		if err != nil || maxKeys < 0 {
+			return nil, fmt.Errorf("invalid max keys in prefix quota %q", q)
+		}
+		for _, pq := range ret {
+			if pq.Prefix == q[:i] {
+				return nil, fmt.Errorf("duplicate prefix quota for %q", pq.Prefix)
+			}
+		}
+		ret = append(ret, config.PrefixQuota{Prefix: q[:i], MaxBytes: maxBytes, MaxKeys: maxKeys})
+	}
+	return ret, nil
+}
+
+func parsePeerCompression(compressions []string) ([]config.PeerCompression, error) {
+	var ret []config.PeerCompression
+	for _, c := range compressions {
+		pc := config.PeerCompression{Compression: c}
+		if i := strings.LastIndex(c, "="); i >= 0 {
+			if i == 0 {
+				return nil, fmt.Errorf("invalid peer compression %q (expected [<member-name>=]<compression>)", c)
+			}
+			pc = config.PeerCompression{Member: c[:i], Compression: c[i+1:]}
+		}
+		switch pc.Compression {
+		case "none":
+			pc.Compression = ""
+		case rafthttp.CompressionGzip:
+		default:
+			return nil, fmt.Errorf("unsupported compression in peer compression %q", c)
+		}
+		for _, p := range ret {
+			if p.Member == pc.Member {
+				return nil, fmt.Errorf("duplicate peer compression for member %q", pc.Member)
+			}
+		}
+		ret = append(ret, pc)
+	}
+	return ret, nil
+}
+
 func parseCompactionRetention(mode, retention string) (ret time.Duration, err error) {
 	h, err := strconv.Atoi(retention)
 	if err == nil && h >= 0 {
 		switch mode {
 		case CompactorModeRevision:
 			ret = time.Duration(int64(h))
-		case CompactorModePeriodic:
+		case CompactorModePeriodic, CompactorModeHybrid:
 			ret = time.Duration(int64(h)) * time.Hour
 		}
 	} else {
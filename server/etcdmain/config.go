@@ -231,7 +231,9 @@ func newConfig() *config {
 	fs.BoolVar(&cfg.printVersion, "version", false, "Print the version and exit.")
 
 	fs.StringVar(&cfg.ec.AutoCompactionRetention, "auto-compaction-retention", "0", "Auto compaction retention for mvcc key value store. 0 means disable auto compaction.")
-	fs.StringVar(&cfg.ec.AutoCompactionMode, "auto-compaction-mode", "periodic", "interpret 'auto-compaction-retention' one of: periodic|revision. 'periodic' for duration based retention, defaulting to hours if no time unit is provided (e.g. '5m'). 'revision' for revision number based retention.")
+	fs.StringVar(&cfg.ec.AutoCompactionMode, "auto-compaction-mode", "periodic", "interpret 'auto-compaction-retention' one of: periodic|revision|size|hybrid. 'periodic' for duration based retention, defaulting to hours if no time unit is provided (e.g. '5m'). 'revision' for revision number based retention. 'size' compacts to keep the history under 'auto-compaction-max-bytes' and 'auto-compaction-max-revisions-per-key', ignoring the retention. 'hybrid' does the same but keeps at least the 'periodic' retention.")
+	fs.Int64Var(&cfg.ec.AutoCompactionMaxBytes, "auto-compaction-max-bytes", 0, "Backend size in use the 'size' and 'hybrid' auto compaction modes keep the history under. 0 means no limit.")
+	fs.Int64Var(&cfg.ec.AutoCompactionMaxRevisionsPerKey, "auto-compaction-max-revisions-per-key", 0, "Average number of historical revisions per key the 'size' and 'hybrid' auto compaction modes keep the history under. 0 means no limit.")
 
 	// pprof profiler via HTTP
 	fs.BoolVar(&cfg.ec.EnablePprof, "enable-pprof", false, "Enable runtime profiling data via HTTP server. Address is at client URL + \"/debug/pprof/\"")
@@ -271,6 +273,21 @@ func newConfig() *config {
 	fs.BoolVar(&cfg.ec.ExperimentalTxnModeWriteWithSharedBuffer, "experimental-txn-mode-write-with-shared-buffer", true, "Enable the write transaction to use a shared buffer in its readonly check operations.")
 	fs.UintVar(&cfg.ec.ExperimentalBootstrapDefragThresholdMegabytes, "experimental-bootstrap-defrag-threshold-megabytes", 0, "Enable the defrag during etcd server bootstrap on condition that it will free at least the provided threshold of disk space. Needs to be set to non-zero value to take effect.")
 	fs.IntVar(&cfg.ec.ExperimentalMaxLearners, "experimental-max-learners", membership.DefaultMaxLearners, "Sets the maximum number of learners that can be available in the cluster membership.")
+	fs.Var(flags.NewStringsValue(""), "experimental-prefix-quotas", "Comma-separated list of '<prefix>=<max-bytes>:<max-keys>' storage quotas on key prefixes. A zero limit means no limit.")
+	fs.DurationVar(&cfg.ec.ExperimentalLeaderPriorityCheckTime, "experimental-leader-priority-check-time", cfg.ec.ExperimentalLeaderPriorityCheckTime, "Duration of time between two checks for a voting member with a higher leader priority. 0 disables leadership transfers for the leader priority.")
+	fs.DurationVar(&cfg.ec.ExperimentalLeaderPriorityCooldown, "experimental-leader-priority-cooldown", cfg.ec.ExperimentalLeaderPriorityCooldown, "Minimum duration of time between two leadership transfers for the leader priority.")
+	fs.DurationVar(&cfg.ec.ExperimentalQuiesceTimeout, "experimental-quiesce-timeout", 0, "Duration of time without proposals after which the leader stops heartbeating once all followers have caught up. 0 disables quiescence.")
+	fs.Uint64Var(&cfg.ec.ExperimentalMaxInflightBytes, "experimental-max-inflight-bytes", 0, "Maximum total entry size of the raft append messages sent to a follower but not yet acknowledged. Values below the 1MiB max size of a single message are raised to it. 0 means no limit.")
+	fs.BoolVar(&cfg.ec.ExperimentalSnapshotDelegation, "experimental-snapshot-delegation", false, "Enable the leader to delegate sending snapshots to lagging members to up-to-date followers.")
+	fs.StringVar(&cfg.ec.ExperimentalSnapshotCompression, "experimental-snapshot-compression", "", "Compression applied to snapshots sent to peers that support it. Only 'gzip' is supported. Empty means no compression.")
+	fs.Var(flags.NewStringsValue(""), "experimental-peer-compression", "Comma-separated list of '[<member-name>=]<compression>' compressions of the messages sent to peers that support it. An entry without a member name applies to all other members. Only 'gzip' and 'none' are supported.")
+	fs.Uint64Var(&cfg.ec.ExperimentalSnapshotSendRateLimit, "experimental-snapshot-send-rate-limit", 0, "Maximum rate, in bytes per second, at which snapshots are sent to peers. 0 means no limit.")
+	fs.StringVar(&cfg.ec.ExperimentalEncryptionKeyFile, "experimental-encryption-key-file", "", "Path to the file of key encryption keys, one '<id>:<base64 32 byte key>' per line with the current one first, enabling encryption of the backend and WAL at rest. Every member must have the same keys, since the snapshots sent between members carry data encryption keys wrapped with them.")
+	fs.StringVar(&cfg.ec.ExperimentalEncryptionKMSSocket, "experimental-encryption-kms-socket", "", "Path to the unix socket of the KMS plugin wrapping the data encryption keys, enabling encryption of the backend and WAL at rest. Every member must be able to unwrap the keys wrapped by any other member, since the snapshots sent between members carry them.")
+	fs.DurationVar(&cfg.ec.ExperimentalEncryptionKeyRotationInterval, "experimental-encryption-key-rotation-interval", 0, "Interval at which a new data encryption key is put in use and the existing ones are re-wrapped with the current key encryption key. 0 disables the rotation.")
+	fs.Var(flags.NewStringsValue(""), "experimental-value-compression-prefixes", "Comma-separated list of key prefixes whose values are stored compressed in the backend. Compressed values cannot be read by versions before v3.6.")
+	fs.BoolVar(&cfg.ec.ExperimentalKeyIndexCheckpoint, "experimental-key-index-checkpoint", false, "Enable persisting checkpoints of the key index on snapshot and shutdown, from which it is restored on restart instead of being rebuilt from the whole backend.")
+	fs.Int64Var(&cfg.ec.ExperimentalKeyIndexMemoryBudget, "experimental-key-index-memory-budget", 0, "Memory in bytes the keys of the key index may hold before the least recently used ones are paged out to disk. 0 keeps the whole key index in memory.")
 	fs.DurationVar(&cfg.ec.ExperimentalWaitClusterReadyTimeout, "experimental-wait-cluster-ready-timeout", cfg.ec.ExperimentalWaitClusterReadyTimeout, "Maximum duration to wait for the cluster to be ready.")
 
 	// unsafe
@@ -380,6 +397,10 @@ func (cfg *config) configFromCmdLine() error {
 
 	cfg.ec.CipherSuites = flags.StringsFromFlag(cfg.cf.flagSet, "cipher-suites")
 
+	cfg.ec.ExperimentalPrefixQuotas = flags.StringsFromFlag(cfg.cf.flagSet, "experimental-prefix-quotas")
+	cfg.ec.ExperimentalPeerCompression = flags.StringsFromFlag(cfg.cf.flagSet, "experimental-peer-compression")
+	cfg.ec.ExperimentalValueCompressionPrefixes = flags.StringsFromFlag(cfg.cf.flagSet, "experimental-value-compression-prefixes")
+
 	cfg.ec.LogOutputs = flags.UniqueStringsFromFlag(cfg.cf.flagSet, "log-outputs")
 
 	cfg.ec.ClusterState = cfg.cf.clusterState.String()
@@ -148,7 +148,11 @@ Clustering:
   --auto-compaction-retention '0'
     Auto compaction retention length. 0 means disable auto compaction.
   --auto-compaction-mode 'periodic'
-    Interpret 'auto-compaction-retention' one of: periodic|revision. 'periodic' for duration based retention, defaulting to hours if no time unit is provided (e.g. '5m'). 'revision' for revision number based retention.
+    Interpret 'auto-compaction-retention' one of: periodic|revision|size|hybrid. 'periodic' for duration based retention, defaulting to hours if no time unit is provided (e.g. '5m'). 'revision' for revision number based retention. 'size' compacts to keep the history under 'auto-compaction-max-bytes' and 'auto-compaction-max-revisions-per-key', ignoring the retention. 'hybrid' does the same but keeps at least the 'periodic' retention.
+  --auto-compaction-max-bytes 0
+    Backend size in use the 'size' and 'hybrid' auto compaction modes keep the history under. 0 means no limit.
+  --auto-compaction-max-revisions-per-key 0
+    Average number of historical revisions per key the 'size' and 'hybrid' auto compaction modes keep the history under. 0 means no limit.
   --v2-deprecation '` + string(cconfig.V2_DEPR_DEFAULT) + `'
     Phase of v2store deprecation. Allows to opt-in for higher compatibility mode.
     Supported values:
@@ -262,6 +266,36 @@ Experimental feature:
     Set time duration after which a warning is generated if a unary request takes more than this duration.
   --experimental-max-learners '1'
     Set the max number of learner members allowed in the cluster membership.
+  --experimental-prefix-quotas ''
+    Comma-separated list of '<prefix>=<max-bytes>:<max-keys>' storage quotas on key prefixes. A zero limit means no limit.
+  --experimental-leader-priority-check-time '5s'
+    Duration of time between two checks for a voting member with a higher leader priority. 0 disables leadership transfers for the leader priority.
+  --experimental-leader-priority-cooldown '1m'
+    Minimum duration of time between two leadership transfers for the leader priority. A member must also have been connected to the leader for that long.
+  --experimental-quiesce-timeout '0s'
+    Duration of time without proposals after which the leader stops heartbeating once all followers have caught up. 0 disables quiescence.
+  --experimental-max-inflight-bytes '0'
+    Maximum total entry size of the raft append messages sent to a follower but not yet acknowledged. Values below the 1MiB max size of a single message are raised to it. 0 means no limit.
+  --experimental-snapshot-delegation 'false'
+    Enable the leader to delegate sending snapshots to lagging members to up-to-date followers.
+  --experimental-snapshot-compression ''
+    Compression applied to snapshots sent to peers that support it. Only 'gzip' is supported. Empty means no compression.
+  --experimental-peer-compression ''
+    Comma-separated list of '[<member-name>=]<compression>' compressions of the messages sent to peers that support it. An entry without a member name applies to all other members. Only 'gzip' and 'none' are supported.
+  --experimental-snapshot-send-rate-limit '0'
+    Maximum rate, in bytes per second, at which snapshots are sent to peers. 0 means no limit.
+  --experimental-encryption-key-file ''
+    Path to the file of key encryption keys, one '<id>:<base64 32 byte key>' per line with the current one first, enabling encryption of the backend and WAL at rest. Every member must have the same keys, since the snapshots sent between members carry data encryption keys wrapped with them.
+  --experimental-encryption-kms-socket ''
+    Path to the unix socket of the KMS plugin wrapping the data encryption keys, enabling encryption of the backend and WAL at rest. Every member must be able to unwrap the keys wrapped by any other member, since the snapshots sent between members carry them.
+  --experimental-encryption-key-rotation-interval '0s'
+    Interval at which a new data encryption key is put in use and the existing ones are re-wrapped with the current key encryption key. 0 disables the rotation.
+  --experimental-value-compression-prefixes ''
+    Comma-separated list of key prefixes whose values are stored compressed in the backend. Compressed values cannot be read by versions before v3.6.
+  --experimental-key-index-checkpoint 'false'
+    Enable persisting checkpoints of the key index on snapshot and shutdown, from which it is restored on restart instead of being rebuilt from the whole backend.
+  --experimental-key-index-memory-budget '0'
+    Memory in bytes the keys of the key index may hold before the least recently used ones are paged out to disk. 0 keeps the whole key index in memory.
   --experimental-wait-cluster-ready-timeout '5s'
     Set the maximum time duration to wait for the cluster to be ready.
 
@@ -71,6 +71,7 @@ func newPeerHandler(
 	}
 	if hashKVHandler != nil {
 		mux.Handle(etcdserver.PeerHashKVPath, hashKVHandler)
+		mux.Handle(etcdserver.PeerHashKVRangesPath, hashKVHandler)
 	}
 	mux.HandleFunc(versionPath, versionHandler(s, serveVersion))
 	return mux
@@ -73,6 +73,12 @@ func (s *fakeServer) UpdateMember(ctx context.Context, updateMemb membership.Mem
 func (s *fakeServer) PromoteMember(ctx context.Context, id uint64) ([]*membership.Member, error) {
 	return nil, fmt.Errorf("PromoteMember not implemented in fakeServer")
 }
+func (s *fakeServer) SetMemberLeaderPriority(ctx context.Context, id uint64, priority uint64) ([]*membership.Member, error) {
+	return nil, fmt.Errorf("SetMemberLeaderPriority not implemented in fakeServer")
+}
+func (s *fakeServer) ReconfigureMembers(ctx context.Context, add []membership.Member, remove []uint64, promote []uint64) ([]*membership.Member, error) {
+	return nil, fmt.Errorf("ReconfigureMembers not implemented in fakeServer")
+}
 func (s *fakeServer) ClusterVersion() *semver.Version      { return nil }
 func (s *fakeServer) StorageVersion() *semver.Version      { return nil }
 func (s *fakeServer) Cluster() api.Cluster                 { return s.cluster }
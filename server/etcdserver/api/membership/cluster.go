@@ -71,6 +71,19 @@ type ConfigChangeContext struct {
 	IsPromote bool `json:"isPromote"`
 }
 
+// ReconfigureContext represents a context for a joint consensus confChangeV2
+// that adds, removes and promotes several members at once.
+type ReconfigureContext struct {
+	// ID identifies the reconfiguration request the confChangeV2 was proposed for.
+	ID uint64 `json:"id"`
+	// Add is the list of members to add. Learners are added as learners.
+	Add []Member `json:"add,omitempty"`
+	// Remove is the list of IDs of the members to remove.
+	Remove []types.ID `json:"remove,omitempty"`
+	// Promote is the list of IDs of the learner members to promote.
+	Promote []types.ID `json:"promote,omitempty"`
+}
+
 type ShouldApplyV3 bool
 
 const (
@@ -312,7 +325,7 @@ func (c *RaftCluster) ValidateConfigurationChange(cc raftpb.ConfChange) error {
 		return ErrIDRemoved
 	}
 	switch cc.Type {
-	case raftpb.ConfChangeAddNode, raftpb.ConfChangeAddLearnerNode:
+	case raftpb.ConfChangeAddNode, raftpb.ConfChangeAddLearnerNode, raftpb.ConfChangeAddWitnessNode:
 		confChangeContext := new(ConfigChangeContext)
 		if err := json.Unmarshal(cc.Context, confChangeContext); err != nil {
 			c.lg.Panic("failed to unmarshal confChangeContext", zap.Error(err))
@@ -329,6 +342,12 @@ func (c *RaftCluster) ValidateConfigurationChange(cc raftpb.ConfChange) error {
 			if membersMap[id] != nil {
 				return ErrIDExists
 			}
+			if confChangeContext.Member.IsWitness && confChangeContext.Member.IsLearner {
+				return ErrWitnessLearner
+			}
+			if confChangeContext.Member.AutoPromote && !confChangeContext.Member.IsLearner {
+				return ErrMemberNotLearner
+			}
 
 			var members []*Member
 			urls := make(map[string]bool)
@@ -355,6 +374,22 @@ func (c *RaftCluster) ValidateConfigurationChange(cc raftpb.ConfChange) error {
 		if membersMap[id] == nil {
 			return ErrIDNotFound
 		}
+		// witnesses cannot lead, so keep a voting member that can
+		if m := membersMap[id]; !m.IsLearner && !m.IsWitness {
+			hasWitness, hasVoter := false, false
+			for _, mm := range membersMap {
+				switch {
+				case mm.ID == id || mm.IsLearner:
+				case mm.IsWitness:
+					hasWitness = true
+				default:
+					hasVoter = true
+				}
+			}
+			if hasWitness && !hasVoter {
+				return ErrBadReconfigure
+			}
+		}
 
 	case raftpb.ConfChangeUpdateNode:
 		if membersMap[id] == nil {
@@ -369,10 +404,13 @@ func (c *RaftCluster) ValidateConfigurationChange(cc raftpb.ConfChange) error {
 				urls[u] = true
 			}
 		}
-		m := new(Member)
+		m := new(RaftAttributesUpdate)
 		if err := json.Unmarshal(cc.Context, m); err != nil {
 			c.lg.Panic("failed to unmarshal member", zap.Error(err))
 		}
+		if m.LeaderPriorityOnly {
+			break
+		}
 		for _, u := range m.PeerURLs {
 			if urls[u] {
 				return ErrPeerURLexists
@@ -385,6 +423,100 @@ func (c *RaftCluster) ValidateConfigurationChange(cc raftpb.ConfChange) error {
 	return nil
 }
 
+// ValidateReconfiguration takes a proposed ReconfigureContext and ensures that
+// all of its member changes are valid on their own and together.
+func (c *RaftCluster) ValidateReconfiguration(rc *ReconfigureContext) error {
+	if len(rc.Add)+len(rc.Remove)+len(rc.Promote) == 0 {
+		return ErrBadReconfigure
+	}
+	membersMap, removedMap := membersFromStore(c.lg, c.v2store)
+
+	seen := make(map[types.ID]bool)
+	checkID := func(id types.ID) error {
+		if removedMap[id] {
+			return ErrIDRemoved
+		}
+		// a member can only be changed once per reconfiguration
+		if seen[id] {
+			return ErrBadReconfigure
+		}
+		seen[id] = true
+		return nil
+	}
+
+	voters, learners, addsLearner := 0, 0, false
+	urls := make(map[string]bool)
+	// witnesses are not counted as voters since they cannot lead
+	for _, m := range membersMap {
+		if m.IsLearner {
+			learners++
+		} else if !m.IsWitness {
+			voters++
+		}
+		for _, u := range m.PeerURLs {
+			urls[u] = true
+		}
+	}
+	for _, m := range rc.Add {
+		if err := checkID(m.ID); err != nil {
+			return err
+		}
+		if membersMap[m.ID] != nil {
+			return ErrIDExists
+		}
+		if m.IsWitness && m.IsLearner {
+			return ErrWitnessLearner
+		}
+		for _, u := range m.PeerURLs {
+			if urls[u] {
+				return ErrPeerURLexists
+			}
+			urls[u] = true
+		}
+		if m.IsLearner {
+			learners++
+			addsLearner = true
+		} else if !m.IsWitness {
+			voters++
+		}
+	}
+	for _, id := range rc.Promote {
+		if err := checkID(id); err != nil {
+			return err
+		}
+		if membersMap[id] == nil {
+			return ErrIDNotFound
+		}
+		if !membersMap[id].IsLearner {
+			return ErrMemberNotLearner
+		}
+		learners--
+		voters++
+	}
+	for _, id := range rc.Remove {
+		if err := checkID(id); err != nil {
+			return err
+		}
+		if membersMap[id] == nil {
+			return ErrIDNotFound
+		}
+		if membersMap[id].IsLearner {
+			learners--
+		} else if !membersMap[id].IsWitness {
+			voters--
+		}
+	}
+
+	if addsLearner && learners > c.maxLearners {
+		return ErrTooManyLearners
+	}
+	// raft cannot leave the joint configuration without a voter that can lead
+	if voters < 1 {
+		return ErrBadReconfigure
+	}
+	return nil
+}
+
 // AddMember adds a new Member into the cluster, and saves the given member's
 // raftAttributes into the store. The given member should have empty attributes.
 // A Member with a matching id must not exist.
@@ -408,6 +540,7 @@ func (c *RaftCluster) AddMember(m *Member, shouldApplyV3 ShouldApplyV3) {
 		zap.String("added-peer-id", m.ID.String()),
 		zap.Strings("added-peer-peer-urls", m.PeerURLs),
 		zap.Bool("added-peer-is-learner", m.IsLearner),
+		zap.Bool("added-peer-is-witness", m.IsWitness),
 	)
 }
 
@@ -486,6 +619,7 @@ func (c *RaftCluster) PromoteMember(id types.ID, shouldApplyV3 ShouldApplyV3) {
 	defer c.Unlock()
 
 	c.members[id].RaftAttributes.IsLearner = false
+	c.members[id].RaftAttributes.AutoPromote = false
 	c.updateMembershipMetric(id, true)
 	if c.v2store != nil {
 		mustUpdateMemberInStore(c.lg, c.v2store, c.members[id])
@@ -501,11 +635,20 @@ func (c *RaftCluster) PromoteMember(id types.ID, shouldApplyV3 ShouldApplyV3) {
 	)
 }
 
-func (c *RaftCluster) UpdateRaftAttributes(id types.ID, raftAttr RaftAttributes, shouldApplyV3 ShouldApplyV3) {
+// UpdateRaftAttributes applies the given update to the raft attributes of the
+// member. Whether the member is a learner, a witness or auto-promoted is kept,
+// as it only changes through its own configuration changes.
+func (c *RaftCluster) UpdateRaftAttributes(u RaftAttributesUpdate, shouldApplyV3 ShouldApplyV3) {
 	c.Lock()
 	defer c.Unlock()
 
-	c.members[id].RaftAttributes = raftAttr
+	id := u.ID
+	if u.LeaderPriorityOnly {
+		c.members[id].LeaderPriority = u.LeaderPriority
+	} else {
+		c.members[id].PeerURLs = u.PeerURLs
+	}
+	raftAttr := c.members[id].RaftAttributes
 	if c.v2store != nil {
 		mustUpdateMemberInStore(c.lg, c.v2store, c.members[id])
 	}
@@ -520,6 +663,7 @@ func (c *RaftCluster) UpdateRaftAttributes(id types.ID, raftAttr RaftAttributes,
 		zap.String("updated-remote-peer-id", id.String()),
 		zap.Strings("updated-remote-peer-urls", raftAttr.PeerURLs),
 		zap.Bool("updated-remote-peer-is-learner", raftAttr.IsLearner),
+		zap.Uint64("updated-remote-peer-leader-priority", raftAttr.LeaderPriority),
 	)
 }
 
@@ -715,6 +859,8 @@ func ValidateClusterAndAssignIDs(lg *zap.Logger, local *RaftCluster, existing *R
 		for j := range lms {
 			if ok, err = netutil.URLStringsEqual(ctx, lg, ems[i].PeerURLs, lms[j].PeerURLs); ok {
 				lms[j].ID = ems[i].ID
+				// a joining witness must skip key-value entries from the start
+				lms[j].IsWitness = ems[i].IsWitness
 				break
 			}
 		}
@@ -745,6 +891,21 @@ func (c *RaftCluster) IsLocalMemberLearner() bool {
 	return localMember.IsLearner
 }
 
+// IsLocalMemberWitness returns if the local member is a witness
+func (c *RaftCluster) IsLocalMemberWitness() bool {
+	c.Lock()
+	defer c.Unlock()
+	localMember, ok := c.members[c.localID]
+	if !ok {
+		c.lg.Panic(
+			"failed to find local ID in cluster members",
+			zap.String("cluster-id", c.cid.String()),
+			zap.String("local-member-id", c.localID.String()),
+		)
+	}
+	return localMember.IsWitness
+}
+
 // DowngradeInfo returns the downgrade status of the cluster
 func (c *RaftCluster) DowngradeInfo() *serverversion.DowngradeInfo {
 	c.Lock()
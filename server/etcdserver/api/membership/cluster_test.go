@@ -339,6 +339,12 @@ func TestClusterValidateConfigurationChange(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+
+	attr = RaftAttributes{PeerURLs: []string{fmt.Sprintf("http://127.0.0.1:%d", 9)}, AutoPromote: true}
+	ctx9, err := json.Marshal(&ConfigChangeContext{Member: Member{ID: types.ID(9), RaftAttributes: attr}})
+	if err != nil {
+		t.Fatal(err)
+	}
 	tests := []struct {
 		cc   raftpb.ConfChange
 		werr error
@@ -452,6 +458,96 @@ func TestClusterValidateConfigurationChange(t *testing.T) {
 			},
 			nil,
 		},
+		// only a learner member can be auto-promoted
+		{
+			raftpb.ConfChange{
+				Type:    raftpb.ConfChangeAddNode,
+				NodeID:  9,
+				Context: ctx9,
+			},
+			ErrMemberNotLearner,
+		},
+	}
+	for i, tt := range tests {
+		err := cl.ValidateConfigurationChange(tt.cc)
+		if err != tt.werr {
+			t.Errorf("#%d: validateConfigurationChange error = %v, want %v", i, err, tt.werr)
+		}
+	}
+}
+
+func TestClusterValidateReconfiguration(t *testing.T) {
+	cl := NewCluster(zaptest.NewLogger(t), WithMaxLearners(1))
+	cl.SetStore(v2store.New())
+	for i := 1; i <= 4; i++ {
+		attr := RaftAttributes{PeerURLs: []string{fmt.Sprintf("http://127.0.0.1:%d", i)}, IsLearner: i == 1}
+		cl.AddMember(&Member{ID: types.ID(i), RaftAttributes: attr}, true)
+	}
+	cl.RemoveMember(4, true)
+
+	newMember := func(id int, port int, isLearner bool) Member {
+		attr := RaftAttributes{PeerURLs: []string{fmt.Sprintf("http://127.0.0.1:%d", port)}, IsLearner: isLearner}
+		return Member{ID: types.ID(id), RaftAttributes: attr}
+	}
+
+	tests := []struct {
+		rc   ReconfigureContext
+		werr error
+	}{
+		{ReconfigureContext{}, ErrBadReconfigure},
+		// replace a voting member
+		{ReconfigureContext{Add: []Member{newMember(5, 5, false)}, Remove: []types.ID{3}}, nil},
+		// promote the learner and move the voting members to new hosts
+		{ReconfigureContext{Add: []Member{newMember(5, 5, false), newMember(6, 6, false)}, Remove: []types.ID{2, 3}, Promote: []types.ID{1}}, nil},
+		{ReconfigureContext{Add: []Member{newMember(4, 5, false)}}, ErrIDRemoved},
+		{ReconfigureContext{Remove: []types.ID{4}}, ErrIDRemoved},
+		{ReconfigureContext{Add: []Member{newMember(2, 5, false)}}, ErrIDExists},
+		{ReconfigureContext{Add: []Member{newMember(5, 3, false)}}, ErrPeerURLexists},
+		{ReconfigureContext{Add: []Member{newMember(5, 5, false), newMember(6, 5, false)}}, ErrPeerURLexists},
+		{ReconfigureContext{Add: []Member{newMember(5, 5, true)}}, ErrTooManyLearners},
+		{ReconfigureContext{Add: []Member{newMember(5, 5, true)}, Remove: []types.ID{1}}, nil},
+		{ReconfigureContext{Remove: []types.ID{5}}, ErrIDNotFound},
+		{ReconfigureContext{Promote: []types.ID{5}}, ErrIDNotFound},
+		{ReconfigureContext{Promote: []types.ID{2}}, ErrMemberNotLearner},
+		{ReconfigureContext{Remove: []types.ID{1}, Promote: []types.ID{1}}, ErrBadReconfigure},
+		// no voting member would be left
+		{ReconfigureContext{Remove: []types.ID{2, 3}}, ErrBadReconfigure},
+	}
+	for i, tt := range tests {
+		err := cl.ValidateReconfiguration(&tt.rc)
+		if err != tt.werr {
+			t.Errorf("#%d: ValidateReconfiguration error = %v, want %v", i, err, tt.werr)
+		}
+	}
+}
+
+func TestClusterValidateConfigurationChangeWitness(t *testing.T) {
+	cl := NewCluster(zaptest.NewLogger(t))
+	cl.SetStore(v2store.New())
+	for i := 1; i <= 3; i++ {
+		attr := RaftAttributes{PeerURLs: []string{fmt.Sprintf("http://127.0.0.1:%d", i)}, IsLearner: i == 3, IsWitness: i == 2}
+		cl.AddMember(&Member{ID: types.ID(i), RaftAttributes: attr}, true)
+	}
+
+	newContext := func(id int, isLearner, isWitness bool) []byte {
+		attr := RaftAttributes{PeerURLs: []string{fmt.Sprintf("http://127.0.0.1:%d", id)}, IsLearner: isLearner, IsWitness: isWitness}
+		ctx, err := json.Marshal(&ConfigChangeContext{Member: Member{ID: types.ID(id), RaftAttributes: attr}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ctx
+	}
+
+	tests := []struct {
+		cc   raftpb.ConfChange
+		werr error
+	}{
+		{raftpb.ConfChange{Type: raftpb.ConfChangeAddWitnessNode, NodeID: 4, Context: newContext(4, false, true)}, nil},
+		{raftpb.ConfChange{Type: raftpb.ConfChangeAddWitnessNode, NodeID: 4, Context: newContext(4, true, true)}, ErrWitnessLearner},
+		// the witness would be left as the only voting member
+		{raftpb.ConfChange{Type: raftpb.ConfChangeRemoveNode, NodeID: 1}, ErrBadReconfigure},
+		{raftpb.ConfChange{Type: raftpb.ConfChangeRemoveNode, NodeID: 2}, nil},
+		{raftpb.ConfChange{Type: raftpb.ConfChangeRemoveNode, NodeID: 3}, nil},
 	}
 	for i, tt := range tests {
 		err := cl.ValidateConfigurationChange(tt.cc)
@@ -459,6 +555,26 @@ func TestClusterValidateConfigurationChange(t *testing.T) {
 			t.Errorf("#%d: validateConfigurationChange error = %v, want %v", i, err, tt.werr)
 		}
 	}
+
+	witness := func(id int, isLearner bool) Member {
+		attr := RaftAttributes{PeerURLs: []string{fmt.Sprintf("http://127.0.0.1:%d", id)}, IsLearner: isLearner, IsWitness: true}
+		return Member{ID: types.ID(id), RaftAttributes: attr}
+	}
+	rtests := []struct {
+		rc   ReconfigureContext
+		werr error
+	}{
+		{ReconfigureContext{Add: []Member{witness(4, false)}, Remove: []types.ID{2}}, nil},
+		{ReconfigureContext{Add: []Member{witness(4, true)}}, ErrWitnessLearner},
+		// witnesses cannot lead, so they do not count as voting members
+		{ReconfigureContext{Add: []Member{witness(4, false)}, Remove: []types.ID{1}}, ErrBadReconfigure},
+	}
+	for i, tt := range rtests {
+		err := cl.ValidateReconfiguration(&tt.rc)
+		if err != tt.werr {
+			t.Errorf("#%d: ValidateReconfiguration error = %v, want %v", i, err, tt.werr)
+		}
+	}
 }
 
 func TestClusterGenID(t *testing.T) {
@@ -630,6 +746,41 @@ func TestClusterUpdateAttributes(t *testing.T) {
 	}
 }
 
+func TestClusterUpdateRaftAttributes(t *testing.T) {
+	learner := func() *Member {
+		return &Member{ID: 1, RaftAttributes: RaftAttributes{
+			PeerURLs:       []string{"http://127.0.0.1:2380"},
+			IsLearner:      true,
+			AutoPromote:    true,
+			LeaderPriority: 1,
+		}}
+	}
+	urls := []string{"http://127.0.0.1:2381"}
+	tests := []struct {
+		u     RaftAttributesUpdate
+		wattr RaftAttributes
+	}{
+		// updating the peer URLs keeps the other attributes
+		{
+			RaftAttributesUpdate{Member: Member{ID: 1, RaftAttributes: RaftAttributes{PeerURLs: urls}}},
+			RaftAttributes{PeerURLs: urls, IsLearner: true, AutoPromote: true, LeaderPriority: 1},
+		},
+		// updating the leader priority keeps the other attributes, even if the
+		// update carries stale peer URLs
+		{
+			RaftAttributesUpdate{Member: Member{ID: 1, RaftAttributes: RaftAttributes{PeerURLs: urls, LeaderPriority: 2}}, LeaderPriorityOnly: true},
+			RaftAttributes{PeerURLs: []string{"http://127.0.0.1:2380"}, IsLearner: true, AutoPromote: true, LeaderPriority: 2},
+		},
+	}
+	for i, tt := range tests {
+		c := newTestCluster(t, []*Member{learner()})
+		c.UpdateRaftAttributes(tt.u, true)
+		if g := c.Member(1).RaftAttributes; !reflect.DeepEqual(g, tt.wattr) {
+			t.Errorf("#%d: raft attributes = %+v, want %+v", i, g, tt.wattr)
+		}
+	}
+}
+
 func TestNodeToMember(t *testing.T) {
 	n := &v2store.NodeExtern{Key: "/1234", Nodes: []*v2store.NodeExtern{
 		{Key: "/1234/attributes", Value: stringp(`{"name":"node1","clientURLs":null}`)},
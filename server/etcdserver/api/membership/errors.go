@@ -27,6 +27,8 @@ var (
 	ErrPeerURLexists    = errors.New("membership: peerURL exists")
 	ErrMemberNotLearner = errors.New("membership: can only promote a learner member")
 	ErrTooManyLearners  = errors.New("membership: too many learner members in cluster")
+	ErrBadReconfigure   = errors.New("membership: bad member reconfiguration")
+	ErrWitnessLearner   = errors.New("membership: a witness member cannot be a learner")
 )
 
 func isKeyNotFound(err error) bool {
@@ -33,6 +33,27 @@ type RaftAttributes struct {
 	PeerURLs []string `json:"peerURLs"`
 	// IsLearner indicates if the member is raft learner.
 	IsLearner bool `json:"isLearner,omitempty"`
+	// LeaderPriority is the priority of the member to become the raft leader.
+	// The leader hands leadership over to the voting member with the highest priority.
+	LeaderPriority uint64 `json:"leaderPriority,omitempty"`
+	// IsWitness indicates if the member is a witness. A witness votes in raft
+	// but never becomes leader and stores no key-value data.
+	IsWitness bool `json:"isWitness,omitempty"`
+	// AutoPromote indicates if the learner member is promoted by the leader
+	// once it has caught up with the leader.
+	AutoPromote bool `json:"autoPromote,omitempty"`
+}
+
+// RaftAttributesUpdate is the context of a ConfChangeUpdateNode. It carries
+// the whole member, which members before v3.6 take as the new raft attributes
+// of the member, but later members only update either the peer URLs or the
+// leader priority of the member as it is when the change is applied, so that
+// concurrent updates do not undo each other.
+type RaftAttributesUpdate struct {
+	Member
+	// LeaderPriorityOnly is set if the update only changes the leader priority,
+	// and unset if it only changes the peer URLs.
+	LeaderPriorityOnly bool `json:"leaderPriorityOnly,omitempty"`
 }
 
 // Attributes represents all the non-raft related attributes of an etcd member.
@@ -61,6 +82,15 @@ func NewMemberAsLearner(name string, peerURLs types.URLs, clusterName string, no
 	return newMember(name, peerURLs, memberId, true)
 }
 
+// NewMemberAsWitness creates a witness Member without an ID and generates one based on the
+// cluster name, peer URLs, and time. This is used for adding new witness member.
+func NewMemberAsWitness(name string, peerURLs types.URLs, clusterName string, now *time.Time) *Member {
+	memberId := computeMemberId(peerURLs, clusterName, now)
+	m := newMember(name, peerURLs, memberId, false)
+	m.IsWitness = true
+	return m
+}
+
 func computeMemberId(peerURLs types.URLs, clusterName string, now *time.Time) types.ID {
 	peerURLstrs := peerURLs.StringSlice()
 	sort.Strings(peerURLstrs)
@@ -104,7 +134,10 @@ func (m *Member) Clone() *Member {
 	mm := &Member{
 		ID: m.ID,
 		RaftAttributes: RaftAttributes{
-			IsLearner: m.IsLearner,
+			IsLearner:      m.IsLearner,
+			LeaderPriority: m.LeaderPriority,
+			IsWitness:      m.IsWitness,
+			AutoPromote:    m.AutoPromote,
 		},
 		Attributes: Attributes{
 			Name: m.Name,
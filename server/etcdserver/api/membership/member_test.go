@@ -94,6 +94,9 @@ func TestMemberClone(t *testing.T) {
 		newTestMember(1, []string{"http://a"}, "abc", nil),
 		newTestMember(1, nil, "abc", []string{"http://b"}),
 		newTestMember(1, []string{"http://a"}, "abc", []string{"http://b"}),
+		{ID: 1, RaftAttributes: RaftAttributes{PeerURLs: []string{"http://a"}, IsLearner: true, LeaderPriority: 3}},
+		{ID: 1, RaftAttributes: RaftAttributes{PeerURLs: []string{"http://a"}, IsWitness: true}},
+		{ID: 1, RaftAttributes: RaftAttributes{PeerURLs: []string{"http://a"}, IsLearner: true, AutoPromote: true}},
 	}
 	for i, tt := range tests {
 		nm := tt.Clone()
@@ -0,0 +1,172 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rafthttp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"go.etcd.io/etcd/client/pkg/v3/types"
+)
+
+// CompressionGzip compresses the data sent to a peer with gzip.
+const CompressionGzip = "gzip"
+
+var (
+	// supportedCompressions lists the compressions the local member can
+	// decode, in order of preference.
+	supportedCompressions = []string{CompressionGzip}
+
+	// compressionTotals holds the number of bytes sent to each peer before
+	// and after compression, from which peerCompressionRatio is computed.
+	compressionTotals = struct {
+		sync.Mutex
+		in, out map[string]float64
+	}{in: make(map[string]float64), out: make(map[string]float64)}
+)
+
+// checkCompression returns an error if the given compression is not
+// supported.
+func checkCompression(c string) error {
+	if c == "" {
+		return nil
+	}
+	for _, sc := range supportedCompressions {
+		if c == sc {
+			return nil
+		}
+	}
+	return fmt.Errorf("unsupported compression %q", c)
+}
+
+// setAcceptCompressionHeader advertises the compressions the local member
+// can decode.
+func setAcceptCompressionHeader(h http.Header) {
+	h.Set("X-Etcd-Accept-Compression", strings.Join(supportedCompressions, ","))
+}
+
+// acceptsCompression reports whether the given comma-separated list of
+// compressions, as advertised by a peer, contains c.
+func acceptsCompression(accepted string, c string) bool {
+	for _, a := range strings.Split(accepted, ",") {
+		if strings.TrimSpace(a) == c {
+			return true
+		}
+	}
+	return false
+}
+
+// observeCompression records that in bytes were compressed to out bytes
+// before being sent to the given peer.
+func observeCompression(to string, in, out int) {
+	compressionTotals.Lock()
+	defer compressionTotals.Unlock()
+	compressionTotals.in[to] += float64(in)
+	compressionTotals.out[to] += float64(out)
+	if o := compressionTotals.out[to]; o > 0 {
+		peerCompressionRatio.WithLabelValues(to).Set(compressionTotals.in[to] / o)
+	}
+}
+
+// compress returns the data compressed with the given compression.
+func compress(c string, data []byte) ([]byte, error) {
+	if c != CompressionGzip {
+		return nil, fmt.Errorf("unsupported compression %q", c)
+	}
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// decompressReader returns a reader of the data read from r decompressed
+// with the given compression.
+func decompressReader(c string, r io.Reader) (io.ReadCloser, error) {
+	switch c {
+	case "":
+		return io.NopCloser(r), nil
+	case CompressionGzip:
+		return gzip.NewReader(r)
+	default:
+		return nil, fmt.Errorf("unsupported compression %q", c)
+	}
+}
+
+// countingWriter counts the bytes written to the underlying writer.
+type countingWriter struct {
+	w io.Writer
+	n int
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.n += n
+	return n, err
+}
+
+// compressedWriter compresses a stream of messages. Each flush sends all
+// messages written so far to the peer, so that the peer can decode them
+// without waiting for more data.
+type compressedWriter struct {
+	zw *gzip.Writer
+	cw *countingWriter
+	f  http.Flusher
+
+	to string
+	in int
+}
+
+func newCompressedWriter(w io.Writer, f http.Flusher, to types.ID) *compressedWriter {
+	cw := &countingWriter{w: w}
+	return &compressedWriter{zw: gzip.NewWriter(cw), cw: cw, f: f, to: to.String()}
+}
+
+func (w *compressedWriter) Write(p []byte) (int, error) {
+	n, err := w.zw.Write(p)
+	w.in += n
+	return n, err
+}
+
+func (w *compressedWriter) Flush() {
+	// a failed flush surfaces as an error of the next write to the
+	// underlying connection.
+	w.zw.Flush()
+	w.f.Flush()
+	observeCompression(w.to, w.in, w.cw.n)
+	w.in, w.cw.n = 0, 0
+}
+
+// compressOutgoingConn compresses the messages written to the given
+// connection with the given compression.
+func compressOutgoingConn(conn *outgoingConn, c string) {
+	if c != CompressionGzip {
+		return
+	}
+	cw := newCompressedWriter(conn.Writer, conn.Flusher, conn.peerID)
+	// send the compression header right away, as the remote stream reader
+	// reads it before decoding any message.
+	cw.Flush()
+	conn.Writer, conn.Flusher = cw, cw
+}
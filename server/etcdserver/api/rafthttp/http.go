@@ -53,6 +53,8 @@ var (
 	RaftStreamPrefix   = path.Join(RaftPrefix, "stream")
 	RaftSnapshotPrefix = path.Join(RaftPrefix, "snapshot")
 
+	RaftSnapshotDelegatePrefix = path.Join(RaftSnapshotPrefix, "delegate")
+
 	errIncompatibleVersion = errors.New("incompatible version")
 	errClusterIDMismatch   = errors.New("cluster ID mismatch")
 )
@@ -100,6 +102,7 @@ func (h *pipelineHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	w.Header().Set("X-Etcd-Cluster-ID", h.cid.String())
+	setAcceptCompressionHeader(w.Header())
 
 	if err := checkClusterCompatibilityFromHeader(h.lg, h.localID, r.Header, h.cid); err != nil {
 		http.Error(w, err.Error(), http.StatusPreconditionFailed)
@@ -108,9 +111,22 @@ func (h *pipelineHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	addRemoteFromRequest(h.tr, r)
 
+	body, err := decompressReader(r.Header.Get("Content-Encoding"), r.Body)
+	if err != nil {
+		h.lg.Warn(
+			"failed to read Raft message",
+			zap.String("local-member-id", h.localID.String()),
+			zap.Error(err),
+		)
+		http.Error(w, "error reading raft message", http.StatusBadRequest)
+		recvFailures.WithLabelValues(r.RemoteAddr).Inc()
+		return
+	}
+	defer body.Close()
+
 	// Limit the data size that could be read from the request body, which ensures that read from
 	// connection will not time out accidentally due to possible blocking in underlying implementation.
-	limitedr := pioutil.NewLimitedBufferReader(r.Body, connReadLimitByte)
+	limitedr := pioutil.NewLimitedBufferReader(body, connReadLimitByte)
 	b, err := io.ReadAll(limitedr)
 	if err != nil {
 		h.lg.Warn(
@@ -168,6 +184,9 @@ type snapshotHandler struct {
 
 	localID types.ID
 	cid     types.ID
+
+	// saved, if set, is called with the index of every snapshot saved.
+	saved func(index uint64)
 }
 
 func newSnapshotHandler(t *Transport, r Raft, snapshotter *snap.Snapshotter, cid types.ID) http.Handler {
@@ -216,7 +235,13 @@ func (h *snapshotHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	addRemoteFromRequest(h.tr, r)
 
-	dec := &messageDecoder{r: r.Body}
+	h.receive(w, r.RemoteAddr, r.Body, r.Header.Get("X-Etcd-Snapshot-Checksum"), start)
+}
+
+// receive saves the database snapshot read from the given body, which starts
+// with the encoded snapshot message, and passes the message to raft.
+func (h *snapshotHandler) receive(w http.ResponseWriter, remoteAddr string, body io.Reader, checksum string, start time.Time) {
+	dec := &messageDecoder{r: body}
 	// let snapshots be very large since they can exceed 512MB for large installations
 	m, err := dec.decodeLimit(snapshotLimitByte)
 	from := types.ID(m.From).String()
@@ -229,7 +254,7 @@ func (h *snapshotHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			zap.Error(err),
 		)
 		http.Error(w, msg, http.StatusBadRequest)
-		recvFailures.WithLabelValues(r.RemoteAddr).Inc()
+		recvFailures.WithLabelValues(remoteAddr).Inc()
 		snapshotReceiveFailures.WithLabelValues(from).Inc()
 		return
 	}
@@ -265,7 +290,23 @@ func (h *snapshotHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	// save incoming database snapshot.
 
-	n, err := h.snapshotter.SaveDBFrom(r.Body, m.Snapshot.Metadata.Index)
+	switch checksum {
+	case "":
+	case snapshotChecksumSHA256:
+		body = newChecksumVerifier(body)
+	default:
+		h.lg.Warn(
+			"unsupported database snapshot checksum",
+			zap.String("local-member-id", h.localID.String()),
+			zap.String("remote-snapshot-sender-id", from),
+			zap.String("checksum", checksum),
+		)
+		http.Error(w, fmt.Sprintf("unsupported snapshot checksum %q", checksum), http.StatusBadRequest)
+		snapshotReceiveFailures.WithLabelValues(from).Inc()
+		return
+	}
+
+	n, err := h.snapshotter.SaveDBFrom(body, m.Snapshot.Metadata.Index)
 	if err != nil {
 		msg := fmt.Sprintf("failed to save KV snapshot (%v)", err)
 		h.lg.Warn(
@@ -281,6 +322,9 @@ func (h *snapshotHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	receivedBytes.WithLabelValues(from).Add(float64(n))
+	if h.saved != nil {
+		h.saved(m.Snapshot.Metadata.Index)
+	}
 
 	downloadTook := time.Since(start)
 	h.lg.Info(
@@ -321,6 +365,84 @@ func (h *snapshotHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	snapshotReceiveSeconds.WithLabelValues(from).Observe(time.Since(start).Seconds())
 }
 
+type snapshotDelegateHandler struct {
+	lg      *zap.Logger
+	tr      Transporter
+	sd      SnapshotDelegate
+	localID types.ID
+	cid     types.ID
+}
+
+// newSnapshotDelegateHandler returns a handler for handling requests of the
+// leader to send a snapshot to a member on its behalf, for
+// RaftSnapshotDelegatePrefix.
+func newSnapshotDelegateHandler(t *Transport, sd SnapshotDelegate, cid types.ID) http.Handler {
+	h := &snapshotDelegateHandler{
+		lg:      t.Logger,
+		tr:      t,
+		sd:      sd,
+		localID: t.ID,
+		cid:     cid,
+	}
+	if h.lg == nil {
+		h.lg = zap.NewNop()
+	}
+	return h
+}
+
+// ServeHTTP serves HTTP request to send a snapshot on behalf of the leader.
+// It only responds once the snapshot has been sent, so that the leader can
+// report the snapshot status to raft.
+func (h *snapshotDelegateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("X-Etcd-Cluster-ID", h.cid.String())
+
+	if err := checkClusterCompatibilityFromHeader(h.lg, h.localID, r.Header, h.cid); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	dec := &messageDecoder{r: r.Body}
+	m, err := dec.decode()
+	if err != nil {
+		h.lg.Warn(
+			"failed to decode Raft message",
+			zap.String("local-member-id", h.localID.String()),
+			zap.Error(err),
+		)
+		http.Error(w, fmt.Sprintf("failed to decode raft message (%v)", err), http.StatusBadRequest)
+		recvFailures.WithLabelValues(r.RemoteAddr).Inc()
+		return
+	}
+	if m.Type != raftpb.MsgSnap {
+		h.lg.Warn(
+			"unexpected Raft message type",
+			zap.String("local-member-id", h.localID.String()),
+			zap.String("message-type", m.Type.String()),
+		)
+		http.Error(w, "wrong raft message type", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.sd.SendDelegatedSnapshot(r.Context(), m); err != nil {
+		h.lg.Warn(
+			"failed to send delegated database snapshot",
+			zap.String("local-member-id", h.localID.String()),
+			zap.String("leader-id", types.ID(m.From).String()),
+			zap.String("remote-peer-id", types.ID(m.To).String()),
+			zap.Error(err),
+		)
+		http.Error(w, fmt.Sprintf("failed to send snapshot (%v)", err), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
 type streamHandler struct {
 	lg         *zap.Logger
 	tr         *Transport
@@ -435,6 +557,14 @@ func (h *streamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// compress the stream if the remote stream reader accepts it
+	compression := h.tr.peerCompression(from)
+	if compression != "" && acceptsCompression(r.Header.Get("X-Etcd-Accept-Compression"), compression) {
+		w.Header().Set("X-Etcd-Stream-Compression", compression)
+	} else {
+		compression = ""
+	}
+
 	w.WriteHeader(http.StatusOK)
 	w.(http.Flusher).Flush()
 
@@ -447,6 +577,7 @@ func (h *streamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		localID: h.tr.ID,
 		peerID:  from,
 	}
+	compressOutgoingConn(conn, compression)
 	p.attachOutgoingConn(conn)
 	<-c.closeNotify()
 }
@@ -22,6 +22,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -171,6 +172,36 @@ func TestServeRaftPrefix(t *testing.T) {
 	}
 }
 
+func TestServeRaftPrefixCompressed(t *testing.T) {
+	m := raftpb.Message{Type: raftpb.MsgApp, From: 1, To: 2, Entries: []raftpb.Entry{{Data: []byte("some data")}}}
+	body, err := compress(CompressionGzip, pbutil.MustMarshal(&m))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, err := http.NewRequest("POST", "foo", bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("X-Etcd-Cluster-ID", "0")
+	req.Header.Set("X-Server-Version", version.Version)
+	req.Header.Set("Content-Encoding", CompressionGzip)
+
+	recvc := make(chan raftpb.Message, 1)
+	rw := httptest.NewRecorder()
+	h := newPipelineHandler(&Transport{Logger: zaptest.NewLogger(t)}, &fakeRaft{recvc: recvc}, types.ID(0))
+	h.ServeHTTP(rw, req)
+
+	if rw.Code != http.StatusNoContent {
+		t.Fatalf("got code=%d, want %d", rw.Code, http.StatusNoContent)
+	}
+	if g := rw.Header().Get("X-Etcd-Accept-Compression"); g != CompressionGzip {
+		t.Errorf("accepted compression = %q, want %q", g, CompressionGzip)
+	}
+	if g := <-recvc; !reflect.DeepEqual(g, m) {
+		t.Errorf("message = %+v, want %+v", g, m)
+	}
+}
+
 func TestServeRaftStreamPrefix(t *testing.T) {
 	tests := []struct {
 		path  string
@@ -385,6 +416,7 @@ func (pr *fakePeer) sendSnap(m snap.Message) {
 	pr.snapMsgs = append(pr.snapMsgs, m)
 }
 
+func (pr *fakePeer) delegateSnap(m snap.Message)           { pr.sendSnap(m) }
 func (pr *fakePeer) update(urls types.URLs)                { pr.peerURLs = urls }
 func (pr *fakePeer) attachOutgoingConn(conn *outgoingConn) { pr.connc <- conn }
 func (pr *fakePeer) activeSince() time.Time                { return time.Time{} }
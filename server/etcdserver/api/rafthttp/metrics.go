@@ -44,6 +44,15 @@ var (
 		[]string{"To"},
 	)
 
+	peerCompressionRatio = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "etcd",
+		Subsystem: "network",
+		Name:      "peer_compression_ratio",
+		Help:      "The ratio of the size of the data sent to a peer before compression to its size after compression.",
+	},
+		[]string{"To"},
+	)
+
 	receivedBytes = prometheus.NewCounterVec(prometheus.CounterOpts{
 		Namespace: "etcd",
 		Subsystem: "network",
@@ -111,6 +120,15 @@ var (
 		[]string{"To"},
 	)
 
+	snapshotDelegated = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "etcd",
+		Subsystem: "network",
+		Name:      "snapshot_delegate_success",
+		Help:      "Total number of snapshots successfully sent by a delegate on behalf of this member",
+	},
+		[]string{"Delegate"},
+	)
+
 	snapshotReceive = prometheus.NewCounterVec(prometheus.CounterOpts{
 		Namespace: "etcd",
 		Subsystem: "network",
@@ -169,6 +187,7 @@ func init() {
 	prometheus.MustRegister(activePeers)
 	prometheus.MustRegister(disconnectedPeers)
 	prometheus.MustRegister(sentBytes)
+	prometheus.MustRegister(peerCompressionRatio)
 	prometheus.MustRegister(receivedBytes)
 	prometheus.MustRegister(sentFailures)
 	prometheus.MustRegister(recvFailures)
@@ -177,6 +196,7 @@ func init() {
 	prometheus.MustRegister(snapshotSendInflights)
 	prometheus.MustRegister(snapshotSendFailures)
 	prometheus.MustRegister(snapshotSendSeconds)
+	prometheus.MustRegister(snapshotDelegated)
 	prometheus.MustRegister(snapshotReceive)
 	prometheus.MustRegister(snapshotReceiveInflights)
 	prometheus.MustRegister(snapshotReceiveFailures)
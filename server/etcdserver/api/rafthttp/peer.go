@@ -71,6 +71,11 @@ type Peer interface {
 	// is similar to send.
 	sendSnap(m snap.Message)
 
+	// delegateSnap asks the remote peer to send a snapshot of its own state
+	// to the receiver of the given snapshot message. Its behavior is similar
+	// to sendSnap.
+	delegateSnap(m snap.Message)
+
 	// update updates the urls of remote peer.
 	update(urls types.URLs)
 
@@ -269,6 +274,10 @@ func (p *peer) sendSnap(m snap.Message) {
 	go p.snapSender.send(m)
 }
 
+func (p *peer) delegateSnap(m snap.Message) {
+	go p.snapSender.delegate(m)
+}
+
 func (p *peer) update(urls types.URLs) {
 	p.picker.update(urls)
 }
@@ -21,6 +21,7 @@ import (
 	"io"
 	"runtime"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.etcd.io/etcd/client/pkg/v3/types"
@@ -55,6 +56,9 @@ type pipeline struct {
 	followerStats *stats.FollowerStats
 
 	msgc chan raftpb.Message
+	// accepted holds the compressions the peer advertised it accepts in its
+	// last response.
+	accepted atomic.Value
 	// wait for the handling routines
 	wg    sync.WaitGroup
 	stopc chan struct{}
@@ -131,8 +135,23 @@ func (p *pipeline) handle() {
 // post POSTs a data payload to a url. Returns nil if the POST succeeds,
 // error on any failure.
 func (p *pipeline) post(data []byte) (err error) {
+	// only compress once the peer has shown that it accepts the compression,
+	// as older peers cannot decode it.
+	body, compression := data, p.tr.peerCompression(p.peerID)
+	if accepted, _ := p.accepted.Load().(string); compression != "" && acceptsCompression(accepted, compression) {
+		if body, err = compress(compression, data); err != nil {
+			return err
+		}
+		observeCompression(p.peerID.String(), len(data), len(body))
+	} else {
+		compression = ""
+	}
+
 	u := p.picker.pick()
-	req := createPostRequest(p.tr.Logger, u, RaftPrefix, bytes.NewBuffer(data), "application/protobuf", p.tr.URLs, p.tr.ID, p.tr.ClusterID)
+	req := createPostRequest(p.tr.Logger, u, RaftPrefix, bytes.NewBuffer(body), "application/protobuf", p.tr.URLs, p.tr.ID, p.tr.ClusterID)
+	if compression != "" {
+		req.Header.Set("Content-Encoding", compression)
+	}
 
 	done := make(chan struct{}, 1)
 	ctx, cancel := context.WithCancel(context.Background())
@@ -154,6 +173,7 @@ func (p *pipeline) post(data []byte) (err error) {
 		return err
 	}
 	defer resp.Body.Close()
+	p.accepted.Store(resp.Header.Get("X-Etcd-Accept-Compression"))
 	b, err := io.ReadAll(resp.Body)
 	if err != nil {
 		p.picker.unreachable(u)
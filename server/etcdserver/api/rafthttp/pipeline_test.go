@@ -165,6 +165,49 @@ func TestPipelinePost(t *testing.T) {
 	}
 }
 
+// TestPipelinePostCompressed ensures the pipeline compresses messages once the
+// peer has advertised that it accepts the compression.
+func TestPipelinePostCompressed(t *testing.T) {
+	tr := &respRoundTripper{
+		rec:    &testutil.RecorderBuffered{},
+		code:   http.StatusNoContent,
+		header: http.Header{"X-Etcd-Accept-Compression": []string{CompressionGzip}},
+	}
+	picker := mustNewURLPicker(t, []string{"http://localhost:2380"})
+	tp := &Transport{ClusterID: types.ID(1), pipelineRt: tr, PeerCompression: func(types.ID) string { return CompressionGzip }}
+	p := startTestPipeline(t, tp, picker)
+	defer p.stop()
+
+	for i := 0; i < 2; i++ {
+		if err := p.post([]byte("some data")); err != nil {
+			t.Fatalf("#%d: unexpected post error: %v", i, err)
+		}
+	}
+	act, err := tr.rec.Wait(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, wc := range []string{"", CompressionGzip} {
+		req := act[i].Params[0].(*http.Request)
+		c := req.Header.Get("Content-Encoding")
+		if c != wc {
+			t.Errorf("#%d: content encoding = %q, want %q", i, c, wc)
+		}
+		r, err := decompressReader(c, req.Body)
+		if err != nil {
+			t.Fatalf("#%d: unexpected decompress error: %v", i, err)
+		}
+		b, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatalf("#%d: unexpected ReadAll error: %v", i, err)
+		}
+		if string(b) != "some data" {
+			t.Errorf("#%d: body = %s, want %s", i, b, "some data")
+		}
+	}
+}
+
 func TestPipelinePostBad(t *testing.T) {
 	tests := []struct {
 		u    string
@@ -0,0 +1,111 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rafthttp
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"hash"
+	"io"
+)
+
+// snapshotChecksumSHA256 is the value of the X-Etcd-Snapshot-Checksum header
+// of a snapshot whose database is followed by its sha256 digest.
+const snapshotChecksumSHA256 = "sha256"
+
+var (
+	errSnapshotChecksumMissing  = errors.New("snapshot checksum missing")
+	errSnapshotChecksumMismatch = errors.New("snapshot checksum mismatch")
+)
+
+// checksumAppender reads the underlying reader, followed by the sha256 digest
+// of everything read from it.
+type checksumAppender struct {
+	r      io.Reader
+	h      hash.Hash
+	digest []byte
+	done   bool
+}
+
+func newChecksumAppender(r io.Reader) io.Reader {
+	return &checksumAppender{r: r, h: sha256.New()}
+}
+
+func (a *checksumAppender) Read(p []byte) (int, error) {
+	if !a.done {
+		n, err := a.r.Read(p)
+		a.h.Write(p[:n])
+		if err != io.EOF {
+			return n, err
+		}
+		a.done = true
+		a.digest = a.h.Sum(nil)
+		if n > 0 {
+			return n, nil
+		}
+	}
+	if len(a.digest) == 0 {
+		return 0, io.EOF
+	}
+	n := copy(p, a.digest)
+	a.digest = a.digest[n:]
+	return n, nil
+}
+
+// checksumVerifier reads the underlying reader except for its trailing sha256
+// digest, which it verifies against everything read before returning io.EOF.
+type checksumVerifier struct {
+	r   io.Reader
+	h   hash.Hash
+	buf []byte
+	err error
+	tmp [32 * 1024]byte
+}
+
+func newChecksumVerifier(r io.Reader) io.Reader {
+	return &checksumVerifier{r: r, h: sha256.New()}
+}
+
+func (v *checksumVerifier) Read(p []byte) (int, error) {
+	for {
+		// always hold back the last bytes read, which may be the digest
+		if n := len(v.buf) - sha256.Size; n > 0 {
+			n = copy(p, v.buf[:n])
+			v.h.Write(p[:n])
+			v.buf = v.buf[n:]
+			return n, nil
+		}
+		if v.err != nil {
+			return 0, v.verify()
+		}
+		n, err := v.r.Read(v.tmp[:])
+		v.buf = append(v.buf, v.tmp[:n]...)
+		v.err = err
+	}
+}
+
+func (v *checksumVerifier) verify() error {
+	if v.err != io.EOF {
+		return v.err
+	}
+	if len(v.buf) != sha256.Size {
+		return errSnapshotChecksumMissing
+	}
+	if !bytes.Equal(v.h.Sum(nil), v.buf) {
+		return errSnapshotChecksumMismatch
+	}
+	return io.EOF
+}
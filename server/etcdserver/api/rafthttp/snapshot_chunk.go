@@ -0,0 +1,601 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rafthttp
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.etcd.io/etcd/api/v3/version"
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/raft/v3/raftpb"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/snap"
+
+	"github.com/coreos/go-semver/semver"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+)
+
+// A snapshot sent in chunks is the same stream as the one sent in a single
+// request to RaftSnapshotPrefix: the encoded raft message followed by the
+// database. The stream is cut into chunks that are posted one by one to
+// RaftSnapshotChunkPrefix. Each chunk carries the offset it starts at and a
+// checksum of its uncompressed content, so that a chunk lost or corrupted on
+// the way can be sent again from where the receiver stopped instead of
+// restarting the whole transfer.
+//
+// A transfer is identified by its sender and the term and index of the
+// snapshot, so a later attempt to send the same snapshot asks the receiver how
+// much of it is already held and resumes from there. The stream is created
+// again for every attempt and its database may have changed in between, so
+// the receiver also reports a checksum of what it holds and the sender only
+// resumes if it matches the start of the new stream.
+const (
+	// maxSnapshotChunkBytes limits the size of a chunk the receiver accepts.
+	maxSnapshotChunkBytes = 64 * 1024 * 1024
+
+	snapshotChunkRetries = 3
+)
+
+var (
+	RaftSnapshotChunkPrefix = path.Join(RaftSnapshotPrefix, "chunk")
+
+	// snapshotChunkSize is the size of the chunks a snapshot is cut into.
+	snapshotChunkSize = 4 * 1024 * 1024
+	// snapshotChunkRetryInterval is the time to wait before sending a
+	// failed chunk again.
+	snapshotChunkRetryInterval = 500 * time.Millisecond
+	// snapshotTransferIdleTimeout is the time after which a partially
+	// received snapshot that got no new chunk is dropped.
+	snapshotTransferIdleTimeout = 5 * time.Minute
+
+	errSnapshotChunkChecksumMismatch = errors.New("snapshot chunk checksum mismatch")
+	errSnapshotChanged               = errors.New("snapshot changed since the partial transfer")
+)
+
+// checkSnapshotChunkSupport checks whether a member of the given version can
+// receive snapshots in chunks.
+func checkSnapshotChunkSupport(v *semver.Version) bool {
+	return compareMajorMinorVersion(v, &version.V3_6) >= 0
+}
+
+// newSnapshotRateLimiter returns a limiter for the given rate in bytes per
+// second, or nil if the rate is zero.
+func newSnapshotRateLimiter(bytesPerSec uint64) *rate.Limiter {
+	if bytesPerSec == 0 {
+		return nil
+	}
+	burst := snapshotChunkSize
+	if bytesPerSec < uint64(burst) {
+		burst = int(bytesPerSec)
+	}
+	return rate.NewLimiter(rate.Limit(bytesPerSec), burst)
+}
+
+// rateLimitedReader limits the rate at which data is read from the
+// underlying reader.
+type rateLimitedReader struct {
+	ctx context.Context
+	r   io.Reader
+	lim *rate.Limiter
+}
+
+func (r *rateLimitedReader) Read(p []byte) (int, error) {
+	if len(p) > r.lim.Burst() {
+		p = p[:r.lim.Burst()]
+	}
+	n, err := r.r.Read(p)
+	if n > 0 {
+		if werr := r.lim.WaitN(r.ctx, n); werr != nil {
+			return n, werr
+		}
+	}
+	return n, err
+}
+
+// chunkedTransfer is a snapshot being sent in chunks.
+type chunkedTransfer struct {
+	id          string
+	index       uint64
+	compression string
+
+	// offset is the size of the part of the stream the receiver holds from
+	// an earlier attempt, and checksum the SHA-256 checksum of that part.
+	offset   int64
+	checksum string
+}
+
+// newChunkRequest creates a request about the given transfer that carries no
+// chunk.
+func (s *snapshotSender) newChunkRequest(method string, u url.URL, transfer string) (*http.Request, error) {
+	uu := u
+	uu.Path = RaftSnapshotChunkPrefix
+	req, err := http.NewRequest(method, uu.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Server-From", s.from.String())
+	req.Header.Set("X-Server-Version", version.Version)
+	req.Header.Set("X-Min-Cluster-Version", version.MinClusterVersion)
+	req.Header.Set("X-Etcd-Cluster-ID", s.cid.String())
+	req.Header.Set("X-Etcd-Snapshot-Transfer", transfer)
+	setPeerURLsHeader(req, s.tr.URLs)
+	return req, nil
+}
+
+// negotiateChunks asks the remote peer whether it can receive snapshots in
+// chunks, and how much of the given snapshot it already holds. It returns nil
+// if the snapshot should not be sent in chunks.
+func (s *snapshotSender) negotiateChunks(u url.URL, snapshot raftpb.SnapshotMetadata) (*chunkedTransfer, error) {
+	ct := &chunkedTransfer{
+		id:    fmt.Sprintf("%s-%016x-%016x", s.from, snapshot.Term, snapshot.Index),
+		index: snapshot.Index,
+	}
+	req, err := s.newChunkRequest("GET", u, ct.id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, body, err := s.roundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	// peers that predate chunked snapshots have no handler for the prefix
+	if resp.StatusCode == http.StatusNotFound || !checkSnapshotChunkSupport(serverVersion(resp.Header)) {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, checkPostResponse(s.tr.Logger, resp, body, req, s.to)
+	}
+
+	if ct.offset, err = strconv.ParseInt(resp.Header.Get("X-Etcd-Snapshot-Offset"), 10, 64); err != nil {
+		return nil, fmt.Errorf("invalid snapshot transfer offset %q", resp.Header.Get("X-Etcd-Snapshot-Offset"))
+	}
+	ct.checksum = resp.Header.Get("X-Etcd-Snapshot-Prefix-Checksum")
+
+	if s.tr.SnapshotCompression == "" {
+		return ct, nil
+	}
+	for _, c := range resp.Header.Values("X-Etcd-Snapshot-Accept-Compression") {
+		if c == s.tr.SnapshotCompression {
+			ct.compression = c
+			break
+		}
+	}
+	return ct, nil
+}
+
+// skipReceived reads the part of the snapshot stream the receiver already
+// holds, so that sending resumes after it. If the part differs from what the
+// receiver holds, the receiver is asked to drop it and errSnapshotChanged is
+// returned; the next attempt then starts from the beginning.
+func (s *snapshotSender) skipReceived(u url.URL, ct *chunkedTransfer, body io.Reader) error {
+	h := sha256.New()
+	_, err := io.CopyN(h, body, ct.offset)
+	if err == nil && hex.EncodeToString(h.Sum(nil)) == ct.checksum {
+		return nil
+	}
+	if derr := s.dropTransfer(u, ct.id); derr != nil {
+		return derr
+	}
+	if err == nil || err == io.EOF {
+		return errSnapshotChanged
+	}
+	return err
+}
+
+// dropTransfer asks the receiver to drop what it holds of the given transfer.
+func (s *snapshotSender) dropTransfer(u url.URL, transfer string) error {
+	req, err := s.newChunkRequest("DELETE", u, transfer)
+	if err != nil {
+		return err
+	}
+	resp, body, err := s.roundTrip(req)
+	if err != nil {
+		return err
+	}
+	return checkPostResponse(s.tr.Logger, resp, body, req, s.to)
+}
+
+// sendChunks sends the rest of the snapshot stream read from body in chunks,
+// starting at the offset the receiver holds.
+func (s *snapshotSender) sendChunks(u url.URL, ct *chunkedTransfer, body io.Reader, withChecksum bool) error {
+	buf := make([]byte, snapshotChunkSize)
+	offset := ct.offset
+	for {
+		n, err := io.ReadFull(body, buf)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return err
+		}
+		final := err != nil
+		if err := s.sendChunk(u, ct, offset, buf[:n], withChecksum, final); err != nil {
+			return err
+		}
+		offset += int64(n)
+		if final {
+			return nil
+		}
+	}
+}
+
+// sendChunk sends a single chunk starting at the given offset of the snapshot
+// stream. A chunk that fails is sent again a few times before giving up.
+func (s *snapshotSender) sendChunk(u url.URL, ct *chunkedTransfer, offset int64, chunk []byte, withChecksum, final bool) error {
+	sum := sha256.Sum256(chunk)
+	payload := chunk
+	if ct.compression != "" {
+		var err error
+		if payload, err = compress(ct.compression, chunk); err != nil {
+			return err
+		}
+		observeCompression(s.to.String(), len(chunk), len(payload))
+	}
+
+	var err error
+	for i := 0; i < snapshotChunkRetries; i++ {
+		if i > 0 {
+			select {
+			case <-time.After(snapshotChunkRetryInterval):
+			case <-s.stopc:
+				return errStopped
+			}
+		}
+
+		req := createPostRequest(s.tr.Logger, u, RaftSnapshotChunkPrefix, bytes.NewReader(payload), "application/octet-stream", s.tr.URLs, s.from, s.cid)
+		req.Header.Set("X-Etcd-Snapshot-Transfer", ct.id)
+		req.Header.Set("X-Etcd-Snapshot-Index", strconv.FormatUint(ct.index, 10))
+		req.Header.Set("X-Etcd-Snapshot-Offset", strconv.FormatInt(offset, 10))
+		req.Header.Set("X-Etcd-Snapshot-Chunk-Checksum", hex.EncodeToString(sum[:]))
+		if ct.compression != "" {
+			req.Header.Set("Content-Encoding", ct.compression)
+		}
+		if withChecksum {
+			req.Header.Set("X-Etcd-Snapshot-Checksum", snapshotChecksumSHA256)
+		}
+		if final {
+			req.Header.Set("X-Etcd-Snapshot-Final", "true")
+		}
+
+		var (
+			resp *http.Response
+			body []byte
+		)
+		resp, body, err = s.roundTrip(req)
+		if err == errStopped {
+			return err
+		}
+		if err != nil {
+			if s.tr.Logger != nil {
+				s.tr.Logger.Warn(
+					"failed to send database snapshot chunk",
+					zap.String("remote-peer-id", s.to.String()),
+					zap.Int64("offset", offset),
+					zap.Int("attempt", i+1),
+					zap.Error(err),
+				)
+			}
+			continue
+		}
+
+		if resp.StatusCode == http.StatusConflict {
+			// the receiver has already written this chunk if the connection
+			// was lost before its response came back.
+			got, perr := strconv.ParseInt(resp.Header.Get("X-Etcd-Snapshot-Offset"), 10, 64)
+			if perr == nil && !final && got == offset+int64(len(chunk)) {
+				return nil
+			}
+			return fmt.Errorf("snapshot transfer %s out of sync at offset %d (receiver at %q)", ct.id, offset, resp.Header.Get("X-Etcd-Snapshot-Offset"))
+		}
+		err = checkPostResponse(s.tr.Logger, resp, body, req, s.to)
+		switch err {
+		case nil:
+			return nil
+		case errMemberRemoved, errIncompatibleVersion, errClusterIDMismatch:
+			return err
+		}
+		if final {
+			// the whole snapshot has been received and failed to be
+			// processed; sending the last chunk again cannot help.
+			return err
+		}
+	}
+	return err
+}
+
+// snapshotTransfer is a snapshot that is being received in chunks.
+type snapshotTransfer struct {
+	mu sync.Mutex
+
+	from     string
+	index    uint64
+	f        *os.File
+	offset   int64
+	checksum string
+	// sum is the checksum of the part of the stream received so far.
+	sum hash.Hash
+
+	// updated is protected by the mutex of the handler so that idle
+	// transfers can be found without locking each of them.
+	updated time.Time
+}
+
+func (t *snapshotTransfer) remove() {
+	t.f.Close()
+	os.Remove(t.f.Name())
+}
+
+type snapshotChunkHandler struct {
+	*snapshotHandler
+
+	mu        sync.Mutex
+	transfers map[string]*snapshotTransfer
+}
+
+// newSnapshotChunkHandler returns a handler for receiving snapshots in chunks
+// for RaftSnapshotChunkPrefix. Its embedded snapshotHandler receives snapshots
+// sent in a single request, and drops the partial snapshots they supersede.
+func newSnapshotChunkHandler(t *Transport, r Raft, snapshotter *snap.Snapshotter, cid types.ID) *snapshotChunkHandler {
+	h := &snapshotChunkHandler{
+		snapshotHandler: newSnapshotHandler(t, r, snapshotter, cid).(*snapshotHandler),
+		transfers:       make(map[string]*snapshotTransfer),
+	}
+	h.snapshotHandler.saved = h.dropSuperseded
+	return h
+}
+
+// ServeHTTP serves a GET request to report the offset a transfer is at and
+// the compressions the local member accepts, DELETE requests to drop a
+// transfer, and POST requests to receive the chunks of a snapshot. The
+// snapshot is processed once the final chunk is received.
+func (h *snapshotChunkHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if r.Method != "GET" && r.Method != "POST" && r.Method != "DELETE" {
+		w.Header().Set("Allow", "GET, POST, DELETE")
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("X-Server-Version", version.Version)
+	w.Header().Set("X-Etcd-Cluster-ID", h.cid.String())
+	for _, c := range supportedCompressions {
+		w.Header().Add("X-Etcd-Snapshot-Accept-Compression", c)
+	}
+
+	if err := checkClusterCompatibilityFromHeader(h.lg, h.localID, r.Header, h.cid); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	id := r.Header.Get("X-Etcd-Snapshot-Transfer")
+	switch r.Method {
+	case "GET":
+		var offset int64
+		if t := h.transfer(id); t != nil {
+			t.mu.Lock()
+			offset = t.offset
+			w.Header().Set("X-Etcd-Snapshot-Prefix-Checksum", hex.EncodeToString(t.sum.Sum(nil)))
+			t.mu.Unlock()
+		}
+		w.Header().Set("X-Etcd-Snapshot-Offset", strconv.FormatInt(offset, 10))
+		w.WriteHeader(http.StatusOK)
+		return
+	case "DELETE":
+		if t := h.transfer(id); t != nil {
+			t.mu.Lock()
+			h.endTransfer(id, t)
+			t.mu.Unlock()
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	addRemoteFromRequest(h.tr, r)
+
+	from := r.Header.Get("X-Server-From")
+	offset, err := strconv.ParseInt(r.Header.Get("X-Etcd-Snapshot-Offset"), 10, 64)
+	index, ierr := strconv.ParseUint(r.Header.Get("X-Etcd-Snapshot-Index"), 10, 64)
+	if id == "" || err != nil || ierr != nil {
+		http.Error(w, "invalid snapshot transfer", http.StatusBadRequest)
+		snapshotReceiveFailures.WithLabelValues(unknownSnapshotSender).Inc()
+		return
+	}
+
+	t := h.transfer(id)
+	if t == nil {
+		if offset != 0 {
+			w.Header().Set("X-Etcd-Snapshot-Offset", "0")
+			http.Error(w, "unknown snapshot transfer", http.StatusConflict)
+			return
+		}
+		if t, err = h.beginTransfer(id, from, index, r.Header.Get("X-Etcd-Snapshot-Checksum")); err != nil {
+			h.lg.Warn(
+				"failed to create partial database snapshot",
+				zap.String("local-member-id", h.localID.String()),
+				zap.String("remote-snapshot-sender-id", from),
+				zap.Error(err),
+			)
+			http.Error(w, fmt.Sprintf("failed to create partial snapshot (%v)", err), http.StatusInternalServerError)
+			snapshotReceiveFailures.WithLabelValues(from).Inc()
+			return
+		}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if offset != t.offset {
+		w.Header().Set("X-Etcd-Snapshot-Offset", strconv.FormatInt(t.offset, 10))
+		http.Error(w, "unexpected snapshot chunk offset", http.StatusConflict)
+		return
+	}
+
+	chunk, err := readSnapshotChunk(r)
+	if err != nil {
+		h.lg.Warn(
+			"failed to read database snapshot chunk",
+			zap.String("local-member-id", h.localID.String()),
+			zap.String("remote-snapshot-sender-id", from),
+			zap.Int64("offset", offset),
+			zap.Error(err),
+		)
+		http.Error(w, fmt.Sprintf("failed to read snapshot chunk (%v)", err), http.StatusBadRequest)
+		recvFailures.WithLabelValues(r.RemoteAddr).Inc()
+		return
+	}
+	if _, err := t.f.Write(chunk); err != nil {
+		// the file may hold part of the chunk now, so the transfer cannot
+		// be resumed.
+		h.endTransfer(id, t)
+		h.lg.Warn(
+			"failed to write database snapshot chunk",
+			zap.String("local-member-id", h.localID.String()),
+			zap.String("remote-snapshot-sender-id", from),
+			zap.Error(err),
+		)
+		http.Error(w, fmt.Sprintf("failed to write snapshot chunk (%v)", err), http.StatusInternalServerError)
+		snapshotReceiveFailures.WithLabelValues(from).Inc()
+		return
+	}
+	t.sum.Write(chunk)
+	t.offset += int64(len(chunk))
+	h.touch(t)
+
+	if r.Header.Get("X-Etcd-Snapshot-Final") != "true" {
+		w.Header().Set("X-Etcd-Snapshot-Offset", strconv.FormatInt(t.offset, 10))
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	// the transfer is done with, and must not be dropped as superseded by
+	// the snapshot it carries once that is saved.
+	h.forget(id, t)
+	defer t.remove()
+	if _, err := t.f.Seek(0, io.SeekStart); err != nil {
+		http.Error(w, fmt.Sprintf("failed to read partial snapshot (%v)", err), http.StatusInternalServerError)
+		snapshotReceiveFailures.WithLabelValues(from).Inc()
+		return
+	}
+	h.receive(w, r.RemoteAddr, t.f, t.checksum, start)
+}
+
+// readSnapshotChunk reads the chunk carried by the given request and checks
+// it against its checksum.
+func readSnapshotChunk(r *http.Request) ([]byte, error) {
+	body, err := decompressReader(r.Header.Get("Content-Encoding"), r.Body)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	chunk, err := io.ReadAll(io.LimitReader(body, maxSnapshotChunkBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(chunk) > maxSnapshotChunkBytes {
+		return nil, fmt.Errorf("snapshot chunk exceeds %d bytes", maxSnapshotChunkBytes)
+	}
+	sum := sha256.Sum256(chunk)
+	if r.Header.Get("X-Etcd-Snapshot-Chunk-Checksum") != hex.EncodeToString(sum[:]) {
+		return nil, errSnapshotChunkChecksumMismatch
+	}
+	return chunk, nil
+}
+
+func (h *snapshotChunkHandler) transfer(id string) *snapshotTransfer {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.transfers[id]
+}
+
+func (h *snapshotChunkHandler) touch(t *snapshotTransfer) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	t.updated = time.Now()
+}
+
+// beginTransfer starts receiving a new snapshot at the given index from the
+// given sender. Any other transfer from the same sender, any transfer of an
+// older snapshot, and any transfer that has been idle for too long, is
+// abandoned.
+func (h *snapshotChunkHandler) beginTransfer(id, from string, index uint64, checksum string) (*snapshotTransfer, error) {
+	f, err := h.snapshotter.CreatePartialDB()
+	if err != nil {
+		return nil, err
+	}
+	t := &snapshotTransfer{from: from, index: index, f: f, checksum: checksum, sum: sha256.New(), updated: time.Now()}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for oid, ot := range h.transfers {
+		if ot.from != from && ot.index >= index && time.Since(ot.updated) < snapshotTransferIdleTimeout {
+			continue
+		}
+		h.abandon(oid, ot)
+	}
+	h.transfers[id] = t
+	return t, nil
+}
+
+// dropSuperseded abandons the transfers of snapshots that are not newer than
+// the snapshot saved at the given index.
+func (h *snapshotChunkHandler) dropSuperseded(index uint64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for id, t := range h.transfers {
+		if t.index <= index {
+			h.abandon(id, t)
+		}
+	}
+}
+
+// abandon removes the given transfer, and its partial snapshot once no chunk
+// is being written to it. The caller must hold the lock of the handler.
+func (h *snapshotChunkHandler) abandon(id string, t *snapshotTransfer) {
+	delete(h.transfers, id)
+	go func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		t.remove()
+	}()
+}
+
+// forget removes the given transfer without removing its partial snapshot.
+func (h *snapshotChunkHandler) forget(id string, t *snapshotTransfer) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.transfers[id] == t {
+		delete(h.transfers, id)
+	}
+}
+
+// endTransfer removes the given transfer and its partial snapshot. The caller
+// must hold the lock of the transfer.
+func (h *snapshotChunkHandler) endTransfer(id string, t *snapshotTransfer) {
+	h.forget(id, t)
+	t.remove()
+}
@@ -71,11 +71,14 @@ func (s *snapshotSender) send(merged snap.Message) {
 	m := merged.Message
 	to := types.ID(m.To).String()
 
-	body := createSnapBody(s.tr.Logger, merged)
+	// a snapshot sent on behalf of the leader carries a checksum of the
+	// database for the receiver to verify.
+	delegated := types.ID(m.From) != s.from
+
+	body := createSnapBody(s.tr.Logger, merged, delegated)
 	defer body.Close()
 
 	u := s.picker.pick()
-	req := createPostRequest(s.tr.Logger, u, RaftSnapshotPrefix, body, "application/octet-stream", s.tr.URLs, s.from, s.cid)
 
 	snapshotSizeVal := uint64(merged.TotalSize)
 	snapshotSize := humanize.Bytes(snapshotSizeVal)
@@ -94,7 +97,45 @@ func (s *snapshotSender) send(merged snap.Message) {
 		snapshotSendInflights.WithLabelValues(to).Dec()
 	}()
 
-	err := s.post(req)
+	// the part of the snapshot the receiver already holds is skipped before
+	// the rate limit applies, since it is not sent again.
+	ct, err := s.negotiateChunks(u, m.Snapshot.Metadata)
+	if err == nil && ct != nil && ct.offset > 0 {
+		if err = s.skipReceived(u, ct, body); err == nil && s.tr.Logger != nil {
+			s.tr.Logger.Info(
+				"resuming database snapshot transfer",
+				zap.Uint64("snapshot-index", m.Snapshot.Metadata.Index),
+				zap.String("remote-peer-id", to),
+				zap.Int64("offset", ct.offset),
+			)
+		}
+	}
+
+	var r io.Reader = body
+	if s.tr.snapLimiter != nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() {
+			select {
+			case <-s.stopc:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+		r = &rateLimitedReader{ctx: ctx, r: body, lim: s.tr.snapLimiter}
+	}
+
+	if err == nil {
+		if ct != nil {
+			err = s.sendChunks(u, ct, r, delegated)
+		} else {
+			req := createPostRequest(s.tr.Logger, u, RaftSnapshotPrefix, r, "application/octet-stream", s.tr.URLs, s.from, s.cid)
+			if delegated {
+				req.Header.Set("X-Etcd-Snapshot-Checksum", snapshotChecksumSHA256)
+			}
+			err = s.post(req)
+		}
+	}
 	defer merged.CloseWithError(err)
 	if err != nil {
 		if s.tr.Logger != nil {
@@ -143,9 +184,80 @@ func (s *snapshotSender) send(merged snap.Message) {
 	snapshotSendSeconds.WithLabelValues(to).Observe(time.Since(start).Seconds())
 }
 
+// delegate asks the remote peer to send a snapshot of its own state to the
+// receiver of the given snapshot message, in place of the local member. The
+// result is reported to raft as if the local member had sent the snapshot.
+func (s *snapshotSender) delegate(merged snap.Message) {
+	start := time.Now()
+
+	m := merged.Message
+	to := types.ID(m.To).String()
+
+	buf := new(bytes.Buffer)
+	enc := &messageEncoder{w: buf}
+	if err := enc.encode(&m); err != nil {
+		if s.tr.Logger != nil {
+			s.tr.Logger.Panic("failed to encode message", zap.Error(err))
+		}
+	}
+
+	u := s.picker.pick()
+	req := createPostRequest(s.tr.Logger, u, RaftSnapshotDelegatePrefix, buf, "application/octet-stream", s.tr.URLs, s.from, s.cid)
+
+	if s.tr.Logger != nil {
+		s.tr.Logger.Info(
+			"delegating database snapshot",
+			zap.Uint64("snapshot-index", m.Snapshot.Metadata.Index),
+			zap.String("remote-peer-id", to),
+			zap.String("delegate-peer-id", s.to.String()),
+		)
+	}
+
+	err := s.post(req)
+	defer merged.CloseWithError(err)
+	if err != nil {
+		if s.tr.Logger != nil {
+			s.tr.Logger.Warn(
+				"failed to delegate database snapshot",
+				zap.Uint64("snapshot-index", m.Snapshot.Metadata.Index),
+				zap.String("remote-peer-id", to),
+				zap.String("delegate-peer-id", s.to.String()),
+				zap.Error(err),
+			)
+		}
+		if err == errMemberRemoved {
+			reportCriticalError(err, s.errorc)
+		}
+		s.r.ReportSnapshot(m.To, raft.SnapshotFailure)
+		snapshotSendFailures.WithLabelValues(to).Inc()
+		return
+	}
+	s.r.ReportSnapshot(m.To, raft.SnapshotFinish)
+
+	if s.tr.Logger != nil {
+		s.tr.Logger.Info(
+			"delegated database snapshot",
+			zap.Uint64("snapshot-index", m.Snapshot.Metadata.Index),
+			zap.String("remote-peer-id", to),
+			zap.String("delegate-peer-id", s.to.String()),
+			zap.Duration("took", time.Since(start)),
+		)
+	}
+	snapshotDelegated.WithLabelValues(s.to.String()).Inc()
+}
+
 // post posts the given request.
 // It returns nil when request is sent out and processed successfully.
-func (s *snapshotSender) post(req *http.Request) (err error) {
+func (s *snapshotSender) post(req *http.Request) error {
+	resp, body, err := s.roundTrip(req)
+	if err != nil {
+		return err
+	}
+	return checkPostResponse(s.tr.Logger, resp, body, req, s.to)
+}
+
+// roundTrip sends the given request and reads its response.
+func (s *snapshotSender) roundTrip(req *http.Request) (*http.Response, []byte, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 	req = req.WithContext(ctx)
 	defer cancel()
@@ -174,16 +286,13 @@ func (s *snapshotSender) post(req *http.Request) (err error) {
 
 	select {
 	case <-s.stopc:
-		return errStopped
+		return nil, nil, errStopped
 	case r := <-result:
-		if r.err != nil {
-			return r.err
-		}
-		return checkPostResponse(s.tr.Logger, r.resp, r.body, req, s.to)
+		return r.resp, r.body, r.err
 	}
 }
 
-func createSnapBody(lg *zap.Logger, merged snap.Message) io.ReadCloser {
+func createSnapBody(lg *zap.Logger, merged snap.Message, withChecksum bool) io.ReadCloser {
 	buf := new(bytes.Buffer)
 	enc := &messageEncoder{w: buf}
 	// encode raft message
@@ -193,8 +302,12 @@ func createSnapBody(lg *zap.Logger, merged snap.Message) io.ReadCloser {
 		}
 	}
 
+	var r io.Reader = merged.ReadCloser
+	if withChecksum {
+		r = newChecksumAppender(r)
+	}
 	return &pioutil.ReaderAndCloser{
-		Reader: io.MultiReader(buf, merged.ReadCloser),
+		Reader: io.MultiReader(buf, r),
 		Closer: merged.ReadCloser,
 	}
 }
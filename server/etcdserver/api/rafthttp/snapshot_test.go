@@ -15,13 +15,21 @@
 package rafthttp
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"testing"
+	"testing/iotest"
 	"time"
 
 	"go.etcd.io/etcd/client/pkg/v3/types"
@@ -92,6 +100,331 @@ func TestSnapshotSend(t *testing.T) {
 	}
 }
 
+// TestSnapshotSendDelegated ensures a snapshot sent on behalf of the leader
+// carries a checksum of the database, which the receiver verifies.
+func TestSnapshotSendDelegated(t *testing.T) {
+	m := raftpb.Message{Type: raftpb.MsgSnap, From: 2, To: 1}
+	sent, files := testSnapshotSend(t, snap.NewMessage(m, strReaderCloser{strings.NewReader("hello")}, 5))
+	if !sent {
+		t.Errorf("snapshot expected sent, got not sent")
+	}
+	if len(files) != 1 {
+		t.Fatalf("expected 1 file, got %d files", len(files))
+	}
+
+	d := t.TempDir()
+	r := &fakeRaft{}
+	tr := &Transport{ClusterID: types.ID(1), Raft: r}
+	h := newSnapshotHandler(tr, r, snap.New(zaptest.NewLogger(t), d), types.ID(1))
+	for i, body := range []string{"hello", "hello" + strings.Repeat("x", sha256.Size)} {
+		buf := new(bytes.Buffer)
+		enc := &messageEncoder{w: buf}
+		if err := enc.encode(&m); err != nil {
+			t.Fatal(err)
+		}
+		buf.WriteString(body)
+		req := createPostRequest(zaptest.NewLogger(t), url.URL{Scheme: "http", Host: "localhost"}, RaftSnapshotPrefix, buf, "application/octet-stream", nil, types.ID(2), types.ID(1))
+		req.Header.Set("X-Etcd-Snapshot-Checksum", snapshotChecksumSHA256)
+		rw := httptest.NewRecorder()
+		h.ServeHTTP(rw, req)
+		if rw.Code != http.StatusInternalServerError {
+			t.Errorf("#%d: code = %d, want %d", i, rw.Code, http.StatusInternalServerError)
+		}
+	}
+	if files, _ := os.ReadDir(d); len(files) != 0 {
+		t.Errorf("expected no file, got %d files", len(files))
+	}
+}
+
+func TestSnapshotDelegate(t *testing.T) {
+	tests := []struct {
+		err   error
+		wsent bool
+	}{
+		{nil, true},
+		{errors.New("delegate failed"), false},
+	}
+	for i, tt := range tests {
+		sd := &fakeSnapshotDelegate{err: tt.err}
+		r := &fakeRaft{}
+		tr := &Transport{pipelineRt: &http.Transport{}, ClusterID: types.ID(1), Raft: r}
+		srv := httptest.NewServer(newSnapshotDelegateHandler(tr, sd, types.ID(1)))
+
+		picker := mustNewURLPicker(t, []string{srv.URL})
+		snapsend := newSnapshotSender(tr, picker, types.ID(3), newPeerStatus(zaptest.NewLogger(t), types.ID(0), types.ID(3)))
+
+		m := raftpb.Message{Type: raftpb.MsgSnap, From: 1, To: 2, Snapshot: raftpb.Snapshot{Metadata: raftpb.SnapshotMetadata{Index: 10}}}
+		sm := snap.NewMessage(m, io.NopCloser(strings.NewReader("")), 0)
+		snapsend.delegate(*sm)
+
+		select {
+		case <-time.After(time.Second):
+			t.Fatalf("#%d: timed out delegating snapshot", i)
+		case sent := <-sm.CloseNotify():
+			if sent != tt.wsent {
+				t.Errorf("#%d: snapshot expected %v, got %v", i, tt.wsent, sent)
+			}
+		}
+		if sd.m.To != 2 || sd.m.Snapshot.Metadata.Index != 10 {
+			t.Errorf("#%d: delegated message = %+v, want to 2 at index 10", i, sd.m)
+		}
+		snapsend.stop()
+		srv.Close()
+	}
+}
+
+// TestSnapshotSendChunked ensures a snapshot is sent in compressed chunks to a
+// receiver that accepts them, and is saved once the final chunk is received.
+func TestSnapshotSendChunked(t *testing.T) {
+	defer func(n int) { snapshotChunkSize = n }(snapshotChunkSize)
+	snapshotChunkSize = 16
+
+	data := strings.Repeat("snapshot data ", 10)
+	ms, d, chunks := testSnapshotSendChunked(t, CompressionGzip, 1024, nil, data)
+	if !ms {
+		t.Fatalf("snapshot expected sent, got not sent")
+	}
+	if chunks < 2 {
+		t.Errorf("expected snapshot to be sent in several chunks, got %d", chunks)
+	}
+	b, err := os.ReadFile(filepath.Join(d, fmt.Sprintf("%016x.snap.db", 10)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != data {
+		t.Errorf("saved snapshot = %q, want %q", b, data)
+	}
+}
+
+// TestSnapshotSendChunkedResume ensures a chunk whose response is lost does
+// not restart the transfer.
+func TestSnapshotSendChunkedResume(t *testing.T) {
+	defer func(n int, d time.Duration) {
+		snapshotChunkSize, snapshotChunkRetryInterval = n, d
+	}(snapshotChunkSize, snapshotChunkRetryInterval)
+	snapshotChunkSize, snapshotChunkRetryInterval = 16, 10*time.Millisecond
+
+	// the second chunk is written by the receiver, but the connection is
+	// closed before the sender gets the response.
+	n := 0
+	drop := func(w http.ResponseWriter, r *http.Request, h http.Handler) {
+		if r.Method == "POST" {
+			n++
+			if n == 2 {
+				h.ServeHTTP(httptest.NewRecorder(), r)
+				panic(http.ErrAbortHandler)
+			}
+		}
+		h.ServeHTTP(w, r)
+	}
+
+	data := strings.Repeat("snapshot data ", 10)
+	sent, _, _ := testSnapshotSendChunked(t, "", 0, drop, data)
+	if !sent {
+		t.Fatalf("snapshot expected sent, got not sent")
+	}
+}
+
+// TestSnapshotSendChunkedAttempts ensures a later attempt to send the same
+// snapshot resumes where the receiver stopped only if the snapshot has not
+// changed, and that partial snapshots are dropped once superseded.
+func TestSnapshotSendChunkedAttempts(t *testing.T) {
+	defer func(n int, d time.Duration) {
+		snapshotChunkSize, snapshotChunkRetryInterval = n, d
+	}(snapshotChunkSize, snapshotChunkRetryInterval)
+	snapshotChunkSize, snapshotChunkRetryInterval = 16, 10*time.Millisecond
+
+	d := t.TempDir()
+	recvc := make(chan raftpb.Message, 1)
+	r := &fakeRaft{recvc: recvc}
+	tr := &Transport{pipelineRt: &http.Transport{}, ClusterID: types.ID(1), Raft: r}
+	h := newSnapshotChunkHandler(tr, r, snap.New(zaptest.NewLogger(t), d), types.ID(1))
+
+	// chunks from failFrom on are rejected if it is not negative.
+	var failFrom, firstOffset int64 = -1, -1
+	mux := http.NewServeMux()
+	mux.HandleFunc(RaftSnapshotChunkPrefix, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			offset, _ := strconv.ParseInt(r.Header.Get("X-Etcd-Snapshot-Offset"), 10, 64)
+			if firstOffset < 0 {
+				firstOffset = offset
+			}
+			if failFrom >= 0 && offset >= failFrom {
+				http.Error(w, "injected failure", http.StatusInternalServerError)
+				return
+			}
+		}
+		h.ServeHTTP(w, r)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	picker := mustNewURLPicker(t, []string{srv.URL})
+	snapsend := newSnapshotSender(tr, picker, types.ID(1), newPeerStatus(zaptest.NewLogger(t), types.ID(0), types.ID(1)))
+	defer snapsend.stop()
+
+	send := func(index uint64, data string, fail int64) bool {
+		failFrom, firstOffset = fail, -1
+		m := raftpb.Message{Type: raftpb.MsgSnap, To: 1, Snapshot: raftpb.Snapshot{Metadata: raftpb.SnapshotMetadata{Term: 1, Index: index}}}
+		sm := snap.NewMessage(m, io.NopCloser(strings.NewReader(data)), int64(len(data)))
+		snapsend.send(*sm)
+		select {
+		case <-time.After(time.Second):
+			t.Fatalf("timed out sending snapshot")
+		case sent := <-sm.CloseNotify():
+			return sent
+		}
+		return false
+	}
+	partials := func() int {
+		files, err := filepath.Glob(filepath.Join(d, "db.tmp.partial*"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return len(files)
+	}
+
+	// chunks are rejected from offset 48 on, past the encoded message, so
+	// that the receiver holds part of the database.
+	data := strings.Repeat("snapshot data ", 10)
+	changed := strings.Repeat("changed data ", 10)
+
+	if send(10, data, 48) {
+		t.Fatalf("snapshot expected not sent, got sent")
+	}
+	if n := partials(); n != 1 {
+		t.Fatalf("expected 1 partial snapshot, got %d", n)
+	}
+	if !send(10, data, -1) {
+		t.Fatalf("snapshot expected sent, got not sent")
+	}
+	if firstOffset != 48 {
+		t.Errorf("resumed at offset %d, want %d", firstOffset, 48)
+	}
+	b, err := os.ReadFile(filepath.Join(d, fmt.Sprintf("%016x.snap.db", 10)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != data {
+		t.Errorf("saved snapshot = %q, want %q", b, data)
+	}
+
+	// the snapshot is created again with a changed database
+	if send(20, data, 48) {
+		t.Fatalf("snapshot expected not sent, got sent")
+	}
+	if send(20, changed, -1) {
+		t.Fatalf("changed snapshot expected not sent, got sent")
+	}
+	if n := partials(); n != 0 {
+		t.Fatalf("expected no partial snapshot, got %d", n)
+	}
+	if !send(20, changed, -1) {
+		t.Fatalf("snapshot expected sent, got not sent")
+	}
+	if firstOffset != 0 {
+		t.Errorf("resumed at offset %d, want %d", firstOffset, 0)
+	}
+
+	// a newer snapshot supersedes the partial one
+	if send(30, data, 48) {
+		t.Fatalf("snapshot expected not sent, got sent")
+	}
+	if !send(40, data, -1) {
+		t.Fatalf("snapshot expected sent, got not sent")
+	}
+	for i := 0; partials() != 0; i++ {
+		if i == 100 {
+			t.Fatalf("expected superseded partial snapshot to be removed")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func testSnapshotSendChunked(t *testing.T, compression string, rateLimit uint64, wrap func(http.ResponseWriter, *http.Request, http.Handler), data string) (bool, string, int) {
+	d := t.TempDir()
+
+	recvc := make(chan raftpb.Message, 1)
+	r := &fakeRaft{recvc: recvc}
+	tr := &Transport{
+		pipelineRt:            &http.Transport{},
+		ClusterID:             types.ID(1),
+		Raft:                  r,
+		SnapshotCompression:   compression,
+		SnapshotSendRateLimit: rateLimit,
+		snapLimiter:           newSnapshotRateLimiter(rateLimit),
+	}
+	h := newSnapshotChunkHandler(tr, r, snap.New(zaptest.NewLogger(t), d), types.ID(1))
+	chunks := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc(RaftSnapshotChunkPrefix, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			chunks++
+			if r.Header.Get("Content-Encoding") != compression {
+				t.Errorf("Content-Encoding = %q, want %q", r.Header.Get("Content-Encoding"), compression)
+			}
+		}
+		if wrap != nil {
+			wrap(w, r, h)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	picker := mustNewURLPicker(t, []string{srv.URL})
+	snapsend := newSnapshotSender(tr, picker, types.ID(1), newPeerStatus(zaptest.NewLogger(t), types.ID(0), types.ID(1)))
+	defer snapsend.stop()
+
+	m := raftpb.Message{Type: raftpb.MsgSnap, To: 1, Snapshot: raftpb.Snapshot{Metadata: raftpb.SnapshotMetadata{Index: 10}}}
+	sm := snap.NewMessage(m, io.NopCloser(strings.NewReader(data)), int64(len(data)))
+	snapsend.send(*sm)
+
+	sent := false
+	select {
+	case <-time.After(time.Second):
+		t.Fatalf("timed out sending snapshot")
+	case sent = <-sm.CloseNotify():
+	}
+	if sent {
+		select {
+		case <-recvc:
+		default:
+			t.Errorf("expected snapshot message to be processed")
+		}
+	}
+	return sent, d, chunks
+}
+
+func TestChecksumReaders(t *testing.T) {
+	for _, n := range []int{0, 1, sha256.Size, 100 * 1024} {
+		data := bytes.Repeat([]byte{'a'}, n)
+		r := newChecksumVerifier(iotest.OneByteReader(newChecksumAppender(bytes.NewReader(data))))
+		b, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatalf("size %d: unexpected error %v", n, err)
+		}
+		if !bytes.Equal(b, data) {
+			t.Fatalf("size %d: read %d bytes, want %d", n, len(b), n)
+		}
+	}
+
+	if _, err := io.ReadAll(newChecksumVerifier(strings.NewReader("short"))); err != errSnapshotChecksumMissing {
+		t.Errorf("err = %v, want %v", err, errSnapshotChecksumMissing)
+	}
+}
+
+type fakeSnapshotDelegate struct {
+	m   raftpb.Message
+	err error
+}
+
+func (sd *fakeSnapshotDelegate) SendDelegatedSnapshot(ctx context.Context, m raftpb.Message) error {
+	sd.m = m
+	return sd.err
+}
+
 func testSnapshotSend(t *testing.T, sm *snap.Message) (bool, []os.DirEntry) {
 	d := t.TempDir()
 
@@ -99,7 +432,10 @@ func testSnapshotSend(t *testing.T, sm *snap.Message) (bool, []os.DirEntry) {
 	tr := &Transport{pipelineRt: &http.Transport{}, ClusterID: types.ID(1), Raft: r}
 	ch := make(chan struct{}, 1)
 	h := &syncHandler{newSnapshotHandler(tr, r, snap.New(zaptest.NewLogger(t), d), types.ID(1)), ch}
-	srv := httptest.NewServer(h)
+	// the receiver does not accept snapshots in chunks
+	mux := http.NewServeMux()
+	mux.Handle(RaftSnapshotPrefix, h)
+	srv := httptest.NewServer(mux)
 	defer srv.Close()
 
 	picker := mustNewURLPicker(t, []string{srv.URL})
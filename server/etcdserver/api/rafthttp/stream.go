@@ -28,6 +28,7 @@ import (
 	"go.etcd.io/etcd/client/pkg/v3/transport"
 	"go.etcd.io/etcd/client/pkg/v3/types"
 	"go.etcd.io/etcd/pkg/v3/httputil"
+	pioutil "go.etcd.io/etcd/pkg/v3/ioutil"
 	"go.etcd.io/etcd/raft/v3/raftpb"
 	stats "go.etcd.io/etcd/server/v3/etcdserver/api/v2stats"
 
@@ -584,6 +585,7 @@ func (cr *streamReader) dial(t streamType) (io.ReadCloser, error) {
 	req.Header.Set("X-Min-Cluster-Version", version.MinClusterVersion)
 	req.Header.Set("X-Etcd-Cluster-ID", cr.tr.ClusterID.String())
 	req.Header.Set("X-Raft-To", cr.peerID.String())
+	setAcceptCompressionHeader(req.Header)
 
 	setPeerURLsHeader(req, cr.tr.URLs)
 
@@ -620,7 +622,17 @@ func (cr *streamReader) dial(t streamType) (io.ReadCloser, error) {
 		return nil, errMemberRemoved
 
 	case http.StatusOK:
-		return resp.Body, nil
+		c := resp.Header.Get("X-Etcd-Stream-Compression")
+		if c == "" {
+			return resp.Body, nil
+		}
+		zr, err := decompressReader(c, resp.Body)
+		if err != nil {
+			httputil.GracefulClose(resp)
+			cr.picker.unreachable(u)
+			return nil, err
+		}
+		return &pioutil.ReaderAndCloser{Reader: zr, Closer: resp.Body}, nil
 
 	case http.StatusNotFound:
 		httputil.GracefulClose(resp)
@@ -299,52 +299,54 @@ func TestStream(t *testing.T) {
 			recvc,
 		},
 	}
-	for i, tt := range tests {
-		h := &fakeStreamHandler{t: tt.t}
-		srv := httptest.NewServer(h)
-		defer srv.Close()
-
-		sw := startStreamWriter(zaptest.NewLogger(t), types.ID(0), types.ID(1), newPeerStatus(zaptest.NewLogger(t), types.ID(0), types.ID(1)), &stats.FollowerStats{}, &fakeRaft{})
-		defer sw.stop()
-		h.sw = sw
-
-		picker := mustNewURLPicker(t, []string{srv.URL})
-		tr := &Transport{streamRt: &http.Transport{}, ClusterID: types.ID(1)}
+	for _, compression := range []string{"", CompressionGzip} {
+		for i, tt := range tests {
+			h := &fakeStreamHandler{t: tt.t, compression: compression}
+			srv := httptest.NewServer(h)
+			defer srv.Close()
+
+			sw := startStreamWriter(zaptest.NewLogger(t), types.ID(0), types.ID(1), newPeerStatus(zaptest.NewLogger(t), types.ID(0), types.ID(1)), &stats.FollowerStats{}, &fakeRaft{})
+			defer sw.stop()
+			h.sw = sw
+
+			picker := mustNewURLPicker(t, []string{srv.URL})
+			tr := &Transport{streamRt: &http.Transport{}, ClusterID: types.ID(1)}
+
+			sr := &streamReader{
+				peerID: types.ID(2),
+				typ:    tt.t,
+				tr:     tr,
+				picker: picker,
+				status: newPeerStatus(zaptest.NewLogger(t), types.ID(0), types.ID(2)),
+				recvc:  recvc,
+				propc:  propc,
+				rl:     rate.NewLimiter(rate.Every(100*time.Millisecond), 1),
+			}
+			sr.start()
+
+			// wait for stream to work
+			var writec chan<- raftpb.Message
+			for {
+				var ok bool
+				if writec, ok = sw.writec(); ok {
+					break
+				}
+				time.Sleep(time.Millisecond)
+			}
 
-		sr := &streamReader{
-			peerID: types.ID(2),
-			typ:    tt.t,
-			tr:     tr,
-			picker: picker,
-			status: newPeerStatus(zaptest.NewLogger(t), types.ID(0), types.ID(2)),
-			recvc:  recvc,
-			propc:  propc,
-			rl:     rate.NewLimiter(rate.Every(100*time.Millisecond), 1),
-		}
-		sr.start()
-
-		// wait for stream to work
-		var writec chan<- raftpb.Message
-		for {
-			var ok bool
-			if writec, ok = sw.writec(); ok {
-				break
+			writec <- tt.m
+			var m raftpb.Message
+			select {
+			case m = <-tt.wc:
+			case <-time.After(time.Second):
+				t.Fatalf("#%d (compression %q): failed to receive message from the channel", i, compression)
+			}
+			if !reflect.DeepEqual(m, tt.m) {
+				t.Fatalf("#%d (compression %q): message = %+v, want %+v", i, compression, m, tt.m)
 			}
-			time.Sleep(time.Millisecond)
-		}
 
-		writec <- tt.m
-		var m raftpb.Message
-		select {
-		case m = <-tt.wc:
-		case <-time.After(time.Second):
-			t.Fatalf("#%d: failed to receive message from the channel", i)
-		}
-		if !reflect.DeepEqual(m, tt.m) {
-			t.Fatalf("#%d: message = %+v, want %+v", i, m, tt.m)
+			sr.stop()
 		}
-
-		sr.stop()
 	}
 }
 
@@ -438,19 +440,27 @@ func (wfc *fakeWriteFlushCloser) Closed() bool {
 }
 
 type fakeStreamHandler struct {
-	t  streamType
-	sw *streamWriter
+	t           streamType
+	sw          *streamWriter
+	compression string
 }
 
 func (h *fakeStreamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	w.Header().Add("X-Server-Version", version.Version)
+	if h.compression != "" && acceptsCompression(r.Header.Get("X-Etcd-Accept-Compression"), h.compression) {
+		w.Header().Set("X-Etcd-Stream-Compression", h.compression)
+	}
 	w.(http.Flusher).Flush()
 	c := newCloseNotifier()
-	h.sw.attach(&outgoingConn{
+	conn := &outgoingConn{
 		t:       h.t,
 		Writer:  w,
 		Flusher: w.(http.Flusher),
 		Closer:  c,
-	})
+	}
+	if w.Header().Get("X-Etcd-Stream-Compression") != "" {
+		compressOutgoingConn(conn, h.compression)
+	}
+	h.sw.attach(conn)
 	<-c.closeNotify()
 }
@@ -39,6 +39,16 @@ type Raft interface {
 	ReportSnapshot(id uint64, status raft.SnapshotStatus)
 }
 
+// SnapshotDelegate is implemented by a Raft that can send snapshots on behalf
+// of the leader. The Transport only serves delegated snapshot requests if its
+// Raft implements it.
+type SnapshotDelegate interface {
+	// SendDelegatedSnapshot sends a snapshot of the local state to the
+	// receiver of the given MsgSnap message on behalf of its sender. It
+	// returns once the snapshot has been sent, or failed to be sent.
+	SendDelegatedSnapshot(ctx context.Context, m raftpb.Message) error
+}
+
 type Transporter interface {
 	// Start starts the given Transporter.
 	// Start MUST be called before calling other functions in the interface.
@@ -58,6 +68,10 @@ type Transporter interface {
 	// SendSnapshot sends out the given snapshot message to a remote peer.
 	// The behavior of SendSnapshot is similar to Send.
 	SendSnapshot(m snap.Message)
+	// DelegateSnapshot asks the given peer to send a snapshot of its own
+	// state to the receiver of the snapshot message in place of the local
+	// member. The snapshot status is reported as with SendSnapshot.
+	DelegateSnapshot(delegate types.ID, m snap.Message)
 	// AddRemote adds a remote with given peer urls into the transport.
 	// A remote helps newly joined member to catch up the progress of cluster,
 	// and will not be used after that.
@@ -119,9 +133,23 @@ type Transport struct {
 	// machine and thus stop the Transport.
 	ErrorC chan error
 
+	// PeerCompression returns the compression applied to the stream and
+	// pipeline messages sent to the given peer, if the peer accepts it.
+	// A nil function or an empty compression means no compression.
+	PeerCompression func(id types.ID) string
+
+	// SnapshotCompression is the compression applied to the chunks of
+	// snapshots sent to peers that accept it. Empty means no compression.
+	SnapshotCompression string
+	// SnapshotSendRateLimit limits the rate, in bytes per second, at which
+	// snapshots are sent to all peers. Zero means no limit.
+	SnapshotSendRateLimit uint64
+
 	streamRt   http.RoundTripper // roundTripper used by streams
 	pipelineRt http.RoundTripper // roundTripper used by pipelines
 
+	snapLimiter *rate.Limiter // limits the rate of snapshots sent to peers
+
 	mu      sync.RWMutex         // protect the remote and peer map
 	remotes map[types.ID]*remote // remotes map that helps newly joined member to catch up
 	peers   map[types.ID]Peer    // peers map
@@ -131,6 +159,9 @@ type Transport struct {
 }
 
 func (t *Transport) Start() error {
+	if err := checkCompression(t.SnapshotCompression); err != nil {
+		return err
+	}
 	var err error
 	t.streamRt, err = newStreamRoundTripper(t.TLSInfo, t.DialTimeout)
 	if err != nil {
@@ -144,6 +175,7 @@ func (t *Transport) Start() error {
 	t.peers = make(map[types.ID]Peer)
 	t.pipelineProber = probing.NewProber(t.pipelineRt)
 	t.streamProber = probing.NewProber(t.streamRt)
+	t.snapLimiter = newSnapshotRateLimiter(t.SnapshotSendRateLimit)
 
 	// If client didn't provide dial retry frequency, use the default
 	// (100ms backoff between attempts to create a new stream),
@@ -154,15 +186,35 @@ func (t *Transport) Start() error {
 	return nil
 }
 
+// peerCompression returns the compression to apply to the messages sent to
+// the given peer.
+func (t *Transport) peerCompression(id types.ID) string {
+	if t.PeerCompression == nil {
+		return ""
+	}
+	c := t.PeerCompression(id)
+	if err := checkCompression(c); err != nil {
+		if t.Logger != nil {
+			t.Logger.Warn("ignored unsupported peer compression", zap.String("remote-peer-id", id.String()), zap.Error(err))
+		}
+		return ""
+	}
+	return c
+}
+
 func (t *Transport) Handler() http.Handler {
 	pipelineHandler := newPipelineHandler(t, t.Raft, t.ClusterID)
 	streamHandler := newStreamHandler(t, t, t.Raft, t.ID, t.ClusterID)
-	snapHandler := newSnapshotHandler(t, t.Raft, t.Snapshotter, t.ClusterID)
+	snapHandler := newSnapshotChunkHandler(t, t.Raft, t.Snapshotter, t.ClusterID)
 	mux := http.NewServeMux()
 	mux.Handle(RaftPrefix, pipelineHandler)
 	mux.Handle(RaftStreamPrefix+"/", streamHandler)
-	mux.Handle(RaftSnapshotPrefix, snapHandler)
+	mux.Handle(RaftSnapshotPrefix, snapHandler.snapshotHandler)
+	mux.Handle(RaftSnapshotChunkPrefix, snapHandler)
 	mux.Handle(ProbingPrefix, probing.NewHandler())
+	if sd, ok := t.Raft.(SnapshotDelegate); ok {
+		mux.Handle(RaftSnapshotDelegatePrefix, newSnapshotDelegateHandler(t, sd, t.ClusterID))
+	}
 	return mux
 }
 
@@ -416,6 +468,18 @@ func (t *Transport) SendSnapshot(m snap.Message) {
 	p.sendSnap(m)
 }
 
+func (t *Transport) DelegateSnapshot(delegate types.ID, m snap.Message) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	p := t.peers[delegate]
+	if p == nil {
+		m.CloseWithError(errMemberNotFound)
+		t.Raft.ReportSnapshot(m.To, raft.SnapshotFailure)
+		return
+	}
+	p.delegateSnap(m)
+}
+
 // Pausable is a testing interface for pausing transport traffic.
 type Pausable interface {
 	Pause()
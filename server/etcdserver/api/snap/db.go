@@ -73,6 +73,13 @@ func (s *Snapshotter) SaveDBFrom(r io.Reader, id uint64) (int64, error) {
 	return n, nil
 }
 
+// CreatePartialDB creates a temporary file in the snapshot directory to hold
+// a database snapshot that is still being received. Its name is prefixed
+// with "db.tmp" so that it is cleaned up if the transfer is never completed.
+func (s *Snapshotter) CreatePartialDB() (*os.File, error) {
+	return os.CreateTemp(s.dir, "db.tmp.partial")
+}
+
 // DBFilePath returns the file path for the snapshot of the database with
 // given id. If the snapshot does not exist, it returns error.
 func (s *Snapshotter) DBFilePath(id uint64) (string, error) {
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
 
 	"github.com/jonboulle/clockwork"
 	"go.uber.org/zap"
@@ -28,6 +29,8 @@ import (
 const (
 	ModePeriodic = "periodic"
 	ModeRevision = "revision"
+	ModeSize     = "size"
+	ModeHybrid   = "hybrid"
 )
 
 // Compactor purges old log from the storage periodically.
@@ -51,12 +54,31 @@ type RevGetter interface {
 	Rev() int64
 }
 
+// StatsGetter provides the statistics the "size" and "hybrid" modes
+// decide the compactions on.
+type StatsGetter interface {
+	RevGetter
+	Stats() mvcc.Stats
+}
+
+// Targets are the limits the "size" and "hybrid" modes keep the history
+// under. A zero target is not enforced.
+type Targets struct {
+	// MaxBytes is the backend size in use to keep under.
+	MaxBytes int64
+	// MaxRevisionsPerKey is the average number of historical revisions
+	// per key to keep under. It is not a limit on any single key: a few
+	// frequently updated keys may keep more revisions than it.
+	MaxRevisionsPerKey int64
+}
+
 // New returns a new Compactor based on given "mode".
 func New(
 	lg *zap.Logger,
 	mode string,
 	retention time.Duration,
-	rg RevGetter,
+	targets Targets,
+	sg StatsGetter,
 	c Compactable,
 ) (Compactor, error) {
 	if lg == nil {
@@ -64,9 +86,16 @@ func New(
 	}
 	switch mode {
 	case ModePeriodic:
-		return newPeriodic(lg, clockwork.NewRealClock(), retention, rg, c), nil
+		return newPeriodic(lg, clockwork.NewRealClock(), retention, sg, c), nil
 	case ModeRevision:
-		return newRevision(lg, clockwork.NewRealClock(), int64(retention), rg, c), nil
+		return newRevision(lg, clockwork.NewRealClock(), int64(retention), sg, c), nil
+	case ModeSize:
+		return newSize(lg, clockwork.NewRealClock(), targets, sg, c), nil
+	case ModeHybrid:
+		if retention <= 0 {
+			return nil, fmt.Errorf("compaction mode %s requires a retention", mode)
+		}
+		return newHybrid(lg, clockwork.NewRealClock(), retention, targets, sg, c), nil
 	default:
 		return nil, fmt.Errorf("unsupported compaction mode %s", mode)
 	}
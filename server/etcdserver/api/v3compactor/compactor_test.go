@@ -16,10 +16,12 @@ package v3compactor
 
 import (
 	"context"
+	"sync"
 	"sync/atomic"
 
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/client/pkg/v3/testutil"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
 )
 
 type fakeCompactable struct {
@@ -45,3 +47,22 @@ func (fr *fakeRevGetter) Rev() int64 {
 func (fr *fakeRevGetter) SetRev(rev int64) {
 	atomic.StoreInt64(&fr.rev, rev)
 }
+
+type fakeStatsGetter struct {
+	*fakeRevGetter
+
+	mu sync.Mutex
+	st mvcc.Stats
+}
+
+func (fs *fakeStatsGetter) Stats() mvcc.Stats {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	return fs.st
+}
+
+func (fs *fakeStatsGetter) SetStats(st mvcc.Stats) {
+	fs.mu.Lock()
+	fs.st = st
+	fs.mu.Unlock()
+}
@@ -0,0 +1,152 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3compactor
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"go.uber.org/zap"
+)
+
+// Hybrid compacts the log like Size, but never purges revisions newer
+// than the configured retention time. The history is kept for at least
+// the retention time, and under the targets as far as that allows.
+type Hybrid struct {
+	lg      *zap.Logger
+	clock   clockwork.Clock
+	period  time.Duration
+	targets Targets
+
+	sg StatsGetter
+	c  Compactable
+
+	revs   []int64
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// mu protects paused
+	mu     sync.RWMutex
+	paused bool
+}
+
+// newHybrid creates a new instance of Hybrid compactor that purges the
+// oldest revisions to keep the history under the targets, but keeps the
+// log of the last h Duration.
+func newHybrid(lg *zap.Logger, clock clockwork.Clock, h time.Duration, targets Targets, sg StatsGetter, c Compactable) *Hybrid {
+	hc := &Hybrid{
+		lg:      lg,
+		clock:   clock,
+		period:  h,
+		targets: targets,
+		sg:      sg,
+		c:       c,
+	}
+	// revs won't be longer than the retentions.
+	hc.revs = make([]int64, 0, hc.getRetentions())
+	hc.ctx, hc.cancel = context.WithCancel(context.Background())
+	return hc
+}
+
+// Run runs hybrid compactor. Like Periodic, it records the revision every
+// 1/10 of the retention time (at most 6 minutes), so that revs[0] is the
+// revision at the retention time ago, and checks the targets as often.
+func (hc *Hybrid) Run() {
+	retryInterval := hc.getRetryInterval()
+	retentions := hc.getRetentions()
+
+	go func() {
+		prev := int64(0)
+		for {
+			hc.revs = append(hc.revs, hc.sg.Rev())
+			if len(hc.revs) > retentions {
+				hc.revs = hc.revs[1:] // hc.revs[0] is always the rev at hc.period ago
+			}
+
+			select {
+			case <-hc.ctx.Done():
+				return
+			case <-hc.clock.After(retryInterval):
+				hc.mu.RLock()
+				p := hc.paused
+				hc.mu.RUnlock()
+				if p {
+					compactorDecisions.WithLabelValues(ModeHybrid, decisionPaused).Inc()
+					continue
+				}
+			}
+
+			st := hc.sg.Stats()
+			rev := hc.targets.compactRev(st)
+			if rev <= 0 {
+				compactorDecisions.WithLabelValues(ModeHybrid, decisionKeep).Inc()
+				continue
+			}
+			// keep the history of the retention time, even if that
+			// leaves it over the targets
+			if len(hc.revs) < retentions {
+				compactorDecisions.WithLabelValues(ModeHybrid, decisionHold).Inc()
+				continue
+			}
+			if rev > hc.revs[0] {
+				rev = hc.revs[0]
+				if rev <= st.CompactRev || rev == prev {
+					compactorDecisions.WithLabelValues(ModeHybrid, decisionHold).Inc()
+					continue
+				}
+			}
+			if rev == prev {
+				compactorDecisions.WithLabelValues(ModeHybrid, decisionKeep).Inc()
+				continue
+			}
+			if compactTo(hc.ctx, hc.lg, hc.clock, hc.c, ModeHybrid, rev, st, retryInterval) {
+				prev = rev
+			}
+		}
+	}()
+}
+
+func (hc *Hybrid) getRetentions() int {
+	return int(hc.period/hc.getRetryInterval()) + 1
+}
+
+func (hc *Hybrid) getRetryInterval() time.Duration {
+	itv := hc.period
+	if itv > time.Hour {
+		itv = time.Hour
+	}
+	return itv / retryDivisor
+}
+
+// Stop stops hybrid compactor.
+func (hc *Hybrid) Stop() {
+	hc.cancel()
+}
+
+// Pause pauses hybrid compactor.
+func (hc *Hybrid) Pause() {
+	hc.mu.Lock()
+	hc.paused = true
+	hc.mu.Unlock()
+}
+
+// Resume resumes hybrid compactor.
+func (hc *Hybrid) Resume() {
+	hc.mu.Lock()
+	hc.paused = false
+	hc.mu.Unlock()
+}
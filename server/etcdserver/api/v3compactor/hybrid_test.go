@@ -0,0 +1,125 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3compactor
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/client/pkg/v3/testutil"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/jonboulle/clockwork"
+)
+
+func TestHybrid(t *testing.T) {
+	fc := clockwork.NewFakeClock()
+	sg := &fakeStatsGetter{fakeRevGetter: &fakeRevGetter{testutil.NewRecorderStreamWithWaitTimout(10 * time.Millisecond), 0}}
+	sg.SetStats(mvcc.Stats{Rev: 1000, CompactRev: -1, Keys: 10, Revisions: 1000, SizeInUse: 10000})
+	compactable := &fakeCompactable{testutil.NewRecorderStreamWithWaitTimout(10 * time.Millisecond)}
+	hc := newHybrid(zaptest.NewLogger(t), fc, time.Hour, Targets{MaxBytes: 5000}, sg, compactable)
+
+	hc.Run()
+	defer hc.Stop()
+
+	// the history of the last hour is held even over the targets
+	for i := 0; i < hc.getRetentions()-1; i++ {
+		sg.Wait(1)
+		fc.BlockUntil(1)
+		fc.Advance(hc.getRetryInterval())
+	}
+	select {
+	case a := <-compactable.Chan():
+		t.Fatalf("unexpected action %v", a)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	// compaction is limited to the revision an hour ago
+	sg.Wait(1)
+	fc.BlockUntil(1)
+	fc.Advance(hc.getRetryInterval())
+	a, err := compactable.Wait(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedRevision := int64(1)
+	if !reflect.DeepEqual(a[0].Params[0], &pb.CompactionRequest{Revision: expectedRevision}) {
+		t.Errorf("compact request = %v, want %v", a[0].Params[0], &pb.CompactionRequest{Revision: expectedRevision})
+	}
+
+	// history under the targets is kept
+	sg.SetStats(mvcc.Stats{Rev: 1000, CompactRev: 1, Keys: 10, Revisions: 500, SizeInUse: 5000})
+	sg.Wait(1)
+	fc.BlockUntil(1)
+	fc.Advance(hc.getRetryInterval())
+	if a, err = compactable.Wait(1); err == nil {
+		t.Fatalf("unexpected action %v", a)
+	}
+
+	sg.SetStats(mvcc.Stats{Rev: 1000, CompactRev: 1, Keys: 10, Revisions: 1000, SizeInUse: 10000})
+	sg.Wait(1)
+	fc.BlockUntil(1)
+	fc.Advance(hc.getRetryInterval())
+	a, err = compactable.Wait(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedRevision = int64(3)
+	if !reflect.DeepEqual(a[0].Params[0], &pb.CompactionRequest{Revision: expectedRevision}) {
+		t.Errorf("compact request = %v, want %v", a[0].Params[0], &pb.CompactionRequest{Revision: expectedRevision})
+	}
+}
+
+func TestHybridPause(t *testing.T) {
+	fc := clockwork.NewFakeClock()
+	sg := &fakeStatsGetter{fakeRevGetter: &fakeRevGetter{&testutil.RecorderBuffered{}, 0}}
+	sg.SetStats(mvcc.Stats{Rev: 1000, CompactRev: -1, Keys: 10, Revisions: 1000, SizeInUse: 10000})
+	compactable := &fakeCompactable{testutil.NewRecorderStream()}
+	hc := newHybrid(zaptest.NewLogger(t), fc, time.Hour, Targets{MaxBytes: 5000}, sg, compactable)
+
+	hc.Run()
+	hc.Pause()
+
+	// hc will collect 3 hours of revisions but not compact since paused
+	for i := 0; i < 3*hc.getRetentions(); i++ {
+		fc.BlockUntil(1)
+		fc.Advance(hc.getRetryInterval())
+	}
+
+	select {
+	case a := <-compactable.Chan():
+		t.Fatalf("unexpected action %v", a)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	// hc resumes to being blocked on the clock
+	hc.Resume()
+
+	fc.BlockUntil(1)
+	fc.Advance(hc.getRetryInterval())
+	a, err := compactable.Wait(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the revision an hour ago is the one recorded 10 intervals earlier
+	expectedRevision := int64(3*hc.getRetentions() + 1 - (hc.getRetentions() - 1))
+	if !reflect.DeepEqual(a[0].Params[0], &pb.CompactionRequest{Revision: expectedRevision}) {
+		t.Errorf("compact request = %v, want %v", a[0].Params[0], &pb.CompactionRequest{Revision: expectedRevision})
+	}
+	hc.Stop()
+}
@@ -0,0 +1,56 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3compactor
+
+import "github.com/prometheus/client_golang/prometheus"
+
+const (
+	// decisionCompact is a compaction that was started.
+	decisionCompact = "compact"
+	// decisionKeep is a check that found the history within the targets.
+	decisionKeep = "keep"
+	// decisionHold is a compaction that was held back or limited to keep
+	// the history of the retention period.
+	decisionHold = "hold"
+	// decisionPaused is a check skipped while the compactor is paused.
+	decisionPaused = "paused"
+	// decisionFail is a compaction that failed.
+	decisionFail = "fail"
+)
+
+var (
+	compactorDecisions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "etcd_debugging",
+		Subsystem: "server",
+		Name:      "auto_compaction_decisions_total",
+		Help:      "The total number of decisions made by the size and hybrid auto compactors.",
+	},
+		[]string{"mode", "decision"},
+	)
+
+	compactorTargetRevision = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "etcd_debugging",
+		Subsystem: "server",
+		Name:      "auto_compaction_target_revision",
+		Help:      "The revision the size and hybrid auto compactors last decided to compact to.",
+	},
+		[]string{"mode"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(compactorDecisions)
+	prometheus.MustRegister(compactorTargetRevision)
+}
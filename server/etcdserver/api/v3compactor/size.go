@@ -0,0 +1,191 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3compactor
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+
+	"github.com/jonboulle/clockwork"
+	"go.uber.org/zap"
+)
+
+// Size compacts the log by purging the oldest revisions whenever the
+// history held by the store exceeds the configured targets. The store
+// statistics are checked every 5 minutes.
+type Size struct {
+	lg *zap.Logger
+
+	clock   clockwork.Clock
+	targets Targets
+
+	sg StatsGetter
+	c  Compactable
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu     sync.Mutex
+	paused bool
+}
+
+// newSize creates a new instance of Size compactor that purges the oldest
+// revisions to keep the history under the targets.
+func newSize(lg *zap.Logger, clock clockwork.Clock, targets Targets, sg StatsGetter, c Compactable) *Size {
+	sc := &Size{
+		lg:      lg,
+		clock:   clock,
+		targets: targets,
+		sg:      sg,
+		c:       c,
+	}
+	sc.ctx, sc.cancel = context.WithCancel(context.Background())
+	return sc
+}
+
+const sizeInterval = 5 * time.Minute
+
+// Run runs size-based compactor.
+func (sc *Size) Run() {
+	prev := int64(0)
+	go func() {
+		for {
+			select {
+			case <-sc.ctx.Done():
+				return
+			case <-sc.clock.After(sizeInterval):
+				sc.mu.Lock()
+				p := sc.paused
+				sc.mu.Unlock()
+				if p {
+					compactorDecisions.WithLabelValues(ModeSize, decisionPaused).Inc()
+					continue
+				}
+			}
+
+			st := sc.sg.Stats()
+			rev := sc.targets.compactRev(st)
+			if rev <= 0 || rev == prev {
+				compactorDecisions.WithLabelValues(ModeSize, decisionKeep).Inc()
+				continue
+			}
+			if compactTo(sc.ctx, sc.lg, sc.clock, sc.c, ModeSize, rev, st, sizeInterval) {
+				prev = rev
+			}
+		}
+	}()
+}
+
+// compactRev returns the revision to compact to for the history described
+// by st to meet the targets, or 0 if it already meets them. The revision is
+// estimated from the average size of a revision, and the store is checked
+// again after the compaction, so the targets are met over a few rounds.
+func (t Targets) compactRev(st mvcc.Stats) int64 {
+	if st.Revisions <= 0 {
+		return 0
+	}
+	// excess is the number of revisions to purge
+	excess := int64(0)
+	if t.MaxBytes > 0 && st.SizeInUse > t.MaxBytes {
+		revSize := st.SizeInUse / st.Revisions
+		if revSize == 0 {
+			revSize = 1
+		}
+		excess = (st.SizeInUse - t.MaxBytes + revSize - 1) / revSize
+	}
+	if t.MaxRevisionsPerKey > 0 {
+		// every key holds its latest revision besides the historical ones;
+		// the target is an average, the store does not track the history
+		// of each key
+		if e := st.Revisions - st.Keys*(t.MaxRevisionsPerKey+1); e > excess {
+			excess = e
+		}
+	}
+	if excess <= 0 {
+		return 0
+	}
+
+	rev := st.CompactRev
+	if rev < 0 {
+		rev = 0
+	}
+	// excess counts the revisions of keys, while a txn writes all of its
+	// keys at a single main revision, so convert it with the average number
+	// of key revisions per main revision
+	if span := st.Rev - rev; span > 0 && span < st.Revisions {
+		excess = (excess*span + st.Revisions - 1) / st.Revisions
+	}
+	rev += excess
+	if rev > st.Rev {
+		rev = st.Rev
+	}
+	return rev
+}
+
+// compactTo compacts the store to rev and records the decision of the
+// compactor of the given mode. It returns true if the compaction succeeded.
+func compactTo(ctx context.Context, lg *zap.Logger, clock clockwork.Clock, c Compactable, mode string, rev int64, st mvcc.Stats, retryInterval time.Duration) bool {
+	compactorDecisions.WithLabelValues(mode, decisionCompact).Inc()
+	compactorTargetRevision.WithLabelValues(mode).Set(float64(rev))
+
+	startTime := clock.Now()
+	lg.Info(
+		"starting auto "+mode+" compaction",
+		zap.Int64("revision", rev),
+		zap.Int64("revisions", st.Revisions),
+		zap.Int64("keys", st.Keys),
+		zap.Int64("size-in-use", st.SizeInUse),
+	)
+	_, err := c.Compact(ctx, &pb.CompactionRequest{Revision: rev})
+	if err == nil || err == mvcc.ErrCompacted {
+		lg.Info(
+			"completed auto "+mode+" compaction",
+			zap.Int64("revision", rev),
+			zap.Duration("took", clock.Now().Sub(startTime)),
+		)
+		return true
+	}
+	compactorDecisions.WithLabelValues(mode, decisionFail).Inc()
+	lg.Warn(
+		"failed auto "+mode+" compaction",
+		zap.Int64("revision", rev),
+		zap.Duration("retry-interval", retryInterval),
+		zap.Error(err),
+	)
+	return false
+}
+
+// Stop stops size-based compactor.
+func (sc *Size) Stop() {
+	sc.cancel()
+}
+
+// Pause pauses size-based compactor.
+func (sc *Size) Pause() {
+	sc.mu.Lock()
+	sc.paused = true
+	sc.mu.Unlock()
+}
+
+// Resume resumes size-based compactor.
+func (sc *Size) Resume() {
+	sc.mu.Lock()
+	sc.paused = false
+	sc.mu.Unlock()
+}
@@ -0,0 +1,180 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3compactor
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/client/pkg/v3/testutil"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/jonboulle/clockwork"
+)
+
+func TestTargetsCompactRev(t *testing.T) {
+	tests := []struct {
+		name    string
+		targets Targets
+		st      mvcc.Stats
+		wrev    int64
+	}{
+		{
+			"no targets",
+			Targets{},
+			mvcc.Stats{Rev: 100, CompactRev: -1, Keys: 10, Revisions: 100, SizeInUse: 1000},
+			0,
+		},
+		{
+			"under max bytes",
+			Targets{MaxBytes: 1000},
+			mvcc.Stats{Rev: 100, CompactRev: -1, Keys: 10, Revisions: 100, SizeInUse: 1000},
+			0,
+		},
+		{
+			"over max bytes",
+			Targets{MaxBytes: 600},
+			mvcc.Stats{Rev: 100, CompactRev: -1, Keys: 10, Revisions: 100, SizeInUse: 1000},
+			40,
+		},
+		{
+			"over max bytes after compaction",
+			Targets{MaxBytes: 600},
+			mvcc.Stats{Rev: 150, CompactRev: 50, Keys: 10, Revisions: 100, SizeInUse: 1000},
+			90,
+		},
+		{
+			"over max revisions per key",
+			Targets{MaxRevisionsPerKey: 4},
+			mvcc.Stats{Rev: 100, CompactRev: -1, Keys: 10, Revisions: 100, SizeInUse: 1000},
+			50,
+		},
+		{
+			"larger excess wins",
+			Targets{MaxBytes: 600, MaxRevisionsPerKey: 4},
+			mvcc.Stats{Rev: 100, CompactRev: -1, Keys: 10, Revisions: 100, SizeInUse: 1000},
+			50,
+		},
+		{
+			"several keys per revision",
+			Targets{MaxBytes: 1200},
+			mvcc.Stats{Rev: 100, CompactRev: -1, Keys: 10, Revisions: 200, SizeInUse: 2000},
+			40,
+		},
+		{
+			"capped at current revision",
+			Targets{MaxBytes: 1},
+			mvcc.Stats{Rev: 100, CompactRev: -1, Keys: 10, Revisions: 100, SizeInUse: 1000},
+			100,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if rev := tt.targets.compactRev(tt.st); rev != tt.wrev {
+				t.Errorf("compactRev = %d, want %d", rev, tt.wrev)
+			}
+		})
+	}
+}
+
+func TestSize(t *testing.T) {
+	fc := clockwork.NewFakeClock()
+	sg := &fakeStatsGetter{fakeRevGetter: &fakeRevGetter{testutil.NewRecorderStreamWithWaitTimout(10 * time.Millisecond), 0}}
+	sg.SetStats(mvcc.Stats{Rev: 100, CompactRev: -1, Keys: 10, Revisions: 100, SizeInUse: 1000})
+	compactable := &fakeCompactable{testutil.NewRecorderStreamWithWaitTimout(10 * time.Millisecond)}
+	sc := newSize(zaptest.NewLogger(t), fc, Targets{MaxBytes: 600}, sg, compactable)
+
+	sc.Run()
+	defer sc.Stop()
+
+	fc.BlockUntil(1)
+	fc.Advance(sizeInterval)
+	a, err := compactable.Wait(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedRevision := int64(40)
+	if !reflect.DeepEqual(a[0].Params[0], &pb.CompactionRequest{Revision: expectedRevision}) {
+		t.Errorf("compact request = %v, want %v", a[0].Params[0], &pb.CompactionRequest{Revision: expectedRevision})
+	}
+
+	// skip the same revision
+	fc.BlockUntil(1)
+	fc.Advance(sizeInterval)
+	if a, err = compactable.Wait(1); err == nil {
+		t.Fatalf("unexpected action %v", a)
+	}
+
+	// history under the targets is kept
+	sg.SetStats(mvcc.Stats{Rev: 100, CompactRev: 40, Keys: 10, Revisions: 60, SizeInUse: 600})
+	fc.BlockUntil(1)
+	fc.Advance(sizeInterval)
+	if a, err = compactable.Wait(1); err == nil {
+		t.Fatalf("unexpected action %v", a)
+	}
+
+	sg.SetStats(mvcc.Stats{Rev: 200, CompactRev: 40, Keys: 10, Revisions: 160, SizeInUse: 1600})
+	fc.BlockUntil(1)
+	fc.Advance(sizeInterval)
+	a, err = compactable.Wait(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedRevision = int64(140)
+	if !reflect.DeepEqual(a[0].Params[0], &pb.CompactionRequest{Revision: expectedRevision}) {
+		t.Errorf("compact request = %v, want %v", a[0].Params[0], &pb.CompactionRequest{Revision: expectedRevision})
+	}
+}
+
+func TestSizePause(t *testing.T) {
+	fc := clockwork.NewFakeClock()
+	sg := &fakeStatsGetter{fakeRevGetter: &fakeRevGetter{&testutil.RecorderBuffered{}, 0}}
+	sg.SetStats(mvcc.Stats{Rev: 100, CompactRev: -1, Keys: 10, Revisions: 100, SizeInUse: 1000})
+	compactable := &fakeCompactable{testutil.NewRecorderStream()}
+	sc := newSize(zaptest.NewLogger(t), fc, Targets{MaxBytes: 600}, sg, compactable)
+
+	sc.Run()
+	sc.Pause()
+
+	// sc will check 3 times but not compact since paused
+	for i := 0; i < 3; i++ {
+		fc.BlockUntil(1)
+		fc.Advance(sizeInterval)
+	}
+
+	select {
+	case a := <-compactable.Chan():
+		t.Fatalf("unexpected action %v", a)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	// sc resumes to being blocked on the clock
+	sc.Resume()
+
+	fc.BlockUntil(1)
+	fc.Advance(sizeInterval)
+	a, err := compactable.Wait(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedRevision := int64(40)
+	if !reflect.DeepEqual(a[0].Params[0], &pb.CompactionRequest{Revision: expectedRevision}) {
+		t.Errorf("compact request = %v, want %v", a[0].Params[0], &pb.CompactionRequest{Revision: expectedRevision})
+	}
+	sc.Stop()
+}
@@ -23,6 +23,7 @@ import (
 	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	"go.etcd.io/etcd/client/pkg/v3/types"
 	"go.etcd.io/etcd/raft/v3"
+	"go.etcd.io/etcd/server/v3/auth"
 	"go.etcd.io/etcd/server/v3/etcdserver"
 	"go.etcd.io/etcd/server/v3/etcdserver/api"
 
@@ -53,6 +54,19 @@ func newUnaryInterceptor(s *etcdserver.EtcdServer) grpc.UnaryServerInterceptor {
 			return nil, rpctypes.ErrGRPCNotSupportedForLearner
 		}
 
+		if s.IsWitness() && !isRPCSupportedForWitness(req) {
+			return nil, rpctypes.ErrGRPCNotSupportedForWitness
+		}
+
+		ai, err := checkRateLimit(ctx, s)
+		if err != nil {
+			return nil, err
+		}
+		if ai != nil {
+			// the handler uses the auth info instead of verifying the token again
+			ctx = etcdserver.WithAuthInfo(ctx, ai)
+		}
+
 		md, ok := metadata.FromIncomingContext(ctx)
 		if ok {
 			ver, vs := "unknown", md.Get(rpctypes.MetadataClientAPIVersionKey)
@@ -222,6 +236,14 @@ func newStreamInterceptor(s *etcdserver.EtcdServer) grpc.StreamServerInterceptor
 			return rpctypes.ErrGRPCNotSupportedForLearner
 		}
 
+		if s.IsWitness() { // witness does not support stream RPC
+			return rpctypes.ErrGRPCNotSupportedForWitness
+		}
+
+		// Every request received on a stream is charged to the rate limits of
+		// its user.
+		ss = rateLimitedServerStream{ServerStream: ss, s: s}
+
 		md, ok := metadata.FromIncomingContext(ss.Context())
 		if ok {
 			ver, vs := "unknown", md.Get(rpctypes.MetadataClientAPIVersionKey)
@@ -259,6 +281,20 @@ func newStreamInterceptor(s *etcdserver.EtcdServer) grpc.StreamServerInterceptor
 	}
 }
 
+// checkRateLimit takes a request from the rate limits of the user sending it
+// and its roles, and returns the auth info of the user.
+func checkRateLimit(ctx context.Context, s *etcdserver.EtcdServer) (*auth.AuthInfo, error) {
+	ai, err := s.AuthInfoFromCtx(ctx)
+	if err != nil || ai == nil {
+		// leave requests without a valid user to the auth checks of the handlers
+		return nil, nil
+	}
+	if err := s.AuthStore().AllowRequest(ai); err != nil {
+		return nil, togRPCError(err)
+	}
+	return ai, nil
+}
+
 // cancellableContext wraps a context with new cancellable context that allows a
 // specific cancellation error to be preserved and later retrieved using the
 // Context.Err() function. This is so downstream context users can disambiguate
@@ -309,6 +345,22 @@ type serverStreamWithCtx struct {
 
 func (ssc serverStreamWithCtx) Context() context.Context { return ssc.ctx }
 
+// rateLimitedServerStream charges every message received to the rate limits
+// of the user sending it. The auth info is read again for each message, since
+// the token of the stream may expire while it is open.
+type rateLimitedServerStream struct {
+	grpc.ServerStream
+	s *etcdserver.EtcdServer
+}
+
+func (rs rateLimitedServerStream) RecvMsg(m interface{}) error {
+	if err := rs.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	_, err := checkRateLimit(rs.Context(), rs.s)
+	return err
+}
+
 func monitorLeader(s *etcdserver.EtcdServer) *streamsMap {
 	smap := &streamsMap{
 		streams: make(map[grpc.ServerStream]struct{}),
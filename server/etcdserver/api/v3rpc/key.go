@@ -22,6 +22,7 @@ import (
 	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	"go.etcd.io/etcd/pkg/v3/adt"
 	"go.etcd.io/etcd/server/v3/etcdserver"
+	"go.etcd.io/etcd/server/v3/lease"
 )
 
 type kvServer struct {
@@ -52,6 +53,27 @@ func (s *kvServer) Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeResp
 	return resp, nil
 }
 
+func (s *kvServer) RangeStream(r *pb.RangeRequest, stream pb.KV_RangeStreamServer) error {
+	if err := checkRangeRequest(r); err != nil {
+		return err
+	}
+
+	var sendErr error
+	err := s.kv.RangeStream(stream.Context(), r, func(resp *pb.RangeResponse) error {
+		s.hdr.fill(resp.Header)
+		sendErr = stream.Send(&pb.RangeStreamResponse{RangeResponse: resp})
+		return sendErr
+	})
+	if sendErr != nil {
+		// already a gRPC error
+		return sendErr
+	}
+	if err != nil {
+		return togRPCError(err)
+	}
+	return nil
+}
+
 func (s *kvServer) Put(ctx context.Context, r *pb.PutRequest) (*pb.PutResponse, error) {
 	if err := checkPutRequest(r); err != nil {
 		return nil, err
@@ -124,6 +146,15 @@ func checkRangeRequest(r *pb.RangeRequest) error {
 		return rpctypes.ErrGRPCInvalidSortOption
 	}
 
+	if f := r.ValueFilter; f != nil {
+		if _, ok := pb.ValueFilter_FilterType_name[int32(f.Type)]; !ok {
+			return rpctypes.ErrGRPCInvalidValueFilter
+		}
+		if f.Type == pb.ValueFilter_JSON_FIELD && len(f.JsonPath) == 0 {
+			return rpctypes.ErrGRPCInvalidValueFilter
+		}
+	}
+
 	return nil
 }
 
@@ -137,6 +168,15 @@ func checkPutRequest(r *pb.PutRequest) error {
 	if r.IgnoreLease && r.Lease != 0 {
 		return rpctypes.ErrGRPCLeaseProvided
 	}
+	if r.Ttl < 0 {
+		return rpctypes.ErrGRPCInvalidTTL
+	}
+	if r.Ttl > 0 && (r.Lease != 0 || r.IgnoreLease) {
+		return rpctypes.ErrGRPCLeaseProvided
+	}
+	if r.Ttl > lease.MaxLeaseTTL {
+		return rpctypes.ErrGRPCLeaseTTLTooLarge
+	}
 	return nil
 }
 
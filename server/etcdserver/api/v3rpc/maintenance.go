@@ -30,6 +30,7 @@ import (
 	"go.etcd.io/etcd/server/v3/etcdserver/apply"
 	"go.etcd.io/etcd/server/v3/etcdserver/errors"
 	serverversion "go.etcd.io/etcd/server/v3/etcdserver/version"
+	"go.etcd.io/etcd/server/v3/storage"
 	"go.etcd.io/etcd/server/v3/storage/backend"
 	"go.etcd.io/etcd/server/v3/storage/mvcc"
 	"go.etcd.io/etcd/server/v3/storage/schema"
@@ -56,6 +57,10 @@ type Downgrader interface {
 	Downgrade(ctx context.Context, dr *pb.DowngradeRequest) (*pb.DowngradeResponse, error)
 }
 
+type CorruptionChecker interface {
+	CheckCorruption(ctx context.Context, r *pb.CorruptionCheckRequest) (*pb.CorruptionCheckResponse, error)
+}
+
 type LeaderTransferrer interface {
 	MoveLeader(ctx context.Context, lead, target uint64) error
 }
@@ -67,6 +72,7 @@ type AuthGetter interface {
 
 type ClusterStatusGetter interface {
 	IsLearner() bool
+	IsEncryptedAtRest() bool
 }
 
 type maintenanceServer struct {
@@ -80,10 +86,12 @@ type maintenanceServer struct {
 	cs     ClusterStatusGetter
 	d      Downgrader
 	vs     serverversion.Server
+	pq     *storage.PrefixQuota
+	cc     CorruptionChecker
 }
 
 func NewMaintenanceServer(s *etcdserver.EtcdServer) pb.MaintenanceServer {
-	srv := &maintenanceServer{lg: s.Cfg.Logger, rg: s, hasher: s.KV().HashStorage(), bg: s, a: s, lt: s, hdr: newHeader(s), cs: s, d: s, vs: etcdserver.NewServerVersionAdapter(s)}
+	srv := &maintenanceServer{lg: s.Cfg.Logger, rg: s, hasher: s.KV().HashStorage(), bg: s, a: s, lt: s, hdr: newHeader(s), cs: s, d: s, vs: etcdserver.NewServerVersionAdapter(s), pq: s.PrefixQuota(), cc: s}
 	if srv.lg == nil {
 		srv.lg = zap.NewNop()
 	}
@@ -91,8 +99,14 @@ func NewMaintenanceServer(s *etcdserver.EtcdServer) pb.MaintenanceServer {
 }
 
 func (ms *maintenanceServer) Defragment(ctx context.Context, sr *pb.DefragmentRequest) (*pb.DefragmentResponse, error) {
-	ms.lg.Info("starting defragment")
-	err := ms.bg.Backend().Defrag()
+	online := sr.Mode == pb.DefragmentRequest_ONLINE
+	ms.lg.Info("starting defragment", zap.Bool("online", online))
+	var err error
+	if online {
+		err = ms.bg.Backend().OnlineDefrag()
+	} else {
+		err = ms.bg.Backend().Defrag()
+	}
 	if err != nil {
 		ms.lg.Warn("failed to defragment", zap.Error(err))
 		return nil, err
@@ -238,6 +252,7 @@ func (ms *maintenanceServer) Status(ctx context.Context, ar *pb.StatusRequest) (
 		DbSize:           ms.bg.Backend().Size(),
 		DbSizeInUse:      ms.bg.Backend().SizeInUse(),
 		IsLearner:        ms.cs.IsLearner(),
+		EncryptedAtRest:  ms.cs.IsEncryptedAtRest(),
 	}
 	if storageVersion := ms.vs.GetStorageVersion(); storageVersion != nil {
 		resp.StorageVersion = storageVersion.String()
@@ -272,6 +287,21 @@ func (ms *maintenanceServer) Downgrade(ctx context.Context, r *pb.DowngradeReque
 	return resp, nil
 }
 
+func (ms *maintenanceServer) PrefixQuotaStatus(ctx context.Context, r *pb.PrefixQuotaStatusRequest) (*pb.PrefixQuotaStatusResponse, error) {
+	resp := &pb.PrefixQuotaStatusResponse{Header: &pb.ResponseHeader{}, Usages: ms.pq.Status()}
+	ms.hdr.fill(resp.Header)
+	return resp, nil
+}
+
+func (ms *maintenanceServer) CorruptionCheck(ctx context.Context, r *pb.CorruptionCheckRequest) (*pb.CorruptionCheckResponse, error) {
+	resp, err := ms.cc.CheckCorruption(ctx, r)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	ms.hdr.fill(resp.Header)
+	return resp, nil
+}
+
 type authMaintenanceServer struct {
 	*maintenanceServer
 	ag AuthGetter
@@ -328,3 +358,17 @@ func (ams *authMaintenanceServer) MoveLeader(ctx context.Context, tr *pb.MoveLea
 func (ams *authMaintenanceServer) Downgrade(ctx context.Context, r *pb.DowngradeRequest) (*pb.DowngradeResponse, error) {
 	return ams.maintenanceServer.Downgrade(ctx, r)
 }
+
+func (ams *authMaintenanceServer) PrefixQuotaStatus(ctx context.Context, r *pb.PrefixQuotaStatusRequest) (*pb.PrefixQuotaStatusResponse, error) {
+	if err := ams.isAuthenticated(ctx); err != nil {
+		return nil, err
+	}
+	return ams.maintenanceServer.PrefixQuotaStatus(ctx, r)
+}
+
+func (ams *authMaintenanceServer) CorruptionCheck(ctx context.Context, r *pb.CorruptionCheckRequest) (*pb.CorruptionCheckResponse, error) {
+	if err := ams.isAuthenticated(ctx); err != nil {
+		return nil, err
+	}
+	return ams.maintenanceServer.CorruptionCheck(ctx, r)
+}
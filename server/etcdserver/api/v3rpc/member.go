@@ -44,11 +44,22 @@ func (cs *ClusterServer) MemberAdd(ctx context.Context, r *pb.MemberAddRequest)
 		return nil, rpctypes.ErrGRPCMemberBadURLs
 	}
 
+	if r.IsLearner && r.IsWitness {
+		return nil, rpctypes.ErrGRPCWitnessLearner
+	}
+	if r.AutoPromote && !r.IsLearner {
+		return nil, rpctypes.ErrGRPCMemberNotLearner
+	}
+
 	now := time.Now()
 	var m *membership.Member
-	if r.IsLearner {
+	switch {
+	case r.IsLearner:
 		m = membership.NewMemberAsLearner("", urls, "", &now)
-	} else {
+		m.AutoPromote = r.AutoPromote
+	case r.IsWitness:
+		m = membership.NewMemberAsWitness("", urls, "", &now)
+	default:
 		m = membership.NewMember("", urls, "", &now)
 	}
 	membs, merr := cs.server.AddMember(ctx, *m)
@@ -59,9 +70,11 @@ func (cs *ClusterServer) MemberAdd(ctx context.Context, r *pb.MemberAddRequest)
 	return &pb.MemberAddResponse{
 		Header: cs.header(),
 		Member: &pb.Member{
-			ID:        uint64(m.ID),
-			PeerURLs:  m.PeerURLs,
-			IsLearner: m.IsLearner,
+			ID:          uint64(m.ID),
+			PeerURLs:    m.PeerURLs,
+			IsLearner:   m.IsLearner,
+			IsWitness:   m.IsWitness,
+			AutoPromote: m.AutoPromote,
 		},
 		Members: membersToProtoMembers(membs),
 	}, nil
@@ -76,10 +89,13 @@ func (cs *ClusterServer) MemberRemove(ctx context.Context, r *pb.MemberRemoveReq
 }
 
 func (cs *ClusterServer) MemberUpdate(ctx context.Context, r *pb.MemberUpdateRequest) (*pb.MemberUpdateResponse, error) {
-	m := membership.Member{
-		ID:             types.ID(r.ID),
-		RaftAttributes: membership.RaftAttributes{PeerURLs: r.PeerURLs},
+	m := membership.Member{ID: types.ID(r.ID)}
+	// carry the other raft attributes of the member, which members before
+	// v3.6 apply along with the peer URLs
+	if cur := cs.cluster.Member(m.ID); cur != nil {
+		m.RaftAttributes = cur.RaftAttributes
 	}
+	m.PeerURLs = r.PeerURLs
 	membs, err := cs.server.UpdateMember(ctx, m)
 	if err != nil {
 		return nil, togRPCError(err)
@@ -105,6 +121,56 @@ func (cs *ClusterServer) MemberPromote(ctx context.Context, r *pb.MemberPromoteR
 	return &pb.MemberPromoteResponse{Header: cs.header(), Members: membersToProtoMembers(membs)}, nil
 }
 
+func (cs *ClusterServer) MemberSetLeaderPriority(ctx context.Context, r *pb.MemberSetLeaderPriorityRequest) (*pb.MemberSetLeaderPriorityResponse, error) {
+	membs, err := cs.server.SetMemberLeaderPriority(ctx, r.ID, r.LeaderPriority)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	return &pb.MemberSetLeaderPriorityResponse{Header: cs.header(), Members: membersToProtoMembers(membs)}, nil
+}
+
+func (cs *ClusterServer) MemberReconfigure(ctx context.Context, r *pb.MemberReconfigureRequest) (*pb.MemberReconfigureResponse, error) {
+	now := time.Now()
+	add := make([]membership.Member, 0, len(r.Add))
+	for _, a := range r.Add {
+		urls, err := types.NewURLs(a.PeerURLs)
+		if err != nil {
+			return nil, rpctypes.ErrGRPCMemberBadURLs
+		}
+		switch {
+		case a.IsLearner && a.IsWitness:
+			return nil, rpctypes.ErrGRPCWitnessLearner
+		case a.AutoPromote && !a.IsLearner:
+			return nil, rpctypes.ErrGRPCMemberNotLearner
+		case a.IsLearner:
+			m := membership.NewMemberAsLearner("", urls, "", &now)
+			m.AutoPromote = a.AutoPromote
+			add = append(add, *m)
+		case a.IsWitness:
+			add = append(add, *membership.NewMemberAsWitness("", urls, "", &now))
+		default:
+			add = append(add, *membership.NewMember("", urls, "", &now))
+		}
+	}
+
+	membs, err := cs.server.ReconfigureMembers(ctx, add, r.Remove, r.Promote)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+
+	added := make([]*pb.Member, len(add))
+	for i, m := range add {
+		added[i] = &pb.Member{
+			ID:          uint64(m.ID),
+			PeerURLs:    m.PeerURLs,
+			IsLearner:   m.IsLearner,
+			IsWitness:   m.IsWitness,
+			AutoPromote: m.AutoPromote,
+		}
+	}
+	return &pb.MemberReconfigureResponse{Header: cs.header(), Added: added, Members: membersToProtoMembers(membs)}, nil
+}
+
 func (cs *ClusterServer) header() *pb.ResponseHeader {
 	return &pb.ResponseHeader{ClusterId: uint64(cs.cluster.ID()), MemberId: uint64(cs.server.MemberId()), RaftTerm: cs.server.Term()}
 }
@@ -113,11 +179,14 @@ func membersToProtoMembers(membs []*membership.Member) []*pb.Member {
 	protoMembs := make([]*pb.Member, len(membs))
 	for i := range membs {
 		protoMembs[i] = &pb.Member{
-			Name:       membs[i].Name,
-			ID:         uint64(membs[i].ID),
-			PeerURLs:   membs[i].PeerURLs,
-			ClientURLs: membs[i].ClientURLs,
-			IsLearner:  membs[i].IsLearner,
+			Name:           membs[i].Name,
+			ID:             uint64(membs[i].ID),
+			PeerURLs:       membs[i].PeerURLs,
+			ClientURLs:     membs[i].ClientURLs,
+			IsLearner:      membs[i].IsLearner,
+			LeaderPriority: membs[i].LeaderPriority,
+			IsWitness:      membs[i].IsWitness,
+			AutoPromote:    membs[i].AutoPromote,
 		}
 	}
 	return protoMembs
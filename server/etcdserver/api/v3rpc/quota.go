@@ -27,6 +27,7 @@ import (
 type quotaKVServer struct {
 	pb.KVServer
 	qa quotaAlarmer
+	pq storage.Quota
 }
 
 type quotaAlarmer struct {
@@ -54,10 +55,24 @@ func NewQuotaKVServer(s *etcdserver.EtcdServer) pb.KVServer {
 	return &quotaKVServer{
 		NewKVServer(s),
 		quotaAlarmer{newBackendQuota(s, "kv"), s, s.MemberId()},
+		s.PrefixQuota(),
 	}
 }
 
+// checkPrefix checks whether request fits within the quotas of the key
+// prefixes it writes to before it is proposed. Unlike the backend quota,
+// exceeding a prefix quota only rejects the request and raises no alarm.
+func (s *quotaKVServer) checkPrefix(r interface{}) error {
+	if s.pq.Available(r) {
+		return nil
+	}
+	return rpctypes.ErrGRPCPrefixQuotaExceeded
+}
+
 func (s *quotaKVServer) Put(ctx context.Context, r *pb.PutRequest) (*pb.PutResponse, error) {
+	if err := s.checkPrefix(r); err != nil {
+		return nil, err
+	}
 	if err := s.qa.check(ctx, r); err != nil {
 		return nil, err
 	}
@@ -65,6 +80,9 @@ func (s *quotaKVServer) Put(ctx context.Context, r *pb.PutRequest) (*pb.PutRespo
 }
 
 func (s *quotaKVServer) Txn(ctx context.Context, r *pb.TxnRequest) (*pb.TxnResponse, error) {
+	if err := s.checkPrefix(r); err != nil {
+		return nil, err
+	}
 	if err := s.qa.check(ctx, r); err != nil {
 		return nil, err
 	}
@@ -38,8 +38,12 @@ var toGRPCErrorMap = map[error]error{
 	membership.ErrPeerURLexists:       rpctypes.ErrGRPCPeerURLExist,
 	membership.ErrMemberNotLearner:    rpctypes.ErrGRPCMemberNotLearner,
 	membership.ErrTooManyLearners:     rpctypes.ErrGRPCTooManyLearners,
+	membership.ErrBadReconfigure:      rpctypes.ErrGRPCBadReconfigure,
+	membership.ErrWitnessLearner:      rpctypes.ErrGRPCWitnessLearner,
 	errors.ErrNotEnoughStartedMembers: rpctypes.ErrMemberNotEnoughStarted,
 	errors.ErrLearnerNotReady:         rpctypes.ErrGRPCLearnerNotReady,
+	errors.ErrWitnessNotSupported:     rpctypes.ErrGRPCWitnessNotSupported,
+	errors.ErrReconfigureNotSupported: rpctypes.ErrGRPCReconfigNotSupported,
 
 	mvcc.ErrCompacted:         rpctypes.ErrGRPCCompacted,
 	mvcc.ErrFutureRev:         rpctypes.ErrGRPCFutureRev,
@@ -57,6 +61,10 @@ var toGRPCErrorMap = map[error]error{
 	errors.ErrTimeoutWaitAppliedIndex:    rpctypes.ErrGRPCTimeoutWaitAppliedIndex,
 	errors.ErrUnhealthy:                  rpctypes.ErrGRPCUnhealthy,
 	errors.ErrKeyNotFound:                rpctypes.ErrGRPCKeyNotFound,
+	errors.ErrInvalidSortOption:          rpctypes.ErrGRPCInvalidSortOption,
+	errors.ErrInvalidValueFilter:         rpctypes.ErrGRPCInvalidValueFilter,
+	errors.ErrInvalidContinueToken:       rpctypes.ErrGRPCInvalidContinueToken,
+	errors.ErrExpiredContinueToken:       rpctypes.ErrGRPCExpiredContinueToken,
 	errors.ErrCorrupt:                    rpctypes.ErrGRPCCorrupt,
 	errors.ErrBadLeaderTransferee:        rpctypes.ErrGRPCBadLeaderTransferee,
 
@@ -87,6 +95,7 @@ var toGRPCErrorMap = map[error]error{
 	auth.ErrInvalidAuthToken:     rpctypes.ErrGRPCInvalidAuthToken,
 	auth.ErrInvalidAuthMgmt:      rpctypes.ErrGRPCInvalidAuthMgmt,
 	auth.ErrAuthOldRevision:      rpctypes.ErrGRPCAuthOldRevision,
+	auth.ErrRateLimitExceeded:    rpctypes.ErrGRPCRateLimitExceeded,
 
 	// In sync with status.FromContextError
 	context.Canceled:         rpctypes.ErrGRPCCanceled,
@@ -138,6 +147,15 @@ func isClientCtxErr(ctxErr error, err error) bool {
 }
 
 // in v3.4, learner is allowed to serve serializable read and endpoint status
+func isRPCSupportedForWitness(req interface{}) bool {
+	switch req.(type) {
+	case *pb.StatusRequest, *pb.MemberListRequest:
+		return true
+	default:
+		return false
+	}
+}
+
 func isRPCSupportedForLearner(req interface{}) bool {
 	switch r := req.(type) {
 	case *pb.StatusRequest:
@@ -15,9 +15,11 @@
 package v3rpc
 
 import (
+	"bytes"
 	"context"
 	"io"
 	"math/rand"
+	"regexp"
 	"sync"
 	"time"
 
@@ -133,7 +135,7 @@ type serverWatchStream struct {
 	watchStream mvcc.WatchStream
 	ctrlStream  chan *pb.WatchResponse
 
-	// mu protects progress, prevKV, fragment
+	// mu protects progress, prevKV, fragment, leases
 	mu sync.RWMutex
 	// tracks the watchID that stream might need to send progress to
 	// TODO: combine progress and prevKV into a single struct?
@@ -143,6 +145,9 @@ type serverWatchStream struct {
 	// records fragmented watch IDs
 	// 传输数据量大于阈值，需要拆分发送
 	fragment map[mvcc.WatchID]bool
+	// records the lease of the watch IDs with a LEASE filter, whose delete
+	// events are filtered by the lease of the previous key-value pair
+	leases map[mvcc.WatchID]int64
 
 	// closec indicates the stream is closed.
 	closec chan struct{}
@@ -172,6 +177,7 @@ func (ws *watchServer) Watch(stream pb.Watch_WatchServer) (err error) {
 		progress: make(map[mvcc.WatchID]bool),
 		prevKV:   make(map[mvcc.WatchID]bool),
 		fragment: make(map[mvcc.WatchID]bool),
+		leases:   make(map[mvcc.WatchID]int64),
 
 		closec: make(chan struct{}),
 	}
@@ -287,7 +293,23 @@ func (sws *serverWatchStream) recvLoop() error {
 				}
 			}
 
-			filters := FiltersFromRequest(creq)
+			filters, err := FiltersFromRequest(creq)
+			if err != nil {
+				wr := &pb.WatchResponse{
+					Header:       sws.newResponseHeader(sws.watchStream.Rev()),
+					WatchId:      creq.WatchId,
+					Canceled:     true,
+					Created:      true,
+					CancelReason: rpctypes.ErrorDesc(err),
+				}
+
+				select {
+				case sws.ctrlStream <- wr:
+					continue
+				case <-sws.closec:
+					return nil
+				}
+			}
 
 			wsrev := sws.watchStream.Rev()
 			rev := creq.StartRevision
@@ -306,6 +328,9 @@ func (sws *serverWatchStream) recvLoop() error {
 				if creq.Fragment {
 					sws.fragment[id] = true
 				}
+				if hasFilter(creq, pb.WatchCreateRequest_LEASE) {
+					sws.leases[id] = creq.Lease
+				}
 				sws.mu.Unlock()
 			}
 			wr := &pb.WatchResponse{
@@ -337,6 +362,7 @@ func (sws *serverWatchStream) recvLoop() error {
 					delete(sws.progress, mvcc.WatchID(id))
 					delete(sws.prevKV, mvcc.WatchID(id))
 					delete(sws.fragment, mvcc.WatchID(id))
+					delete(sws.leases, mvcc.WatchID(id))
 					sws.mu.Unlock()
 				}
 			}
@@ -392,19 +418,36 @@ func (sws *serverWatchStream) sendLoop() {
 			// either return []*mvccpb.Event from the mvcc package
 			// or define protocol buffer with []mvccpb.Event.
 			evs := wresp.Events
-			events := make([]*mvccpb.Event, len(evs))
+			events := make([]*mvccpb.Event, 0, len(evs))
 			sws.mu.RLock()
 			needPrevKV := sws.prevKV[wresp.WatchID]
+			leaseID, filterLease := sws.leases[wresp.WatchID]
 			sws.mu.RUnlock()
 			for i := range evs {
-				events[i] = &evs[i]
-				if needPrevKV && !IsCreateEvent(evs[i]) {
+				var prevKV *mvccpb.KeyValue
+				if (needPrevKV || filterLease && evs[i].Type == mvccpb.DELETE) && !IsCreateEvent(evs[i]) {
 					opt := mvcc.RangeOptions{Rev: evs[i].Kv.ModRevision - 1}
 					r, err := sws.watchable.Range(context.TODO(), evs[i].Kv.Key, nil, opt)
 					if err == nil && len(r.KVs) != 0 {
-						events[i].PrevKv = &(r.KVs[0])
+						prevKV = &(r.KVs[0])
 					}
 				}
+				// the tombstone of a delete has no lease, so the delete is
+				// filtered by the lease of the deleted key-value pair. It is
+				// kept if that pair cannot be read, e.g. once compacted.
+				if filterLease && evs[i].Type == mvccpb.DELETE && prevKV != nil && prevKV.Lease != leaseID {
+					continue
+				}
+				if needPrevKV {
+					evs[i].PrevKv = prevKV
+				}
+				events = append(events, &evs[i])
+			}
+			if filtered := len(evs) - len(events); filtered > 0 {
+				mvcc.ReportEventReceived(filtered)
+				if len(events) == 0 && wresp.CompactRevision == 0 {
+					continue
+				}
 			}
 
 			canceled := wresp.CompactRevision != 0
@@ -423,7 +466,7 @@ func (sws *serverWatchStream) sendLoop() {
 				continue
 			}
 
-			mvcc.ReportEventReceived(len(evs))
+			mvcc.ReportEventReceived(len(events))
 
 			sws.mu.RLock()
 			fragmented, ok := sws.fragment[wresp.WatchID]
@@ -576,8 +619,38 @@ func filterNoPut(e mvccpb.Event) bool {
 	return e.Type == mvccpb.PUT
 }
 
+func filterValuePrefix(prefix []byte) mvcc.FilterFunc {
+	return func(e mvccpb.Event) bool {
+		return e.Type == mvccpb.PUT && !bytes.HasPrefix(e.Kv.Value, prefix)
+	}
+}
+
+// filterLease filters out the put events whose key is not attached to the
+// lease. Delete events are filtered by the send loop, which needs the lease
+// of the deleted key-value pair.
+func filterLease(leaseID int64) mvcc.FilterFunc {
+	return func(e mvccpb.Event) bool {
+		return e.Type == mvccpb.PUT && e.Kv.Lease != leaseID
+	}
+}
+
+func filterKeyRegex(re *regexp.Regexp) mvcc.FilterFunc {
+	return func(e mvccpb.Event) bool {
+		return !re.Match(e.Kv.Key)
+	}
+}
+
+func hasFilter(creq *pb.WatchCreateRequest, filter pb.WatchCreateRequest_FilterType) bool {
+	for _, ft := range creq.Filters {
+		if ft == filter {
+			return true
+		}
+	}
+	return false
+}
+
 // FiltersFromRequest returns "mvcc.FilterFunc" from a given watch create request.
-func FiltersFromRequest(creq *pb.WatchCreateRequest) []mvcc.FilterFunc {
+func FiltersFromRequest(creq *pb.WatchCreateRequest) ([]mvcc.FilterFunc, error) {
 	filters := make([]mvcc.FilterFunc, 0, len(creq.Filters))
 	for _, ft := range creq.Filters {
 		switch ft {
@@ -585,8 +658,18 @@ func FiltersFromRequest(creq *pb.WatchCreateRequest) []mvcc.FilterFunc {
 			filters = append(filters, filterNoPut)
 		case pb.WatchCreateRequest_NODELETE:
 			filters = append(filters, filterNoDelete)
+		case pb.WatchCreateRequest_VALUE_PREFIX:
+			filters = append(filters, filterValuePrefix(creq.ValuePrefix))
+		case pb.WatchCreateRequest_LEASE:
+			filters = append(filters, filterLease(creq.Lease))
+		case pb.WatchCreateRequest_KEY_REGEX:
+			re, err := regexp.Compile(creq.KeyRegex)
+			if err != nil {
+				return nil, rpctypes.ErrGRPCInvalidWatchFilter
+			}
+			filters = append(filters, filterKeyRegex(re))
 		default:
 		}
 	}
-	return filters
+	return filters, nil
 }
@@ -21,6 +21,7 @@ import (
 
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 )
 
 func TestSendFragment(t *testing.T) {
@@ -93,3 +94,45 @@ func createResponse(dataSize, events int) (resp *pb.WatchResponse) {
 	}
 	return resp
 }
+
+func TestFiltersFromRequest(t *testing.T) {
+	put := func(key, val string, lease int64) mvccpb.Event {
+		return mvccpb.Event{Type: mvccpb.PUT, Kv: &mvccpb.KeyValue{Key: []byte(key), Value: []byte(val), Lease: lease}}
+	}
+	del := mvccpb.Event{Type: mvccpb.DELETE, Kv: &mvccpb.KeyValue{Key: []byte("b")}}
+
+	tests := []struct {
+		creq *pb.WatchCreateRequest
+		ev   mvccpb.Event
+
+		wfiltered bool
+	}{
+		{&pb.WatchCreateRequest{Filters: []pb.WatchCreateRequest_FilterType{pb.WatchCreateRequest_VALUE_PREFIX}, ValuePrefix: []byte("v1")}, put("a", "v1x", 0), false},
+		{&pb.WatchCreateRequest{Filters: []pb.WatchCreateRequest_FilterType{pb.WatchCreateRequest_VALUE_PREFIX}, ValuePrefix: []byte("v1")}, put("a", "v2", 0), true},
+		{&pb.WatchCreateRequest{Filters: []pb.WatchCreateRequest_FilterType{pb.WatchCreateRequest_VALUE_PREFIX}, ValuePrefix: []byte("v1")}, del, false},
+		{&pb.WatchCreateRequest{Filters: []pb.WatchCreateRequest_FilterType{pb.WatchCreateRequest_LEASE}, Lease: 5}, put("a", "v", 5), false},
+		{&pb.WatchCreateRequest{Filters: []pb.WatchCreateRequest_FilterType{pb.WatchCreateRequest_LEASE}, Lease: 5}, put("a", "v", 0), true},
+		{&pb.WatchCreateRequest{Filters: []pb.WatchCreateRequest_FilterType{pb.WatchCreateRequest_LEASE}, Lease: 5}, del, false},
+		{&pb.WatchCreateRequest{Filters: []pb.WatchCreateRequest_FilterType{pb.WatchCreateRequest_KEY_REGEX}, KeyRegex: "^a[0-9]$"}, put("a1", "v", 0), false},
+		{&pb.WatchCreateRequest{Filters: []pb.WatchCreateRequest_FilterType{pb.WatchCreateRequest_KEY_REGEX}, KeyRegex: "^a[0-9]$"}, put("ab", "v", 0), true},
+		{&pb.WatchCreateRequest{Filters: []pb.WatchCreateRequest_FilterType{pb.WatchCreateRequest_KEY_REGEX}, KeyRegex: "^a[0-9]$"}, del, true},
+	}
+	for i, tt := range tests {
+		fcs, err := FiltersFromRequest(tt.creq)
+		if err != nil {
+			t.Fatalf("#%d: unexpected error %v", i, err)
+		}
+		filtered := false
+		for _, fc := range fcs {
+			filtered = filtered || fc(tt.ev)
+		}
+		if filtered != tt.wfiltered {
+			t.Errorf("#%d: filtered = %v, want %v", i, filtered, tt.wfiltered)
+		}
+	}
+
+	creq := &pb.WatchCreateRequest{Filters: []pb.WatchCreateRequest_FilterType{pb.WatchCreateRequest_KEY_REGEX}, KeyRegex: "("}
+	if _, err := FiltersFromRequest(creq); err != rpctypes.ErrGRPCInvalidWatchFilter {
+		t.Errorf("err = %v, want %v", err, rpctypes.ErrGRPCInvalidWatchFilter)
+	}
+}
@@ -175,6 +175,8 @@ func needAdminPermission(r *pb.InternalRaftRequest) bool {
 		return true
 	case r.AuthUserRevokeRole != nil:
 		return true
+	case r.AuthUserSetRateLimit != nil:
+		return true
 	case r.AuthRoleAdd != nil:
 		return true
 	case r.AuthRoleGrantPermission != nil:
@@ -183,6 +185,8 @@ func needAdminPermission(r *pb.InternalRaftRequest) bool {
 		return true
 	case r.AuthRoleDelete != nil:
 		return true
+	case r.AuthRoleSetRateLimit != nil:
+		return true
 	case r.AuthUserList != nil:
 		return true
 	case r.AuthRoleList != nil:
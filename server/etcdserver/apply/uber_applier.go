@@ -206,6 +206,9 @@ func (a *uberApplier) dispatch(ctx context.Context, r *pb.InternalRaftRequest, s
 	case r.AuthUserRevokeRole != nil:
 		op = "AuthUserRevokeRole"
 		ar.Resp, ar.Err = a.applyV3.UserRevokeRole(r.AuthUserRevokeRole)
+	case r.AuthUserSetRateLimit != nil:
+		op = "AuthUserSetRateLimit"
+		ar.Resp, ar.Err = a.applyV3.UserSetRateLimit(r.AuthUserSetRateLimit)
 	case r.AuthRoleAdd != nil:
 		op = "AuthRoleAdd"
 		ar.Resp, ar.Err = a.applyV3.RoleAdd(r.AuthRoleAdd)
@@ -221,6 +224,9 @@ func (a *uberApplier) dispatch(ctx context.Context, r *pb.InternalRaftRequest, s
 	case r.AuthRoleDelete != nil:
 		op = "AuthRoleDelete"
 		ar.Resp, ar.Err = a.applyV3.RoleDelete(r.AuthRoleDelete)
+	case r.AuthRoleSetRateLimit != nil:
+		op = "AuthRoleSetRateLimit"
+		ar.Resp, ar.Err = a.applyV3.RoleSetRateLimit(r.AuthRoleSetRateLimit)
 	case r.AuthUserList != nil:
 		op = "AuthUserList"
 		ar.Resp, ar.Err = a.applyV3.UserList(r.AuthUserList)
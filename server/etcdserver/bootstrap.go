@@ -45,6 +45,7 @@ import (
 	"go.etcd.io/etcd/server/v3/etcdserver/cindex"
 	serverstorage "go.etcd.io/etcd/server/v3/storage"
 	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/encryption"
 	"go.etcd.io/etcd/server/v3/storage/schema"
 	"go.etcd.io/etcd/server/v3/storage/wal"
 	"go.etcd.io/etcd/server/v3/storage/wal/walpb"
@@ -77,7 +78,26 @@ func bootstrap(cfg config.ServerConfig) (b *bootstrappedServer, err error) {
 
 	haveWAL := wal.Exist(cfg.WALDir())
 	st := v2store.New(StoreClusterPrefix, StoreKeysPrefix)
-	backend, err := bootstrapBackend(cfg, haveWAL, st, ss)
+
+	// A witness keeps no database file, so whether the local member is one must be
+	// known before the backend is opened: it is recorded in the WAL metadata,
+	// or taken from the cluster the member joins.
+	var (
+		cluster *bootstrapedCluster
+		witness bool
+	)
+	if haveWAL {
+		if witness, err = isWitnessWAL(cfg); err != nil {
+			return nil, err
+		}
+	} else {
+		if cluster, err = bootstrapCluster(cfg, nil, prt); err != nil {
+			return nil, err
+		}
+		witness = cluster.cl.MemberByName(cfg.Name).IsWitness
+	}
+
+	backend, err := bootstrapBackend(cfg, haveWAL, witness, st, ss)
 	if err != nil {
 		return nil, err
 	}
@@ -87,15 +107,16 @@ func bootstrap(cfg config.ServerConfig) (b *bootstrappedServer, err error) {
 
 	if haveWAL {
 		if err = fileutil.IsDirWriteable(cfg.WALDir()); err != nil {
+			backend.Close()
 			return nil, fmt.Errorf("cannot write to WAL directory: %v", err)
 		}
-		bwal = bootstrapWALFromSnapshot(cfg, backend.snapshot)
-	}
+		bwal = bootstrapWALFromSnapshot(cfg, backend.snapshot, backend.keyring)
 
-	cluster, err := bootstrapCluster(cfg, bwal, prt)
-	if err != nil {
-		backend.Close()
-		return nil, err
+		cluster, err = bootstrapCluster(cfg, bwal, prt)
+		if err != nil {
+			backend.Close()
+			return nil, err
+		}
 	}
 
 	s, err := bootstrapStorage(cfg, st, backend, bwal, cluster)
@@ -147,6 +168,12 @@ type bootstrappedBackend struct {
 	ci       cindex.ConsistentIndexer
 	beExist  bool
 	snapshot *raftpb.Snapshot
+	// keyring encrypts the backend and the WAL, if encryption at rest is
+	// enabled.
+	keyring *encryption.Keyring
+	// witness is set if the local member is a witness, whose backend is kept
+	// in memory only.
+	witness bool
 }
 
 func (s *bootstrappedBackend) Close() {
@@ -170,7 +197,7 @@ type bootstrappedRaft struct {
 
 func bootstrapStorage(cfg config.ServerConfig, st v2store.Store, be *bootstrappedBackend, wal *bootstrappedWAL, cl *bootstrapedCluster) (b *bootstrappedStorage, err error) {
 	if wal == nil {
-		wal = bootstrapNewWAL(cfg, cl)
+		wal = bootstrapNewWAL(cfg, cl, be.keyring, be.witness)
 	}
 
 	return &bootstrappedStorage{
@@ -201,11 +228,19 @@ func bootstrapSnapshot(cfg config.ServerConfig) *snap.Snapshotter {
 	return snap.New(cfg.Logger, cfg.SnapDir())
 }
 
-func bootstrapBackend(cfg config.ServerConfig, haveWAL bool, st v2store.Store, ss *snap.Snapshotter) (backend *bootstrappedBackend, err error) {
+func bootstrapBackend(cfg config.ServerConfig, haveWAL, witness bool, st v2store.Store, ss *snap.Snapshotter) (backend *bootstrappedBackend, err error) {
+	if witness {
+		return bootstrapWitnessBackend(cfg, haveWAL, st, ss)
+	}
 	beExist := fileutil.Exist(cfg.BackendPath())
 	ci := cindex.NewConsistentIndex(nil)
 	beHooks := serverstorage.NewBackendHooks(cfg.Logger, ci)
-	be := serverstorage.OpenBackend(cfg, beHooks)
+	kp, err := serverstorage.NewKeyProvider(cfg.EncryptionKeyFile, cfg.EncryptionKMSSocket)
+	if err != nil {
+		return nil, err
+	}
+	kr := serverstorage.NewKeyring(kp)
+	be := serverstorage.OpenBackend(cfg, beHooks, kr)
 	defer func() {
 		if err != nil && be != nil {
 			be.Close()
@@ -213,6 +248,9 @@ func bootstrapBackend(cfg config.ServerConfig, haveWAL bool, st v2store.Store, s
 	}()
 	ci.SetBackend(be)
 	schema.CreateMetaBucket(be.BatchTx())
+	if err = serverstorage.LoadKeyring(be, kr); err != nil {
+		return nil, err
+	}
 	if cfg.ExperimentalBootstrapDefragThresholdMegabytes != 0 {
 		err = maybeDefragBackend(cfg, be)
 		if err != nil {
@@ -226,7 +264,7 @@ func bootstrapBackend(cfg config.ServerConfig, haveWAL bool, st v2store.Store, s
 		snapshot *raftpb.Snapshot
 	)
 	if haveWAL {
-		snapshot, be, err = recoverSnapshot(cfg, st, be, beExist, beHooks, ci, ss)
+		snapshot, be, err = recoverSnapshot(cfg, st, be, beExist, beHooks, kr, ci, ss)
 		if err != nil {
 			return nil, err
 		}
@@ -245,9 +283,65 @@ func bootstrapBackend(cfg config.ServerConfig, haveWAL bool, st v2store.Store, s
 		ci:       ci,
 		beExist:  beExist,
 		snapshot: snapshot,
+		keyring:  kr,
 	}, nil
 }
 
+// bootstrapWitnessBackend returns the in-memory backend of a witness, which
+// keeps no key-value data. The members and the cluster version of a witness
+// are recovered from the snapshot into the v2 store, and its consistent index
+// is the index of the snapshot, so that the WAL entries after the snapshot are
+// applied again.
+func bootstrapWitnessBackend(cfg config.ServerConfig, haveWAL bool, st v2store.Store, ss *snap.Snapshotter) (*bootstrappedBackend, error) {
+	ci := cindex.NewConsistentIndex(nil)
+	beHooks := serverstorage.NewBackendHooks(cfg.Logger, ci)
+	kp, err := serverstorage.NewKeyProvider(cfg.EncryptionKeyFile, cfg.EncryptionKMSSocket)
+	if err != nil {
+		return nil, err
+	}
+	kr := serverstorage.NewKeyring(kp)
+	be := backend.NewMemoryBackend(cfg.Logger, beHooks)
+	ci.SetBackend(be)
+	schema.CreateMetaBucket(be.BatchTx())
+	if err = serverstorage.LoadKeyringFile(cfg.KeyringPath(), kr); err != nil {
+		return nil, err
+	}
+
+	var snapshot *raftpb.Snapshot
+	if haveWAL {
+		if snapshot, err = loadSnapshot(cfg, st, ss); err != nil {
+			return nil, err
+		}
+		if snapshot != nil {
+			ci.SetConsistentIndex(snapshot.Metadata.Index, snapshot.Metadata.Term)
+			beHooks.SetConfState(&snapshot.Metadata.ConfState)
+		}
+	}
+	cfg.Logger.Info("kept witness backend in memory", zap.Uint64("consistent-index", ci.ConsistentIndex()))
+
+	return &bootstrappedBackend{
+		beHooks:  beHooks,
+		be:       be,
+		ci:       ci,
+		snapshot: snapshot,
+		keyring:  kr,
+		witness:  true,
+	}, nil
+}
+
+// isWitnessWAL returns if the WAL of the local member is the WAL of a witness.
+func isWitnessWAL(cfg config.ServerConfig) (bool, error) {
+	wmetadata, err := wal.ReadMetadata(cfg.Logger, cfg.WALDir())
+	if err != nil {
+		return false, fmt.Errorf("cannot read WAL metadata: %v", err)
+	}
+	var metadata etcdserverpb.Metadata
+	if err = metadata.Unmarshal(wmetadata); err != nil {
+		return false, fmt.Errorf("cannot read WAL metadata: %v", err)
+	}
+	return metadata.Witness, nil
+}
+
 func maybeDefragBackend(cfg config.ServerConfig, be backend.Backend) error {
 	size := be.Size()
 	sizeInUse := be.SizeInUse()
@@ -384,36 +478,49 @@ func bootstrapClusterWithWAL(cfg config.ServerConfig, meta *snapshotMetadata) (*
 	}, nil
 }
 
-func recoverSnapshot(cfg config.ServerConfig, st v2store.Store, be backend.Backend, beExist bool, beHooks *serverstorage.BackendHooks, ci cindex.ConsistentIndexer, ss *snap.Snapshotter) (*raftpb.Snapshot, backend.Backend, error) {
+// loadSnapshot loads the newest snapshot saved to the WAL and recovers the v2
+// store from it. It returns a nil snapshot if there is none.
+func loadSnapshot(cfg config.ServerConfig, st v2store.Store, ss *snap.Snapshotter) (*raftpb.Snapshot, error) {
 	// Find a snapshot to start/restart a raft node
 	walSnaps, err := wal.ValidSnapshotEntries(cfg.Logger, cfg.WALDir())
 	if err != nil {
-		return nil, be, err
+		return nil, err
 	}
 	// snapshot files can be orphaned if etcd crashes after writing them but before writing the corresponding
 	// bwal log entries
 	snapshot, err := ss.LoadNewestAvailable(walSnaps)
 	if err != nil && err != snap.ErrNoSnapshot {
-		return nil, be, err
+		return nil, err
+	}
+	if snapshot == nil {
+		return nil, nil
 	}
 
-	if snapshot != nil {
-		if err = st.Recovery(snapshot.Data); err != nil {
-			cfg.Logger.Panic("failed to recover from snapshot", zap.Error(err))
-		}
+	if err = st.Recovery(snapshot.Data); err != nil {
+		cfg.Logger.Panic("failed to recover from snapshot", zap.Error(err))
+	}
 
-		if err = serverstorage.AssertNoV2StoreContent(cfg.Logger, st, cfg.V2Deprecation); err != nil {
-			cfg.Logger.Error("illegal v2store content", zap.Error(err))
-			return nil, be, err
-		}
+	if err = serverstorage.AssertNoV2StoreContent(cfg.Logger, st, cfg.V2Deprecation); err != nil {
+		cfg.Logger.Error("illegal v2store content", zap.Error(err))
+		return nil, err
+	}
 
-		cfg.Logger.Info(
-			"recovered v2 store from snapshot",
-			zap.Uint64("snapshot-index", snapshot.Metadata.Index),
-			zap.String("snapshot-size", humanize.Bytes(uint64(snapshot.Size()))),
-		)
+	cfg.Logger.Info(
+		"recovered v2 store from snapshot",
+		zap.Uint64("snapshot-index", snapshot.Metadata.Index),
+		zap.String("snapshot-size", humanize.Bytes(uint64(snapshot.Size()))),
+	)
+	return snapshot, nil
+}
 
-		if be, err = serverstorage.RecoverSnapshotBackend(cfg, be, *snapshot, beExist, beHooks); err != nil {
+func recoverSnapshot(cfg config.ServerConfig, st v2store.Store, be backend.Backend, beExist bool, beHooks *serverstorage.BackendHooks, kr *encryption.Keyring, ci cindex.ConsistentIndexer, ss *snap.Snapshotter) (*raftpb.Snapshot, backend.Backend, error) {
+	snapshot, err := loadSnapshot(cfg, st, ss)
+	if err != nil {
+		return nil, be, err
+	}
+
+	if snapshot != nil {
+		if be, err = serverstorage.RecoverSnapshotBackend(cfg, be, *snapshot, beExist, beHooks, kr); err != nil {
 			cfg.Logger.Panic("failed to recover v3 backend from snapshot", zap.Error(err))
 		}
 		// A snapshot db may have already been recovered, and the old db should have
@@ -453,10 +560,13 @@ func (c *bootstrapedCluster) Finalize(cfg config.ServerConfig, s *bootstrappedSt
 		c.cl.SetID(c.nodeID, c.cl.ID())
 	}
 	c.cl.SetStore(s.st)
-	c.cl.SetBackend(schema.NewMembershipBackend(cfg.Logger, s.backend.be))
+	// a witness keeps its members and cluster version in the v2 store only
+	if !s.backend.witness {
+		c.cl.SetBackend(schema.NewMembershipBackend(cfg.Logger, s.backend.be))
+	}
 	if s.wal.haveWAL {
 		c.cl.Recover(api.UpdateCapability)
-		if c.databaseFileMissing(s) {
+		if !s.backend.witness && c.databaseFileMissing(s) {
 			bepath := cfg.BackendPath()
 			os.RemoveAll(bepath)
 			return fmt.Errorf("database file (%v) of the backend is missing", bepath)
@@ -522,16 +632,27 @@ func bootstrapRaftFromWAL(cfg config.ServerConfig, bwal *bootstrappedWAL) *boots
 }
 
 func raftConfig(cfg config.ServerConfig, id uint64, s *raft.MemoryStorage) *raft.Config {
+	var quiesceTicks int
+	if cfg.QuiesceTimeout > 0 && cfg.TickMs > 0 {
+		quiesceTicks = int(cfg.QuiesceTimeout / (time.Duration(cfg.TickMs) * time.Millisecond))
+	}
+	maxInflightBytes := cfg.MaxInflightBytes
+	if maxInflightBytes != 0 && maxInflightBytes < maxSizePerMsg {
+		// a single append message must always fit into the in-flight window
+		maxInflightBytes = maxSizePerMsg
+	}
 	return &raft.Config{
-		ID:              id,
-		ElectionTick:    cfg.ElectionTicks,
-		HeartbeatTick:   1,
-		Storage:         s,
-		MaxSizePerMsg:   maxSizePerMsg,
-		MaxInflightMsgs: maxInflightMsgs,
-		CheckQuorum:     true,
-		PreVote:         cfg.PreVote,
-		Logger:          NewRaftLoggerZap(cfg.Logger.Named("raft")),
+		ID:               id,
+		ElectionTick:     cfg.ElectionTicks,
+		HeartbeatTick:    1,
+		Storage:          s,
+		MaxSizePerMsg:    maxSizePerMsg,
+		MaxInflightMsgs:  maxInflightMsgs,
+		MaxInflightBytes: maxInflightBytes,
+		CheckQuorum:      true,
+		PreVote:          cfg.PreVote,
+		QuiesceTicks:     quiesceTicks,
+		Logger:           NewRaftLoggerZap(cfg.Logger.Named("raft")),
 	}
 }
 
@@ -557,8 +678,8 @@ func (b *bootstrappedRaft) newRaftNode(ss *snap.Snapshotter, wal *wal.WAL, cl *m
 	)
 }
 
-func bootstrapWALFromSnapshot(cfg config.ServerConfig, snapshot *raftpb.Snapshot) *bootstrappedWAL {
-	wal, st, ents, snap, meta := openWALFromSnapshot(cfg, snapshot)
+func bootstrapWALFromSnapshot(cfg config.ServerConfig, snapshot *raftpb.Snapshot, kr *encryption.Keyring) *bootstrappedWAL {
+	wal, st, ents, snap, meta := openWALFromSnapshot(cfg, snapshot, kr)
 	bwal := &bootstrappedWAL{
 		lg:       cfg.Logger,
 		w:        wal,
@@ -595,7 +716,7 @@ func bootstrapWALFromSnapshot(cfg config.ServerConfig, snapshot *raftpb.Snapshot
 // openWALFromSnapshot reads the WAL at the given snap and returns the wal, its latest HardState and cluster ID, and all entries that appear
 // after the position of the given snap in the WAL.
 // The snap must have been previously saved to the WAL, or this call will panic.
-func openWALFromSnapshot(cfg config.ServerConfig, snapshot *raftpb.Snapshot) (*wal.WAL, *raftpb.HardState, []raftpb.Entry, *raftpb.Snapshot, *snapshotMetadata) {
+func openWALFromSnapshot(cfg config.ServerConfig, snapshot *raftpb.Snapshot, kr *encryption.Keyring) (*wal.WAL, *raftpb.HardState, []raftpb.Entry, *raftpb.Snapshot, *snapshotMetadata) {
 	var walsnap walpb.Snapshot
 	if snapshot != nil {
 		walsnap.Index, walsnap.Term = snapshot.Metadata.Index, snapshot.Metadata.Term
@@ -609,6 +730,9 @@ func openWALFromSnapshot(cfg config.ServerConfig, snapshot *raftpb.Snapshot) (*w
 		if cfg.UnsafeNoFsync {
 			w.SetUnsafeNoFsync()
 		}
+		if kr != nil {
+			w.SetEncryptor(kr)
+		}
 		wmetadata, st, ents, err := w.ReadAll()
 		if err != nil {
 			w.Close()
@@ -637,11 +761,12 @@ type snapshotMetadata struct {
 	nodeID, clusterID types.ID
 }
 
-func bootstrapNewWAL(cfg config.ServerConfig, cl *bootstrapedCluster) *bootstrappedWAL {
+func bootstrapNewWAL(cfg config.ServerConfig, cl *bootstrapedCluster, kr *encryption.Keyring, witness bool) *bootstrappedWAL {
 	metadata := pbutil.MustMarshal(
 		&etcdserverpb.Metadata{
 			NodeID:    uint64(cl.nodeID),
 			ClusterID: uint64(cl.cl.ID()),
+			Witness:   witness,
 		},
 	)
 	w, err := wal.Create(cfg.Logger, cfg.WALDir(), metadata)
@@ -651,6 +776,9 @@ func bootstrapNewWAL(cfg config.ServerConfig, cl *bootstrapedCluster) *bootstrap
 	if cfg.UnsafeNoFsync {
 		w.SetUnsafeNoFsync()
 	}
+	if kr != nil {
+		w.SetEncryptor(kr)
+	}
 	return &bootstrappedWAL{
 		lg: cfg.Logger,
 		w:  w,
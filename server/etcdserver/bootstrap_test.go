@@ -36,6 +36,7 @@ import (
 
 	"go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/version"
+	"go.etcd.io/etcd/client/pkg/v3/fileutil"
 	"go.etcd.io/etcd/client/pkg/v3/types"
 	"go.etcd.io/etcd/raft/v3/raftpb"
 	"go.etcd.io/etcd/server/v3/config"
@@ -155,6 +156,7 @@ func mockMembersJSON(m []etcdserverpb.Member) string {
 func TestBootstrapBackend(t *testing.T) {
 	tests := []struct {
 		name                  string
+		witness               bool
 		prepareData           func(config.ServerConfig) error
 		expectedConsistentIdx uint64
 		expectedError         error
@@ -171,6 +173,20 @@ func TestBootstrapBackend(t *testing.T) {
 			expectedConsistentIdx: 5,
 			expectedError:         nil,
 		},
+		{
+			name:                  "bootstrap witness backend success: no data files",
+			witness:               true,
+			prepareData:           nil,
+			expectedConsistentIdx: 0,
+			expectedError:         nil,
+		},
+		{
+			name:                  "bootstrap witness backend success: have snapshot and no db file",
+			witness:               true,
+			prepareData:           prepareWitnessData,
+			expectedConsistentIdx: 5,
+			expectedError:         nil,
+		},
 		// TODO(ahrtr): add more test cases
 		// https://github.com/etcd-io/etcd/issues/13507
 	}
@@ -197,7 +213,7 @@ func TestBootstrapBackend(t *testing.T) {
 			haveWAL := wal.Exist(cfg.WALDir())
 			st := v2store.New(StoreClusterPrefix, StoreKeysPrefix)
 			ss := snap.New(cfg.Logger, cfg.SnapDir())
-			backend, err := bootstrapBackend(cfg, haveWAL, st, ss)
+			backend, err := bootstrapBackend(cfg, haveWAL, tt.witness, st, ss)
 
 			hasError := err != nil
 			expectedHasError := tt.expectedError != nil
@@ -211,6 +227,9 @@ func TestBootstrapBackend(t *testing.T) {
 			if backend.ci.ConsistentIndex() != tt.expectedConsistentIdx {
 				t.Errorf("expected consistent index: %d, got: %d", tt.expectedConsistentIdx, backend.ci.ConsistentIndex())
 			}
+			if tt.witness && fileutil.Exist(cfg.BackendPath()) {
+				t.Errorf("expected no database file for a witness, got %s", cfg.BackendPath())
+			}
 		})
 	}
 }
@@ -241,6 +260,17 @@ func prepareData(cfg config.ServerConfig) (err error) {
 	return createSnapshotAndBackendDB(cfg, snapshotTerm, snapshotIndex)
 }
 
+// prepare data for the witness test case, which has no database file
+func prepareWitnessData(cfg config.ServerConfig) (err error) {
+	var snapshotTerm, snapshotIndex uint64 = 2, 5
+
+	if err = createWALFileWithSnapshotRecord(cfg, snapshotTerm, snapshotIndex); err != nil {
+		return
+	}
+
+	return createSnapshot(cfg, snapshotTerm, snapshotIndex, raftpb.ConfState{Voters: []uint64{1, 2, 3}})
+}
+
 func createWALFileWithSnapshotRecord(cfg config.ServerConfig, snapshotTerm, snapshotIndex uint64) (err error) {
 	var w *wal.WAL
 	if w, err = wal.Create(cfg.Logger, cfg.WALDir(), []byte("somedata")); err != nil {
@@ -272,21 +302,12 @@ func createSnapshotAndBackendDB(cfg config.ServerConfig, snapshotTerm, snapshotI
 		Voters: []uint64{1, 2, 3},
 	}
 
-	// create snapshot file
-	ss := snap.New(cfg.Logger, cfg.SnapDir())
-	if err = ss.SaveSnap(raftpb.Snapshot{
-		Data: []byte("{}"),
-		Metadata: raftpb.SnapshotMetadata{
-			ConfState: confState,
-			Index:     snapshotIndex,
-			Term:      snapshotTerm,
-		},
-	}); err != nil {
+	if err = createSnapshot(cfg, snapshotTerm, snapshotIndex, confState); err != nil {
 		return
 	}
 
 	// create snapshot db file: "%016x.snap.db"
-	be := serverstorage.OpenBackend(cfg, nil)
+	be := serverstorage.OpenBackend(cfg, nil, nil)
 	schema.CreateMetaBucket(be.BatchTx())
 	schema.UnsafeUpdateConsistentIndex(be.BatchTx(), snapshotIndex, snapshotTerm)
 	schema.MustUnsafeSaveConfStateToBackend(cfg.Logger, be.BatchTx(), &confState)
@@ -299,8 +320,21 @@ func createSnapshotAndBackendDB(cfg config.ServerConfig, snapshotTerm, snapshotI
 	}
 
 	// create backend db file
-	be = serverstorage.OpenBackend(cfg, nil)
+	be = serverstorage.OpenBackend(cfg, nil, nil)
 	schema.CreateMetaBucket(be.BatchTx())
 	schema.UnsafeUpdateConsistentIndex(be.BatchTx(), 1, 1)
 	return be.Close()
 }
+
+// create snapshot file
+func createSnapshot(cfg config.ServerConfig, snapshotTerm, snapshotIndex uint64, confState raftpb.ConfState) error {
+	ss := snap.New(cfg.Logger, cfg.SnapDir())
+	return ss.SaveSnap(raftpb.Snapshot{
+		Data: []byte("{}"),
+		Metadata: raftpb.SnapshotMetadata{
+			ConfState: confState,
+			Index:     snapshotIndex,
+			Term:      snapshotTerm,
+		},
+	})
+}
@@ -69,6 +69,7 @@ func (h hasherAdapter) PeerHashByRev(rev int64) []*peerHashKVResp {
 
 func (h hasherAdapter) TriggerCorruptAlarm(memberID uint64) {
 	h.EtcdServer.triggerCorruptAlarm(memberID)
+	h.EtcdServer.GoAttach(h.EtcdServer.logDivergentRanges)
 }
 
 // InitialCheck compares initial hash values with its peers
@@ -269,7 +270,8 @@ func (s *EtcdServer) getPeerHashKVs(rev int64) []*peerHashKVResp {
 	members := s.cluster.Members()
 	peers := make([]peerInfo, 0, len(members))
 	for _, m := range members {
-		if m.ID == s.MemberId() {
+		// witnesses keep no key-value data to compare with
+		if m.ID == s.MemberId() || m.IsWitness {
 			continue
 		}
 		peers = append(peers, peerInfo{id: m.ID, eps: m.PeerURLs})
@@ -328,7 +330,7 @@ func (h *hashKVHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
 		return
 	}
-	if r.URL.Path != PeerHashKVPath {
+	if r.URL.Path != PeerHashKVPath && r.URL.Path != PeerHashKVRangesPath {
 		http.Error(w, "bad path", http.StatusBadRequest)
 		return
 	}
@@ -340,23 +342,16 @@ func (h *hashKVHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	req := &pb.HashKVRequest{}
-	if err := json.Unmarshal(b, req); err != nil {
-		h.lg.Warn("failed to unmarshal request", zap.Error(err))
-		http.Error(w, "error unmarshalling request", http.StatusBadRequest)
-		return
+	var resp interface{}
+	if r.URL.Path == PeerHashKVRangesPath {
+		resp, err = h.serveHashKVRanges(b)
+	} else {
+		resp, err = h.serveHashKV(b)
 	}
-	hash, rev, err := h.server.KV().HashStorage().HashByRev(req.Revision)
 	if err != nil {
-		h.lg.Warn(
-			"failed to get hashKV",
-			zap.Int64("requested-revision", req.Revision),
-			zap.Error(err),
-		)
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-	resp := &pb.HashKVResponse{Header: &pb.ResponseHeader{Revision: rev}, Hash: hash.Hash, CompactRevision: hash.CompactRevision}
 	respBytes, err := json.Marshal(resp)
 	if err != nil {
 		h.lg.Warn("failed to marshal hashKV response", zap.Error(err))
@@ -369,17 +364,43 @@ func (h *hashKVHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	w.Write(respBytes)
 }
 
+func (h *hashKVHandler) serveHashKV(b []byte) (*pb.HashKVResponse, error) {
+	req := &pb.HashKVRequest{}
+	if err := json.Unmarshal(b, req); err != nil {
+		h.lg.Warn("failed to unmarshal request", zap.Error(err))
+		return nil, fmt.Errorf("error unmarshalling request")
+	}
+	hash, rev, err := h.server.KV().HashStorage().HashByRev(req.Revision)
+	if err != nil {
+		h.lg.Warn(
+			"failed to get hashKV",
+			zap.Int64("requested-revision", req.Revision),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	return &pb.HashKVResponse{Header: &pb.ResponseHeader{Revision: rev}, Hash: hash.Hash, CompactRevision: hash.CompactRevision}, nil
+}
+
 // HashByRev fetch hash of kv store at the given rev via http call to the given url
 func HashByRev(ctx context.Context, cc *http.Client, url string, rev int64) (*pb.HashKVResponse, error) {
-	hashReq := &pb.HashKVRequest{Revision: rev}
+	hashResp := &pb.HashKVResponse{}
+	if err := peerHashRequest(ctx, cc, url+PeerHashKVPath, &pb.HashKVRequest{Revision: rev}, hashResp); err != nil {
+		return nil, err
+	}
+	return hashResp, nil
+}
+
+// peerHashRequest sends the hash request hashReq to the given url and reads
+// the response into hashResp.
+func peerHashRequest(ctx context.Context, cc *http.Client, url string, hashReq, hashResp interface{}) error {
 	hashReqBytes, err := json.Marshal(hashReq)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	requestUrl := url + PeerHashKVPath
-	req, err := http.NewRequest(http.MethodGet, requestUrl, bytes.NewReader(hashReqBytes))
+	req, err := http.NewRequest(http.MethodGet, url, bytes.NewReader(hashReqBytes))
 	if err != nil {
-		return nil, err
+		return err
 	}
 	req = req.WithContext(ctx)
 	req.Header.Set("Content-Type", "application/json")
@@ -387,29 +408,24 @@ func HashByRev(ctx context.Context, cc *http.Client, url string, rev int64) (*pb
 
 	resp, err := cc.Do(req)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer resp.Body.Close()
 	b, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	if resp.StatusCode == http.StatusBadRequest {
 		if strings.Contains(string(b), mvcc.ErrCompacted.Error()) {
-			return nil, rpctypes.ErrCompacted
+			return rpctypes.ErrCompacted
 		}
 		if strings.Contains(string(b), mvcc.ErrFutureRev.Error()) {
-			return nil, rpctypes.ErrFutureRev
+			return rpctypes.ErrFutureRev
 		}
 	}
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unknown error: %s", string(b))
+		return fmt.Errorf("unknown error: %s", string(b))
 	}
-
-	hashResp := &pb.HashKVResponse{}
-	if err := json.Unmarshal(b, hashResp); err != nil {
-		return nil, err
-	}
-	return hashResp, nil
+	return json.Unmarshal(b, hashResp)
 }
@@ -0,0 +1,402 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+
+	"go.uber.org/zap"
+)
+
+const (
+	PeerHashKVRangesPath = "/members/hashkv/ranges"
+
+	// divergentRangeSplits is the number of ranges a divergent range is split
+	// into to drill down to the keys it diverges on.
+	divergentRangeSplits = 16
+	// divergentRangeMaxKeys is the number of keys of a divergent range under
+	// which its keys are compared one by one rather than split further.
+	divergentRangeMaxKeys = 64
+	// defaultDivergentKeysLimit is the number of divergent keys a check
+	// drills down to by default.
+	defaultDivergentKeysLimit = 100
+)
+
+// hashKVRangesRequest asks a member for the hashes of consecutive key ranges
+// of its mvcc.HashTree at a revision.
+type hashKVRangesRequest struct {
+	Revision int64 `json:"revision"`
+	// Bounds bound the ranges: range i is [Bounds[i], Bounds[i+1]). An empty
+	// last bound is the end of the keyspace.
+	Bounds [][]byte `json:"bounds"`
+	// Splits asks for the range requested to be split into up to Splits
+	// ranges of about as many keys of the member.
+	Splits int `json:"splits,omitempty"`
+	// Keys asks for the hashes of the keys of the range requested.
+	Keys bool `json:"keys,omitempty"`
+}
+
+type hashKVRangesResponse struct {
+	Revision        int64 `json:"revision"`
+	CompactRevision int64 `json:"compact-revision"`
+	// Bounds bound the ranges hashed, split if requested.
+	Bounds [][]byte       `json:"bounds"`
+	Ranges []rangeHash    `json:"ranges"`
+	Keys   []mvcc.KeyHash `json:"keys,omitempty"`
+}
+
+type rangeHash struct {
+	Hash  uint64 `json:"hash"`
+	Count int    `json:"count"`
+}
+
+func hashKVRanges(hs mvcc.HashStorage, req *hashKVRangesRequest) (*hashKVRangesResponse, error) {
+	if len(req.Bounds) < 2 || (req.Splits > 0 || req.Keys) && len(req.Bounds) != 2 {
+		return nil, fmt.Errorf("invalid bounds %q", req.Bounds)
+	}
+	tree, _, err := hs.HashTreeByRev(req.Revision)
+	if err != nil {
+		return nil, err
+	}
+	resp := &hashKVRangesResponse{Revision: tree.Revision, CompactRevision: tree.CompactRevision, Bounds: req.Bounds}
+	key, end := req.Bounds[0], req.Bounds[1]
+	if req.Splits > 0 {
+		resp.Bounds = append(append([][]byte{key}, tree.Split(key, end, req.Splits)...), end)
+	}
+	for i := 0; i+1 < len(resp.Bounds); i++ {
+		hash, count := tree.RangeHash(resp.Bounds[i], resp.Bounds[i+1])
+		resp.Ranges = append(resp.Ranges, rangeHash{Hash: hash, Count: count})
+	}
+	if req.Keys {
+		resp.Keys = tree.KeyHashes(key, end)
+	}
+	return resp, nil
+}
+
+func (h *hashKVHandler) serveHashKVRanges(b []byte) (*hashKVRangesResponse, error) {
+	req := &hashKVRangesRequest{}
+	if err := json.Unmarshal(b, req); err != nil {
+		h.lg.Warn("failed to unmarshal request", zap.Error(err))
+		return nil, fmt.Errorf("error unmarshalling request")
+	}
+	resp, err := hashKVRanges(h.server.KV().HashStorage(), req)
+	if err != nil {
+		h.lg.Warn(
+			"failed to get hashKV ranges",
+			zap.Int64("requested-revision", req.Revision),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// rangeHasher hashes the key ranges of a member.
+type rangeHasher struct {
+	id   types.ID
+	hash func(ctx context.Context, req *hashKVRangesRequest) (*hashKVRangesResponse, error)
+}
+
+// rangeHashers returns the hashers of the members keeping key-value data,
+// starting with the local member.
+func (s *EtcdServer) rangeHashers() []rangeHasher {
+	hashers := []rangeHasher{{
+		id: s.MemberId(),
+		hash: func(ctx context.Context, req *hashKVRangesRequest) (*hashKVRangesResponse, error) {
+			return hashKVRanges(s.KV().HashStorage(), req)
+		},
+	}}
+	cc := &http.Client{Transport: s.peerRt}
+	for _, m := range s.cluster.Members() {
+		if m.ID == s.MemberId() || m.IsWitness || len(m.PeerURLs) == 0 {
+			continue
+		}
+		eps := m.PeerURLs
+		hashers = append(hashers, rangeHasher{
+			id: m.ID,
+			hash: func(ctx context.Context, req *hashKVRangesRequest) (*hashKVRangesResponse, error) {
+				return s.peerHashKVRanges(ctx, cc, eps, req)
+			},
+		})
+	}
+	return hashers
+}
+
+// peerHashKVRanges hashes the key ranges of the peer at the given endpoints,
+// waiting for the peer to catch up with the revision requested.
+func (s *EtcdServer) peerHashKVRanges(ctx context.Context, cc *http.Client, eps []string, req *hashKVRangesRequest) (*hashKVRangesResponse, error) {
+	var lastErr error
+	for _, ep := range eps {
+		for {
+			rctx, cancel := context.WithTimeout(ctx, s.Cfg.ReqTimeout())
+			resp := &hashKVRangesResponse{}
+			lastErr = peerHashRequest(rctx, cc, ep+PeerHashKVRangesPath, req, resp)
+			cancel()
+			if lastErr == nil {
+				return resp, nil
+			}
+			if lastErr != rpctypes.ErrFutureRev {
+				break
+			}
+			select {
+			case <-time.After(100 * time.Millisecond):
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+		s.Logger().Warn(
+			"failed hash kv ranges request",
+			zap.String("local-member-id", s.MemberId().String()),
+			zap.Int64("requested-revision", req.Revision),
+			zap.String("remote-peer-endpoint", ep),
+			zap.Error(lastErr),
+		)
+	}
+	return nil, lastErr
+}
+
+// CheckCorruption compares the mvcc.HashTree of the members at a revision and
+// drills down to the keys whose hashes differ between them. A range whose
+// hashes differ is split by the keys of the member holding the most keys in
+// it, and the splits are compared in turn, until its keys are few enough to
+// be compared one by one.
+func (s *EtcdServer) CheckCorruption(ctx context.Context, r *pb.CorruptionCheckRequest) (*pb.CorruptionCheckResponse, error) {
+	rev := r.Revision
+	if rev == 0 {
+		rev = s.KV().Rev()
+	}
+	limit := int(r.Limit)
+	if limit <= 0 {
+		limit = defaultDivergentKeysLimit
+	}
+	d := &rangeDiff{members: s.rangeHashers(), rev: rev, limit: limit}
+	if err := d.run(ctx); err != nil {
+		return nil, err
+	}
+
+	resp := &pb.CorruptionCheckResponse{
+		Header:          &pb.ResponseHeader{},
+		Revision:        rev,
+		CompactRevision: d.compactRev,
+		Ranges:          d.ranges,
+	}
+	for _, m := range d.members {
+		resp.Members = append(resp.Members, uint64(m.id))
+	}
+	return resp, nil
+}
+
+// logDivergentRanges logs the keys the members diverge on, once corruption
+// is detected.
+func (s *EtcdServer) logDivergentRanges() {
+	ctx, cancel := context.WithTimeout(s.ctx, 10*s.Cfg.ReqTimeout())
+	defer cancel()
+	lg := s.Logger()
+	resp, err := s.CheckCorruption(ctx, &pb.CorruptionCheckRequest{})
+	if err != nil {
+		lg.Warn("failed to find divergent key ranges", zap.Error(err))
+		return
+	}
+	for _, r := range resp.Ranges {
+		hashes := make([]string, 0, len(r.Hashes))
+		for _, h := range r.Hashes {
+			hashes = append(hashes, fmt.Sprintf("%s=%016x/%d", types.ID(h.MemberID), h.Hash, h.Count))
+		}
+		lg.Warn(
+			"found divergent key range",
+			zap.Int64("revision", resp.Revision),
+			zap.Int64("compact-revision", resp.CompactRevision),
+			zap.String("key", string(r.Key)),
+			zap.String("range-end", string(r.RangeEnd)),
+			zap.Strings("member-hashes", hashes),
+		)
+	}
+}
+
+// rangeDiff drills down to the key ranges the members diverge on.
+type rangeDiff struct {
+	members []rangeHasher
+	rev     int64
+	limit   int
+
+	compactRev int64
+	ranges     []*pb.DivergentRange
+	// keys is the number of divergent keys found.
+	keys int
+}
+
+func (d *rangeDiff) run(ctx context.Context) error {
+	bounds := [][]byte{{}, {}}
+	resps := make([]*hashKVRangesResponse, len(d.members))
+	for i, m := range d.members {
+		resp, err := d.hash(ctx, m, &hashKVRangesRequest{Revision: d.rev, Bounds: bounds}, i == 0)
+		if err != nil {
+			return err
+		}
+		resps[i] = resp
+	}
+	return d.drill(ctx, bounds[0], bounds[1], rangeHashes(resps, 0))
+}
+
+// drill drills down to the keys [key, end) diverges on, given its hashes on
+// each member.
+func (d *rangeDiff) drill(ctx context.Context, key, end []byte, hashes []rangeHash) error {
+	if !diverge(hashes) {
+		return nil
+	}
+	if d.keys >= d.limit {
+		d.ranges = append(d.ranges, d.divergentRange(key, end, hashes))
+		return nil
+	}
+	ref := 0
+	for i := range hashes {
+		if hashes[i].Count > hashes[ref].Count {
+			ref = i
+		}
+	}
+	if hashes[ref].Count <= divergentRangeMaxKeys {
+		return d.diffKeys(ctx, key, end)
+	}
+
+	resps := make([]*hashKVRangesResponse, len(d.members))
+	resp, err := d.hash(ctx, d.members[ref], &hashKVRangesRequest{Revision: d.rev, Bounds: [][]byte{key, end}, Splits: divergentRangeSplits}, false)
+	if err != nil {
+		return err
+	}
+	resps[ref] = resp
+	bounds := resp.Bounds
+	for i, m := range d.members {
+		if i == ref {
+			continue
+		}
+		if resps[i], err = d.hash(ctx, m, &hashKVRangesRequest{Revision: d.rev, Bounds: bounds}, false); err != nil {
+			return err
+		}
+	}
+	for j := 0; j+1 < len(bounds); j++ {
+		if err := d.drill(ctx, bounds[j], bounds[j+1], rangeHashes(resps, j)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// diffKeys compares the keys of [key, end) one by one. Once the limit is
+// reached, the keys left are reported as a range.
+func (d *rangeDiff) diffKeys(ctx context.Context, key, end []byte) error {
+	keyHashes := make(map[string][]rangeHash)
+	var keys []string
+	for i, m := range d.members {
+		resp, err := d.hash(ctx, m, &hashKVRangesRequest{Revision: d.rev, Bounds: [][]byte{key, end}, Keys: true}, false)
+		if err != nil {
+			return err
+		}
+		for _, kh := range resp.Keys {
+			k := string(kh.Key)
+			if _, ok := keyHashes[k]; !ok {
+				keyHashes[k] = make([]rangeHash, len(d.members))
+				keys = append(keys, k)
+			}
+			keyHashes[k][i] = rangeHash{Hash: kh.Hash, Count: 1}
+		}
+	}
+	sort.Strings(keys)
+
+	for i, k := range keys {
+		if !diverge(keyHashes[k]) {
+			continue
+		}
+		if d.keys >= d.limit {
+			// the hash of the range left is the sum of the hashes of its keys.
+			hashes := make([]rangeHash, len(d.members))
+			for _, k := range keys[i:] {
+				for j, kh := range keyHashes[k] {
+					hashes[j].Hash += kh.Hash
+					hashes[j].Count += kh.Count
+				}
+			}
+			d.ranges = append(d.ranges, d.divergentRange([]byte(k), end, hashes))
+			return nil
+		}
+		d.ranges = append(d.ranges, d.divergentKey([]byte(k), keyHashes[k]))
+		d.keys++
+	}
+	return nil
+}
+
+// hash hashes ranges of member m, which must be compacted at the same
+// revision as the others unless first is set.
+func (d *rangeDiff) hash(ctx context.Context, m rangeHasher, req *hashKVRangesRequest, first bool) (*hashKVRangesResponse, error) {
+	resp, err := m.hash(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash key ranges of member %s (%v)", m.id, err)
+	}
+	if len(resp.Ranges) != len(resp.Bounds)-1 {
+		return nil, fmt.Errorf("member %s hashed %d key ranges, want %d", m.id, len(resp.Ranges), len(resp.Bounds)-1)
+	}
+	if first {
+		d.compactRev = resp.CompactRevision
+	} else if resp.CompactRevision != d.compactRev {
+		return nil, fmt.Errorf("member %s is compacted at revision %d, not %d; retry once the members have applied the compaction", m.id, resp.CompactRevision, d.compactRev)
+	}
+	return resp, nil
+}
+
+// divergentRange returns the range [key, end) with its hashes on each member.
+func (d *rangeDiff) divergentRange(key, end []byte, hashes []rangeHash) *pb.DivergentRange {
+	if len(end) == 0 {
+		end = []byte{0}
+	}
+	r := d.divergentKey(key, hashes)
+	r.RangeEnd = end
+	return r
+}
+
+// divergentKey returns the single key with its hashes on each member.
+func (d *rangeDiff) divergentKey(key []byte, hashes []rangeHash) *pb.DivergentRange {
+	r := &pb.DivergentRange{Key: key}
+	for i, m := range d.members {
+		r.Hashes = append(r.Hashes, &pb.MemberRangeHash{MemberID: uint64(m.id), Hash: hashes[i].Hash, Count: int64(hashes[i].Count)})
+	}
+	return r
+}
+
+// rangeHashes returns the hashes of range i on each member.
+func rangeHashes(resps []*hashKVRangesResponse, i int) []rangeHash {
+	hashes := make([]rangeHash, len(resps))
+	for j, resp := range resps {
+		hashes[j] = resp.Ranges[i]
+	}
+	return hashes
+}
+
+func diverge(hashes []rangeHash) bool {
+	for _, h := range hashes[1:] {
+		if h != hashes[0] {
+			return true
+		}
+	}
+	return false
+}
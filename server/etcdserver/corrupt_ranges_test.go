@@ -0,0 +1,101 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/server/v3/lease"
+	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestRangeDiff(t *testing.T) {
+	// member 2 has another value for key0500, and member 3 put key0700x
+	// instead of key0700.
+	var (
+		members []rangeHasher
+		rev     int64
+	)
+	for id := 1; id <= 3; id++ {
+		b, _ := betesting.NewDefaultTmpBackend(t)
+		defer betesting.Close(t, b)
+		s := mvcc.NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, mvcc.StoreConfig{})
+		defer s.Close()
+		for i := 0; i < 1000; i++ {
+			key, val := fmt.Sprintf("key%04d", i), "bar"
+			if id == 2 && i == 500 {
+				val = "baz"
+			}
+			if id == 3 && i == 700 {
+				key += "x"
+			}
+			s.Put([]byte(key), []byte(val), lease.NoLease)
+		}
+		hs := s.HashStorage()
+		members = append(members, rangeHasher{
+			id: types.ID(id),
+			hash: func(ctx context.Context, req *hashKVRangesRequest) (*hashKVRangesResponse, error) {
+				return hashKVRanges(hs, req)
+			},
+		})
+		rev = s.Rev()
+	}
+
+	hashes := func(r *pb.DivergentRange) []int64 {
+		var counts []int64
+		for _, h := range r.Hashes {
+			counts = append(counts, h.Count)
+		}
+		return counts
+	}
+
+	d := &rangeDiff{members: members, rev: rev, limit: 10}
+	assert.NoError(t, d.run(context.TODO()))
+	var keys []string
+	for _, r := range d.ranges {
+		assert.Empty(t, r.RangeEnd)
+		keys = append(keys, string(r.Key))
+	}
+	assert.Equal(t, []string{"key0500", "key0700", "key0700x"}, keys)
+	assert.Equal(t, []int64{1, 1, 1}, hashes(d.ranges[0]))
+	assert.NotEqual(t, d.ranges[0].Hashes[0].Hash, d.ranges[0].Hashes[1].Hash)
+	assert.Equal(t, d.ranges[0].Hashes[0].Hash, d.ranges[0].Hashes[2].Hash)
+	assert.Equal(t, []int64{1, 1, 0}, hashes(d.ranges[1]))
+	assert.Equal(t, []int64{0, 0, 1}, hashes(d.ranges[2]))
+
+	// once the limit is reached, the divergent range left is reported whole.
+	d = &rangeDiff{members: members, rev: rev, limit: 1}
+	assert.NoError(t, d.run(context.TODO()))
+	if assert.Len(t, d.ranges, 2) {
+		assert.Equal(t, "key0500", string(d.ranges[0].Key))
+		r := d.ranges[1]
+		assert.LessOrEqual(t, string(r.Key), "key0700")
+		assert.Less(t, "key0700x", string(r.RangeEnd))
+		assert.Equal(t, r.Hashes[0].Count, r.Hashes[2].Count)
+		assert.NotEqual(t, r.Hashes[0].Hash, r.Hashes[2].Hash)
+	}
+
+	// members agreeing on the keyspace have no divergent range.
+	d = &rangeDiff{members: members[:1], rev: rev, limit: 1}
+	assert.NoError(t, d.run(context.TODO()))
+	assert.Empty(t, d.ranges)
+}
@@ -31,6 +31,8 @@ var (
 	ErrLeaderChanged               = errors.New("etcdserver: leader changed")
 	ErrNotEnoughStartedMembers     = errors.New("etcdserver: re-configuration failed due to not enough started members")
 	ErrLearnerNotReady             = errors.New("etcdserver: can only promote a learner member which is in sync with leader")
+	ErrWitnessNotSupported         = errors.New("etcdserver: witness members need cluster version 3.6 or later")
+	ErrReconfigureNotSupported     = errors.New("etcdserver: member reconfiguration needs cluster version 3.6 or later")
 	ErrNoLeader                    = errors.New("etcdserver: no leader")
 	ErrNotLeader                   = errors.New("etcdserver: not leader")
 	ErrRequestTooLarge             = errors.New("etcdserver: request is too large")
@@ -42,6 +44,10 @@ var (
 	ErrClusterVersionUnavailable   = errors.New("etcdserver: cluster version not found during downgrade")
 	ErrWrongDowngradeVersionFormat = errors.New("etcdserver: wrong downgrade target version format")
 	ErrKeyNotFound                 = errors.New("etcdserver: key not found")
+	ErrInvalidSortOption           = errors.New("etcdserver: invalid sort option")
+	ErrInvalidValueFilter          = errors.New("etcdserver: invalid value filter")
+	ErrInvalidContinueToken        = errors.New("etcdserver: invalid continue token")
+	ErrExpiredContinueToken        = errors.New("etcdserver: continue token expired, its revision has been compacted")
 )
 
 type DiscoveryError struct {
@@ -38,6 +38,12 @@ var (
 		Name:      "is_leader",
 		Help:      "Whether or not this member is a leader. 1 if is, 0 otherwise.",
 	})
+	isQuiesced = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "etcd",
+		Subsystem: "server",
+		Name:      "is_quiesced",
+		Help:      "Whether or not the raft group is quiesced on this member. 1 if is, 0 otherwise.",
+	})
 	leaderChanges = prometheus.NewCounter(prometheus.CounterOpts{
 		Namespace: "etcd",
 		Subsystem: "server",
@@ -112,6 +118,22 @@ var (
 		Name:      "read_indexes_failed_total",
 		Help:      "The total number of failed read indexes seen.",
 	})
+	inflightMessages = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "etcd",
+		Subsystem: "server",
+		Name:      "raft_inflight_messages",
+		Help:      "The number of raft append messages sent to the peer but not yet acknowledged, while this member is leader.",
+	},
+		[]string{"To"},
+	)
+	inflightBytes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "etcd",
+		Subsystem: "server",
+		Name:      "raft_inflight_bytes",
+		Help:      "The total entry size of the raft append messages sent to the peer but not yet acknowledged, while this member is leader.",
+	},
+		[]string{"To"},
+	)
 	leaseExpired = prometheus.NewCounter(prometheus.CounterOpts{
 		Namespace: "etcd_debugging",
 		Subsystem: "server",
@@ -158,6 +180,7 @@ var (
 func init() {
 	prometheus.MustRegister(hasLeader)
 	prometheus.MustRegister(isLeader)
+	prometheus.MustRegister(isQuiesced)
 	prometheus.MustRegister(leaderChanges)
 	prometheus.MustRegister(heartbeatSendFailures)
 	prometheus.MustRegister(applySnapshotInProgress)
@@ -167,6 +190,8 @@ func init() {
 	prometheus.MustRegister(proposalsFailed)
 	prometheus.MustRegister(slowReadIndex)
 	prometheus.MustRegister(readIndexFailed)
+	prometheus.MustRegister(inflightMessages)
+	prometheus.MustRegister(inflightBytes)
 	prometheus.MustRegister(leaseExpired)
 	prometheus.MustRegister(currentVersion)
 	prometheus.MustRegister(currentGoVersion)
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"go.etcd.io/etcd/client/pkg/v3/logutil"
+	"go.etcd.io/etcd/client/pkg/v3/types"
 	"go.etcd.io/etcd/pkg/v3/contention"
 	"go.etcd.io/etcd/raft/v3"
 	"go.etcd.io/etcd/raft/v3/raftpb"
@@ -153,6 +154,38 @@ func (r *raftNode) tick() {
 	r.tickMu.Unlock()
 }
 
+// reportInactivePeers reports the peers the local member lost its connection to
+// as unreachable, which wakes up the quiesced raft group: quiesced, a follower
+// does not notice that the leader failed, nor the leader that it lost its quorum.
+func (r *raftNode) reportInactivePeers(lead uint64, islead bool) {
+	if !islead {
+		if lead != raft.None && r.transport.ActiveSince(types.ID(lead)).IsZero() {
+			r.ReportUnreachable(lead)
+		}
+		return
+	}
+	st := r.Status()
+	for id := range st.Progress {
+		if id != st.ID && r.transport.ActiveSince(types.ID(id)).IsZero() {
+			r.ReportUnreachable(id)
+		}
+	}
+}
+
+// updateInflightMetrics exports the in-flight append messages to each follower.
+// It must only be called on the leader.
+func (r *raftNode) updateInflightMetrics() {
+	st := r.Status()
+	for id, pr := range st.Progress {
+		if id == st.ID || pr.Inflights == nil {
+			continue
+		}
+		to := types.ID(id).String()
+		inflightMessages.WithLabelValues(to).Set(float64(pr.Inflights.Count()))
+		inflightBytes.WithLabelValues(to).Set(float64(pr.Inflights.Bytes()))
+	}
+}
+
 // start prepares and starts raftNode in a new goroutine. It is no longer safe
 // to modify the fields after it has been started.
 func (r *raftNode) start(rh *raftReadyHandler) {
@@ -161,11 +194,18 @@ func (r *raftNode) start(rh *raftReadyHandler) {
 	go func() {
 		defer r.onStop()
 		islead := false
+		quiesced := false
 
 		for {
 			select {
 			case <-r.ticker.C:
 				r.tick()
+				if islead {
+					r.updateInflightMetrics()
+				}
+				if quiesced {
+					r.reportInactivePeers(rh.getLead(), islead)
+				}
 			case rd := <-r.Ready():
 				if rd.SoftState != nil {
 					newLeader := rd.SoftState.Lead != raft.None && rh.getLead() != rd.SoftState.Lead
@@ -185,6 +225,14 @@ func (r *raftNode) start(rh *raftReadyHandler) {
 						isLeader.Set(1)
 					} else {
 						isLeader.Set(0)
+						inflightMessages.Reset()
+						inflightBytes.Reset()
+					}
+					quiesced = rd.SoftState.Quiesced
+					if quiesced {
+						isQuiesced.Set(1)
+					} else {
+						isQuiesced.Set(0)
 					}
 					rh.updateLeadership(newLeader)
 					r.td.Reset()
@@ -267,6 +315,14 @@ func (r *raftNode) start(rh *raftReadyHandler) {
 
 				r.raftStorage.Append(rd.Entries)
 
+				waitApply := false
+				for _, ent := range rd.CommittedEntries {
+					if ent.Type == raftpb.EntryConfChange || ent.Type == raftpb.EntryConfChangeV2 {
+						waitApply = true
+						break
+					}
+				}
+
 				if !islead {
 					// finish processing incoming messages before we signal raftdone chan
 					msgs := r.processMessages(rd.Messages)
@@ -281,13 +337,6 @@ func (r *raftNode) start(rh *raftReadyHandler) {
 					// on its own single-node cluster, before toApply-layer applies the config change.
 					// We simply wait for ALL pending entries to be applied for now.
 					// We might improve this later on if it causes unnecessary long blocking issues.
-					waitApply := false
-					for _, ent := range rd.CommittedEntries {
-						if ent.Type == raftpb.EntryConfChange {
-							waitApply = true
-							break
-						}
-					}
 					if waitApply {
 						// blocks until 'applyAll' calls 'applyWait.Trigger'
 						// to be in sync with scheduled config-change job
@@ -304,6 +353,18 @@ func (r *raftNode) start(rh *raftReadyHandler) {
 				} else {
 					// leader already processed 'MsgSnap' and signaled
 					notifyc <- struct{}{}
+
+					// The leader needs to wait for the configuration changes to be
+					// applied before advancing, so that raft only sees the entry
+					// entering a joint configuration as applied once it is in that
+					// configuration, and leaves it automatically.
+					if waitApply {
+						select {
+						case notifyc <- struct{}{}:
+						case <-r.stopped:
+							return
+						}
+					}
 				}
 
 				r.Advance()
@@ -22,6 +22,7 @@ import (
 	"math"
 	"math/rand"
 	"net/http"
+	"os"
 	"path"
 	"regexp"
 	"strconv"
@@ -68,6 +69,7 @@ import (
 	"go.etcd.io/etcd/server/v3/lease/leasehttp"
 	serverstorage "go.etcd.io/etcd/server/v3/storage"
 	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/encryption"
 	"go.etcd.io/etcd/server/v3/storage/mvcc"
 	"go.etcd.io/etcd/server/v3/storage/schema"
 )
@@ -91,6 +93,10 @@ const (
 
 	purgeFileInterval = 30 * time.Second
 
+	// learnerAutoPromoteInterval is the duration between two checks by the leader
+	// for learner members to auto-promote.
+	learnerAutoPromoteInterval = time.Second
+
 	// max number of in-flight snapshot messages etcdserver allows to have
 	// This number is more than enough for most clusters with 5 machines.
 	maxInFlightMsgSnap = 16
@@ -172,6 +178,15 @@ type Server interface {
 	// return ErrLearnerNotReady if the member are not ready.
 	// return ErrMemberNotLearner if the member is not a learner.
 	PromoteMember(ctx context.Context, id uint64) ([]*membership.Member, error)
+	// SetMemberLeaderPriority attempts to set the leader priority of an existing
+	// member in the cluster. It will return ErrIDNotFound if the member ID does
+	// not exist.
+	SetMemberLeaderPriority(ctx context.Context, id uint64, priority uint64) ([]*membership.Member, error)
+	// ReconfigureMembers attempts to atomically add, remove and promote the
+	// given members through a joint consensus configuration. It returns the
+	// same errors as AddMember, RemoveMember and PromoteMember, or
+	// ErrBadReconfigure if the changes are not valid together.
+	ReconfigureMembers(ctx context.Context, add []membership.Member, remove []uint64, promote []uint64) ([]*membership.Member, error)
 
 	// ClusterVersion is the cluster-wide minimum major.minor version.
 	// Cluster version is set to the min version that an etcd member is
@@ -250,19 +265,34 @@ type EtcdServer struct {
 	v2store     v2store.Store
 	snapshotter *snap.Snapshotter
 
+	// delegatedSnapC receives the snapshots to send on behalf of the leader.
+	delegatedSnapC chan delegatedSnapshot
+	// snapDelegateMu protects snapDelegateFailed.
+	snapDelegateMu sync.Mutex
+	// snapDelegateFailed holds the members the last delegated snapshot to
+	// failed to be sent to.
+	snapDelegateFailed map[types.ID]bool
+
 	applyV2 ApplierV2
 
 	uberApply apply.UberApplier
 
 	applyWait wait.WaitTime
 
-	kv         mvcc.WatchableKV
-	lessor     lease.Lessor
-	bemu       sync.RWMutex
-	be         backend.Backend
-	beHooks    *serverstorage.BackendHooks
-	authStore  auth.AuthStore
-	alarmStore *v3alarm.AlarmStore
+	kv          mvcc.WatchableKV
+	prefixQuota *serverstorage.PrefixQuota
+	lessor      lease.Lessor
+	bemu        sync.RWMutex
+	be          backend.Backend
+	beHooks     *serverstorage.BackendHooks
+	authStore   auth.AuthStore
+	alarmStore  *v3alarm.AlarmStore
+
+	// keyring encrypts the backend and the WAL, if encryption at rest is
+	// enabled. keyringMu serializes the rotations of its keys with the
+	// replacement of the backend they are persisted to.
+	keyring   *encryption.Keyring
+	keyringMu sync.Mutex
 
 	stats  *stats.ServerStats
 	lstats *stats.LeaderStats
@@ -324,6 +354,7 @@ func NewServer(cfg config.ServerConfig) (srv *EtcdServer, err error) {
 		errorc:                make(chan error, 1),
 		v2store:               b.storage.st,
 		snapshotter:           b.ss,
+		delegatedSnapC:        make(chan delegatedSnapshot),
 		r:                     *b.raft.newRaftNode(b.ss, b.storage.wal.w, b.cluster.cl),
 		memberId:              b.cluster.nodeID,
 		attributes:            membership.Attributes{Name: cfg.Name, ClientURLs: cfg.ClientURLs.StringSlice()},
@@ -344,6 +375,7 @@ func NewServer(cfg config.ServerConfig) (srv *EtcdServer, err error) {
 
 	srv.be = b.storage.backend.be
 	srv.beHooks = b.storage.backend.beHooks
+	srv.keyring = b.storage.backend.keyring
 	minTTL := time.Duration((3*cfg.ElectionTicks)/2) * heartbeat
 
 	// always recover lessor before kv. When we recover the mvcc.KV it will reattach keys to its leases.
@@ -369,8 +401,18 @@ func NewServer(cfg config.ServerConfig) (srv *EtcdServer, err error) {
 	mvccStoreConfig := mvcc.StoreConfig{
 		CompactionBatchLimit:    cfg.CompactionBatchLimit,
 		CompactionSleepInterval: cfg.CompactionSleepInterval,
+		IndexCheckpoint:         cfg.KeyIndexCheckpoint,
+		IndexMemoryBudget:       cfg.KeyIndexMemoryBudget,
+		IndexPagesPath:          cfg.KeyIndexPagesPath(),
+	}
+	for _, q := range cfg.ExperimentalPrefixQuotas {
+		mvccStoreConfig.QuotaPrefixes = append(mvccStoreConfig.QuotaPrefixes, []byte(q.Prefix))
+	}
+	for _, p := range cfg.ValueCompressionPrefixes {
+		mvccStoreConfig.CompressionPrefixes = append(mvccStoreConfig.CompressionPrefixes, []byte(p))
 	}
 	srv.kv = mvcc.New(srv.Logger(), srv.be, srv.lessor, mvccStoreConfig)
+	srv.prefixQuota = serverstorage.NewPrefixQuota(cfg.ExperimentalPrefixQuotas, srv.kv)
 
 	srv.authStore = auth.NewAuthStore(srv.Logger(), schema.NewAuthBackend(srv.Logger(), srv.be), tp, int(cfg.BcryptCost))
 
@@ -382,8 +424,13 @@ func NewServer(cfg config.ServerConfig) (srv *EtcdServer, err error) {
 			newSrv.kv.Close()
 		}
 	}()
-	if num := cfg.AutoCompactionRetention; num != 0 {
-		srv.compactor, err = v3compactor.New(cfg.Logger, cfg.AutoCompactionMode, num, srv.kv, srv)
+	targets := v3compactor.Targets{
+		MaxBytes:           cfg.AutoCompactionMaxBytes,
+		MaxRevisionsPerKey: cfg.AutoCompactionMaxRevisionsPerKey,
+	}
+	sizeMode := cfg.AutoCompactionMode == v3compactor.ModeSize || cfg.AutoCompactionMode == v3compactor.ModeHybrid
+	if num := cfg.AutoCompactionRetention; num != 0 || (sizeMode && targets != v3compactor.Targets{}) {
+		srv.compactor, err = v3compactor.New(cfg.Logger, cfg.AutoCompactionMode, num, targets, srv.kv, srv)
 		if err != nil {
 			return nil, err
 		}
@@ -419,6 +466,10 @@ func NewServer(cfg config.ServerConfig) (srv *EtcdServer, err error) {
 		ServerStats: sstats,
 		LeaderStats: lstats,
 		ErrorC:      srv.errorc,
+
+		PeerCompression:       srv.peerCompression,
+		SnapshotCompression:   cfg.SnapshotCompression,
+		SnapshotSendRateLimit: cfg.SnapshotSendRateLimit,
 	}
 	if err = tr.Start(); err != nil {
 		return nil, err
@@ -522,6 +573,7 @@ func (s *EtcdServer) adjustTicks() {
 // should be implemented in goroutines.
 func (s *EtcdServer) Start() {
 	s.start()
+	s.updateLocalLeaderPriority()
 	s.GoAttach(func() { s.adjustTicks() })
 	s.GoAttach(func() { s.publishV3(s.Cfg.ReqTimeout()) })
 	s.GoAttach(s.purgeFile)
@@ -531,6 +583,9 @@ func (s *EtcdServer) Start() {
 	s.GoAttach(s.linearizableReadLoop)
 	s.GoAttach(s.monitorKVHash)
 	s.GoAttach(s.monitorDowngrade)
+	s.GoAttach(s.monitorLeaderPriority)
+	s.GoAttach(s.monitorLearnerAutoPromotion)
+	s.GoAttach(s.monitorEncryptionKeyRotation)
 }
 
 // start prepares and starts server in a new goroutine. It is no longer safe to
@@ -705,6 +760,18 @@ func (s *EtcdServer) Process(ctx context.Context, m raftpb.Message) error {
 	if m.Type == raftpb.MsgApp {
 		s.stats.RecvAppendReq(types.ID(m.From).String(), m.Size())
 	}
+	if m.Type == raftpb.MsgSnap {
+		if err := s.verifyIncomingSnapshot(m.Snapshot); err != nil {
+			lg.Warn(
+				"rejected invalid database snapshot",
+				zap.String("local-member-id", s.MemberId().String()),
+				zap.String("from", types.ID(m.From).String()),
+				zap.Uint64("snapshot-index", m.Snapshot.Metadata.Index),
+				zap.Error(err),
+			)
+			return httptypes.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+	}
 	return s.r.Step(ctx, m)
 }
 
@@ -718,6 +785,16 @@ func (s *EtcdServer) ReportSnapshot(id uint64, status raft.SnapshotStatus) {
 	s.r.ReportSnapshot(id, status)
 }
 
+// peerCompression returns the compression of the messages sent to the given
+// member.
+func (s *EtcdServer) peerCompression(id types.ID) string {
+	var name string
+	if m := s.cluster.Member(id); m != nil {
+		name = m.Name
+	}
+	return s.Cfg.PeerCompressionFor(name)
+}
+
 type etcdProgress struct {
 	confState raftpb.ConfState
 	snapi     uint64
@@ -841,6 +918,9 @@ func (s *EtcdServer) run() {
 		case ap := <-s.r.apply():
 			f := schedule.NewJob("server_applyAll", func(context.Context) { s.applyAll(&ep, &ap) })
 			sched.Schedule(f)
+		case ds := <-s.delegatedSnapC:
+			f := schedule.NewJob("server_sendDelegatedSnap", func(context.Context) { s.sendDelegatedSnap(&ep, ds) })
+			sched.Schedule(f)
 		case leases := <-expiredLeaseC:
 			s.revokeExpiredLeases(leases)
 		case err := <-s.errorc:
@@ -930,6 +1010,10 @@ func (s *EtcdServer) applyAll(ep *etcdProgress, apply *toApply) {
 	select {
 	// snapshot requested via send()
 	case m := <-s.r.msgSnapC:
+		if delegate := s.pickSnapshotDelegate(m); delegate != 0 {
+			s.delegateSnap(delegate, m)
+			break
+		}
 		merged := s.createMergedSnapshotMessage(m, ep.appliedt, ep.appliedi, ep.confState)
 		s.sendMergedSnap(merged)
 	default:
@@ -974,7 +1058,76 @@ func (s *EtcdServer) applySnapshot(ep *etcdProgress, toApply *toApply) {
 	// wait for raftNode to persist snapshot onto the disk
 	<-toApply.notifyc
 
-	newbe, err := serverstorage.OpenSnapshotBackend(s.Cfg, s.snapshotter, toApply.snapshot, s.beHooks)
+	if s.IsWitness() {
+		s.recoverWitnessIndex(toApply.snapshot)
+	} else {
+		s.recoverBackend(toApply.snapshot)
+	}
+
+	lg.Info("restoring v2 store")
+	if err := s.v2store.Recovery(toApply.snapshot.Data); err != nil {
+		lg.Panic("failed to restore v2 store", zap.Error(err))
+	}
+
+	if err := serverstorage.AssertNoV2StoreContent(lg, s.v2store, s.Cfg.V2Deprecation); err != nil {
+		lg.Panic("illegal v2store content", zap.Error(err))
+	}
+
+	lg.Info("restored v2 store")
+
+	lg.Info("restoring cluster configuration")
+
+	s.cluster.Recover(api.UpdateCapability)
+	s.updateLocalLeaderPriority()
+
+	lg.Info("restored cluster configuration")
+	lg.Info("removing old peers from network")
+
+	// recover raft transport
+	s.r.transport.RemoveAllPeers()
+
+	lg.Info("removed old peers from network")
+	lg.Info("adding peers from new cluster configuration")
+
+	for _, m := range s.cluster.Members() {
+		if m.ID == s.MemberId() {
+			continue
+		}
+		s.r.transport.AddPeer(m.ID, m.PeerURLs)
+	}
+
+	lg.Info("added peers from new cluster configuration")
+
+	ep.appliedt = toApply.snapshot.Metadata.Term
+	ep.appliedi = toApply.snapshot.Metadata.Index
+	ep.snapi = ep.appliedi
+	ep.confState = toApply.snapshot.Metadata.ConfState
+
+	// As backends and implementations like alarmsStore changed, we need
+	// to re-bootstrap Appliers.
+	s.uberApply = s.NewUberApplier()
+}
+
+func (s *EtcdServer) NewUberApplier() apply.UberApplier {
+	return apply.NewUberApplier(s.lg, s.be, s.KV(), s.alarmStore, s.authStore, s.lessor, s.cluster, s, s, s.consistIndex,
+		s.Cfg.WarningApplyDuration, s.Cfg.ExperimentalTxnModeWriteWithSharedBuffer, s.Cfg.QuotaBackendBytes)
+}
+
+func verifySnapshotIndex(snapshot raftpb.Snapshot, cindex uint64) {
+	verify.Verify(func() {
+		if cindex != snapshot.Metadata.Index {
+			panic(fmt.Sprintf("consistent_index(%d) isn't equal to snapshot index (%d)", cindex, snapshot.Metadata.Index))
+		}
+	})
+}
+
+// recoverBackend replaces the backend and the stores built on it with the
+// database received along with the given snapshot.
+func (s *EtcdServer) recoverBackend(snapshot raftpb.Snapshot) {
+	lg := s.Logger()
+	s.keyringMu.Lock()
+	defer s.keyringMu.Unlock()
+	newbe, err := serverstorage.OpenSnapshotBackend(s.Cfg, s.snapshotter, snapshot, s.beHooks, s.keyring)
 	if err != nil {
 		lg.Panic("failed to open snapshot backend", zap.Error(err))
 	}
@@ -985,7 +1138,7 @@ func (s *EtcdServer) applySnapshot(ep *etcdProgress, toApply *toApply) {
 	// Eventually the new consistent_index value coming from snapshot is overwritten
 	// by the old value.
 	s.consistIndex.SetBackend(newbe)
-	verifySnapshotIndex(toApply.snapshot, s.consistIndex.ConsistentIndex())
+	verifySnapshotIndex(snapshot, s.consistIndex.ConsistentIndex())
 
 	// always recover lessor before kv. When we recover the mvcc.KV it will reattach keys to its leases.
 	// If we recover mvcc.KV first, it will attach the keys to the wrong lessor before it recovers.
@@ -1041,62 +1194,23 @@ func (s *EtcdServer) applySnapshot(ep *etcdProgress, toApply *toApply) {
 		lg.Info("restored auth store")
 	}
 
-	lg.Info("restoring v2 store")
-	if err := s.v2store.Recovery(toApply.snapshot.Data); err != nil {
-		lg.Panic("failed to restore v2 store", zap.Error(err))
-	}
-
-	if err := serverstorage.AssertNoV2StoreContent(lg, s.v2store, s.Cfg.V2Deprecation); err != nil {
-		lg.Panic("illegal v2store content", zap.Error(err))
-	}
-
-	lg.Info("restored v2 store")
-
 	s.cluster.SetBackend(schema.NewMembershipBackend(lg, newbe))
+}
 
-	lg.Info("restoring cluster configuration")
-
-	s.cluster.Recover(api.UpdateCapability)
-
-	lg.Info("restored cluster configuration")
-	lg.Info("removing old peers from network")
-
-	// recover raft transport
-	s.r.transport.RemoveAllPeers()
-
-	lg.Info("removed old peers from network")
-	lg.Info("adding peers from new cluster configuration")
-
-	for _, m := range s.cluster.Members() {
-		if m.ID == s.MemberId() {
-			continue
+// recoverWitnessIndex moves the consistent index of a witness to the given
+// snapshot. The snapshot a witness receives carries no key-value data, and a
+// witness keeps its members and cluster version in the v2 store restored from
+// the snapshot, so it has no backend to recover.
+func (s *EtcdServer) recoverWitnessIndex(snapshot raftpb.Snapshot) {
+	lg := s.Logger()
+	if fn, err := s.snapshotter.DBFilePath(snapshot.Metadata.Index); err == nil {
+		if err := os.Remove(fn); err != nil {
+			lg.Warn("failed to remove empty database snapshot", zap.String("path", fn), zap.Error(err))
 		}
-		s.r.transport.AddPeer(m.ID, m.PeerURLs)
 	}
 
-	lg.Info("added peers from new cluster configuration")
-
-	ep.appliedt = toApply.snapshot.Metadata.Term
-	ep.appliedi = toApply.snapshot.Metadata.Index
-	ep.snapi = ep.appliedi
-	ep.confState = toApply.snapshot.Metadata.ConfState
-
-	// As backends and implementations like alarmsStore changed, we need
-	// to re-bootstrap Appliers.
-	s.uberApply = s.NewUberApplier()
-}
-
-func (s *EtcdServer) NewUberApplier() apply.UberApplier {
-	return apply.NewUberApplier(s.lg, s.be, s.KV(), s.alarmStore, s.authStore, s.lessor, s.cluster, s, s, s.consistIndex,
-		s.Cfg.WarningApplyDuration, s.Cfg.ExperimentalTxnModeWriteWithSharedBuffer, s.Cfg.QuotaBackendBytes)
-}
-
-func verifySnapshotIndex(snapshot raftpb.Snapshot, cindex uint64) {
-	verify.Verify(func() {
-		if cindex != snapshot.Metadata.Index {
-			panic(fmt.Sprintf("consistent_index(%d) isn't equal to snapshot index (%d)", cindex, snapshot.Metadata.Index))
-		}
-	})
+	s.consistIndex.SetConsistentIndex(snapshot.Metadata.Index, snapshot.Metadata.Term)
+	lg.Info("recovered witness consistent index", zap.Uint64("consistent-index", snapshot.Metadata.Index))
 }
 
 func (s *EtcdServer) applyEntries(ep *etcdProgress, apply *toApply) {
@@ -1162,7 +1276,7 @@ func (s *EtcdServer) isLeader() bool {
 
 // MoveLeader transfers the leader to the given transferee.
 func (s *EtcdServer) MoveLeader(ctx context.Context, lead, transferee uint64) error {
-	if !s.cluster.IsMemberExist(types.ID(transferee)) || s.cluster.Member(types.ID(transferee)).IsLearner {
+	if m := s.cluster.Member(types.ID(transferee)); m == nil || m.IsLearner || m.IsWitness {
 		return errors.ErrBadLeaderTransferee
 	}
 
@@ -1303,6 +1417,10 @@ func (s *EtcdServer) AddMember(ctx context.Context, memb membership.Member) ([]*
 		return nil, err
 	}
 
+	if memb.IsWitness && !s.clusterVersionAtLeast(version.V3_6) {
+		return nil, errors.ErrWitnessNotSupported
+	}
+
 	// TODO: move Member to protobuf type
 	b, err := json.Marshal(memb)
 	if err != nil {
@@ -1323,6 +1441,9 @@ func (s *EtcdServer) AddMember(ctx context.Context, memb membership.Member) ([]*
 	if memb.IsLearner {
 		cc.Type = raftpb.ConfChangeAddLearnerNode
 	}
+	if memb.IsWitness {
+		cc.Type = raftpb.ConfChangeAddWitnessNode
+	}
 
 	return s.configure(ctx, cc)
 }
@@ -1425,7 +1546,12 @@ func (s *EtcdServer) promoteMember(ctx context.Context, id uint64) ([]*membershi
 	if err := s.checkMembershipOperationPermission(ctx); err != nil {
 		return nil, err
 	}
+	return s.proposePromoteMember(ctx, id)
+}
 
+// proposePromoteMember sends the promote request for a learner node to raft once the
+// learner node is ready, without checking the permission of the caller.
+func (s *EtcdServer) proposePromoteMember(ctx context.Context, id uint64) ([]*membership.Member, error) {
 	// check if we can promote this learner.
 	if err := s.mayPromoteMember(types.ID(id)); err != nil {
 		return nil, err
@@ -1554,8 +1680,14 @@ func (s *EtcdServer) mayRemoveMember(id types.ID) error {
 	return nil
 }
 
+// UpdateMember updates the peer URLs of the given member. Its other raft
+// attributes are kept as they are when the update is applied.
 func (s *EtcdServer) UpdateMember(ctx context.Context, memb membership.Member) ([]*membership.Member, error) {
-	b, merr := json.Marshal(memb)
+	return s.updateRaftAttributes(ctx, membership.RaftAttributesUpdate{Member: memb})
+}
+
+func (s *EtcdServer) updateRaftAttributes(ctx context.Context, u membership.RaftAttributesUpdate) ([]*membership.Member, error) {
+	b, merr := json.Marshal(u)
 	if merr != nil {
 		return nil, merr
 	}
@@ -1565,12 +1697,116 @@ func (s *EtcdServer) UpdateMember(ctx context.Context, memb membership.Member) (
 	}
 	cc := raftpb.ConfChange{
 		Type:    raftpb.ConfChangeUpdateNode,
-		NodeID:  uint64(memb.ID),
+		NodeID:  uint64(u.ID),
 		Context: b,
 	}
 	return s.configure(ctx, cc)
 }
 
+// ReconfigureMembers adds, removes and promotes the given members in a single
+// joint consensus configuration change. Removed voting members only leave the
+// cluster once raft leaves the joint configuration, which it does on its own.
+// Members before v3.6 cannot apply joint configuration changes, so the changes
+// are rejected until the cluster version reaches v3.6.
+func (s *EtcdServer) ReconfigureMembers(ctx context.Context, add []membership.Member, remove []uint64, promote []uint64) ([]*membership.Member, error) {
+	if err := s.checkMembershipOperationPermission(ctx); err != nil {
+		return nil, err
+	}
+
+	if !s.clusterVersionAtLeast(version.V3_6) {
+		return nil, errors.ErrReconfigureNotSupported
+	}
+
+	// by default StrictReconfigCheck is enabled; reject changes if unhealthy.
+	for _, m := range add {
+		if err := s.mayAddMember(m); err != nil {
+			return nil, err
+		}
+	}
+	for _, id := range promote {
+		if err := s.mayPromoteMember(types.ID(id)); err != nil {
+			return nil, err
+		}
+	}
+	for _, id := range remove {
+		if err := s.mayRemoveMember(types.ID(id)); err != nil {
+			return nil, err
+		}
+	}
+
+	rc := membership.ReconfigureContext{ID: s.reqIDGen.Next(), Add: add}
+	cc := raftpb.ConfChangeV2{Transition: raftpb.ConfChangeTransitionJointImplicit}
+	for _, m := range add {
+		typ := raftpb.ConfChangeAddNode
+		if m.IsLearner {
+			typ = raftpb.ConfChangeAddLearnerNode
+		}
+		if m.IsWitness {
+			typ = raftpb.ConfChangeAddWitnessNode
+		}
+		cc.Changes = append(cc.Changes, raftpb.ConfChangeSingle{Type: typ, NodeID: uint64(m.ID)})
+	}
+	for _, id := range promote {
+		rc.Promote = append(rc.Promote, types.ID(id))
+		cc.Changes = append(cc.Changes, raftpb.ConfChangeSingle{Type: raftpb.ConfChangeAddNode, NodeID: id})
+	}
+	for _, id := range remove {
+		rc.Remove = append(rc.Remove, types.ID(id))
+		cc.Changes = append(cc.Changes, raftpb.ConfChangeSingle{Type: raftpb.ConfChangeRemoveNode, NodeID: id})
+	}
+	b, err := json.Marshal(rc)
+	if err != nil {
+		return nil, err
+	}
+	cc.Context = b
+
+	start := time.Now()
+	membs, err := s.configureJoint(ctx, rc.ID, cc)
+	if err != nil {
+		return nil, err
+	}
+
+	// wait for raft to leave the joint configuration and drop the removed members
+	interval := time.Duration(s.Cfg.TickMs) * time.Millisecond
+	for _, id := range rc.Remove {
+		if id == s.MemberId() {
+			return membs, nil
+		}
+		for !s.cluster.IsIDRemoved(id) {
+			select {
+			case <-ctx.Done():
+				return nil, s.parseProposeCtxErr(ctx.Err(), start)
+			case <-s.stopping:
+				return nil, errors.ErrStopped
+			case <-time.After(interval):
+			}
+		}
+	}
+	return s.cluster.Members(), nil
+}
+
+// SetMemberLeaderPriority sets the leader priority of the given member. The
+// leader hands leadership over to the caught up voting member with the highest
+// priority, and members do not grant their vote to a candidate with a lower
+// priority than their own unless its log is more up-to-date.
+func (s *EtcdServer) SetMemberLeaderPriority(ctx context.Context, id uint64, priority uint64) ([]*membership.Member, error) {
+	m := s.cluster.Member(types.ID(id))
+	if m == nil {
+		return nil, membership.ErrIDNotFound
+	}
+	memb := *m
+	memb.LeaderPriority = priority
+	return s.updateRaftAttributes(ctx, membership.RaftAttributesUpdate{Member: memb, LeaderPriorityOnly: true})
+}
+
+// updateLocalLeaderPriority passes the leader priority of the local member
+// down to raft.
+func (s *EtcdServer) updateLocalLeaderPriority() {
+	if m := s.cluster.Member(s.MemberId()); m != nil {
+		s.r.SetPriority(m.LeaderPriority)
+	}
+}
+
 func (s *EtcdServer) setCommittedIndex(v uint64) {
 	atomic.StoreUint64(&s.committedIndex, v)
 }
@@ -1669,6 +1905,41 @@ func (s *EtcdServer) configure(ctx context.Context, cc raftpb.ConfChange) ([]*me
 	}
 }
 
+// configureJoint sends a joint consensus configuration change through consensus
+// and then waits for it to be applied to the server. It will block until the
+// change is performed or there is an error.
+func (s *EtcdServer) configureJoint(ctx context.Context, id uint64, cc raftpb.ConfChangeV2) ([]*membership.Member, error) {
+	lg := s.Logger()
+	ch := s.w.Register(id)
+
+	start := time.Now()
+	if err := s.r.ProposeConfChange(ctx, cc); err != nil {
+		s.w.Trigger(id, nil)
+		return nil, err
+	}
+
+	select {
+	case x := <-ch:
+		if x == nil {
+			lg.Panic("failed to configure")
+		}
+		resp := x.(*confChangeResponse)
+		lg.Info(
+			"applied a joint configuration change through raft",
+			zap.String("local-member-id", s.MemberId().String()),
+			zap.Int("raft-conf-changes", len(cc.Changes)),
+		)
+		return resp.membs, resp.err
+
+	case <-ctx.Done():
+		s.w.Trigger(id, nil) // GC wait
+		return nil, s.parseProposeCtxErr(ctx.Err(), start)
+
+	case <-s.stopping:
+		return nil, errors.ErrStopped
+	}
+}
+
 // sync proposes a SYNC request and is non-blocking.
 // This makes no guarantee that the request will be proposed or performed.
 // The request will be canceled after the given timeout.
@@ -1822,10 +2093,27 @@ func (s *EtcdServer) apply(
 			shouldStop = shouldStop || removedSelf
 			s.w.Trigger(cc.ID, &confChangeResponse{s.cluster.Members(), err})
 
+		case raftpb.EntryConfChangeV2:
+			shouldApplyV3 := membership.ApplyV2storeOnly
+			if e.Index > s.consistIndex.ConsistentIndex() {
+				s.consistIndex.SetConsistentApplyingIndex(e.Index, e.Term)
+				shouldApplyV3 = membership.ApplyBoth
+			}
+
+			var cc raftpb.ConfChangeV2
+			pbutil.MustUnmarshal(&cc, e.Data)
+			id, removedSelf, err := s.applyConfChangeV2(cc, confState, shouldApplyV3)
+			s.setAppliedIndex(e.Index)
+			s.setTerm(e.Term)
+			shouldStop = shouldStop || removedSelf
+			if id != 0 {
+				s.w.Trigger(id, &confChangeResponse{s.cluster.Members(), err})
+			}
+
 		default:
 			lg := s.Logger()
 			lg.Panic(
-				"unknown entry type; must be either EntryNormal or EntryConfChange",
+				"unknown entry type; must be either EntryNormal, EntryConfChange or EntryConfChangeV2",
 				zap.String("type", e.Type.String()),
 			)
 		}
@@ -1895,6 +2183,11 @@ func (s *EtcdServer) applyEntryNormal(e *raftpb.Entry) {
 		id = raftReq.Header.ID
 	}
 
+	// a witness keeps no key-value data and only applies cluster-wide requests
+	if !appliesToWitness(&raftReq) && s.IsWitness() {
+		return
+	}
+
 	needResult := s.w.IsRegistered(id)
 	if needResult || !noSideEffect(&raftReq) {
 		if !needResult && raftReq.Txn != nil {
@@ -1941,6 +2234,12 @@ func noSideEffect(r *pb.InternalRaftRequest) bool {
 	return r.Range != nil || r.AuthUserGet != nil || r.AuthRoleGet != nil || r.AuthStatus != nil
 }
 
+// appliesToWitness returns true if the request changes cluster metadata, which
+// is kept by witnesses as well.
+func appliesToWitness(r *pb.InternalRaftRequest) bool {
+	return r.ClusterVersionSet != nil || r.ClusterMemberAttrSet != nil || r.DowngradeInfoSet != nil || r.Alarm != nil
+}
+
 func removeNeedlessRangeReqs(txn *pb.TxnRequest) {
 	f := func(ops []*pb.RequestOp) []*pb.RequestOp {
 		j := 0
@@ -1979,7 +2278,7 @@ func (s *EtcdServer) applyConfChange(cc raftpb.ConfChange, confState *raftpb.Con
 	*confState = *s.r.ApplyConfChange(cc)
 	s.beHooks.SetConfState(confState)
 	switch cc.Type {
-	case raftpb.ConfChangeAddNode, raftpb.ConfChangeAddLearnerNode:
+	case raftpb.ConfChangeAddNode, raftpb.ConfChangeAddLearnerNode, raftpb.ConfChangeAddWitnessNode:
 		confChangeContext := new(membership.ConfigChangeContext)
 		if err := json.Unmarshal(cc.Context, confChangeContext); err != nil {
 			lg.Panic("failed to unmarshal member", zap.Error(err))
@@ -2019,25 +2318,131 @@ func (s *EtcdServer) applyConfChange(cc raftpb.ConfChange, confState *raftpb.Con
 		s.r.transport.RemovePeer(id)
 
 	case raftpb.ConfChangeUpdateNode:
-		m := new(membership.Member)
-		if err := json.Unmarshal(cc.Context, m); err != nil {
+		u := new(membership.RaftAttributesUpdate)
+		if err := json.Unmarshal(cc.Context, u); err != nil {
 			lg.Panic("failed to unmarshal member", zap.Error(err))
 		}
-		if cc.NodeID != uint64(m.ID) {
+		if cc.NodeID != uint64(u.ID) {
 			lg.Panic(
 				"got different member ID",
 				zap.String("member-id-from-config-change-entry", types.ID(cc.NodeID).String()),
-				zap.String("member-id-from-message", m.ID.String()),
+				zap.String("member-id-from-message", u.ID.String()),
 			)
 		}
-		s.cluster.UpdateRaftAttributes(m.ID, m.RaftAttributes, shouldApplyV3)
+		s.cluster.UpdateRaftAttributes(*u, shouldApplyV3)
+		m := s.cluster.Member(u.ID)
 		if m.ID != s.MemberId() {
 			s.r.transport.UpdatePeer(m.ID, m.PeerURLs)
+		} else {
+			s.r.SetPriority(m.LeaderPriority)
 		}
 	}
 	return false, nil
 }
 
+// applyConfChangeV2 applies a joint consensus ConfChangeV2 to the server. It is
+// only invoked with a ConfChangeV2 that has already passed through Raft. It
+// returns the ID of the reconfiguration request, which is 0 for the change
+// leaving the joint configuration.
+func (s *EtcdServer) applyConfChangeV2(cc raftpb.ConfChangeV2, confState *raftpb.ConfState, shouldApplyV3 membership.ShouldApplyV3) (uint64, bool, error) {
+	lg := s.Logger()
+	if cc.LeaveJoint() {
+		outgoing := confState.VotersOutgoing
+		*confState = *s.r.ApplyConfChange(cc)
+		s.beHooks.SetConfState(confState)
+
+		// Leaving the joint configuration might not write to the backend,
+		// so we should set the consistent index directly.
+		if s.consistIndex != nil && membership.ApplyBoth == shouldApplyV3 {
+			applyingIndex, applyingTerm := s.consistIndex.ConsistentApplyingIndex()
+			s.consistIndex.SetConsistentIndex(applyingIndex, applyingTerm)
+		}
+		return 0, s.removeOutgoingMembers(outgoing, confState, shouldApplyV3), nil
+	}
+
+	rc := new(membership.ReconfigureContext)
+	if err := json.Unmarshal(cc.Context, rc); err != nil {
+		lg.Panic("failed to unmarshal reconfigure context", zap.Error(err))
+	}
+	if err := s.cluster.ValidateReconfiguration(rc); err != nil {
+		s.r.ApplyConfChange(raftpb.ConfChange{NodeID: raft.None})
+
+		// The txPostLock callback will not get called in this case,
+		// so we should set the consistent index directly.
+		if s.consistIndex != nil && membership.ApplyBoth == shouldApplyV3 {
+			applyingIndex, applyingTerm := s.consistIndex.ConsistentApplyingIndex()
+			s.consistIndex.SetConsistentIndex(applyingIndex, applyingTerm)
+		}
+		return rc.ID, false, err
+	}
+
+	// removed learners are not part of the outgoing configuration,
+	// so look them up before they are gone.
+	var removedLearners []types.ID
+	for _, id := range rc.Remove {
+		if m := s.cluster.Member(id); m != nil && m.IsLearner {
+			removedLearners = append(removedLearners, id)
+		}
+	}
+
+	*confState = *s.r.ApplyConfChange(cc)
+	s.beHooks.SetConfState(confState)
+	for i := range rc.Add {
+		m := &rc.Add[i]
+		s.cluster.AddMember(m, shouldApplyV3)
+		if m.ID != s.MemberId() {
+			s.r.transport.AddPeer(m.ID, m.PeerURLs)
+		} else if m.IsLearner {
+			isLearner.Set(1)
+		}
+	}
+	for _, id := range rc.Promote {
+		s.cluster.PromoteMember(id, shouldApplyV3)
+		if id == s.MemberId() {
+			isLearner.Set(0)
+		}
+	}
+	// removed voting members keep counting in the outgoing majority and
+	// only leave the cluster once raft leaves the joint configuration.
+	removedSelf := false
+	for _, id := range removedLearners {
+		s.cluster.RemoveMember(id, shouldApplyV3)
+		if id == s.MemberId() {
+			removedSelf = true
+			continue
+		}
+		s.r.transport.RemovePeer(id)
+	}
+	return rc.ID, removedSelf, nil
+}
+
+// removeOutgoingMembers removes the voting members of the outgoing joint
+// configuration that are not part of the new configuration. It returns true
+// if the local member was removed.
+func (s *EtcdServer) removeOutgoingMembers(outgoing []uint64, confState *raftpb.ConfState, shouldApplyV3 membership.ShouldApplyV3) bool {
+	kept := make(map[uint64]bool)
+	for _, id := range confState.Voters {
+		kept[id] = true
+	}
+	for _, id := range confState.Learners {
+		kept[id] = true
+	}
+
+	removedSelf := false
+	for _, id := range outgoing {
+		if kept[id] {
+			continue
+		}
+		s.cluster.RemoveMember(types.ID(id), shouldApplyV3)
+		if types.ID(id) == s.MemberId() {
+			removedSelf = true
+			continue
+		}
+		s.r.transport.RemovePeer(types.ID(id))
+	}
+	return removedSelf
+}
+
 // TODO: non-blocking snapshot
 func (s *EtcdServer) snapshot(snapi uint64, confState raftpb.ConfState) {
 	clone := s.v2store.Clone()
@@ -2050,6 +2455,9 @@ func (s *EtcdServer) snapshot(snapi uint64, confState raftpb.ConfState) {
 	// from applyAll function.
 	// So KV().Commit() cannot run in parallel with toApply. It has to be called outside
 	// the go routine created below.
+	//
+	// The key index checkpoint is committed along with the consistent index.
+	s.KV().CheckpointIndex()
 	s.KV().Commit()
 
 	s.GoAttach(func() {
@@ -2142,6 +2550,13 @@ func (s *EtcdServer) ClusterVersion() *semver.Version {
 	return s.cluster.Version()
 }
 
+// clusterVersionAtLeast reports whether the cluster version is known and at
+// least v, so that every member can apply what v introduced.
+func (s *EtcdServer) clusterVersionAtLeast(v semver.Version) bool {
+	cv := s.ClusterVersion()
+	return cv != nil && !version.LessThan(*cv, v)
+}
+
 func (s *EtcdServer) StorageVersion() *semver.Version {
 	// `applySnapshot` sets a new backend instance, so we need to acquire the bemu lock.
 	s.bemu.RLock()
@@ -2312,6 +2727,165 @@ func (s *EtcdServer) monitorDowngrade() {
 	}
 }
 
+// monitorLeaderPriority every LeaderPriorityCheckTime checks if it's the leader and hands
+// leadership over to a voting member with a higher leader priority if there is one.
+func (s *EtcdServer) monitorLeaderPriority() {
+	t := s.Cfg.LeaderPriorityCheckTime
+	if t == 0 {
+		return
+	}
+	var lastTransfer time.Time
+	for {
+		select {
+		case <-time.After(t):
+		case <-s.stopping:
+			return
+		}
+
+		if !s.isLeader() || time.Since(lastTransfer) < s.Cfg.LeaderPriorityCooldown {
+			continue
+		}
+		transferee := s.leaderPriorityTransferee()
+		if transferee == 0 {
+			continue
+		}
+
+		lg := s.Logger()
+		lg.Info(
+			"transferring leadership to member with higher leader priority",
+			zap.String("local-member-id", s.MemberId().String()),
+			zap.String("transferee-member-id", types.ID(transferee).String()),
+		)
+		lastTransfer = time.Now()
+		ctx, cancel := context.WithTimeout(s.ctx, s.Cfg.ReqTimeout())
+		err := s.MoveLeader(ctx, s.Lead(), transferee)
+		cancel()
+		if err != nil {
+			lg.Warn(
+				"failed to transfer leadership to member with higher leader priority",
+				zap.String("local-member-id", s.MemberId().String()),
+				zap.String("transferee-member-id", types.ID(transferee).String()),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// monitorLearnerAutoPromotion every learnerAutoPromoteInterval checks if it's the leader and
+// promotes the learner members added with auto promotion once they have caught up.
+func (s *EtcdServer) monitorLearnerAutoPromotion() {
+	for {
+		select {
+		case <-time.After(learnerAutoPromoteInterval):
+		case <-s.stopping:
+			return
+		}
+
+		if !s.isLeader() {
+			continue
+		}
+		for _, m := range s.cluster.Members() {
+			if !m.IsLearner || !m.AutoPromote {
+				continue
+			}
+			s.autoPromoteMember(m.ID)
+		}
+	}
+}
+
+// monitorEncryptionKeyRotation puts a new data encryption key in use every
+// EncryptionKeyRotationInterval.
+func (s *EtcdServer) monitorEncryptionKeyRotation() {
+	if s.keyring == nil || s.Cfg.EncryptionKeyRotationInterval == 0 {
+		return
+	}
+	for {
+		select {
+		case <-time.After(s.Cfg.EncryptionKeyRotationInterval):
+		case <-s.stopping:
+			return
+		}
+		s.rotateEncryptionKey()
+	}
+}
+
+func (s *EtcdServer) rotateEncryptionKey() {
+	lg := s.Logger()
+	s.keyringMu.Lock()
+	defer s.keyringMu.Unlock()
+	var err error
+	if s.IsWitness() {
+		err = serverstorage.RotateKeyringFile(s.Cfg.KeyringPath(), s.keyring)
+	} else {
+		err = serverstorage.RotateKeyring(s.Backend(), s.keyring)
+	}
+	if err != nil {
+		lg.Warn("failed to rotate data encryption key", zap.Error(err))
+		return
+	}
+	lg.Info("rotated data encryption key", zap.String("key-id", fmt.Sprintf("%016x", s.keyring.Current())))
+}
+
+func (s *EtcdServer) autoPromoteMember(id types.ID) {
+	lg := s.Logger()
+	ctx, cancel := context.WithTimeout(s.ctx, s.Cfg.ReqTimeout())
+	_, err := s.proposePromoteMember(ctx, uint64(id))
+	cancel()
+	switch err {
+	case nil:
+		learnerPromoteSucceed.Inc()
+		lg.Info(
+			"auto-promoted learner member",
+			zap.String("local-member-id", s.MemberId().String()),
+			zap.String("promoted-member-id", id.String()),
+		)
+
+	case errors.ErrLearnerNotReady, errors.ErrNotEnoughStartedMembers, errors.ErrNotLeader:
+		// try again on the next check
+
+	default:
+		learnerPromoteFailed.WithLabelValues(err.Error()).Inc()
+		lg.Warn(
+			"failed to auto-promote learner member",
+			zap.String("local-member-id", s.MemberId().String()),
+			zap.String("promoted-member-id", id.String()),
+			zap.Error(err),
+		)
+	}
+}
+
+// leaderPriorityTransferee returns the voting member with the highest leader
+// priority above the local member's one that has caught up with the leader and
+// has been connected to it for at least LeaderPriorityCooldown, or 0 if there is none.
+func (s *EtcdServer) leaderPriorityTransferee() uint64 {
+	rs := s.raftStatus()
+	if rs.Progress == nil {
+		return 0
+	}
+	local := s.cluster.Member(s.MemberId())
+	if local == nil {
+		return 0
+	}
+	leaderMatch := rs.Progress[rs.ID].Match
+	since := time.Now().Add(-s.Cfg.LeaderPriorityCooldown)
+
+	var transferee, priority uint64 = 0, local.LeaderPriority
+	for _, m := range s.cluster.VotingMembers() {
+		if m.ID == s.MemberId() || m.IsWitness || m.LeaderPriority <= priority {
+			continue
+		}
+		pr, ok := rs.Progress[uint64(m.ID)]
+		if !ok || float64(pr.Match) < float64(leaderMatch)*readyPercent {
+			continue
+		}
+		if !isConnectedSince(s.r.transport, since, m.ID) {
+			continue
+		}
+		transferee, priority = uint64(m.ID), m.LeaderPriority
+	}
+	return transferee
+}
+
 func (s *EtcdServer) parseProposeCtxErr(err error, start time.Time) error {
 	switch err {
 	case context.Canceled:
@@ -2346,6 +2920,10 @@ func (s *EtcdServer) parseProposeCtxErr(err error, start time.Time) error {
 }
 
 func (s *EtcdServer) KV() mvcc.WatchableKV { return s.kv }
+
+// PrefixQuota returns the quotas on the storage used under key prefixes.
+func (s *EtcdServer) PrefixQuota() *serverstorage.PrefixQuota { return s.prefixQuota }
+
 func (s *EtcdServer) Backend() backend.Backend {
 	s.bemu.RLock()
 	defer s.bemu.RUnlock()
@@ -2394,6 +2972,22 @@ func (s *EtcdServer) IsLearner() bool {
 	return s.cluster.IsLocalMemberLearner()
 }
 
+// IsEncryptedAtRest returns if the local member encrypts its backend and WAL
+// at rest.
+func (s *EtcdServer) IsEncryptedAtRest() bool {
+	return s.keyring != nil
+}
+
+// IsWitness returns if the local member is a witness
+func (s *EtcdServer) IsWitness() bool {
+	return s.cluster.IsMemberExist(s.MemberId()) && s.cluster.IsLocalMemberWitness()
+}
+
+// IsQuiesced returns if the raft group is quiesced on the local member
+func (s *EtcdServer) IsQuiesced() bool {
+	return s.raftStatus().Quiesced
+}
+
 // IsMemberExist returns if the member with the given id exists in cluster.
 func (s *EtcdServer) IsMemberExist(id types.ID) bool {
 	return s.cluster.IsMemberExist(id)
@@ -1380,6 +1380,52 @@ func TestAddMember(t *testing.T) {
 	}
 }
 
+// TestAddWitnessMemberClusterVersion tests AddMember rejects a witness without
+// proposing it until the cluster version reaches v3.6.
+func TestAddWitnessMemberClusterVersion(t *testing.T) {
+	lg := zaptest.NewLogger(t)
+	n := newNodeRecorder()
+	cl := newTestCluster(t, nil)
+	s := &EtcdServer{
+		lgMu:    new(sync.RWMutex),
+		lg:      lg,
+		r:       *newRaftNode(raftNodeConfig{lg: lg, Node: n}),
+		cluster: cl,
+	}
+	m := membership.Member{ID: 1234, RaftAttributes: membership.RaftAttributes{PeerURLs: []string{"foo"}, IsWitness: true}}
+	if _, err := s.AddMember(context.Background(), m); err != errors.ErrWitnessNotSupported {
+		t.Fatalf("AddMember error = %v, want %v", err, errors.ErrWitnessNotSupported)
+	}
+	if gaction := n.Action(); len(gaction) != 0 {
+		t.Errorf("action = %v, want none", gaction)
+	}
+}
+
+// TestReconfigureMembersClusterVersion tests ReconfigureMembers rejects the
+// changes without proposing any of them until the cluster version reaches v3.6.
+func TestReconfigureMembersClusterVersion(t *testing.T) {
+	lg := zaptest.NewLogger(t)
+	n := newNodeRecorder()
+	cl := newTestCluster(t, nil)
+	cl.AddMember(&membership.Member{ID: 1234}, true)
+	s := &EtcdServer{
+		lgMu:    new(sync.RWMutex),
+		lg:      lg,
+		r:       *newRaftNode(raftNodeConfig{lg: lg, Node: n}),
+		cluster: cl,
+	}
+	add := []membership.Member{{ID: 5678, RaftAttributes: membership.RaftAttributes{PeerURLs: []string{"foo"}}}}
+	if _, err := s.ReconfigureMembers(context.Background(), add, []uint64{1234}, nil); err != errors.ErrReconfigureNotSupported {
+		t.Fatalf("ReconfigureMembers error = %v, want %v", err, errors.ErrReconfigureNotSupported)
+	}
+	if gaction := n.Action(); len(gaction) != 0 {
+		t.Errorf("action = %v, want none", gaction)
+	}
+	if cl.Member(1234) == nil || cl.Member(5678) != nil {
+		t.Errorf("members = %v, want them unchanged", cl.Members())
+	}
+}
+
 // TestRemoveMember tests RemoveMember can propose and perform node removal.
 func TestRemoveMember(t *testing.T) {
 	lg := zaptest.NewLogger(t)
@@ -1747,6 +1793,8 @@ func (n *nodeRecorder) Stop() {
 
 func (n *nodeRecorder) ReportUnreachable(id uint64) {}
 
+func (n *nodeRecorder) SetPriority(p uint64) {}
+
 func (n *nodeRecorder) ReportSnapshot(id uint64, status raft.SnapshotStatus) {}
 
 func (n *nodeRecorder) Compact(index uint64, nodes []uint64, d []byte) {
@@ -1879,6 +1927,8 @@ func (s *nopTransporter) Stop()                               {}
 func (s *nopTransporter) Pause()                              {}
 func (s *nopTransporter) Resume()                             {}
 
+func (s *nopTransporter) DelegateSnapshot(id types.ID, m snap.Message) {}
+
 type snapTransporter struct {
 	nopTransporter
 	snapDoneC chan snap.Message
@@ -0,0 +1,224 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sync/atomic"
+	"time"
+
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/raft/v3/raftpb"
+	"go.etcd.io/etcd/raft/v3/tracker"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/snap"
+	"go.etcd.io/etcd/server/v3/etcdserver/errors"
+	serverstorage "go.etcd.io/etcd/server/v3/storage"
+
+	"go.uber.org/zap"
+)
+
+// delegatedSnapshot is a request of the leader to send a snapshot to a member
+// on its behalf.
+type delegatedSnapshot struct {
+	m raftpb.Message
+	// mergedc receives the snapshot message once it is being sent.
+	mergedc chan snap.Message
+}
+
+// pickSnapshotDelegate returns the member to send the given snapshot in place
+// of the leader: the most up-to-date follower, other than the receiver, that
+// replicates the log past the snapshot index and keeps key-value data. It
+// returns 0 if the leader should send the snapshot itself, which it does once
+// after a delegated snapshot to the same member failed.
+func (s *EtcdServer) pickSnapshotDelegate(m raftpb.Message) types.ID {
+	if !s.Cfg.SnapshotDelegation {
+		return 0
+	}
+	s.snapDelegateMu.Lock()
+	failed := s.snapDelegateFailed[types.ID(m.To)]
+	delete(s.snapDelegateFailed, types.ID(m.To))
+	s.snapDelegateMu.Unlock()
+	if failed {
+		return 0
+	}
+
+	st := s.r.Status()
+	var (
+		delegate types.ID
+		match    uint64
+	)
+	for id, pr := range st.Progress {
+		if id == st.ID || id == m.To || pr.IsWitness || pr.State != tracker.StateReplicate {
+			continue
+		}
+		if pr.Match < m.Snapshot.Metadata.Index || pr.Match < match {
+			continue
+		}
+		if s.r.transport.ActiveSince(types.ID(id)).IsZero() {
+			continue
+		}
+		if pr.Match > match || types.ID(id) < delegate {
+			delegate, match = types.ID(id), pr.Match
+		}
+	}
+	return delegate
+}
+
+// delegateSnap asks the given member to send a snapshot to the receiver of the
+// given snapshot message on behalf of the leader.
+func (s *EtcdServer) delegateSnap(delegate types.ID, m raftpb.Message) {
+	atomic.AddInt64(&s.inflightSnapshots, 1)
+
+	// the delegate sends the v2 store of its own state.
+	m.Snapshot.Data = nil
+	msg := snap.NewMessage(m, io.NopCloser(bytes.NewReader(nil)), 0)
+
+	lg := s.Logger()
+	fields := []zap.Field{
+		zap.String("from", s.MemberId().String()),
+		zap.String("to", types.ID(m.To).String()),
+		zap.String("delegate", delegate.String()),
+		zap.Uint64("snapshot-index", m.Snapshot.Metadata.Index),
+	}
+
+	now := time.Now()
+	s.r.transport.DelegateSnapshot(delegate, *msg)
+	lg.Info("delegating snapshot", fields...)
+
+	s.GoAttach(func() {
+		select {
+		case ok := <-msg.CloseNotify():
+			if ok {
+				// delay releasing inflight snapshot for another 30 seconds to
+				// block log compaction, as in sendMergedSnap.
+				select {
+				case <-time.After(releaseDelayAfterSnapshot):
+				case <-s.stopping:
+				}
+			} else {
+				s.snapDelegateMu.Lock()
+				if s.snapDelegateFailed == nil {
+					s.snapDelegateFailed = make(map[types.ID]bool)
+				}
+				s.snapDelegateFailed[types.ID(m.To)] = true
+				s.snapDelegateMu.Unlock()
+			}
+
+			atomic.AddInt64(&s.inflightSnapshots, -1)
+
+			lg.Info("delegated snapshot", append(fields, zap.Bool("sent", ok), zap.Duration("took", time.Since(now)))...)
+
+		case <-s.stopping:
+			lg.Warn("canceled delegating snapshot; server stopping", fields...)
+			return
+		}
+	})
+}
+
+// SendDelegatedSnapshot implements rafthttp.SnapshotDelegate. Once the local
+// member has applied the leader's snapshot index, it sends a snapshot of its
+// applied state to the receiver of the given MsgSnap message.
+func (s *EtcdServer) SendDelegatedSnapshot(ctx context.Context, m raftpb.Message) error {
+	if s.IsWitness() {
+		return fmt.Errorf("witness %s has no key-value data to send", s.MemberId())
+	}
+	if types.ID(m.From) != s.Leader() {
+		return errors.ErrNotLeader
+	}
+
+	wctx, cancel := context.WithTimeout(ctx, s.Cfg.ReqTimeout())
+	defer cancel()
+	ds := delegatedSnapshot{m: m, mergedc: make(chan snap.Message, 1)}
+	select {
+	case <-s.applyWait.Wait(m.Snapshot.Metadata.Index):
+	case <-wctx.Done():
+		return errors.ErrTimeoutWaitAppliedIndex
+	case <-s.stopping:
+		return errors.ErrStopped
+	}
+	select {
+	case s.delegatedSnapC <- ds:
+	case <-wctx.Done():
+		return errors.ErrTimeout
+	case <-s.stopping:
+		return errors.ErrStopped
+	}
+
+	var merged snap.Message
+	select {
+	case merged = <-ds.mergedc:
+	case <-s.stopping:
+		return errors.ErrStopped
+	}
+	defer atomic.AddInt64(&s.inflightSnapshots, -1)
+	select {
+	case ok := <-merged.CloseNotify():
+		if !ok {
+			return fmt.Errorf("failed to send snapshot to %s", types.ID(m.To))
+		}
+		return nil
+	case <-s.stopping:
+		return errors.ErrStopped
+	}
+}
+
+// sendDelegatedSnap sends a snapshot of the applied state on behalf of the
+// leader. It must run on the apply goroutine, like applyAll.
+func (s *EtcdServer) sendDelegatedSnap(ep *etcdProgress, ds delegatedSnapshot) {
+	atomic.AddInt64(&s.inflightSnapshots, 1)
+	merged := s.createMergedSnapshotMessage(ds.m, ep.appliedt, ep.appliedi, ep.confState)
+	s.Logger().Info(
+		"sending delegated snapshot",
+		zap.String("leader", types.ID(ds.m.From).String()),
+		zap.String("to", types.ID(ds.m.To).String()),
+		zap.Uint64("snapshot-index", merged.Snapshot.Metadata.Index),
+		zap.Int64("bytes", merged.TotalSize),
+	)
+	s.r.transport.SendSnapshot(merged)
+	ds.mergedc <- merged
+}
+
+// verifyIncomingSnapshot verifies that the database received along with the
+// given snapshot is at the snapshot index, and that its data encryption keys
+// can be unwrapped. Witnesses receive no database.
+func (s *EtcdServer) verifyIncomingSnapshot(snapshot raftpb.Snapshot) error {
+	if s.IsWitness() {
+		return nil
+	}
+	index, err := serverstorage.ReadSnapshotConsistentIndex(s.snapshotter, snapshot)
+	if err != nil {
+		return err
+	}
+	if index != snapshot.Metadata.Index {
+		return fmt.Errorf("database snapshot consistent index %d does not match snapshot index %d", index, snapshot.Metadata.Index)
+	}
+
+	// The database holds the data encryption keys of the sender, wrapped with
+	// its key encryption keys. Rejecting it here keeps raft from applying a
+	// snapshot the local member cannot open.
+	path, err := s.snapshotter.DBFilePath(snapshot.Metadata.Index)
+	if err != nil {
+		return err
+	}
+	s.keyringMu.Lock()
+	defer s.keyringMu.Unlock()
+	if _, err = serverstorage.ReadKeyring(path, s.keyring); err != nil {
+		return fmt.Errorf("failed to read encryption keys of database snapshot, all members must share the key encryption keys (%v)", err)
+	}
+	return nil
+}
@@ -15,8 +15,10 @@
 package etcdserver
 
 import (
+	"bytes"
 	"io"
 
+	"go.etcd.io/etcd/client/pkg/v3/types"
 	"go.etcd.io/etcd/raft/v3/raftpb"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/snap"
 	"go.etcd.io/etcd/server/v3/storage/backend"
@@ -27,7 +29,8 @@ import (
 
 // createMergedSnapshotMessage creates a snapshot message that contains: raft status (term, conf),
 // a snapshot of v2 store inside raft.Snapshot as []byte, a snapshot of v3 KV in the top level message
-// as ReadCloser.
+// as ReadCloser. Witnesses keep no key-value data, so the snapshot sent to a witness carries
+// only the raft status and the v2 store.
 func (s *EtcdServer) createMergedSnapshotMessage(m raftpb.Message, snapt, snapi uint64, confState raftpb.ConfState) snap.Message {
 	lg := s.Logger()
 	// get a snapshot of v2 store as []byte
@@ -39,9 +42,18 @@ func (s *EtcdServer) createMergedSnapshotMessage(m raftpb.Message, snapt, snapi
 
 	// commit kv to write metadata(for example: consistent index).
 	s.KV().Commit()
-	dbsnap := s.be.Snapshot()
-	// get a snapshot of v3 KV as readCloser
-	rc := newSnapshotReaderCloser(lg, dbsnap)
+
+	var (
+		rc     io.ReadCloser
+		rcSize int64
+	)
+	if to := s.cluster.Member(types.ID(m.To)); to != nil && to.IsWitness {
+		rc = io.NopCloser(bytes.NewReader(nil))
+	} else {
+		dbsnap := s.be.Snapshot()
+		// get a snapshot of v3 KV as readCloser
+		rc, rcSize = newSnapshotReaderCloser(lg, dbsnap), dbsnap.Size()
+	}
 
 	// put the []byte snapshot of store into raft snapshot and return the merged snapshot with
 	// KV readCloser snapshot.
@@ -57,7 +69,7 @@ func (s *EtcdServer) createMergedSnapshotMessage(m raftpb.Message, snapt, snapi
 
 	verifySnapshotIndex(snapshot, s.consistIndex.ConsistentIndex())
 
-	return *snap.NewMessage(m, rc, dbsnap.Size())
+	return *snap.NewMessage(m, rc, rcSize)
 }
 
 func newSnapshotReaderCloser(lg *zap.Logger, snapshot backend.Snapshot) io.ReadCloser {
@@ -0,0 +1,80 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/etcdserver/errors"
+)
+
+// continueTokenV1 is the version byte of continue tokens holding the read
+// revision as a big endian uint64 followed by the last returned key.
+const continueTokenV1 = 1
+
+func encodeContinueToken(rev int64, lastKey []byte) []byte {
+	tok := make([]byte, 9+len(lastKey))
+	tok[0] = continueTokenV1
+	binary.BigEndian.PutUint64(tok[1:9], uint64(rev))
+	copy(tok[9:], lastKey)
+	return tok
+}
+
+func decodeContinueToken(tok []byte) (rev int64, lastKey []byte, err error) {
+	if len(tok) < 9 || tok[0] != continueTokenV1 {
+		return 0, nil, errors.ErrInvalidContinueToken
+	}
+	rev = int64(binary.BigEndian.Uint64(tok[1:9]))
+	if rev <= 0 {
+		return 0, nil, errors.ErrInvalidContinueToken
+	}
+	return rev, tok[9:], nil
+}
+
+// isKeyOrdered returns true if the range returns its keys in ascending key
+// order, which is the only order a range can be continued in.
+func isKeyOrdered(r *pb.RangeRequest) bool {
+	return r.SortTarget == pb.RangeRequest_KEY &&
+		(r.SortOrder == pb.RangeRequest_NONE || r.SortOrder == pb.RangeRequest_ASCEND)
+}
+
+// continueRange returns the key to start reading from and the revision to
+// read at for the given range request, resuming from its continue token
+// when one is set.
+func continueRange(r *pb.RangeRequest) (key []byte, rev int64, err error) {
+	if len(r.ContinueToken) == 0 {
+		return r.Key, r.Revision, nil
+	}
+	rev, lastKey, err := decodeContinueToken(r.ContinueToken)
+	if err != nil {
+		return nil, 0, err
+	}
+	switch {
+	case r.Revision != 0 && r.Revision != rev:
+		return nil, 0, errors.ErrInvalidContinueToken
+	case !isKeyOrdered(r) || len(r.RangeEnd) == 0:
+		return nil, 0, errors.ErrInvalidContinueToken
+	case bytes.Compare(lastKey, r.Key) < 0:
+		return nil, 0, errors.ErrInvalidContinueToken
+	case len(mkGteRange(r.RangeEnd)) != 0 && bytes.Compare(lastKey, r.RangeEnd) >= 0:
+		return nil, 0, errors.ErrInvalidContinueToken
+	}
+	// start right after the last returned key
+	key = make([]byte, len(lastKey)+1)
+	copy(key, lastKey)
+	return key, rev, nil
+}
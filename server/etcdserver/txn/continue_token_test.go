@@ -0,0 +1,62 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"bytes"
+	"testing"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/etcdserver/errors"
+)
+
+func TestContinueRange(t *testing.T) {
+	tok := encodeContinueToken(5, []byte("foo1"))
+
+	tests := []struct {
+		r *pb.RangeRequest
+
+		wkey []byte
+		wrev int64
+		werr error
+	}{
+		{&pb.RangeRequest{Key: []byte("foo"), RangeEnd: []byte("fop"), Revision: 3}, []byte("foo"), 3, nil},
+		{&pb.RangeRequest{Key: []byte("foo"), RangeEnd: []byte("fop"), ContinueToken: tok}, []byte("foo1\x00"), 5, nil},
+		{&pb.RangeRequest{Key: []byte("foo"), RangeEnd: []byte("fop"), Revision: 5, ContinueToken: tok}, []byte("foo1\x00"), 5, nil},
+		{&pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte{0}, ContinueToken: tok}, []byte("foo1\x00"), 5, nil},
+		{&pb.RangeRequest{Key: []byte("foo"), RangeEnd: []byte("fop"), SortOrder: pb.RangeRequest_ASCEND, ContinueToken: tok}, []byte("foo1\x00"), 5, nil},
+		// revision does not match the token
+		{&pb.RangeRequest{Key: []byte("foo"), RangeEnd: []byte("fop"), Revision: 4, ContinueToken: tok}, nil, 0, errors.ErrInvalidContinueToken},
+		// keys not sorted by ascending key
+		{&pb.RangeRequest{Key: []byte("foo"), RangeEnd: []byte("fop"), SortOrder: pb.RangeRequest_DESCEND, ContinueToken: tok}, nil, 0, errors.ErrInvalidContinueToken},
+		{&pb.RangeRequest{Key: []byte("foo"), RangeEnd: []byte("fop"), SortTarget: pb.RangeRequest_MOD, ContinueToken: tok}, nil, 0, errors.ErrInvalidContinueToken},
+		// last key out of the requested range
+		{&pb.RangeRequest{Key: []byte("foo"), ContinueToken: tok}, nil, 0, errors.ErrInvalidContinueToken},
+		{&pb.RangeRequest{Key: []byte("foo2"), RangeEnd: []byte("fop"), ContinueToken: tok}, nil, 0, errors.ErrInvalidContinueToken},
+		{&pb.RangeRequest{Key: []byte("foo"), RangeEnd: []byte("foo1"), ContinueToken: tok}, nil, 0, errors.ErrInvalidContinueToken},
+		// malformed tokens
+		{&pb.RangeRequest{Key: []byte("foo"), RangeEnd: []byte("fop"), ContinueToken: []byte("foo")}, nil, 0, errors.ErrInvalidContinueToken},
+		{&pb.RangeRequest{Key: []byte("foo"), RangeEnd: []byte("fop"), ContinueToken: encodeContinueToken(0, []byte("foo1"))}, nil, 0, errors.ErrInvalidContinueToken},
+	}
+	for i, tt := range tests {
+		key, rev, err := continueRange(tt.r)
+		if err != tt.werr {
+			t.Fatalf("#%d: err = %v, want %v", i, err, tt.werr)
+		}
+		if !bytes.Equal(key, tt.wkey) || rev != tt.wrev {
+			t.Errorf("#%d: key, rev = %q, %d, want %q, %d", i, key, rev, tt.wkey, tt.wrev)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/server/v3/etcdserver/errors"
+)
+
+// valueFilter is the predicate a value filter is compiled into, evaluated by
+// mvcc while reading the range.
+type valueFilter func(kv *mvccpb.KeyValue) bool
+
+// rangeFilters holds the compiled value filters of the range requests of a
+// txn, so each filter is compiled once when the txn is checked.
+type rangeFilters map[*pb.RangeRequest]valueFilter
+
+// newValueFilter compiles the value filter of a range request into a
+// predicate that can be evaluated by mvcc while reading the range.
+// A nil filter returns a nil predicate.
+func newValueFilter(f *pb.ValueFilter) (valueFilter, error) {
+	if f == nil {
+		return nil, nil
+	}
+	pattern := f.Pattern
+	switch f.Type {
+	case pb.ValueFilter_PREFIX:
+		return func(kv *mvccpb.KeyValue) bool { return bytes.HasPrefix(kv.Value, pattern) }, nil
+	case pb.ValueFilter_EQUAL:
+		return func(kv *mvccpb.KeyValue) bool { return bytes.Equal(kv.Value, pattern) }, nil
+	case pb.ValueFilter_REGEX:
+		re, err := regexp.Compile(string(pattern))
+		if err != nil {
+			return nil, errors.ErrInvalidValueFilter
+		}
+		return func(kv *mvccpb.KeyValue) bool { return re.Match(kv.Value) }, nil
+	case pb.ValueFilter_JSON_FIELD:
+		if len(f.JsonPath) == 0 {
+			return nil, errors.ErrInvalidValueFilter
+		}
+		path := strings.Split(f.JsonPath, ".")
+		return func(kv *mvccpb.KeyValue) bool {
+			obj, ok := decodeJSONObject(kv.Value)
+			if !ok {
+				return false
+			}
+			v, ok := lookupJSONField(obj, path)
+			if !ok {
+				return false
+			}
+			if s, ok := v.(string); ok {
+				return s == string(pattern)
+			}
+			b, err := json.Marshal(v)
+			return err == nil && bytes.Equal(b, pattern)
+		}, nil
+	default:
+		return nil, errors.ErrInvalidValueFilter
+	}
+}
+
+// projectValue returns the value holding only the fields selected by the
+// projection. Values that are not JSON objects are returned unmodified.
+func projectValue(p *pb.ValueProjection, val []byte) []byte {
+	if p == nil || len(p.JsonPaths) == 0 {
+		return val
+	}
+	obj, ok := decodeJSONObject(val)
+	if !ok {
+		return val
+	}
+	projected := make(map[string]interface{})
+	for _, jp := range p.JsonPaths {
+		path := strings.Split(jp, ".")
+		v, ok := lookupJSONField(obj, path)
+		if !ok {
+			continue
+		}
+		m := projected
+		for _, name := range path[:len(path)-1] {
+			next, ok := m[name].(map[string]interface{})
+			if !ok {
+				next = make(map[string]interface{})
+				m[name] = next
+			}
+			m = next
+		}
+		m[path[len(path)-1]] = v
+	}
+	b, err := json.Marshal(projected)
+	if err != nil {
+		return val
+	}
+	return b
+}
+
+func decodeJSONObject(val []byte) (map[string]interface{}, bool) {
+	d := json.NewDecoder(bytes.NewReader(val))
+	// keep numbers as they are written instead of converting them to float64
+	d.UseNumber()
+	var obj map[string]interface{}
+	if err := d.Decode(&obj); err != nil || obj == nil {
+		return nil, false
+	}
+	return obj, true
+}
+
+func lookupJSONField(obj map[string]interface{}, path []string) (interface{}, bool) {
+	var v interface{} = obj
+	for _, name := range path {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if v, ok = m[name]; !ok {
+			return nil, false
+		}
+	}
+	return v, true
+}
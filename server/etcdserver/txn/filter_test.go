@@ -0,0 +1,85 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"testing"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/server/v3/etcdserver/errors"
+)
+
+func TestValueFilter(t *testing.T) {
+	tests := []struct {
+		filter *pb.ValueFilter
+		value  string
+		wmatch bool
+	}{
+		{&pb.ValueFilter{Type: pb.ValueFilter_PREFIX, Pattern: []byte("ba")}, "bar", true},
+		{&pb.ValueFilter{Type: pb.ValueFilter_PREFIX, Pattern: []byte("ba")}, "foo", false},
+		{&pb.ValueFilter{Type: pb.ValueFilter_EQUAL, Pattern: []byte("bar")}, "bar", true},
+		{&pb.ValueFilter{Type: pb.ValueFilter_EQUAL, Pattern: []byte("bar")}, "bar1", false},
+		{&pb.ValueFilter{Type: pb.ValueFilter_REGEX, Pattern: []byte("^b.r[0-9]$")}, "bar1", true},
+		{&pb.ValueFilter{Type: pb.ValueFilter_REGEX, Pattern: []byte("^b.r[0-9]$")}, "bar", false},
+		{&pb.ValueFilter{Type: pb.ValueFilter_JSON_FIELD, JsonPath: "metadata.name", Pattern: []byte("foo")}, `{"metadata":{"name":"foo"}}`, true},
+		{&pb.ValueFilter{Type: pb.ValueFilter_JSON_FIELD, JsonPath: "metadata.name", Pattern: []byte("foo")}, `{"metadata":{"name":"bar"}}`, false},
+		{&pb.ValueFilter{Type: pb.ValueFilter_JSON_FIELD, JsonPath: "spec.replicas", Pattern: []byte("3")}, `{"spec":{"replicas":3}}`, true},
+		{&pb.ValueFilter{Type: pb.ValueFilter_JSON_FIELD, JsonPath: "metadata.name", Pattern: []byte("foo")}, `not json`, false},
+		{&pb.ValueFilter{Type: pb.ValueFilter_JSON_FIELD, JsonPath: "metadata.name", Pattern: []byte("foo")}, `{"metadata":"foo"}`, false},
+	}
+	for i, tt := range tests {
+		f, err := newValueFilter(tt.filter)
+		if err != nil {
+			t.Fatalf("#%d: unexpected error %v", i, err)
+		}
+		if match := f(&mvccpb.KeyValue{Value: []byte(tt.value)}); match != tt.wmatch {
+			t.Errorf("#%d: match = %v, want %v", i, match, tt.wmatch)
+		}
+	}
+}
+
+func TestValueFilterInvalid(t *testing.T) {
+	tests := []*pb.ValueFilter{
+		{Type: pb.ValueFilter_REGEX, Pattern: []byte("(")},
+		{Type: pb.ValueFilter_JSON_FIELD, Pattern: []byte("foo")},
+		{Type: pb.ValueFilter_FilterType(100)},
+	}
+	for i, tt := range tests {
+		if _, err := newValueFilter(tt); err != errors.ErrInvalidValueFilter {
+			t.Errorf("#%d: err = %v, want %v", i, err, errors.ErrInvalidValueFilter)
+		}
+	}
+}
+
+func TestProjectValue(t *testing.T) {
+	tests := []struct {
+		paths  []string
+		value  string
+		wvalue string
+	}{
+		{nil, `{"a":1,"b":2}`, `{"a":1,"b":2}`},
+		{[]string{"a"}, `{"a":1,"b":2}`, `{"a":1}`},
+		{[]string{"a", "c.d"}, `{"a":1.50,"c":{"d":"x","e":"y"}}`, `{"a":1.50,"c":{"d":"x"}}`},
+		{[]string{"missing"}, `{"a":1}`, `{}`},
+		{[]string{"a"}, `plain value`, `plain value`},
+	}
+	for i, tt := range tests {
+		v := projectValue(&pb.ValueProjection{JsonPaths: tt.paths}, []byte(tt.value))
+		if string(v) != tt.wvalue {
+			t.Errorf("#%d: value = %s, want %s", i, v, tt.wvalue)
+		}
+	}
+}
@@ -43,7 +43,23 @@ func Put(ctx context.Context, lg *zap.Logger, lessor lease.Lessor, kv mvcc.KV, t
 	}
 	val, leaseID := p.Value, lease.LeaseID(p.Lease)
 	if txnWrite == nil {
-		if leaseID != lease.NoLease {
+		if p.Ttl > 0 {
+			// the lease is granted before the write txn, since granting
+			// persists it through the same backend batch tx
+			if err = checkPutTTL(kv, trace, p); err != nil {
+				return nil, nil, err
+			}
+			if _, err = lessor.GrantImplicit(leaseID, p.Ttl); err != nil {
+				return nil, nil, err
+			}
+			// revoking writes through the backend as well, so it runs after
+			// the write txn is ended
+			defer func() {
+				if err != nil {
+					revokeImplicitLeases(lg, lessor, []lease.LeaseID{leaseID})
+				}
+			}()
+		} else if leaseID != lease.NoLease {
 			if l := lessor.Lookup(leaseID); l == nil {
 				return nil, nil, lease.ErrLeaseNotFound
 			}
@@ -85,6 +101,17 @@ func Put(ctx context.Context, lg *zap.Logger, lessor lease.Lessor, kv mvcc.KV, t
 	return resp, trace, nil
 }
 
+// checkPutTTL checks that a put with a ttl can be applied before its
+// implicit lease is granted.
+func checkPutTTL(kv mvcc.KV, trace *traceutil.Trace, p *pb.PutRequest) error {
+	if !p.IgnoreValue {
+		return nil
+	}
+	txnRead := kv.Read(mvcc.ConcurrentReadTxMode, trace)
+	defer txnRead.End()
+	return checkRequestPut(txnRead, nil, &pb.RequestOp{Request: &pb.RequestOp_RequestPut{RequestPut: p}})
+}
+
 func DeleteRange(kv mvcc.KV, txnWrite mvcc.TxnWrite, dr *pb.DeleteRangeRequest) (*pb.DeleteRangeResponse, error) {
 	resp := &pb.DeleteRangeResponse{}
 	resp.Header = &pb.ResponseHeader{}
@@ -112,7 +139,18 @@ func DeleteRange(kv mvcc.KV, txnWrite mvcc.TxnWrite, dr *pb.DeleteRangeRequest)
 	return resp, nil
 }
 
-func Range(ctx context.Context, lg *zap.Logger, kv mvcc.KV, txnRead mvcc.TxnRead, r *pb.RangeRequest) (*pb.RangeResponse, error) {
+func Range(ctx context.Context, lg *zap.Logger, lessor lease.Lessor, kv mvcc.KV, txnRead mvcc.TxnRead, r *pb.RangeRequest) (*pb.RangeResponse, error) {
+	filter, err := newValueFilter(r.ValueFilter)
+	if err != nil {
+		return nil, err
+	}
+	return rangeWithFilter(ctx, lg, lessor, kv, txnRead, r, filter, false)
+}
+
+// rangeWithFilter is Range with the value filter of r already compiled.
+// If skipCount is set, the count of the response is not the count of the
+// whole range, which saves visiting the rest of the index.
+func rangeWithFilter(ctx context.Context, lg *zap.Logger, lessor lease.Lessor, kv mvcc.KV, txnRead mvcc.TxnRead, r *pb.RangeRequest, filter valueFilter, skipCount bool) (*pb.RangeResponse, error) {
 	trace := traceutil.Get(ctx)
 
 	resp := &pb.RangeResponse{}
@@ -135,14 +173,24 @@ func Range(ctx context.Context, lg *zap.Logger, kv mvcc.KV, txnRead mvcc.TxnRead
 		limit = limit + 1
 	}
 
+	key, rev, err := continueRange(r)
+	if err != nil {
+		return nil, err
+	}
+
 	ro := mvcc.RangeOptions{
-		Limit: limit,
-		Rev:   r.Revision,
-		Count: r.CountOnly,
+		Limit:     limit,
+		Rev:       rev,
+		Count:     r.CountOnly,
+		SkipCount: skipCount,
+		Filter:    filter,
 	}
 
-	rr, err := txnRead.Range(ctx, r.Key, mkGteRange(r.RangeEnd), ro)
+	rr, err := txnRead.Range(ctx, key, mkGteRange(r.RangeEnd), ro)
 	if err != nil {
+		if err == mvcc.ErrCompacted && len(r.ContinueToken) != 0 {
+			return nil, errors.ErrExpiredContinueToken
+		}
 		return nil, err
 	}
 
@@ -202,6 +250,12 @@ func Range(ctx context.Context, lg *zap.Logger, kv mvcc.KV, txnRead mvcc.TxnRead
 	if r.Limit > 0 && len(rr.KVs) > int(r.Limit) {
 		rr.KVs = rr.KVs[:r.Limit]
 		resp.More = true
+		if isKeyOrdered(r) {
+			if rev == 0 {
+				rev = rr.Rev
+			}
+			resp.ContinueToken = encodeContinueToken(rev, rr.KVs[len(rr.KVs)-1].Key)
+		}
 	}
 	trace.Step("filter and sort the key-value pairs")
 	resp.Header.Revision = rr.Rev
@@ -210,13 +264,155 @@ func Range(ctx context.Context, lg *zap.Logger, kv mvcc.KV, txnRead mvcc.TxnRead
 	for i := range rr.KVs {
 		if r.KeysOnly {
 			rr.KVs[i].Value = nil
+		} else if r.ValueProjection != nil {
+			rr.KVs[i].Value = projectValue(r.ValueProjection, rr.KVs[i].Value)
 		}
 		resp.Kvs[i] = &rr.KVs[i]
 	}
+	resp.RemainingTtls = remainingTTLs(lessor, resp.Kvs)
 	trace.Step("assemble the response")
 	return resp, nil
 }
 
+// remainingTTLs returns the remaining TTLs in seconds of the kvs put with a
+// ttl, or nil if none of them was. Members other than the leader estimate
+// them, see lease.Lease.RemainingTTL.
+func remainingTTLs(lessor lease.Lessor, kvs []*mvccpb.KeyValue) []int64 {
+	var ids []lease.LeaseID
+	for i, kv := range kvs {
+		if kv.Lease == 0 {
+			continue
+		}
+		if ids == nil {
+			ids = make([]lease.LeaseID, len(kvs))
+		}
+		ids[i] = lease.LeaseID(kv.Lease)
+	}
+	if ids == nil {
+		return nil
+	}
+	return lessor.RemainingTTLs(ids)
+}
+
+// RangeStream reads the range like Range, but passes the key-value pairs to
+// send in chunks of at most chunkSize keys instead of building one response.
+// Every chunk is read in its own read transaction at the revision of the
+// first chunk, so the stream is a consistent view of the store at a single
+// revision, and no transaction is held open while a chunk is sent. A
+// compaction past that revision fails the rest of the stream with
+// ErrCompacted.
+//
+// Sorting needs the whole range in memory, so only ranges in ascending key
+// order can be streamed. The revision filters are applied to every chunk,
+// which may leave some chunks empty; those are not sent. As with Range, the
+// count of the last chunk is the count before the revision filters, and its
+// More is set if the limit is reached before the end of the range.
+func RangeStream(ctx context.Context, lg *zap.Logger, lessor lease.Lessor, kv mvcc.KV, r *pb.RangeRequest, chunkSize int64, send func(*pb.RangeResponse) error) error {
+	if r.CountOnly {
+		resp, err := Range(ctx, lg, lessor, kv, nil, r)
+		if err != nil {
+			return err
+		}
+		return send(resp)
+	}
+	if !isKeyOrdered(r) {
+		return errors.ErrInvalidSortOption
+	}
+
+	_, rev, err := continueRange(r)
+	if err != nil {
+		return err
+	}
+	filter, err := newValueFilter(r.ValueFilter)
+	if err != nil {
+		return err
+	}
+
+	var (
+		sent  int64
+		count int64
+		hdr   *pb.ResponseHeader
+	)
+	// the revision filters are applied to each chunk instead of making
+	// Range read the whole range.
+	cr := *r
+	cr.MinModRevision, cr.MaxModRevision = 0, 0
+	cr.MinCreateRevision, cr.MaxCreateRevision = 0, 0
+	pruned := r.MinModRevision != 0 || r.MaxModRevision != 0 ||
+		r.MinCreateRevision != 0 || r.MaxCreateRevision != 0
+	for {
+		cr.Limit = chunkSize
+		if !pruned && r.Limit > 0 && r.Limit-sent < chunkSize {
+			cr.Limit = r.Limit - sent
+		}
+		// only the first chunk counts the whole range
+		resp, err := rangeWithFilter(ctx, lg, lessor, kv, nil, &cr, filter, hdr != nil)
+		if err != nil {
+			return err
+		}
+		if hdr == nil {
+			hdr, count = resp.Header, resp.Count
+			if rev == 0 {
+				rev = resp.Header.Revision
+			}
+		}
+		var lastKey []byte
+		if len(resp.Kvs) != 0 {
+			lastKey = resp.Kvs[len(resp.Kvs)-1].Key
+		}
+		if pruned {
+			pruneRevisions(r, resp)
+			if r.Limit > 0 && sent+int64(len(resp.Kvs)) > r.Limit {
+				resp.Kvs = resp.Kvs[:r.Limit-sent]
+				if resp.RemainingTtls != nil {
+					resp.RemainingTtls = resp.RemainingTtls[:r.Limit-sent]
+				}
+				resp.More = true
+				resp.ContinueToken = encodeContinueToken(rev, resp.Kvs[len(resp.Kvs)-1].Key)
+			}
+		}
+		sent += int64(len(resp.Kvs))
+		resp.Header = hdr
+		if !resp.More || (r.Limit > 0 && sent >= r.Limit) {
+			resp.Count = count
+			return send(resp)
+		}
+		resp.More, resp.Count, resp.ContinueToken = false, 0, nil
+		if len(resp.Kvs) != 0 {
+			if err = send(resp); err != nil {
+				return err
+			}
+		}
+
+		// continue right after the last read key
+		cr.Key = append(append(make([]byte, 0, len(lastKey)+1), lastKey...), 0)
+		cr.Revision, cr.ContinueToken = rev, nil
+	}
+}
+
+// pruneRevisions removes the key-value pairs of resp outside the revision
+// filters of r, along with their remaining TTLs.
+func pruneRevisions(r *pb.RangeRequest, resp *pb.RangeResponse) {
+	j := 0
+	for i, kv := range resp.Kvs {
+		if r.MaxModRevision != 0 && kv.ModRevision > r.MaxModRevision ||
+			r.MinModRevision != 0 && kv.ModRevision < r.MinModRevision ||
+			r.MaxCreateRevision != 0 && kv.CreateRevision > r.MaxCreateRevision ||
+			r.MinCreateRevision != 0 && kv.CreateRevision < r.MinCreateRevision {
+			continue
+		}
+		resp.Kvs[j] = kv
+		if resp.RemainingTtls != nil {
+			resp.RemainingTtls[j] = resp.RemainingTtls[i]
+		}
+		j++
+	}
+	resp.Kvs = resp.Kvs[:j]
+	if resp.RemainingTtls != nil {
+		resp.RemainingTtls = resp.RemainingTtls[:j]
+	}
+}
+
 func Txn(ctx context.Context, lg *zap.Logger, rt *pb.TxnRequest, txnModeWriteWithSharedBuffer bool, kv mvcc.KV, lessor lease.Lessor) (*pb.TxnResponse, *traceutil.Trace, error) {
 	trace := traceutil.Get(ctx)
 	if trace.IsEmpty() {
@@ -250,7 +446,9 @@ func Txn(ctx context.Context, lg *zap.Logger, rt *pb.TxnRequest, txnModeWriteWit
 			return nil, nil, err
 		}
 	}
-	if _, err := checkRequests(txnWrite, rt, txnPath, checkRequestRange); err != nil {
+	filters := make(rangeFilters)
+	if _, err := checkRequests(txnWrite, rt, txnPath,
+		func(rv mvcc.ReadView, ro *pb.RequestOp) error { return checkRequestRange(rv, ro, filters) }); err != nil {
 		txnWrite.End()
 		return nil, nil, err
 	}
@@ -263,9 +461,26 @@ func Txn(ctx context.Context, lg *zap.Logger, rt *pb.TxnRequest, txnModeWriteWit
 	// be the revision of the write txnWrite.
 	if isWrite {
 		txnWrite.End()
+		// implicit leases are granted before the write txn, since granting
+		// persists them through the same backend batch tx
+		var granted []lease.LeaseID
+		if _, err := checkRequests(nil, rt, txnPath,
+			func(_ mvcc.ReadView, ro *pb.RequestOp) error {
+				id, err := grantImplicitLease(lessor, ro)
+				if err != nil {
+					return err
+				}
+				if id != lease.NoLease {
+					granted = append(granted, id)
+				}
+				return nil
+			}); err != nil {
+			revokeImplicitLeases(lg, lessor, granted)
+			return nil, nil, err
+		}
 		txnWrite = kv.Write(trace)
 	}
-	applyTxn(ctx, lg, kv, lessor, txnWrite, rt, txnPath, txnResp)
+	applyTxn(ctx, lg, kv, lessor, txnWrite, rt, txnPath, filters, txnResp)
 	rev := txnWrite.Rev()
 	if len(txnWrite.Changes()) != 0 {
 		rev++
@@ -311,7 +526,7 @@ func newTxnResp(rt *pb.TxnRequest, txnPath []bool) (txnResp *pb.TxnResponse, txn
 	return txnResp, txnCount
 }
 
-func applyTxn(ctx context.Context, lg *zap.Logger, kv mvcc.KV, lessor lease.Lessor, txnWrite mvcc.TxnWrite, rt *pb.TxnRequest, txnPath []bool, tresp *pb.TxnResponse) (txns int) {
+func applyTxn(ctx context.Context, lg *zap.Logger, kv mvcc.KV, lessor lease.Lessor, txnWrite mvcc.TxnWrite, rt *pb.TxnRequest, txnPath []bool, filters rangeFilters, tresp *pb.TxnResponse) (txns int) {
 	trace := traceutil.Get(ctx)
 	reqs := rt.Success
 	if !txnPath[0] {
@@ -326,7 +541,7 @@ func applyTxn(ctx context.Context, lg *zap.Logger, kv mvcc.KV, lessor lease.Less
 				traceutil.Field{Key: "req_type", Value: "range"},
 				traceutil.Field{Key: "range_begin", Value: string(tv.RequestRange.Key)},
 				traceutil.Field{Key: "range_end", Value: string(tv.RequestRange.RangeEnd)})
-			resp, err := Range(ctx, lg, kv, txnWrite, tv.RequestRange)
+			resp, err := rangeWithFilter(ctx, lg, lessor, kv, txnWrite, tv.RequestRange, filters[tv.RequestRange], false)
 			if err != nil {
 				lg.Panic("unexpected error during txnWrite", zap.Error(err))
 			}
@@ -351,7 +566,7 @@ func applyTxn(ctx context.Context, lg *zap.Logger, kv mvcc.KV, lessor lease.Less
 			respi.(*pb.ResponseOp_ResponseDeleteRange).ResponseDeleteRange = resp
 		case *pb.RequestOp_RequestTxn:
 			resp := respi.(*pb.ResponseOp_ResponseTxn).ResponseTxn
-			applyTxns := applyTxn(ctx, lg, kv, lessor, txnWrite, tv.RequestTxn, txnPath[1:], resp)
+			applyTxns := applyTxn(ctx, lg, kv, lessor, txnWrite, tv.RequestTxn, txnPath[1:], filters, resp)
 			txns += applyTxns + 1
 			txnPath = txnPath[applyTxns+1:]
 		default:
@@ -381,7 +596,7 @@ func checkRequestPut(rv mvcc.ReadView, lessor lease.Lessor, reqOp *pb.RequestOp)
 			return errors.ErrKeyNotFound
 		}
 	}
-	if lease.LeaseID(req.Lease) != lease.NoLease {
+	if lease.LeaseID(req.Lease) != lease.NoLease && req.Ttl == 0 {
 		if l := lessor.Lookup(lease.LeaseID(req.Lease)); l == nil {
 			return lease.ErrLeaseNotFound
 		}
@@ -389,18 +604,58 @@ func checkRequestPut(rv mvcc.ReadView, lessor lease.Lessor, reqOp *pb.RequestOp)
 	return nil
 }
 
-func checkRequestRange(rv mvcc.ReadView, reqOp *pb.RequestOp) error {
+// grantImplicitLease grants the implicit lease of a put with a ttl, and returns
+// its ID, or NoLease if the request needs none.
+func grantImplicitLease(lessor lease.Lessor, reqOp *pb.RequestOp) (lease.LeaseID, error) {
+	req := reqOp.GetRequestPut()
+	if req == nil || req.Ttl == 0 {
+		return lease.NoLease, nil
+	}
+	id := lease.LeaseID(req.Lease)
+	if _, err := lessor.GrantImplicit(id, req.Ttl); err != nil {
+		return lease.NoLease, err
+	}
+	return id, nil
+}
+
+// revokeImplicitLeases revokes the implicit leases granted for a request that
+// failed before its puts were applied, so that they do not outlive it.
+func revokeImplicitLeases(lg *zap.Logger, lessor lease.Lessor, ids []lease.LeaseID) {
+	for _, id := range ids {
+		if err := lessor.Revoke(id); err != nil {
+			lg.Warn("failed to revoke implicit lease", zap.Int64("lease-id", int64(id)), zap.Error(err))
+		}
+	}
+}
+
+// checkRequestRange checks a range request of a txn, and compiles its value
+// filter into filters for applyTxn.
+func checkRequestRange(rv mvcc.ReadView, reqOp *pb.RequestOp, filters rangeFilters) error {
 	tv, ok := reqOp.Request.(*pb.RequestOp_RequestRange)
 	if !ok || tv.RequestRange == nil {
 		return nil
 	}
 	req := tv.RequestRange
+	filter, err := newValueFilter(req.ValueFilter)
+	if err != nil {
+		return err
+	}
+	if filter != nil {
+		filters[req] = filter
+	}
+	_, rev, err := continueRange(req)
+	if err != nil {
+		return err
+	}
 	switch {
-	case req.Revision == 0:
+	case rev == 0:
 		return nil
-	case req.Revision > rv.Rev():
+	case rev > rv.Rev():
 		return mvcc.ErrFutureRev
-	case req.Revision < rv.FirstRev():
+	case rev < rv.FirstRev():
+		if len(req.ContinueToken) != 0 {
+			return errors.ErrExpiredContinueToken
+		}
 		return mvcc.ErrCompacted
 	}
 	return nil
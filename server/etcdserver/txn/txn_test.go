@@ -0,0 +1,153 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/etcdserver/errors"
+	"go.etcd.io/etcd/server/v3/lease"
+	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestRangeStream(t *testing.T) {
+	be, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, be)
+	lg := zaptest.NewLogger(t)
+	s := mvcc.NewStore(lg, be, &lease.FakeLessor{}, mvcc.StoreConfig{})
+	defer s.Close()
+
+	for _, k := range []string{"a", "b", "c", "d", "e"} {
+		s.Put([]byte(k), []byte(k), lease.NoLease)
+	}
+
+	tests := []struct {
+		r *pb.RangeRequest
+
+		wchunks [][]string
+		wmore   bool
+		wcount  int64
+	}{
+		{
+			&pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("z")},
+			[][]string{{"a", "b"}, {"c", "d"}, {"e"}}, false, 5,
+		},
+		{
+			&pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("z"), Limit: 3},
+			[][]string{{"a", "b"}, {"c"}}, true, 5,
+		},
+		{
+			&pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("e")},
+			[][]string{{"a", "b"}, {"c", "d"}}, false, 4,
+		},
+		{
+			&pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("z"), ContinueToken: encodeContinueToken(6, []byte("b"))},
+			[][]string{{"c", "d"}, {"e"}}, false, 3,
+		},
+		{
+			// the chunk of "a" and "b" is left empty by the filter
+			&pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("z"), MinModRevision: 5},
+			[][]string{{"d"}, {"e"}}, false, 5,
+		},
+		{
+			&pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("z"), MaxCreateRevision: 4, Limit: 2},
+			[][]string{{"a", "b"}}, true, 5,
+		},
+		{
+			&pb.RangeRequest{Key: []byte("c")},
+			[][]string{{"c"}}, false, 1,
+		},
+	}
+	for i, tt := range tests {
+		var chunks [][]string
+		var last *pb.RangeResponse
+		err := RangeStream(context.Background(), lg, &lease.FakeLessor{}, s, tt.r, 2, func(resp *pb.RangeResponse) error {
+			if last != nil && (last.More || last.Count != 0) {
+				t.Errorf("#%d: more or count set on a chunk before the last one", i)
+			}
+			var keys []string
+			for _, kv := range resp.Kvs {
+				keys = append(keys, string(kv.Key))
+			}
+			chunks, last = append(chunks, keys), resp
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("#%d: unexpected error %v", i, err)
+		}
+		if !reflect.DeepEqual(chunks, tt.wchunks) {
+			t.Errorf("#%d: chunks = %v, want %v", i, chunks, tt.wchunks)
+		}
+		if last.More != tt.wmore || last.Count != tt.wcount {
+			t.Errorf("#%d: more, count = %v, %d, want %v, %d", i, last.More, last.Count, tt.wmore, tt.wcount)
+		}
+	}
+
+	// sorting would need the whole range in memory
+	r := &pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("z"), SortOrder: pb.RangeRequest_DESCEND}
+	err := RangeStream(context.Background(), lg, &lease.FakeLessor{}, s, r, 2, func(*pb.RangeResponse) error {
+		t.Errorf("unexpected chunk sent for a sorted range")
+		return nil
+	})
+	if err != errors.ErrInvalidSortOption {
+		t.Errorf("err = %v, want %v", err, errors.ErrInvalidSortOption)
+	}
+}
+
+// TestRejectedTTLPutRevokesLease ensures the implicit leases granted for puts
+// with a ttl do not outlive a rejected request.
+func TestRejectedTTLPutRevokesLease(t *testing.T) {
+	be, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, be)
+	lg := zaptest.NewLogger(t)
+	le := lease.NewLessor(lg, be, nil, lease.LessorConfig{MinLeaseTTL: 1})
+	defer le.Stop()
+	s := mvcc.NewStore(lg, be, le, mvcc.StoreConfig{})
+	defer s.Close()
+
+	if _, err := le.Grant(2, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	// the put expects a previous key
+	_, _, err := Put(context.Background(), lg, le, s, nil, &pb.PutRequest{Key: []byte("foo"), Ttl: 10, Lease: 1, IgnoreValue: true})
+	if err != errors.ErrKeyNotFound {
+		t.Errorf("err = %v, want %v", err, errors.ErrKeyNotFound)
+	}
+	if n := len(le.Leases()); n != 1 {
+		t.Errorf("leases = %d after a rejected put, want 1", n)
+	}
+
+	// the implicit lease of the second put collides with an existing one
+	rt := &pb.TxnRequest{Success: []*pb.RequestOp{
+		{Request: &pb.RequestOp_RequestPut{RequestPut: &pb.PutRequest{Key: []byte("foo"), Ttl: 10, Lease: 1}}},
+		{Request: &pb.RequestOp_RequestPut{RequestPut: &pb.PutRequest{Key: []byte("bar"), Ttl: 10, Lease: 2}}},
+	}}
+	_, _, err = Txn(context.Background(), lg, rt, false, s, le)
+	if err != lease.ErrLeaseExists {
+		t.Errorf("err = %v, want %v", err, lease.ErrLeaseExists)
+	}
+	if n := len(le.Leases()); n != 1 {
+		t.Errorf("leases = %d after a rejected txn, want 1", n)
+	}
+	if rev := s.Rev(); rev != 1 {
+		t.Errorf("rev = %d, want 1", rev)
+	}
+}
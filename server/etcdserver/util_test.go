@@ -91,6 +91,8 @@ func (s *nopTransporterWithActiveTime) Pause()                              {}
 func (s *nopTransporterWithActiveTime) Resume()                             {}
 func (s *nopTransporterWithActiveTime) reset(am map[types.ID]time.Time)     { s.activeMap = am }
 
+func (s *nopTransporterWithActiveTime) DelegateSnapshot(id types.ID, m snap.Message) {}
+
 func TestPanicAlternativeStringer(t *testing.T) {
 	p := panicAlternativeStringer{alternative: func() string { return "alternative" }}
 
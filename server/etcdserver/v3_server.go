@@ -52,10 +52,15 @@ const (
 	// The timeout for the node to catch up its applied index, and is used in
 	// lease related operations, such as LeaseRenew and LeaseTimeToLive.
 	applyTimeout = time.Second
+
+	// rangeStreamChunkSize is the max number of keys sent in one chunk of
+	// a RangeStream response.
+	rangeStreamChunkSize = 1000
 )
 
 type RaftKV interface {
 	Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeResponse, error)
+	RangeStream(ctx context.Context, r *pb.RangeRequest, send func(*pb.RangeResponse) error) error
 	Put(ctx context.Context, r *pb.PutRequest) (*pb.PutResponse, error)
 	DeleteRange(ctx context.Context, r *pb.DeleteRangeRequest) (*pb.DeleteRangeResponse, error)
 	Txn(ctx context.Context, r *pb.TxnRequest) (*pb.TxnResponse, error)
@@ -90,11 +95,13 @@ type Authenticator interface {
 	UserGrantRole(ctx context.Context, r *pb.AuthUserGrantRoleRequest) (*pb.AuthUserGrantRoleResponse, error)
 	UserGet(ctx context.Context, r *pb.AuthUserGetRequest) (*pb.AuthUserGetResponse, error)
 	UserRevokeRole(ctx context.Context, r *pb.AuthUserRevokeRoleRequest) (*pb.AuthUserRevokeRoleResponse, error)
+	UserSetRateLimit(ctx context.Context, r *pb.AuthUserSetRateLimitRequest) (*pb.AuthUserSetRateLimitResponse, error)
 	RoleAdd(ctx context.Context, r *pb.AuthRoleAddRequest) (*pb.AuthRoleAddResponse, error)
 	RoleGrantPermission(ctx context.Context, r *pb.AuthRoleGrantPermissionRequest) (*pb.AuthRoleGrantPermissionResponse, error)
 	RoleGet(ctx context.Context, r *pb.AuthRoleGetRequest) (*pb.AuthRoleGetResponse, error)
 	RoleRevokePermission(ctx context.Context, r *pb.AuthRoleRevokePermissionRequest) (*pb.AuthRoleRevokePermissionResponse, error)
 	RoleDelete(ctx context.Context, r *pb.AuthRoleDeleteRequest) (*pb.AuthRoleDeleteResponse, error)
+	RoleSetRateLimit(ctx context.Context, r *pb.AuthRoleSetRateLimitRequest) (*pb.AuthRoleSetRateLimitResponse, error)
 	UserList(ctx context.Context, r *pb.AuthUserListRequest) (*pb.AuthUserListResponse, error)
 	RoleList(ctx context.Context, r *pb.AuthRoleListRequest) (*pb.AuthRoleListResponse, error)
 }
@@ -131,7 +138,7 @@ func (s *EtcdServer) Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeRe
 		return s.authStore.IsRangePermitted(ai, r.Key, r.RangeEnd)
 	}
 
-	get := func() { resp, err = txn.Range(ctx, s.Logger(), s.KV(), nil, r) }
+	get := func() { resp, err = txn.Range(ctx, s.Logger(), s.lessor, s.KV(), nil, r) }
 	if serr := s.doSerialize(ctx, chk, get); serr != nil {
 		err = serr
 		return nil, err
@@ -139,8 +146,43 @@ func (s *EtcdServer) Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeRe
 	return resp, err
 }
 
+func (s *EtcdServer) RangeStream(ctx context.Context, r *pb.RangeRequest, send func(*pb.RangeResponse) error) error {
+	trace := traceutil.New("range_stream",
+		s.Logger(),
+		traceutil.Field{Key: "range_begin", Value: string(r.Key)},
+		traceutil.Field{Key: "range_end", Value: string(r.RangeEnd)},
+	)
+	ctx = context.WithValue(ctx, traceutil.TraceKey, trace)
+	defer trace.LogIfLong(traceThreshold)
+
+	if !r.Serializable {
+		err := s.linearizableReadNotify(ctx)
+		trace.Step("agreement among raft nodes before linearized reading")
+		if err != nil {
+			return err
+		}
+	}
+	chk := func(ai *auth.AuthInfo) error {
+		return s.authStore.IsRangePermitted(ai, r.Key, r.RangeEnd)
+	}
+
+	ai, err := s.checkSerialize(ctx, chk)
+	if err != nil {
+		return err
+	}
+	return txn.RangeStream(ctx, s.Logger(), s.lessor, s.KV(), r, rangeStreamChunkSize, func(resp *pb.RangeResponse) error {
+		// like doSerialize, check for stale token revision before sending
+		// each chunk in case the auth store was updated while streaming.
+		if ai.Revision != 0 && ai.Revision != s.authStore.Revision() {
+			return auth.ErrAuthOldRevision
+		}
+		return send(resp)
+	})
+}
+
 func (s *EtcdServer) Put(ctx context.Context, r *pb.PutRequest) (*pb.PutResponse, error) {
 	ctx = context.WithValue(ctx, traceutil.StartTimeKey, time.Now())
+	r = s.chooseImplicitLease(r)
 	resp, err := s.raftRequest(ctx, pb.InternalRaftRequest{Put: r})
 	if err != nil {
 		return nil, err
@@ -191,6 +233,7 @@ func (s *EtcdServer) Txn(ctx context.Context, r *pb.TxnRequest) (*pb.TxnResponse
 	}
 
 	ctx = context.WithValue(ctx, traceutil.StartTimeKey, time.Now())
+	r = s.chooseTxnImplicitLeases(r)
 	resp, err := s.raftRequest(ctx, pb.InternalRaftRequest{Txn: r})
 	if err != nil {
 		return nil, err
@@ -256,6 +299,63 @@ func (s *EtcdServer) LeaseGrant(ctx context.Context, r *pb.LeaseGrantRequest) (*
 	return resp.(*pb.LeaseGrantResponse), nil
 }
 
+// chooseImplicitLease chooses the ID of the lease a put with a ttl is
+// attached to, so that every member grants the lease with the same ID. It
+// returns a copy of r with the lease set, or r itself if r has no ttl.
+func (s *EtcdServer) chooseImplicitLease(r *pb.PutRequest) *pb.PutRequest {
+	if r.Ttl <= 0 || r.Lease != int64(lease.NoLease) {
+		return r
+	}
+	cr := *r
+	for cr.Lease == int64(lease.NoLease) || s.lessor.Lookup(lease.LeaseID(cr.Lease)) != nil {
+		// only use positive int64 id's, and skip the ids of granted leases.
+		// A lease granted with the same id before the put is applied
+		// fails the put with ErrLeaseExists.
+		cr.Lease = int64(s.reqIDGen.Next() & ((1 << 63) - 1))
+	}
+	return &cr
+}
+
+// chooseTxnImplicitLeases is chooseImplicitLease for the puts of a txn. It
+// returns a copy of r holding copies of the puts with a ttl, or r itself if
+// none of them has one.
+func (s *EtcdServer) chooseTxnImplicitLeases(r *pb.TxnRequest) *pb.TxnRequest {
+	success, schanged := s.chooseOpsImplicitLeases(r.Success)
+	failure, fchanged := s.chooseOpsImplicitLeases(r.Failure)
+	if !schanged && !fchanged {
+		return r
+	}
+	cr := *r
+	cr.Success, cr.Failure = success, failure
+	return &cr
+}
+
+func (s *EtcdServer) chooseOpsImplicitLeases(ops []*pb.RequestOp) ([]*pb.RequestOp, bool) {
+	var cops []*pb.RequestOp
+	for i, op := range ops {
+		cop := op
+		if p := op.GetRequestPut(); p != nil {
+			if cp := s.chooseImplicitLease(p); cp != p {
+				cop = &pb.RequestOp{Request: &pb.RequestOp_RequestPut{RequestPut: cp}}
+			}
+		} else if t := op.GetRequestTxn(); t != nil {
+			if ct := s.chooseTxnImplicitLeases(t); ct != t {
+				cop = &pb.RequestOp{Request: &pb.RequestOp_RequestTxn{RequestTxn: ct}}
+			}
+		}
+		if cop != op && cops == nil {
+			cops = append(make([]*pb.RequestOp, 0, len(ops)), ops[:i]...)
+		}
+		if cops != nil {
+			cops = append(cops, cop)
+		}
+	}
+	if cops == nil {
+		return ops, false
+	}
+	return cops, true
+}
+
 func (s *EtcdServer) waitAppliedIndex() error {
 	select {
 	case <-s.ApplyWait():
@@ -559,6 +659,14 @@ func (s *EtcdServer) UserRevokeRole(ctx context.Context, r *pb.AuthUserRevokeRol
 	return resp.(*pb.AuthUserRevokeRoleResponse), nil
 }
 
+func (s *EtcdServer) UserSetRateLimit(ctx context.Context, r *pb.AuthUserSetRateLimitRequest) (*pb.AuthUserSetRateLimitResponse, error) {
+	resp, err := s.raftRequest(ctx, pb.InternalRaftRequest{AuthUserSetRateLimit: r})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.AuthUserSetRateLimitResponse), nil
+}
+
 func (s *EtcdServer) RoleAdd(ctx context.Context, r *pb.AuthRoleAddRequest) (*pb.AuthRoleAddResponse, error) {
 	resp, err := s.raftRequest(ctx, pb.InternalRaftRequest{AuthRoleAdd: r})
 	if err != nil {
@@ -599,6 +707,14 @@ func (s *EtcdServer) RoleRevokePermission(ctx context.Context, r *pb.AuthRoleRev
 	return resp.(*pb.AuthRoleRevokePermissionResponse), nil
 }
 
+func (s *EtcdServer) RoleSetRateLimit(ctx context.Context, r *pb.AuthRoleSetRateLimitRequest) (*pb.AuthRoleSetRateLimitResponse, error) {
+	resp, err := s.raftRequest(ctx, pb.InternalRaftRequest{AuthRoleSetRateLimit: r})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.AuthRoleSetRateLimitResponse), nil
+}
+
 func (s *EtcdServer) RoleDelete(ctx context.Context, r *pb.AuthRoleDeleteRequest) (*pb.AuthRoleDeleteResponse, error) {
 	resp, err := s.raftRequest(ctx, pb.InternalRaftRequest{AuthRoleDelete: r})
 	if err != nil {
@@ -633,19 +749,10 @@ func (s *EtcdServer) raftRequest(ctx context.Context, r pb.InternalRaftRequest)
 
 // doSerialize handles the auth logic, with permissions checked by "chk", for a serialized request "get". Returns a non-nil error on authentication failure.
 func (s *EtcdServer) doSerialize(ctx context.Context, chk func(*auth.AuthInfo) error, get func()) error {
-	trace := traceutil.Get(ctx)
-	ai, err := s.AuthInfoFromCtx(ctx)
+	ai, err := s.checkSerialize(ctx, chk)
 	if err != nil {
 		return err
 	}
-	if ai == nil {
-		// chk expects non-nil AuthInfo; use empty credentials
-		ai = &auth.AuthInfo{}
-	}
-	if err = chk(ai); err != nil {
-		return err
-	}
-	trace.Step("get authentication metadata")
 	// fetch response for serialized request
 	get()
 	// check for stale token revision in case the auth store was updated while
@@ -656,6 +763,25 @@ func (s *EtcdServer) doSerialize(ctx context.Context, chk func(*auth.AuthInfo) e
 	return nil
 }
 
+// checkSerialize checks the permission of a serialized request with chk and
+// returns the auth info it was checked with.
+func (s *EtcdServer) checkSerialize(ctx context.Context, chk func(*auth.AuthInfo) error) (*auth.AuthInfo, error) {
+	trace := traceutil.Get(ctx)
+	ai, err := s.AuthInfoFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ai == nil {
+		// chk expects non-nil AuthInfo; use empty credentials
+		ai = &auth.AuthInfo{}
+	}
+	if err = chk(ai); err != nil {
+		return nil, err
+	}
+	trace.Step("get authentication metadata")
+	return ai, nil
+}
+
 func (s *EtcdServer) processInternalRaftRequestOnce(ctx context.Context, r pb.InternalRaftRequest) (*apply2.Result, error) {
 	ai := s.getAppliedIndex()
 	ci := s.getCommittedIndex()
@@ -902,7 +1028,20 @@ func (s *EtcdServer) linearizableReadNotify(ctx context.Context) error {
 	}
 }
 
+// authInfoKey is the context key of the auth info a request has already
+// been authenticated with, see WithAuthInfo.
+type authInfoKey struct{}
+
+// WithAuthInfo returns a copy of ctx carrying the auth info of the request,
+// so that AuthInfoFromCtx does not authenticate the request again.
+func WithAuthInfo(ctx context.Context, ai *auth.AuthInfo) context.Context {
+	return context.WithValue(ctx, authInfoKey{}, ai)
+}
+
 func (s *EtcdServer) AuthInfoFromCtx(ctx context.Context) (*auth.AuthInfo, error) {
+	if ai, ok := ctx.Value(authInfoKey{}).(*auth.AuthInfo); ok {
+		return ai, nil
+	}
 	authInfo, err := s.AuthStore().AuthInfoFromCtx(ctx)
 	if authInfo != nil || err != nil {
 		return authInfo, err
@@ -34,10 +34,14 @@ type Lease struct {
 	ID           LeaseID
 	ttl          int64 // time to live of the lease in seconds
 	remainingTTL int64 // remaining time to live in seconds, if zero valued it is considered unset and the full ttl should be used
+	implicit     bool  // implicit is true for the lease of a key put with a ttl
 	// expiryMu protects concurrent accesses to expiry
 	expiryMu sync.RWMutex
 	// expiry is time when lease should expire. no expiration when expiry.IsZero() is true
 	expiry time.Time
+	// checkpointed is the time the remaining TTL was last set on this member,
+	// when the lease was granted, recovered or checkpointed.
+	checkpointed time.Time
 
 	// mu protects concurrent accesses to itemSet
 	mu      sync.RWMutex
@@ -49,8 +53,18 @@ func (l *Lease) expired() bool {
 	return l.Remaining() <= 0
 }
 
+// unused returns true for an implicit lease whose key was detached.
+func (l *Lease) unused() bool {
+	if !l.implicit {
+		return false
+	}
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return len(l.itemSet) == 0
+}
+
 func (l *Lease) persistTo(b backend.Backend) {
-	lpb := leasepb.Lease{ID: int64(l.ID), TTL: l.ttl, RemainingTTL: l.remainingTTL}
+	lpb := leasepb.Lease{ID: int64(l.ID), TTL: l.ttl, RemainingTTL: l.remainingTTL, Implicit: l.implicit}
 	tx := b.BatchTx()
 	tx.LockInsideApply()
 	defer tx.Unlock()
@@ -62,6 +76,11 @@ func (l *Lease) TTL() int64 {
 	return l.ttl
 }
 
+// Implicit returns true if the lease was granted for a key put with a TTL.
+func (l *Lease) Implicit() bool {
+	return l.implicit
+}
+
 // RemainingTTL returns the last checkpointed remaining TTL of the lease.
 func (l *Lease) getRemainingTTL() int64 {
 	if l.remainingTTL > 0 {
@@ -96,6 +115,26 @@ func (l *Lease) Keys() []string {
 	return keys
 }
 
+// RemainingTTL returns the remaining TTL of the lease in seconds, at least 1
+// until it is revoked. Only the primary lessor tracks the expiry of leases,
+// so the others estimate it from the last remaining TTL checkpointed and the
+// time it was applied, which misses the renewals since the checkpoint. When
+// lease checkpoints are disabled, the estimate counts down from the grant,
+// so a renewed lease is reported with a TTL of 1 long before it expires.
+func (l *Lease) RemainingTTL() int64 {
+	remaining := l.Remaining()
+	if remaining == time.Duration(math.MaxInt64) {
+		l.expiryMu.RLock()
+		remaining = time.Duration(l.getRemainingTTL())*time.Second - time.Since(l.checkpointed)
+		l.expiryMu.RUnlock()
+	}
+	if ttl := int64(math.Ceil(remaining.Seconds())); ttl > 1 {
+		return ttl
+	}
+	// expired but not revoked yet
+	return 1
+}
+
 // Remaining returns the remaining time of the lease.
 func (l *Lease) Remaining() time.Duration {
 	l.expiryMu.RLock()
@@ -21,6 +21,7 @@ import (
 	"math"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/coreos/go-semver/semver"
@@ -95,6 +96,11 @@ type Lessor interface {
 	// Grant grants a lease that expires at least after TTL seconds.
 	// 创建lease
 	Grant(id LeaseID, ttl int64) (*Lease, error)
+
+	// GrantImplicit grants a lease like Grant for a key put with a TTL. The
+	// lease is revoked as soon as its key is detached from it.
+	GrantImplicit(id LeaseID, ttl int64) (*Lease, error)
+
 	// Revoke revokes a lease with given ID. The item attached to the
 	// given lease will be removed. If the ID does not exist, an error
 	// will be returned.
@@ -138,6 +144,11 @@ type Lessor interface {
 	// Lookup gives the lease at a given lease id, if any
 	Lookup(id LeaseID) *Lease
 
+	// RemainingTTLs returns the remaining TTLs in seconds of the implicit
+	// leases among ids, and 0 for the other ids, or nil if none of them is
+	// an implicit lease.
+	RemainingTTLs(ids []LeaseID) []int64
+
 	// Leases lists all leases.
 	Leases() []*Lease
 
@@ -166,6 +177,10 @@ type lessor struct {
 	leaseCheckpointHeap  LeaseQueue
 	itemMap              map[LeaseItem]LeaseID
 
+	// implicitLeases is the number of implicit leases in leaseMap, so that
+	// RemainingTTLs skips the lookups when there are none.
+	implicitLeases int32
+
 	// When a lease expires, the lessor will delete the
 	// leased range (or key) by the RangeDeleter.
 	rd RangeDeleter
@@ -282,6 +297,14 @@ func (le *lessor) SetCheckpointer(cp Checkpointer) {
 }
 
 func (le *lessor) Grant(id LeaseID, ttl int64) (*Lease, error) {
+	return le.grant(id, ttl, false)
+}
+
+func (le *lessor) GrantImplicit(id LeaseID, ttl int64) (*Lease, error) {
+	return le.grant(id, ttl, true)
+}
+
+func (le *lessor) grant(id LeaseID, ttl int64, implicit bool) (*Lease, error) {
 	if id == NoLease {
 		return nil, ErrLeaseNotFound
 	}
@@ -293,10 +316,12 @@ func (le *lessor) Grant(id LeaseID, ttl int64) (*Lease, error) {
 	// TODO: when lessor is under high load, it should give out lease
 	// with longer TTL to reduce renew load.
 	l := &Lease{
-		ID:      id,
-		ttl:     ttl,
-		itemSet: make(map[LeaseItem]struct{}),
-		revokec: make(chan struct{}),
+		ID:           id,
+		ttl:          ttl,
+		implicit:     implicit,
+		checkpointed: time.Now(),
+		itemSet:      make(map[LeaseItem]struct{}),
+		revokec:      make(chan struct{}),
 	}
 
 	if l.ttl < le.minLeaseTTL {
@@ -317,6 +342,9 @@ func (le *lessor) Grant(id LeaseID, ttl int64) (*Lease, error) {
 	}
 
 	le.leaseMap[id] = l
+	if l.implicit {
+		atomic.AddInt32(&le.implicitLeases, 1)
+	}
 	l.persistTo(le.b)
 
 	leaseTotalTTLs.Observe(float64(l.ttl))
@@ -344,6 +372,9 @@ func (le *lessor) Revoke(id LeaseID) error {
 	// it may lead to deadlock with Grant or Checkpoint operations, which
 	// acquire the le.mu firstly and then the batchTx lock.
 	delete(le.leaseMap, id)
+	if l.implicit {
+		atomic.AddInt32(&le.implicitLeases, -1)
+	}
 
 	defer close(l.revokec)
 	// unlock before doing external work
@@ -380,7 +411,10 @@ func (le *lessor) Checkpoint(id LeaseID, remainingTTL int64) error {
 
 	if l, ok := le.leaseMap[id]; ok {
 		// when checkpointing, we only update the remainingTTL, Promote is responsible for applying this to lease expiry
+		l.expiryMu.Lock()
 		l.remainingTTL = remainingTTL
+		l.checkpointed = time.Now()
+		l.expiryMu.Unlock()
 		if le.shouldPersistCheckpoints() {
 			l.persistTo(le.b)
 		}
@@ -464,6 +498,26 @@ func (le *lessor) Lookup(id LeaseID) *Lease {
 	return le.leaseMap[id]
 }
 
+func (le *lessor) RemainingTTLs(ids []LeaseID) []int64 {
+	if atomic.LoadInt32(&le.implicitLeases) == 0 {
+		return nil
+	}
+	le.mu.RLock()
+	defer le.mu.RUnlock()
+	var ttls []int64
+	for i, id := range ids {
+		l := le.leaseMap[id]
+		if l == nil || !l.implicit {
+			continue
+		}
+		if ttls == nil {
+			ttls = make([]int64, len(ids))
+		}
+		ttls[i] = l.RemainingTTL()
+	}
+	return ttls
+}
+
 func (le *lessor) unsafeLeases() []*Lease {
 	leases := make([]*Lease, 0, len(le.leaseMap))
 	for _, l := range le.leaseMap {
@@ -570,6 +624,13 @@ func (le *lessor) Attach(id LeaseID, items []LeaseItem) error {
 		le.itemMap[it] = id
 	}
 	l.mu.Unlock()
+
+	if l.implicit && le.isPrimary() {
+		// a key may be attached after the lease was left unused, so
+		// restore the expiry of the lease
+		item := &LeaseWithTime{id: l.ID, time: l.expiry}
+		le.leaseExpiredNotifier.RegisterOrUpdate(item)
+	}
 	return nil
 }
 
@@ -582,6 +643,7 @@ func (le *lessor) GetLease(item LeaseItem) LeaseID {
 
 // Detach detaches items from the lease with given ID.
 // If the given lease does not exist, an error will be returned.
+// An implicit lease left without items is revoked right away.
 func (le *lessor) Detach(id LeaseID, items []LeaseItem) error {
 	le.mu.Lock()
 	defer le.mu.Unlock()
@@ -597,6 +659,13 @@ func (le *lessor) Detach(id LeaseID, items []LeaseItem) error {
 		delete(le.itemMap, it)
 	}
 	l.mu.Unlock()
+
+	if l.unused() && le.isPrimary() {
+		// the key of the lease was overwritten or deleted, let the
+		// primary lessor revoke the lease with the expired ones
+		item := &LeaseWithTime{id: l.ID, time: time.Now()}
+		le.leaseExpiredNotifier.RegisterOrUpdate(item)
+	}
 	return nil
 }
 
@@ -608,6 +677,7 @@ func (le *lessor) Recover(b backend.Backend, rd RangeDeleter) {
 	le.rd = rd
 	le.leaseMap = make(map[LeaseID]*Lease)
 	le.itemMap = make(map[LeaseItem]LeaseID)
+	atomic.StoreInt32(&le.implicitLeases, 0)
 	le.initAndRecover()
 }
 
@@ -622,7 +692,6 @@ func (le *lessor) Stop() {
 
 // 开启两个协程
 // 一个固定间隔获取过期的lease进行销毁
-//
 func (le *lessor) runLoop() {
 	defer close(le.doneC)
 
@@ -746,7 +815,7 @@ func (le *lessor) findExpiredLeases(limit int) []*Lease {
 			continue
 		}
 
-		if l.expired() {
+		if l.expired() || l.unused() {
 			leases = append(leases, l)
 
 			// reach expired limit
@@ -828,15 +897,20 @@ func (le *lessor) initAndRecover() {
 			lpb.TTL = le.minLeaseTTL
 		}
 		le.leaseMap[ID] = &Lease{
-			ID:  ID,
-			ttl: lpb.TTL,
+			ID:       ID,
+			ttl:      lpb.TTL,
+			implicit: lpb.Implicit,
 			// itemSet will be filled in when recover key-value pairs
 			// set expiry to forever, refresh when promoted
 			itemSet:      make(map[LeaseItem]struct{}),
 			expiry:       forever,
+			checkpointed: time.Now(),
 			revokec:      make(chan struct{}),
 			remainingTTL: lpb.RemainingTTL,
 		}
+		if lpb.Implicit {
+			atomic.AddInt32(&le.implicitLeases, 1)
+		}
 	}
 	le.leaseExpiredNotifier.Init()
 	heap.Init(&le.leaseCheckpointHeap)
@@ -854,6 +928,8 @@ func (fl *FakeLessor) SetCheckpointer(cp Checkpointer) {}
 
 func (fl *FakeLessor) Grant(id LeaseID, ttl int64) (*Lease, error) { return nil, nil }
 
+func (fl *FakeLessor) GrantImplicit(id LeaseID, ttl int64) (*Lease, error) { return nil, nil }
+
 func (fl *FakeLessor) Revoke(id LeaseID) error { return nil }
 
 func (fl *FakeLessor) Checkpoint(id LeaseID, remainingTTL int64) error { return nil }
@@ -871,6 +947,8 @@ func (fl *FakeLessor) Renew(id LeaseID) (int64, error) { return 10, nil }
 
 func (fl *FakeLessor) Lookup(id LeaseID) *Lease { return nil }
 
+func (fl *FakeLessor) RemainingTTLs(ids []LeaseID) []int64 { return nil }
+
 func (fl *FakeLessor) Leases() []*Lease { return nil }
 
 func (fl *FakeLessor) ExpiredLeasesC() <-chan []*Lease { return nil }
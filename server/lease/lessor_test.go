@@ -99,7 +99,7 @@ func TestLessorGrant(t *testing.T) {
 	defer tx.Unlock()
 	lpb := schema.MustUnsafeGetLease(tx, int64(l.ID))
 	if lpb == nil {
-		t.Errorf("lpb = %d, want not nil", lpb)
+		t.Errorf("lpb = %v, want not nil", lpb)
 	}
 }
 
@@ -203,7 +203,7 @@ func TestLessorRevoke(t *testing.T) {
 	defer tx.Unlock()
 	lpb := schema.MustUnsafeGetLease(tx, int64(l.ID))
 	if lpb != nil {
-		t.Errorf("lpb = %d, want nil", lpb)
+		t.Errorf("lpb = %v, want nil", lpb)
 	}
 }
 
@@ -378,6 +378,48 @@ func TestLessorDetach(t *testing.T) {
 	}
 }
 
+// TestLessorDetachImplicit ensures an implicit lease expires once its key
+// is detached, and that it is recovered as implicit.
+func TestLessorDetachImplicit(t *testing.T) {
+	lg := zap.NewNop()
+	dir, be := NewTestBackend(t)
+	defer os.RemoveAll(dir)
+	defer be.Close()
+
+	le := newLessor(lg, be, clusterLatest(), LessorConfig{MinLeaseTTL: minLeaseTTL})
+	defer le.Stop()
+	le.Promote(0)
+
+	l, err := le.GrantImplicit(1, 100)
+	if err != nil {
+		t.Fatalf("could not grant implicit lease for 100s ttl (%v)", err)
+	}
+	if !l.Implicit() {
+		t.Fatalf("lease is not implicit")
+	}
+	if err = le.Attach(l.ID, []LeaseItem{{"foo"}}); err != nil {
+		t.Fatalf("failed to attach items to the lease: %v", err)
+	}
+
+	nle := newLessor(lg, be, clusterLatest(), LessorConfig{MinLeaseTTL: minLeaseTTL})
+	defer nle.Stop()
+	if nl := nle.Lookup(l.ID); nl == nil || !nl.Implicit() {
+		t.Errorf("recovered lease = %v, want implicit lease", nl)
+	}
+
+	if err = le.Detach(l.ID, []LeaseItem{{"foo"}}); err != nil {
+		t.Fatalf("failed to de-attach items to the lease: %v", err)
+	}
+	select {
+	case el := <-le.ExpiredLeasesC():
+		if el[0].ID != l.ID {
+			t.Fatalf("expired id = %x, want %x", el[0].ID, l.ID)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatalf("failed to receive expired lease")
+	}
+}
+
 // TestLessorRecover ensures Lessor recovers leases from
 // persist backend.
 func TestLessorRecover(t *testing.T) {
@@ -582,6 +624,68 @@ func TestLessorCheckpointsRestoredOnPromote(t *testing.T) {
 	}
 }
 
+// TestLessorRemainingTTLOnFollower ensures that a lessor which is not the
+// primary estimates the remaining TTL of a lease from its last checkpoint.
+func TestLessorRemainingTTLOnFollower(t *testing.T) {
+	lg := zap.NewNop()
+	dir, be := NewTestBackend(t)
+	defer os.RemoveAll(dir)
+	defer be.Close()
+
+	le := newLessor(lg, be, clusterLatest(), LessorConfig{MinLeaseTTL: minLeaseTTL})
+	defer le.Stop()
+	l, err := le.Grant(1, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ttl := l.RemainingTTL(); ttl != 10 {
+		t.Fatalf("expected remaining TTL 10, got %d", ttl)
+	}
+	if err = le.Checkpoint(l.ID, 5); err != nil {
+		t.Fatal(err)
+	}
+	if ttl := l.RemainingTTL(); ttl != 5 {
+		t.Fatalf("expected remaining TTL 5, got %d", ttl)
+	}
+	// an expired lease that is not revoked yet still has a TTL of 1
+	l.expiryMu.Lock()
+	l.checkpointed = l.checkpointed.Add(-time.Minute)
+	l.expiryMu.Unlock()
+	if ttl := l.RemainingTTL(); ttl != 1 {
+		t.Fatalf("expected remaining TTL 1, got %d", ttl)
+	}
+}
+
+// TestLessorRemainingTTLs ensures that RemainingTTLs only returns the
+// remaining TTLs of implicit leases.
+func TestLessorRemainingTTLs(t *testing.T) {
+	lg := zap.NewNop()
+	dir, be := NewTestBackend(t)
+	defer os.RemoveAll(dir)
+	defer be.Close()
+
+	le := newLessor(lg, be, clusterLatest(), LessorConfig{MinLeaseTTL: minLeaseTTL})
+	defer le.Stop()
+	if _, err := le.Grant(1, 10); err != nil {
+		t.Fatal(err)
+	}
+	if ttls := le.RemainingTTLs([]LeaseID{1, 2}); ttls != nil {
+		t.Fatalf("expected no remaining TTLs without implicit leases, got %v", ttls)
+	}
+	if _, err := le.GrantImplicit(2, 20); err != nil {
+		t.Fatal(err)
+	}
+	if ttls := le.RemainingTTLs([]LeaseID{1, 2, 3}); !reflect.DeepEqual(ttls, []int64{0, 20, 0}) {
+		t.Fatalf("expected remaining TTLs [0 20 0], got %v", ttls)
+	}
+	if err := le.Revoke(2); err != nil {
+		t.Fatal(err)
+	}
+	if ttls := le.RemainingTTLs([]LeaseID{1, 2}); ttls != nil {
+		t.Fatalf("expected no remaining TTLs once the implicit lease is revoked, got %v", ttls)
+	}
+}
+
 func TestLessorCheckpointPersistenceAfterRestart(t *testing.T) {
 	const ttl int64 = 10
 	const checkpointTTL int64 = 5
@@ -92,6 +92,14 @@ func (s *as2ac) UserRevokeRole(ctx context.Context, in *pb.AuthUserRevokeRoleReq
 	return s.as.UserRevokeRole(ctx, in)
 }
 
+func (s *as2ac) UserSetRateLimit(ctx context.Context, in *pb.AuthUserSetRateLimitRequest, opts ...grpc.CallOption) (*pb.AuthUserSetRateLimitResponse, error) {
+	return s.as.UserSetRateLimit(ctx, in)
+}
+
+func (s *as2ac) RoleSetRateLimit(ctx context.Context, in *pb.AuthRoleSetRateLimitRequest, opts ...grpc.CallOption) (*pb.AuthRoleSetRateLimitResponse, error) {
+	return s.as.RoleSetRateLimit(ctx, in)
+}
+
 func (s *as2ac) UserChangePassword(ctx context.Context, in *pb.AuthUserChangePasswordRequest, opts ...grpc.CallOption) (*pb.AuthUserChangePasswordResponse, error) {
 	return s.as.UserChangePassword(ctx, in)
 }
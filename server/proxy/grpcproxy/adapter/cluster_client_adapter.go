@@ -47,3 +47,11 @@ func (s *cls2clc) MemberRemove(ctx context.Context, r *pb.MemberRemoveRequest, o
 func (s *cls2clc) MemberPromote(ctx context.Context, r *pb.MemberPromoteRequest, opts ...grpc.CallOption) (*pb.MemberPromoteResponse, error) {
 	return s.cls.MemberPromote(ctx, r)
 }
+
+func (s *cls2clc) MemberSetLeaderPriority(ctx context.Context, r *pb.MemberSetLeaderPriorityRequest, opts ...grpc.CallOption) (*pb.MemberSetLeaderPriorityResponse, error) {
+	return s.cls.MemberSetLeaderPriority(ctx, r)
+}
+
+func (s *cls2clc) MemberReconfigure(ctx context.Context, r *pb.MemberReconfigureRequest, opts ...grpc.CallOption) (*pb.MemberReconfigureResponse, error) {
+	return s.cls.MemberReconfigure(ctx, r)
+}
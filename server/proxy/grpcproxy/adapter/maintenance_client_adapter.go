@@ -56,6 +56,14 @@ func (s *mts2mtc) Downgrade(ctx context.Context, r *pb.DowngradeRequest, opts ..
 	return s.mts.Downgrade(ctx, r)
 }
 
+func (s *mts2mtc) PrefixQuotaStatus(ctx context.Context, r *pb.PrefixQuotaStatusRequest, opts ...grpc.CallOption) (*pb.PrefixQuotaStatusResponse, error) {
+	return s.mts.PrefixQuotaStatus(ctx, r)
+}
+
+func (s *mts2mtc) CorruptionCheck(ctx context.Context, r *pb.CorruptionCheckRequest, opts ...grpc.CallOption) (*pb.CorruptionCheckResponse, error) {
+	return s.mts.CorruptionCheck(ctx, r)
+}
+
 func (s *mts2mtc) Snapshot(ctx context.Context, in *pb.SnapshotRequest, opts ...grpc.CallOption) (pb.Maintenance_SnapshotClient, error) {
 	cs := newPipeStream(ctx, func(ss chanServerStream) error {
 		return s.mts.Snapshot(in, &ss2scServerStream{ss})
@@ -175,3 +175,11 @@ func (cp *clusterProxy) MemberPromote(ctx context.Context, r *pb.MemberPromoteRe
 	// TODO: implement
 	return nil, errors.New("not implemented")
 }
+
+func (cp *clusterProxy) MemberSetLeaderPriority(ctx context.Context, r *pb.MemberSetLeaderPriorityRequest) (*pb.MemberSetLeaderPriorityResponse, error) {
+	return cp.clus.MemberSetLeaderPriority(ctx, r)
+}
+
+func (cp *clusterProxy) MemberReconfigure(ctx context.Context, r *pb.MemberReconfigureRequest) (*pb.MemberReconfigureResponse, error) {
+	return cp.clus.MemberReconfigure(ctx, r)
+}
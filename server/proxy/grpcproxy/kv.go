@@ -16,6 +16,7 @@ package grpcproxy
 
 import (
 	"context"
+	"io"
 
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/client/v3"
@@ -67,6 +68,27 @@ func (p *kvProxy) Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeRespo
 	return gresp, nil
 }
 
+// RangeStream is not served from the cache, since ranges streamed in chunks
+// are usually too large to be worth caching.
+func (p *kvProxy) RangeStream(r *pb.RangeRequest, stream pb.KV_RangeStreamServer) error {
+	rs, err := p.kv.GetStream(stream.Context(), string(r.Key), rangeRequestToOpOptions(r)...)
+	if err != nil {
+		return err
+	}
+	for {
+		resp, err := rs.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err = stream.Send(&pb.RangeStreamResponse{RangeResponse: (*pb.RangeResponse)(resp)}); err != nil {
+			return err
+		}
+	}
+}
+
 func (p *kvProxy) Put(ctx context.Context, r *pb.PutRequest) (*pb.PutResponse, error) {
 	p.cache.Invalidate(r.Key, nil)
 	cacheKeys.Set(float64(p.cache.Size()))
@@ -162,6 +184,10 @@ func requestOpToOp(union *pb.RequestOp) clientv3.Op {
 }
 
 func RangeRequestToOp(r *pb.RangeRequest) clientv3.Op {
+	return clientv3.OpGet(string(r.Key), rangeRequestToOpOptions(r)...)
+}
+
+func rangeRequestToOpOptions(r *pb.RangeRequest) []clientv3.OpOption {
 	opts := []clientv3.OpOption{}
 	if len(r.RangeEnd) != 0 {
 		opts = append(opts, clientv3.WithRange(string(r.RangeEnd)))
@@ -185,8 +211,25 @@ func RangeRequestToOp(r *pb.RangeRequest) clientv3.Op {
 	if r.Serializable {
 		opts = append(opts, clientv3.WithSerializable())
 	}
-
-	return clientv3.OpGet(string(r.Key), opts...)
+	if f := r.ValueFilter; f != nil {
+		switch f.Type {
+		case pb.ValueFilter_PREFIX:
+			opts = append(opts, clientv3.WithValuePrefix(string(f.Pattern)))
+		case pb.ValueFilter_EQUAL:
+			opts = append(opts, clientv3.WithValueEqual(string(f.Pattern)))
+		case pb.ValueFilter_REGEX:
+			opts = append(opts, clientv3.WithValueRegex(string(f.Pattern)))
+		case pb.ValueFilter_JSON_FIELD:
+			opts = append(opts, clientv3.WithValueJSONField(f.JsonPath, string(f.Pattern)))
+		}
+	}
+	if r.ValueProjection != nil {
+		opts = append(opts, clientv3.WithValueFields(r.ValueProjection.JsonPaths...))
+	}
+	if len(r.ContinueToken) != 0 {
+		opts = append(opts, clientv3.WithContinueToken(r.ContinueToken))
+	}
+	return opts
 }
 
 func PutRequestToOp(r *pb.PutRequest) clientv3.Op {
@@ -201,6 +244,9 @@ func PutRequestToOp(r *pb.PutRequest) clientv3.Op {
 	if r.PrevKv {
 		opts = append(opts, clientv3.WithPrevKV())
 	}
+	if r.Ttl != 0 {
+		opts = append(opts, clientv3.WithTTL(r.Ttl))
+	}
 	return clientv3.OpPut(string(r.Key), string(r.Value), opts...)
 }
 
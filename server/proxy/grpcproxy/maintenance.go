@@ -93,3 +93,13 @@ func (mp *maintenanceProxy) Downgrade(ctx context.Context, r *pb.DowngradeReques
 	conn := mp.client.ActiveConnection()
 	return pb.NewMaintenanceClient(conn).Downgrade(ctx, r)
 }
+
+func (mp *maintenanceProxy) PrefixQuotaStatus(ctx context.Context, r *pb.PrefixQuotaStatusRequest) (*pb.PrefixQuotaStatusResponse, error) {
+	conn := mp.client.ActiveConnection()
+	return pb.NewMaintenanceClient(conn).PrefixQuotaStatus(ctx, r)
+}
+
+func (mp *maintenanceProxy) CorruptionCheck(ctx context.Context, r *pb.CorruptionCheckRequest) (*pb.CorruptionCheckResponse, error) {
+	conn := mp.client.ActiveConnection()
+	return pb.NewMaintenanceClient(conn).CorruptionCheck(ctx, r)
+}
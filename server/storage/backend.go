@@ -15,6 +15,7 @@
 package storage
 
 import (
+	"encoding/binary"
 	"fmt"
 	"os"
 	"time"
@@ -23,12 +24,14 @@ import (
 	"go.etcd.io/etcd/server/v3/config"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/snap"
 	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/encryption"
 	"go.etcd.io/etcd/server/v3/storage/schema"
 
+	bolt "go.etcd.io/bbolt"
 	"go.uber.org/zap"
 )
 
-func newBackend(cfg config.ServerConfig, hooks backend.Hooks) backend.Backend {
+func newBackend(cfg config.ServerConfig, hooks backend.Hooks, kr *encryption.Keyring) backend.Backend {
 	bcfg := backend.DefaultBackendConfig(cfg.Logger)
 	bcfg.Path = cfg.BackendPath()
 	bcfg.UnsafeNoFsync = cfg.UnsafeNoFsync
@@ -52,28 +55,66 @@ func newBackend(cfg config.ServerConfig, hooks backend.Hooks) backend.Backend {
 	}
 	bcfg.Mlock = cfg.ExperimentalMemoryMlock
 	bcfg.Hooks = hooks
+	if kr != nil {
+		bcfg.Encryptor = kr
+	}
 	return backend.New(bcfg)
 }
 
 // OpenSnapshotBackend renames a snapshot db to the current etcd db and opens it.
-func OpenSnapshotBackend(cfg config.ServerConfig, ss *snap.Snapshotter, snapshot raftpb.Snapshot, hooks *BackendHooks) (backend.Backend, error) {
+func OpenSnapshotBackend(cfg config.ServerConfig, ss *snap.Snapshotter, snapshot raftpb.Snapshot, hooks *BackendHooks, kr *encryption.Keyring) (backend.Backend, error) {
 	snapPath, err := ss.DBFilePath(snapshot.Metadata.Index)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find database snapshot file (%v)", err)
 	}
+	// The WAL may hold entries encrypted with keys only the current db has,
+	// so they are persisted to the snapshot db before it replaces it.
+	sbe := backend.NewDefaultBackend(cfg.Logger, snapPath)
+	err = LoadKeyring(sbe, kr)
+	sbe.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load encryption keys of database snapshot file (%v)", err)
+	}
 	if err := os.Rename(snapPath, cfg.BackendPath()); err != nil {
 		return nil, fmt.Errorf("failed to rename database snapshot file (%v)", err)
 	}
-	return OpenBackend(cfg, hooks), nil
+	return OpenBackend(cfg, hooks, kr), nil
+}
+
+// ReadSnapshotConsistentIndex returns the consistent index of the snapshot db
+// received along with the given snapshot, without opening it as a backend.
+func ReadSnapshotConsistentIndex(ss *snap.Snapshotter, snapshot raftpb.Snapshot) (uint64, error) {
+	snapPath, err := ss.DBFilePath(snapshot.Metadata.Index)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find database snapshot file (%v)", err)
+	}
+	db, err := bolt.Open(snapPath, 0400, &bolt.Options{ReadOnly: true, Timeout: time.Second})
+	if err != nil {
+		return 0, fmt.Errorf("failed to open database snapshot file (%v)", err)
+	}
+	defer db.Close()
+
+	var index uint64
+	err = db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(schema.Meta.Name())
+		if b == nil {
+			return fmt.Errorf("database snapshot has no %q bucket", schema.Meta.String())
+		}
+		if v := b.Get(schema.MetaConsistentIndexKeyName); len(v) == 8 {
+			index = binary.BigEndian.Uint64(v)
+		}
+		return nil
+	})
+	return index, err
 }
 
 // OpenBackend returns a backend using the current etcd db.
-func OpenBackend(cfg config.ServerConfig, hooks backend.Hooks) backend.Backend {
+func OpenBackend(cfg config.ServerConfig, hooks backend.Hooks, kr *encryption.Keyring) backend.Backend {
 	fn := cfg.BackendPath()
 
 	now, beOpened := time.Now(), make(chan backend.Backend)
 	go func() {
-		beOpened <- newBackend(cfg, hooks)
+		beOpened <- newBackend(cfg, hooks, kr)
 	}()
 
 	select {
@@ -96,7 +137,7 @@ func OpenBackend(cfg config.ServerConfig, hooks backend.Hooks) backend.Backend {
 // before updating the backend db after persisting raft snapshot to disk,
 // violating the invariant snapshot.Metadata.Index < db.consistentIndex. In this
 // case, replace the db with the snapshot db sent by the leader.
-func RecoverSnapshotBackend(cfg config.ServerConfig, oldbe backend.Backend, snapshot raftpb.Snapshot, beExist bool, hooks *BackendHooks) (backend.Backend, error) {
+func RecoverSnapshotBackend(cfg config.ServerConfig, oldbe backend.Backend, snapshot raftpb.Snapshot, beExist bool, hooks *BackendHooks, kr *encryption.Keyring) (backend.Backend, error) {
 	consistentIndex := uint64(0)
 	if beExist {
 		consistentIndex, _ = schema.ReadConsistentIndex(oldbe.ReadTx())
@@ -105,5 +146,5 @@ func RecoverSnapshotBackend(cfg config.ServerConfig, oldbe backend.Backend, snap
 		return oldbe, nil
 	}
 	oldbe.Close()
-	return OpenSnapshotBackend(cfg, snap.New(cfg.Logger, cfg.SnapDir()), snapshot, hooks)
+	return OpenSnapshotBackend(cfg, snap.New(cfg.Logger, cfg.SnapDir()), snapshot, hooks, kr)
 }
@@ -65,6 +65,9 @@ type Backend interface {
 	// OpenReadTxN returns the number of currently open read transactions in the backend.
 	OpenReadTxN() int64
 	Defrag() error
+	// OnlineDefrag defragments the backend without blocking reads and
+	// writes for the duration of the copy. See onlineDefrag.
+	OnlineDefrag() error
 	ForceCommit()
 	Close() error
 
@@ -120,7 +123,12 @@ type backend struct {
 	stopc chan struct{}
 	donec chan struct{}
 
+	// defragMu serializes defragmentations.
+	defragMu sync.Mutex
+
 	hooks Hooks
+	// enc encrypts the values of some buckets, if set.
+	enc Encryptor
 	// TODO simfg confuse 将这个txPostLockInsideApplyHook 放置 Hooks接口中
 
 	// txPostLockInsideApplyHook is called each time right after locking the tx.
@@ -149,6 +157,8 @@ type BackendConfig struct {
 
 	// Hooks are getting executed during lifecycle of Backend's transactions.
 	Hooks Hooks
+	// Encryptor encrypts the values of some buckets at rest, if set.
+	Encryptor Encryptor
 }
 
 func DefaultBackendConfig(lg *zap.Logger) BackendConfig {
@@ -211,6 +221,7 @@ func newBackend(bcfg BackendConfig) *backend {
 				buckets: make(map[BucketID]*bolt.Bucket),
 				txWg:    new(sync.WaitGroup),
 				txMu:    new(sync.RWMutex),
+				enc:     bcfg.Encryptor,
 			},
 		},
 		txReadBufferCache: txReadBufferCache{
@@ -222,7 +233,8 @@ func newBackend(bcfg BackendConfig) *backend {
 		stopc: make(chan struct{}),
 		donec: make(chan struct{}),
 
-		lg: bcfg.Logger,
+		enc: bcfg.Encryptor,
+		lg:  bcfg.Logger,
 	}
 
 	/***
@@ -327,6 +339,7 @@ func (b *backend) ConcurrentReadTx() ReadTx {
 			tx:      b.readTx.tx,
 			buckets: b.readTx.buckets,
 			txWg:    b.readTx.txWg,
+			enc:     b.readTx.enc,
 		},
 	}
 }
@@ -392,6 +405,7 @@ func (b *backend) Hash(ignores func(bucketName, keyName []byte) bool) (uint32, e
 
 	b.mu.RLock()
 	defer b.mu.RUnlock()
+	enc := b.enc
 	err := b.db.View(func(tx *bolt.Tx) error {
 		c := tx.Cursor()
 		for next, _ := c.First(); next != nil; next, _ = c.Next() {
@@ -400,13 +414,14 @@ func (b *backend) Hash(ignores func(bucketName, keyName []byte) bool) (uint32, e
 				return fmt.Errorf("cannot get hash of bucket %s", string(next))
 			}
 			h.Write(next)
-			b.ForEach(func(k, v []byte) error {
+			// hash the plaintext, as each member encrypts with its own keys.
+			b.ForEach(decryptingVisitor(enc, next, func(k, v []byte) error {
 				if ignores != nil && !ignores(next, k) {
 					h.Write(k)
 					h.Write(v)
 				}
 				return nil
-			})
+			}))
 		}
 		return nil
 	})
@@ -471,6 +486,9 @@ TODO simfg 这个函数的作用是什么呢，有点奇怪，
 TODO simfg confuse 如果空间不足，导致迁移过程中失败了；这里是不是可以和下载文件一样，在迁移之前，先校验一下是否有足够的容量，在生成一个和现在db一样大的文件，然后进行写入
 */
 func (b *backend) defrag() error {
+	b.defragMu.Lock()
+	defer b.defragMu.Unlock()
+
 	now := time.Now()
 	isDefragActive.Set(1)
 	defer isDefragActive.Set(0)
@@ -537,7 +555,45 @@ func (b *backend) defrag() error {
 		return err
 	}
 
-	err = b.db.Close()
+	b.closeDBs(tmpdb)
+	// gofail: var defragBeforeRename struct{}
+	b.renameDB(tdbp, dbp)
+
+	// a copy left by an interrupted online defragmentation is of no use now
+	if rmErr := os.RemoveAll(filepath.Join(filepath.Dir(dbp), onlineDefragFileName)); rmErr != nil {
+		b.lg.Warn("failed to remove online defragmentation copy", zap.Error(rmErr))
+	}
+
+	took := time.Since(now)
+	defragSec.Observe(took.Seconds())
+
+	size2, sizeInUse2 := b.Size(), b.SizeInUse()
+	if b.lg != nil {
+		b.lg.Info(
+			"finished defragmenting directory",
+			zap.String("path", dbp),
+			zap.Int64("current-db-size-bytes-diff", size2-size1),
+			zap.Int64("current-db-size-bytes", size2),
+			zap.String("current-db-size", humanize.Bytes(uint64(size2))),
+			zap.Int64("current-db-size-in-use-bytes-diff", sizeInUse2-sizeInUse1),
+			zap.Int64("current-db-size-in-use-bytes", sizeInUse2),
+			zap.String("current-db-size-in-use", humanize.Bytes(uint64(sizeInUse2))),
+			zap.Duration("took", took),
+		)
+	}
+	return nil
+}
+
+// swapDB replaces the database with tmpdb, which is renamed to dbp. It must
+// be called holding all the locks of the backend, with no open tx.
+func (b *backend) swapDB(tmpdb *bolt.DB, dbp string) {
+	tdbp := tmpdb.Path()
+	b.closeDBs(tmpdb)
+	b.renameDB(tdbp, dbp)
+}
+
+func (b *backend) closeDBs(tmpdb *bolt.DB) {
+	err := b.db.Close()
 	if err != nil {
 		b.lg.Fatal("failed to close database", zap.Error(err))
 	}
@@ -545,8 +601,12 @@ func (b *backend) defrag() error {
 	if err != nil {
 		b.lg.Fatal("failed to close tmp database", zap.Error(err))
 	}
-	// gofail: var defragBeforeRename struct{}
-	err = os.Rename(tdbp, dbp)
+}
+
+// renameDB moves the closed tmp database at tdbp over dbp and opens it as
+// the database of the backend.
+func (b *backend) renameDB(tdbp, dbp string) {
+	err := os.Rename(tdbp, dbp)
 	if err != nil {
 		b.lg.Fatal("failed to rename tmp database", zap.Error(err))
 	}
@@ -564,25 +624,6 @@ func (b *backend) defrag() error {
 	db := b.readTx.tx.DB()
 	atomic.StoreInt64(&b.size, size)
 	atomic.StoreInt64(&b.sizeInUse, size-(int64(db.Stats().FreePageN)*int64(db.Info().PageSize)))
-
-	took := time.Since(now)
-	defragSec.Observe(took.Seconds())
-
-	size2, sizeInUse2 := b.Size(), b.SizeInUse()
-	if b.lg != nil {
-		b.lg.Info(
-			"finished defragmenting directory",
-			zap.String("path", dbp),
-			zap.Int64("current-db-size-bytes-diff", size2-size1),
-			zap.Int64("current-db-size-bytes", size2),
-			zap.String("current-db-size", humanize.Bytes(uint64(size2))),
-			zap.Int64("current-db-size-in-use-bytes-diff", sizeInUse2-sizeInUse1),
-			zap.Int64("current-db-size-in-use-bytes", sizeInUse2),
-			zap.String("current-db-size-in-use", humanize.Bytes(uint64(sizeInUse2))),
-			zap.Duration("took", took),
-		)
-	}
-	return nil
 }
 
 /***将 odb 里面的数据迁移到 tmpdb
@@ -347,3 +347,79 @@ func TestBackendWritebackForEach(t *testing.T) {
 		t.Fatalf("expected %q, got %q", seq, partialSeq)
 	}
 }
+
+// prefixEncryptor "encrypts" the values of the key bucket by prefixing them.
+type prefixEncryptor struct{}
+
+func (prefixEncryptor) Encrypts(bucketName []byte) bool {
+	return string(bucketName) == string(schema.Key.Name())
+}
+
+func (prefixEncryptor) Encrypt(v []byte) ([]byte, error) {
+	return append([]byte("enc:"), v...), nil
+}
+
+func (prefixEncryptor) Decrypt(v []byte) ([]byte, error) {
+	if len(v) < 4 || string(v[:4]) != "enc:" {
+		return nil, fmt.Errorf("not encrypted: %q", v)
+	}
+	return v[4:], nil
+}
+
+// TestBackendEncryptor ensures that the values of the buckets an encryptor
+// encrypts are stored encrypted, and are read back and hashed decrypted.
+func TestBackendEncryptor(t *testing.T) {
+	bcfg := backend.DefaultBackendConfig(zaptest.NewLogger(t))
+	bcfg.Encryptor = prefixEncryptor{}
+	b, path := betesting.NewTmpBackendFromCfg(t, bcfg)
+	plain, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, plain)
+
+	for _, be := range []backend.Backend{b, plain} {
+		tx := be.BatchTx()
+		tx.Lock()
+		tx.UnsafeCreateBucket(schema.Key)
+		tx.UnsafeCreateBucket(schema.Meta)
+		tx.UnsafePut(schema.Key, []byte("abc"), []byte("bar"))
+		tx.UnsafePut(schema.Meta, []byte("abc"), []byte("baz"))
+		tx.Unlock()
+		be.ForceCommit()
+	}
+
+	tx := b.BatchTx()
+	tx.Lock()
+	_, vs := tx.UnsafeRange(schema.Key, []byte("abc"), nil, 0)
+	tx.Unlock()
+	assert.Equal(t, [][]byte{[]byte("bar")}, vs)
+
+	rtx := b.ReadTx()
+	rtx.RLock()
+	_, vs = rtx.UnsafeRange(schema.Key, []byte("abc"), nil, 0)
+	var fvs [][]byte
+	rtx.UnsafeForEach(schema.Key, func(k, v []byte) error {
+		fvs = append(fvs, v)
+		return nil
+	})
+	rtx.RUnlock()
+	assert.Equal(t, [][]byte{[]byte("bar")}, vs)
+	assert.Equal(t, [][]byte{[]byte("bar")}, fvs)
+
+	noIgnores := func(bucketName, keyName []byte) bool { return false }
+	h, err := b.Hash(noIgnores)
+	assert.NoError(t, err)
+	ph, err := plain.Hash(noIgnores)
+	assert.NoError(t, err)
+	assert.Equal(t, ph, h)
+
+	betesting.Close(t, b)
+	db, err := bolt.Open(path, 0600, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	db.View(func(tx *bolt.Tx) error {
+		assert.Equal(t, "enc:bar", string(tx.Bucket(schema.Key.Name()).Get([]byte("abc"))))
+		assert.Equal(t, "baz", string(tx.Bucket(schema.Meta.Name()).Get([]byte("abc"))))
+		return nil
+	})
+}
@@ -63,6 +63,10 @@ type batchTx struct {
 	backend *backend
 
 	pending int
+
+	// defrag is the ongoing online defragmentation, which tracks the
+	// writes to the keys it has copied.
+	defrag *onlineDefrag
 }
 
 // Lock is supposed to be called only by the unit test.
@@ -121,6 +125,9 @@ func (t *batchTx) UnsafeCreateBucket(bucket Bucket) {
 			zap.Error(err),
 		)
 	}
+	if t.defrag != nil && err == nil {
+		t.defrag.markBucketDirty(bucket.Name())
+	}
 	t.pending++
 }
 
@@ -133,6 +140,9 @@ func (t *batchTx) UnsafeDeleteBucket(bucket Bucket) {
 			zap.Error(err),
 		)
 	}
+	if t.defrag != nil && err == nil {
+		t.defrag.markBucketDirty(bucket.Name())
+	}
 	t.pending++
 }
 
@@ -163,6 +173,16 @@ func (t *batchTx) unsafePut(bucketType Bucket, key []byte, value []byte, seq boo
 		// this can delay the page split and reduce space usage.
 		bucket.FillPercent = 0.9
 	}
+	if enc := t.backend.enc; enc != nil && enc.Encrypts(bucketType.Name()) {
+		var err error
+		if value, err = enc.Encrypt(value); err != nil {
+			t.backend.lg.Fatal(
+				"failed to encrypt a value",
+				zap.Stringer("bucket-name", bucketType),
+				zap.Error(err),
+			)
+		}
+	}
 	if err := bucket.Put(key, value); err != nil {
 		t.backend.lg.Fatal(
 			"failed to write to a bucket",
@@ -170,6 +190,9 @@ func (t *batchTx) unsafePut(bucketType Bucket, key []byte, value []byte, seq boo
 			zap.Error(err),
 		)
 	}
+	if t.defrag != nil {
+		t.defrag.markDirty(bucketType.Name(), key)
+	}
 	t.pending++
 }
 
@@ -183,7 +206,9 @@ func (t *batchTx) UnsafeRange(bucketType Bucket, key, endKey []byte, limit int64
 			zap.Stack("stack"),
 		)
 	}
-	return unsafeRange(bucket.Cursor(), key, endKey, limit)
+	keys, vs := unsafeRange(bucket.Cursor(), key, endKey, limit)
+	decryptValues(t.backend.enc, bucketType.Name(), vs)
+	return keys, vs
 }
 
 /***
@@ -229,12 +254,15 @@ func (t *batchTx) UnsafeDelete(bucketType Bucket, key []byte) {
 			zap.Error(err),
 		)
 	}
+	if t.defrag != nil {
+		t.defrag.markDirty(bucketType.Name(), key)
+	}
 	t.pending++
 }
 
 // UnsafeForEach must be called holding the lock on the tx.
 func (t *batchTx) UnsafeForEach(bucket Bucket, visitor func(k, v []byte) error) error {
-	return unsafeForEach(t.tx, bucket, visitor)
+	return unsafeForEach(t.tx, bucket, decryptingVisitor(t.backend.enc, bucket.Name(), visitor))
 }
 
 func unsafeForEach(tx *bolt.Tx, bucket Bucket, visitor func(k, v []byte) error) error {
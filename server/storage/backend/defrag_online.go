@@ -0,0 +1,521 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	humanize "github.com/dustin/go-humanize"
+	bolt "go.etcd.io/bbolt"
+	"go.uber.org/zap"
+)
+
+var (
+	// onlineDefragLimit is the number of keys an online defragmentation
+	// copies while holding the batch tx lock.
+	onlineDefragLimit = 1000
+
+	// onlineDefragLogInterval is the number of batches between the progress
+	// logs of an online defragmentation.
+	onlineDefragLogInterval = 100
+
+	// onlineDefragFileName is the name of the copy an online
+	// defragmentation builds next to the backend file. The copy is kept
+	// across restarts, so that the next online defragmentation resumes
+	// from it. The position of the copy is not kept: a resumed
+	// defragmentation still reads every key of the backend and of the copy,
+	// and only saves the writes of the keys that have not changed.
+	onlineDefragFileName = "db.defrag"
+
+	// ErrDefragStopped is returned by an online defragmentation interrupted
+	// by the backend closing. The next one resumes from its copy.
+	ErrDefragStopped = errors.New("backend: defragmentation stopped")
+)
+
+// onlineDefrag is the state of an ongoing online defragmentation. It is
+// guarded by the batch tx lock.
+type onlineDefrag struct {
+	tmpdb *bolt.DB
+
+	// bucket and key are the position of the copy. The keys of the buckets
+	// before bucket, and the keys before key in bucket, have been copied.
+	// A nil bucket means the copy has not started.
+	bucket []byte
+	key    []byte
+	// done is true once every bucket has been copied.
+	done bool
+
+	// dirty holds the keys the copy is past that were written since the
+	// last batch, by bucket. The keys ahead of the copy are not tracked, as
+	// the batch reaching them copies their latest values, so dirty is
+	// bounded by the writes between two batches.
+	dirty map[string]map[string]struct{}
+	// dirtyBuckets holds the buckets created or deleted since the last batch.
+	dirtyBuckets map[string]struct{}
+
+	// copied is the number of keys copied so far.
+	copied int64
+}
+
+func newOnlineDefrag(tmpdb *bolt.DB) *onlineDefrag {
+	return &onlineDefrag{
+		tmpdb:        tmpdb,
+		dirty:        make(map[string]map[string]struct{}),
+		dirtyBuckets: make(map[string]struct{}),
+	}
+}
+
+// markDirty records that key of bucket was written.
+func (d *onlineDefrag) markDirty(bucket, key []byte) {
+	if !d.copiedKey(bucket, key) {
+		return
+	}
+	keys, ok := d.dirty[string(bucket)]
+	if !ok {
+		keys = make(map[string]struct{})
+		d.dirty[string(bucket)] = keys
+	}
+	keys[string(key)] = struct{}{}
+}
+
+// markBucketDirty records that bucket was created or deleted.
+func (d *onlineDefrag) markBucketDirty(bucket []byte) {
+	d.dirtyBuckets[string(bucket)] = struct{}{}
+}
+
+// copiedBucket returns true if the copy is past bucket.
+func (d *onlineDefrag) copiedBucket(bucket []byte) bool {
+	return d.done || (d.bucket != nil && bytes.Compare(bucket, d.bucket) < 0)
+}
+
+// copiedKey returns true if the copy is past key of bucket.
+func (d *onlineDefrag) copiedKey(bucket, key []byte) bool {
+	if d.copiedBucket(bucket) {
+		return true
+	}
+	return bytes.Equal(bucket, d.bucket) && d.key != nil && bytes.Compare(key, d.key) < 0
+}
+
+// syncDirty brings the writes since the last batch that the copy is past
+// over to tmptx.
+func (d *onlineDefrag) syncDirty(tx, tmptx *bolt.Tx) error {
+	for name := range d.dirtyBuckets {
+		bucket := []byte(name)
+		if !d.copiedBucket(bucket) && !(bytes.Equal(bucket, d.bucket) && d.key != nil) {
+			continue
+		}
+		// the bucket may have been recreated with different keys, copy
+		// the part of it the copy is past again
+		if err := tmptx.DeleteBucket(bucket); err != nil && err != bolt.ErrBucketNotFound {
+			return err
+		}
+		b := tx.Bucket(bucket)
+		if b == nil {
+			continue
+		}
+		tmpb, err := tmptx.CreateBucket(bucket)
+		if err != nil {
+			return err
+		}
+		var end []byte
+		if !d.copiedBucket(bucket) {
+			end = d.key
+		}
+		c := b.Cursor()
+		for k, v := c.First(); k != nil && (end == nil || bytes.Compare(k, end) < 0); k, v = c.Next() {
+			if err = tmpb.Put(k, v); err != nil {
+				return err
+			}
+		}
+		delete(d.dirty, name)
+	}
+
+	for name, keys := range d.dirty {
+		bucket := []byte(name)
+		b := tx.Bucket(bucket)
+		for key := range keys {
+			k := []byte(key)
+			if !d.copiedKey(bucket, k) {
+				continue
+			}
+			var v []byte
+			if b != nil {
+				v = b.Get(k)
+			}
+			if v == nil {
+				if tmpb := tmptx.Bucket(bucket); tmpb != nil {
+					if err := tmpb.Delete(k); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			tmpb, err := tmptx.CreateBucketIfNotExists(bucket)
+			if err != nil {
+				return err
+			}
+			if err = tmpb.Put(k, v); err != nil {
+				return err
+			}
+		}
+	}
+
+	d.dirty = make(map[string]map[string]struct{})
+	d.dirtyBuckets = make(map[string]struct{})
+	return nil
+}
+
+// copyBatch copies up to limit keys from the position of the copy, and
+// moves the position past them. Keys already in tmptx are only rewritten
+// if they differ, and keys of tmptx that are not in tx are deleted, so a
+// copy left by an interrupted defragmentation is brought up to date.
+func (d *onlineDefrag) copyBatch(tx, tmptx *bolt.Tx, limit int) error {
+	rc := tx.Cursor()
+	var name []byte
+	if d.bucket == nil {
+		name, _ = rc.First()
+	} else {
+		name, _ = rc.Seek(d.bucket)
+	}
+	for n := 0; n < limit; {
+		if name == nil {
+			d.done = true
+			return nil
+		}
+		if !bytes.Equal(name, d.bucket) {
+			d.bucket, d.key = append([]byte(nil), name...), nil
+		}
+		b := tx.Bucket(name)
+		if b == nil {
+			return fmt.Errorf("backend: cannot defrag bucket %s", string(name))
+		}
+		tmpb, err := tmptx.CreateBucketIfNotExists(name)
+		if err != nil {
+			return err
+		}
+		tmpb.FillPercent = 0.9 // for bucket2seq write in for each
+
+		next, copied, err := copyRange(b, tmpb, d.key, limit-n)
+		if err != nil {
+			return err
+		}
+		n += copied
+		d.copied += int64(copied)
+		if next != nil {
+			d.key = next
+			return nil
+		}
+		// move on to the next bucket
+		name, _ = rc.Seek(name)
+		name, _ = rc.Next()
+		if name == nil {
+			d.done = true
+			return nil
+		}
+		d.bucket, d.key = append([]byte(nil), name...), nil
+	}
+	return nil
+}
+
+// copyRange makes the keys of tmpb from "from" on match the next limit keys
+// of b. It returns the key to continue from, or nil if b has been copied to
+// the end.
+func copyRange(b, tmpb *bolt.Bucket, from []byte, limit int) (next []byte, copied int, err error) {
+	c := b.Cursor()
+	var k, v []byte
+	if from == nil {
+		k, v = c.First()
+	} else {
+		k, v = c.Seek(from)
+	}
+	var keys, vals [][]byte
+	for ; k != nil; k, v = c.Next() {
+		if len(keys) == limit {
+			next = append([]byte(nil), k...)
+			break
+		}
+		keys, vals = append(keys, k), append(vals, v)
+	}
+
+	// delete the keys of a previous copy that are no longer in b
+	var stale [][]byte
+	tc := tmpb.Cursor()
+	i := 0
+	var tk []byte
+	if from == nil {
+		tk, _ = tc.First()
+	} else {
+		tk, _ = tc.Seek(from)
+	}
+	for ; tk != nil && (next == nil || bytes.Compare(tk, next) < 0); tk, _ = tc.Next() {
+		for i < len(keys) && bytes.Compare(keys[i], tk) < 0 {
+			i++
+		}
+		if i == len(keys) || !bytes.Equal(keys[i], tk) {
+			stale = append(stale, append([]byte(nil), tk...))
+		}
+	}
+	for _, sk := range stale {
+		if err = tmpb.Delete(sk); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	for i := range keys {
+		if tv := tmpb.Get(keys[i]); tv != nil && bytes.Equal(tv, vals[i]) {
+			continue
+		}
+		if err = tmpb.Put(keys[i], vals[i]); err != nil {
+			return nil, 0, err
+		}
+	}
+	return next, len(keys), nil
+}
+
+// dropStaleBuckets deletes the buckets of tmptx that are not in tx.
+func dropStaleBuckets(tx, tmptx *bolt.Tx) error {
+	var stale [][]byte
+	if err := tmptx.ForEach(func(name []byte, _ *bolt.Bucket) error {
+		if tx.Bucket(name) == nil {
+			stale = append(stale, append([]byte(nil), name...))
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, name := range stale {
+		if err := tmptx.DeleteBucket(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *backend) OnlineDefrag() error {
+	return b.onlineDefrag()
+}
+
+// onlineDefrag defragments the backend like defrag, but copies it in
+// bounded batches, each holding the batch tx lock as long as a batch
+// commit would. The writes to the keys already copied are tracked and
+// brought over with the next batch. Reads and writes are only blocked for
+// the last batch and the swap of the files.
+func (b *backend) onlineDefrag() error {
+	b.defragMu.Lock()
+	defer b.defragMu.Unlock()
+
+	now := time.Now()
+	isDefragActive.Set(1)
+	defer isDefragActive.Set(0)
+
+	dbp := b.db.Path()
+	tdbp := filepath.Join(filepath.Dir(dbp), onlineDefragFileName)
+	options := bolt.Options{}
+	if boltOpenOptions != nil {
+		options = *boltOpenOptions
+	}
+	// the copy is synced once before it is swapped in
+	options.NoSync = true
+	// Don't load tmp db into memory regardless of opening options
+	options.Mlock = false
+	tmpdb, resumed, err := openOnlineDefragCopy(b.lg, tdbp, &options)
+	if err != nil {
+		return err
+	}
+
+	size1, sizeInUse1 := b.Size(), b.SizeInUse()
+	b.lg.Info(
+		"defragmenting online",
+		zap.String("path", dbp),
+		zap.Bool("resumed", resumed),
+		zap.Int64("current-db-size-bytes", size1),
+		zap.String("current-db-size", humanize.Bytes(uint64(size1))),
+		zap.Int64("current-db-size-in-use-bytes", sizeInUse1),
+		zap.String("current-db-size-in-use", humanize.Bytes(uint64(sizeInUse1))),
+	)
+
+	d := newOnlineDefrag(tmpdb)
+	b.batchTx.LockOutsideApply()
+	b.batchTx.defrag = d
+	b.batchTx.Unlock()
+	defer func() {
+		b.batchTx.LockOutsideApply()
+		b.batchTx.defrag = nil
+		b.batchTx.Unlock()
+	}()
+
+	for batches := 1; ; batches++ {
+		select {
+		case <-b.stopc:
+			tmpdb.Close()
+			return ErrDefragStopped
+		default:
+		}
+
+		b.batchTx.LockOutsideApply()
+		if b.batchTx.tx == nil {
+			// the backend is closing
+			b.batchTx.Unlock()
+			tmpdb.Close()
+			return ErrDefragStopped
+		}
+		err = d.step(b.batchTx.tx, onlineDefragLimit)
+		done := d.done
+		b.batchTx.Unlock()
+		if err != nil {
+			tmpdb.Close()
+			return err
+		}
+		onlineDefragCopiedKeys.Set(float64(d.copied))
+		if done {
+			break
+		}
+		if batches%onlineDefragLogInterval == 0 {
+			b.lg.Info(
+				"defragmenting online in progress",
+				zap.String("path", dbp),
+				zap.String("bucket", string(d.bucket)),
+				zap.Int64("copied-keys", d.copied),
+				zap.Duration("took", time.Since(now)),
+			)
+		}
+	}
+
+	// lock batchTx to ensure nobody is using previous tx, and then
+	// close previous ongoing tx.
+	b.batchTx.LockOutsideApply()
+	defer b.batchTx.Unlock()
+
+	// lock database after lock tx to avoid deadlock.
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// block concurrent read requests while resetting tx
+	b.readTx.Lock()
+	defer b.readTx.Unlock()
+
+	err = d.finish(b.batchTx.tx)
+	if err == nil {
+		err = tmpdb.Sync()
+	}
+	if err != nil {
+		tmpdb.Close()
+		return err
+	}
+
+	b.batchTx.unsafeCommit(true)
+
+	b.batchTx.tx = nil
+
+	// gofail: var onlineDefragBeforeRename struct{}
+	b.swapDB(tmpdb, dbp)
+
+	took := time.Since(now)
+	defragSec.Observe(took.Seconds())
+
+	size2, sizeInUse2 := b.Size(), b.SizeInUse()
+	b.lg.Info(
+		"finished defragmenting online",
+		zap.String("path", dbp),
+		zap.Int64("copied-keys", d.copied),
+		zap.Int64("current-db-size-bytes-diff", size2-size1),
+		zap.Int64("current-db-size-bytes", size2),
+		zap.String("current-db-size", humanize.Bytes(uint64(size2))),
+		zap.Int64("current-db-size-in-use-bytes-diff", sizeInUse2-sizeInUse1),
+		zap.Int64("current-db-size-in-use-bytes", sizeInUse2),
+		zap.String("current-db-size-in-use", humanize.Bytes(uint64(sizeInUse2))),
+		zap.Duration("took", took),
+	)
+	return nil
+}
+
+// openOnlineDefragCopy opens the copy at path, and returns true if it was
+// left by a previous online defragmentation. The copy is written without
+// syncing, so a crash may leave it torn: a left copy that cannot be opened,
+// or fails the consistency check, is removed and started over.
+func openOnlineDefragCopy(lg *zap.Logger, path string, options *bolt.Options) (*bolt.DB, bool, error) {
+	if _, err := os.Stat(path); err != nil {
+		db, err := bolt.Open(path, 0600, options)
+		return db, false, err
+	}
+	db, err := bolt.Open(path, 0600, options)
+	if err == nil {
+		if err = checkOnlineDefragCopy(db); err != nil {
+			db.Close()
+		}
+	}
+	if err == nil {
+		return db, true, nil
+	}
+	lg.Warn("discarding online defragmentation copy, starting over", zap.String("path", path), zap.Error(err))
+	if err = os.Remove(path); err != nil {
+		return nil, false, err
+	}
+	db, err = bolt.Open(path, 0600, options)
+	return db, false, err
+}
+
+// checkOnlineDefragCopy returns the first inconsistency found in db.
+func checkOnlineDefragCopy(db *bolt.DB) error {
+	return db.View(func(tx *bolt.Tx) error {
+		var first error
+		// drain the errors, so that the check goroutine returns
+		for err := range tx.Check() {
+			if first == nil {
+				first = err
+			}
+		}
+		return first
+	})
+}
+
+// step brings the writes since the last batch over, and copies the next
+// batch, from tx into the copy.
+func (d *onlineDefrag) step(tx *bolt.Tx, limit int) error {
+	tmptx, err := d.tmpdb.Begin(true)
+	if err != nil {
+		return err
+	}
+	if err = d.syncDirty(tx, tmptx); err == nil {
+		err = d.copyBatch(tx, tmptx, limit)
+	}
+	if err != nil {
+		tmptx.Rollback()
+		return err
+	}
+	return tmptx.Commit()
+}
+
+// finish brings the last writes over and drops the buckets deleted from tx
+// since a previous copy.
+func (d *onlineDefrag) finish(tx *bolt.Tx) error {
+	tmptx, err := d.tmpdb.Begin(true)
+	if err != nil {
+		return err
+	}
+	if err = d.syncDirty(tx, tmptx); err == nil {
+		err = dropStaleBuckets(tx, tmptx)
+	}
+	if err != nil {
+		tmptx.Rollback()
+		return err
+	}
+	return tmptx.Commit()
+}
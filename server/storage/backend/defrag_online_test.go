@@ -0,0 +1,259 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+func TestBackendOnlineDefrag(t *testing.T) {
+	defer backend.SetOnlineDefragLimitForTest(10)()
+
+	b, _ := betesting.NewTmpBackend(t, time.Hour, 10000)
+	defer betesting.Close(t, b)
+
+	want := make(map[string]string)
+	tx := b.BatchTx()
+	tx.Lock()
+	tx.UnsafeCreateBucket(schema.Test)
+	for i := 0; i < 1000; i++ {
+		k := fmt.Sprintf("foo_%04d", i)
+		tx.UnsafePut(schema.Test, []byte(k), []byte("bar"))
+		want[k] = "bar"
+	}
+	tx.Unlock()
+	b.ForceCommit()
+
+	// write to keys on both sides of the copy while it runs
+	donec := make(chan struct{})
+	writec := make(chan struct{})
+	go func() {
+		defer close(writec)
+		for i := 0; ; i++ {
+			select {
+			case <-donec:
+				return
+			default:
+			}
+			tx := b.BatchTx()
+			tx.Lock()
+			k := fmt.Sprintf("foo_%04d", (i*7)%1000)
+			if i%3 == 0 {
+				tx.UnsafeDelete(schema.Test, []byte(k))
+				delete(want, k)
+			} else {
+				v := fmt.Sprintf("bar_%d", i)
+				tx.UnsafePut(schema.Test, []byte(k), []byte(v))
+				want[k] = v
+			}
+			k = fmt.Sprintf("new_%04d", i)
+			tx.UnsafePut(schema.Test, []byte(k), []byte("bar"))
+			want[k] = "bar"
+			tx.Unlock()
+		}
+	}()
+
+	err := b.OnlineDefrag()
+	close(donec)
+	<-writec
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.ForceCommit()
+
+	// the copy holds the writes made during the defragmentation
+	got := make(map[string]string)
+	tx = b.BatchTx()
+	tx.Lock()
+	tx.UnsafeForEach(schema.Test, func(k, v []byte) error {
+		got[string(k)] = string(v)
+		return nil
+	})
+	tx.Unlock()
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %d keys, want %d keys", len(got), len(want))
+	}
+
+	path := backend.DbFromBackendForTest(b).Path()
+	if _, err = os.Stat(filepath.Join(filepath.Dir(path), "db.defrag")); !os.IsNotExist(err) {
+		t.Errorf("online defragmentation copy is left, err = %v", err)
+	}
+}
+
+func TestBackendOnlineDefragResume(t *testing.T) {
+	b, path := betesting.NewTmpBackend(t, time.Hour, 10000)
+	defer betesting.Close(t, b)
+
+	tx := b.BatchTx()
+	tx.Lock()
+	tx.UnsafeCreateBucket(schema.Test)
+	for i := 0; i < 100; i++ {
+		tx.UnsafePut(schema.Test, []byte(fmt.Sprintf("foo_%d", i)), []byte("bar"))
+	}
+	tx.Unlock()
+	b.ForceCommit()
+
+	// leave a copy as an interrupted defragmentation would, with stale
+	// keys and buckets
+	tdb, err := bolt.Open(filepath.Join(filepath.Dir(path), "db.defrag"), 0600, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = tdb.Update(func(tx *bolt.Tx) error {
+		tb, err := tx.CreateBucket(schema.Test.Name())
+		if err != nil {
+			return err
+		}
+		for i := 0; i < 50; i++ {
+			if err = tb.Put([]byte(fmt.Sprintf("foo_%d", i)), []byte("stale")); err != nil {
+				return err
+			}
+		}
+		if err = tb.Put([]byte("deleted"), []byte("bar")); err != nil {
+			return err
+		}
+		_, err = tx.CreateBucket([]byte("deleted"))
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tdb.Close()
+
+	oh, err := b.Hash(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = b.OnlineDefrag(); err != nil {
+		t.Fatal(err)
+	}
+	nh, err := b.Hash(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if oh != nh {
+		t.Errorf("hash = %v, want %v", nh, oh)
+	}
+}
+
+func TestBackendOnlineDefragResumeCorrupt(t *testing.T) {
+	b, path := betesting.NewTmpBackend(t, time.Hour, 10000)
+	defer betesting.Close(t, b)
+
+	tx := b.BatchTx()
+	tx.Lock()
+	tx.UnsafeCreateBucket(schema.Test)
+	for i := 0; i < 500; i++ {
+		tx.UnsafePut(schema.Test, []byte(fmt.Sprintf("foo_%d", i)), []byte("bar"))
+	}
+	tx.Unlock()
+	b.ForceCommit()
+
+	// leave a copy torn as by a crash, with a page in use that is also
+	// listed as free
+	tdbp := filepath.Join(filepath.Dir(path), "db.defrag")
+	tdb, err := bolt.Open(tdbp, 0600, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	freelist, leaf := -1, -1
+	err = tdb.Update(func(tx *bolt.Tx) error {
+		tb, err := tx.CreateBucket(schema.Test.Name())
+		if err != nil {
+			return err
+		}
+		for i := 0; i < 500; i++ {
+			if err = tb.Put([]byte(fmt.Sprintf("foo_%d", i)), []byte("stale")); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err == nil {
+		err = tdb.View(func(tx *bolt.Tx) error {
+			for id := 2; ; id++ {
+				info, err := tx.Page(id)
+				if err != nil || info == nil {
+					return err
+				}
+				switch info.Type {
+				case "freelist":
+					freelist = id
+				case "leaf":
+					leaf = id
+				}
+			}
+		})
+	}
+	pageSize := tdb.Info().PageSize
+	tdb.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if freelist < 0 || leaf < 0 {
+		t.Fatalf("freelist page = %d, leaf page = %d, want both", freelist, leaf)
+	}
+	f, err := os.OpenFile(tdbp, os.O_WRONLY, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a page header is the id, flags, count and overflow, followed by the
+	// ids of a freelist page
+	count := make([]byte, 2)
+	binary.LittleEndian.PutUint16(count, 1)
+	ids := make([]byte, 8)
+	binary.LittleEndian.PutUint64(ids, uint64(leaf))
+	if _, err = f.WriteAt(count, int64(freelist*pageSize+10)); err == nil {
+		_, err = f.WriteAt(ids, int64(freelist*pageSize+16))
+	}
+	f.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resumed, err := backend.OpenOnlineDefragCopyForTest(tdbp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resumed {
+		t.Fatal("resumed a corrupt online defragmentation copy")
+	}
+
+	oh, err := b.Hash(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = b.OnlineDefrag(); err != nil {
+		t.Fatal(err)
+	}
+	nh, err := b.Hash(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if oh != nh {
+		t.Errorf("hash = %v, want %v", nh, oh)
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import "fmt"
+
+// Encryptor encrypts the values of some buckets before they are written to
+// the database file, and decrypts them when they are read back. Keys are
+// stored as is, as the backend relies on their order.
+type Encryptor interface {
+	// Encrypts reports whether the values of the given bucket are encrypted.
+	Encrypts(bucketName []byte) bool
+	Encrypt(value []byte) ([]byte, error)
+	Decrypt(value []byte) ([]byte, error)
+}
+
+// decryptValues decrypts in place the values of the given bucket read from
+// the database file.
+func decryptValues(enc Encryptor, bucketName []byte, vs [][]byte) {
+	if enc == nil || !enc.Encrypts(bucketName) {
+		return
+	}
+	for i := range vs {
+		vs[i] = mustDecrypt(enc, bucketName, vs[i])
+	}
+}
+
+// decryptingVisitor returns a visitor decrypting the values of the given
+// bucket read from the database file before passing them to visitor.
+func decryptingVisitor(enc Encryptor, bucketName []byte, visitor func(k, v []byte) error) func(k, v []byte) error {
+	if enc == nil || !enc.Encrypts(bucketName) {
+		return visitor
+	}
+	return func(k, v []byte) error {
+		return visitor(k, mustDecrypt(enc, bucketName, v))
+	}
+}
+
+func mustDecrypt(enc Encryptor, bucketName []byte, v []byte) []byte {
+	pv, err := enc.Decrypt(v)
+	if err != nil {
+		// the database file is either corrupted or was encrypted with a key
+		// that is not available; none of its callers can recover from it.
+		panic(fmt.Errorf("failed to decrypt a value of bucket %q (%v)", bucketName, err))
+	}
+	return pv
+}
@@ -1,6 +1,9 @@
 package backend
 
-import bolt "go.etcd.io/bbolt"
+import (
+	bolt "go.etcd.io/bbolt"
+	"go.uber.org/zap"
+)
 
 func DbFromBackendForTest(b Backend) *bolt.DB {
 	return b.(*backend).db
@@ -13,3 +16,17 @@ func DefragLimitForTest() int {
 func CommitsForTest(b Backend) int64 {
 	return b.(*backend).Commits()
 }
+
+func SetOnlineDefragLimitForTest(limit int) (restore func()) {
+	old := onlineDefragLimit
+	onlineDefragLimit = limit
+	return func() { onlineDefragLimit = old }
+}
+
+func OpenOnlineDefragCopyForTest(path string) (resumed bool, err error) {
+	db, resumed, err := openOnlineDefragCopy(zap.NewNop(), path, &bolt.Options{})
+	if err != nil {
+		return false, err
+	}
+	return resumed, db.Close()
+}
@@ -0,0 +1,283 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"bytes"
+	"errors"
+	"hash/crc32"
+	"io"
+	"math"
+	"sort"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// memoryBackend is a Backend keeping its buckets in memory only, for members
+// whose state is rebuilt from the snapshot and the WAL when they restart, such
+// as witnesses. Its writes are visible as soon as they are made, so releasing
+// the batch transaction commits it.
+type memoryBackend struct {
+	lg    *zap.Logger
+	hooks Hooks
+
+	// batchMu and readMu are the locks of the batch and read transactions.
+	batchMu sync.Mutex
+	readMu  sync.RWMutex
+
+	// mu protects buckets, and is only held for the duration of an access.
+	mu      sync.RWMutex
+	buckets map[string]*memoryBucket
+
+	txPostLockInsideApplyHook func()
+}
+
+// memoryBucket holds the key-values of a bucket, ordered by key.
+type memoryBucket struct {
+	keys [][]byte
+	vals [][]byte
+}
+
+// NewMemoryBackend returns a Backend keeping its buckets in memory only. The
+// hooks, if any, are executed every time the batch transaction is released.
+func NewMemoryBackend(lg *zap.Logger, hooks Hooks) Backend {
+	if lg == nil {
+		lg = zap.NewNop()
+	}
+	return &memoryBackend{lg: lg, hooks: hooks, buckets: make(map[string]*memoryBucket)}
+}
+
+func (b *memoryBackend) ReadTx() ReadTx           { return &memoryReadTx{b: b} }
+func (b *memoryBackend) ConcurrentReadTx() ReadTx { return &memoryReadTx{b: b} }
+func (b *memoryBackend) BatchTx() BatchTx         { return &memoryBatchTx{memoryReadTx{b: b}} }
+
+// Snapshot returns a snapshot failing to be written, as a memory backend has
+// no database file to send.
+func (b *memoryBackend) Snapshot() Snapshot { return memorySnapshot{} }
+
+func (b *memoryBackend) Hash(ignores func(bucketName, keyName []byte) bool) (uint32, error) {
+	h := crc32.New(crc32.MakeTable(crc32.Castagnoli))
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	names := make([]string, 0, len(b.buckets))
+	for name := range b.buckets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		h.Write([]byte(name))
+		bkt := b.buckets[name]
+		for i, k := range bkt.keys {
+			if ignores != nil && !ignores([]byte(name), k) {
+				h.Write(k)
+				h.Write(bkt.vals[i])
+			}
+		}
+	}
+	return h.Sum32(), nil
+}
+
+func (b *memoryBackend) Size() int64 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	var size int64
+	for _, bkt := range b.buckets {
+		for i, k := range bkt.keys {
+			size += int64(len(k) + len(bkt.vals[i]))
+		}
+	}
+	return size
+}
+
+func (b *memoryBackend) SizeInUse() int64    { return b.Size() }
+func (b *memoryBackend) OpenReadTxN() int64  { return 0 }
+func (b *memoryBackend) Defrag() error       { return nil }
+func (b *memoryBackend) OnlineDefrag() error { return nil }
+func (b *memoryBackend) ForceCommit()        { b.BatchTx().Commit() }
+func (b *memoryBackend) Close() error        { return nil }
+
+func (b *memoryBackend) SetTxPostLockInsideApplyHook(hook func()) {
+	b.batchMu.Lock()
+	defer b.batchMu.Unlock()
+	b.txPostLockInsideApplyHook = hook
+}
+
+// bucket returns the bucket of the given name, or nil if it does not exist.
+// It must be called holding mu.
+func (b *memoryBackend) bucket(bucketType Bucket) *memoryBucket {
+	return b.buckets[string(bucketType.Name())]
+}
+
+// mustBucket is bucket, for writes, which need the bucket to exist.
+func (b *memoryBackend) mustBucket(bucketType Bucket) *memoryBucket {
+	bkt := b.bucket(bucketType)
+	if bkt == nil {
+		b.lg.Fatal(
+			"failed to find a bucket",
+			zap.Stringer("bucket-name", bucketType),
+			zap.Stack("stack"),
+		)
+	}
+	return bkt
+}
+
+// search returns the index of the first key of the bucket not less than key.
+func (bkt *memoryBucket) search(key []byte) int {
+	return sort.Search(len(bkt.keys), func(i int) bool { return bytes.Compare(bkt.keys[i], key) >= 0 })
+}
+
+type memoryReadTx struct {
+	b *memoryBackend
+}
+
+func (t *memoryReadTx) Lock()    { t.b.readMu.Lock() }
+func (t *memoryReadTx) Unlock()  { t.b.readMu.Unlock() }
+func (t *memoryReadTx) RLock()   { t.b.readMu.RLock() }
+func (t *memoryReadTx) RUnlock() { t.b.readMu.RUnlock() }
+
+func (t *memoryReadTx) UnsafeRange(bucketType Bucket, key, endKey []byte, limit int64) (keys [][]byte, vals [][]byte) {
+	t.b.mu.RLock()
+	defer t.b.mu.RUnlock()
+	bkt := t.b.bucket(bucketType)
+	if bkt == nil {
+		return nil, nil
+	}
+	if limit <= 0 {
+		limit = math.MaxInt64
+	}
+	var isMatch func(b []byte) bool
+	if len(endKey) > 0 {
+		isMatch = func(b []byte) bool { return bytes.Compare(b, endKey) < 0 }
+	} else {
+		isMatch = func(b []byte) bool { return bytes.Equal(b, key) }
+		limit = 1
+	}
+	for i := bkt.search(key); i < len(bkt.keys) && isMatch(bkt.keys[i]); i++ {
+		keys = append(keys, bkt.keys[i])
+		vals = append(vals, bkt.vals[i])
+		if limit == int64(len(keys)) {
+			break
+		}
+	}
+	return keys, vals
+}
+
+func (t *memoryReadTx) UnsafeForEach(bucketType Bucket, visitor func(k, v []byte) error) error {
+	t.b.mu.RLock()
+	bkt := t.b.bucket(bucketType)
+	if bkt == nil {
+		t.b.mu.RUnlock()
+		return nil
+	}
+	keys, vals := append([][]byte(nil), bkt.keys...), append([][]byte(nil), bkt.vals...)
+	t.b.mu.RUnlock()
+	for i, k := range keys {
+		if err := visitor(k, vals[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type memoryBatchTx struct {
+	memoryReadTx
+}
+
+func (t *memoryBatchTx) Lock() { t.b.batchMu.Lock() }
+
+func (t *memoryBatchTx) Unlock() {
+	if t.b.hooks != nil {
+		t.b.hooks.OnPreCommitUnsafe(t)
+	}
+	t.b.batchMu.Unlock()
+}
+
+func (t *memoryBatchTx) LockInsideApply() {
+	t.Lock()
+	if t.b.txPostLockInsideApplyHook != nil {
+		ValidateCalledInsideApply(t.b.lg)
+		t.b.txPostLockInsideApplyHook()
+	}
+}
+
+func (t *memoryBatchTx) LockOutsideApply() {
+	ValidateCalledOutSideApply(t.b.lg)
+	t.Lock()
+}
+
+func (t *memoryBatchTx) UnsafeCreateBucket(bucketType Bucket) {
+	t.b.mu.Lock()
+	defer t.b.mu.Unlock()
+	if t.b.bucket(bucketType) == nil {
+		t.b.buckets[string(bucketType.Name())] = &memoryBucket{}
+	}
+}
+
+func (t *memoryBatchTx) UnsafeDeleteBucket(bucketType Bucket) {
+	t.b.mu.Lock()
+	defer t.b.mu.Unlock()
+	delete(t.b.buckets, string(bucketType.Name()))
+}
+
+func (t *memoryBatchTx) UnsafePut(bucketType Bucket, key []byte, value []byte) {
+	t.b.mu.Lock()
+	defer t.b.mu.Unlock()
+	bkt := t.b.mustBucket(bucketType)
+	key, value = append([]byte(nil), key...), append([]byte(nil), value...)
+	i := bkt.search(key)
+	if i < len(bkt.keys) && bytes.Equal(bkt.keys[i], key) {
+		bkt.vals[i] = value
+		return
+	}
+	bkt.keys = append(bkt.keys, nil)
+	copy(bkt.keys[i+1:], bkt.keys[i:])
+	bkt.keys[i] = key
+	bkt.vals = append(bkt.vals, nil)
+	copy(bkt.vals[i+1:], bkt.vals[i:])
+	bkt.vals[i] = value
+}
+
+func (t *memoryBatchTx) UnsafeSeqPut(bucketType Bucket, key []byte, value []byte) {
+	t.UnsafePut(bucketType, key, value)
+}
+
+func (t *memoryBatchTx) UnsafeDelete(bucketType Bucket, key []byte) {
+	t.b.mu.Lock()
+	defer t.b.mu.Unlock()
+	bkt := t.b.mustBucket(bucketType)
+	i := bkt.search(key)
+	if i == len(bkt.keys) || !bytes.Equal(bkt.keys[i], key) {
+		return
+	}
+	bkt.keys = append(bkt.keys[:i], bkt.keys[i+1:]...)
+	bkt.vals = append(bkt.vals[:i], bkt.vals[i+1:]...)
+}
+
+func (t *memoryBatchTx) Commit() {
+	t.Lock()
+	t.Unlock()
+}
+
+func (t *memoryBatchTx) CommitAndStop() { t.Commit() }
+
+type memorySnapshot struct{}
+
+func (memorySnapshot) Size() int64 { return 0 }
+func (memorySnapshot) WriteTo(w io.Writer) (int64, error) {
+	return 0, errors.New("backend: cannot snapshot a memory backend")
+}
+func (memorySnapshot) Close() error { return nil }
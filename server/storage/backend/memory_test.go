@@ -0,0 +1,99 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend_test
+
+import (
+	"reflect"
+	"testing"
+
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+	"go.uber.org/zap/zaptest"
+)
+
+// TestMemoryBackend ensures the memory backend reads back what the bolt
+// backend does for the same writes.
+func TestMemoryBackend(t *testing.T) {
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, b)
+	mb := backend.NewMemoryBackend(zaptest.NewLogger(t), nil)
+	defer mb.Close()
+
+	for _, be := range []backend.Backend{b, mb} {
+		tx := be.BatchTx()
+		tx.Lock()
+		tx.UnsafeCreateBucket(schema.Key)
+		tx.UnsafeCreateBucket(schema.Meta)
+		for _, k := range []string{"foo2", "foo", "foo3", "foo1", "bar"} {
+			tx.UnsafePut(schema.Key, []byte(k), []byte("v-"+k))
+		}
+		tx.UnsafePut(schema.Key, []byte("foo1"), []byte("updated"))
+		tx.UnsafeDelete(schema.Key, []byte("foo3"))
+		tx.UnsafePut(schema.Meta, []byte("meta"), []byte("data"))
+		tx.Unlock()
+		be.ForceCommit()
+	}
+
+	tests := []struct {
+		key, end []byte
+		limit    int64
+	}{
+		{[]byte("foo"), nil, 0},
+		{[]byte("foo3"), nil, 0},
+		{[]byte("foo"), []byte("foo3"), 0},
+		{[]byte("foo"), []byte("foo3"), 2},
+		{[]byte("a"), []byte("z"), 0},
+		{[]byte("z"), []byte("zz"), 0},
+	}
+	for i, tt := range tests {
+		wks, wvs := rangeReadTx(b.ReadTx(), tt.key, tt.end, tt.limit)
+		ks, vs := rangeReadTx(mb.ReadTx(), tt.key, tt.end, tt.limit)
+		if !reflect.DeepEqual(ks, wks) || !reflect.DeepEqual(vs, wvs) {
+			t.Errorf("#%d: range = %q %q, want %q %q", i, ks, vs, wks, wvs)
+		}
+	}
+
+	var visited []string
+	err := mb.ReadTx().UnsafeForEach(schema.Key, func(k, v []byte) error {
+		visited = append(visited, string(k))
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"bar", "foo", "foo1", "foo2"}; !reflect.DeepEqual(visited, want) {
+		t.Errorf("visited = %q, want %q", visited, want)
+	}
+
+	ignores := func(bucket, key []byte) bool { return string(bucket) == "meta" }
+	wh, err := b.Hash(ignores)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h, err := mb.Hash(ignores)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h != wh {
+		t.Errorf("hash = %d, want %d", h, wh)
+	}
+}
+
+func rangeReadTx(tx backend.ReadTx, key, end []byte, limit int64) ([][]byte, [][]byte) {
+	tx.RLock()
+	defer tx.RUnlock()
+	return tx.UnsafeRange(schema.Key, key, end, limit)
+}
@@ -90,6 +90,13 @@ var (
 		Name:      "defrag_inflight",
 		Help:      "Whether or not defrag is active on the member. 1 means active, 0 means not.",
 	})
+
+	onlineDefragCopiedKeys = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "etcd_debugging",
+		Subsystem: "disk",
+		Name:      "backend_online_defrag_copied_keys",
+		Help:      "The number of keys the ongoing or last online defragmentation has copied.",
+	})
 )
 
 func init() {
@@ -100,4 +107,5 @@ func init() {
 	prometheus.MustRegister(defragSec)
 	prometheus.MustRegister(snapshotTransferSec)
 	prometheus.MustRegister(isDefragActive)
+	prometheus.MustRegister(onlineDefragCopiedKeys)
 }
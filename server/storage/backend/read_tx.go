@@ -48,6 +48,8 @@ type baseReadTx struct {
 	buckets map[BucketID]*bolt.Bucket
 	// txWg protects tx from being rolled back at the end of a batch interval until all reads using this tx are done.
 	txWg *sync.WaitGroup
+	// enc decrypts the values read from tx, if set.
+	enc Encryptor
 }
 
 /*** 这个逻辑感觉怪怪的
@@ -73,7 +75,7 @@ func (baseReadTx *baseReadTx) UnsafeForEach(bucket Bucket, visitor func(k, v []b
 		return err
 	}
 	baseReadTx.txMu.Lock()
-	err := unsafeForEach(baseReadTx.tx, bucket, visitNoDup)
+	err := unsafeForEach(baseReadTx.tx, bucket, decryptingVisitor(baseReadTx.enc, bucket.Name(), visitNoDup))
 	baseReadTx.txMu.Unlock()
 	if err != nil {
 		return err
@@ -137,6 +139,7 @@ func (baseReadTx *baseReadTx) UnsafeRange(bucketType Bucket, key, endKey []byte,
 	baseReadTx.txMu.Unlock()
 
 	k2, v2 := unsafeRange(c, key, endKey, limit-int64(len(keys)))
+	decryptValues(baseReadTx.enc, bucketType.Name(), v2)
 	return append(k2, keys...), append(v2, vals...)
 }
 
@@ -0,0 +1,235 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"go.etcd.io/etcd/pkg/v3/ioutil"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/encryption"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// keyProviderTimeout bounds the time spent wrapping or unwrapping the data
+// encryption keys.
+const keyProviderTimeout = 30 * time.Second
+
+// ErrEncryptionKeyRequired is returned when a backend holds encrypted data
+// but no key encryption key is configured.
+var ErrEncryptionKeyRequired = errors.New("backend is encrypted, but no key encryption key is configured")
+
+// NewKeyProvider returns the provider of the key encryption keys set by the
+// given key file or KMS plugin socket, or nil if neither is set.
+func NewKeyProvider(keyFile, kmsSocket string) (encryption.KeyProvider, error) {
+	switch {
+	case keyFile != "" && kmsSocket != "":
+		return nil, errors.New("a key encryption key file and a KMS plugin cannot both be set")
+	case keyFile != "":
+		return encryption.NewFileKeyProvider(keyFile)
+	case kmsSocket != "":
+		return encryption.NewKMSKeyProvider(kmsSocket), nil
+	}
+	return nil, nil
+}
+
+// NewKeyring returns a keyring encrypting the buckets holding user data with
+// keys wrapped by the given provider, or nil if the provider is nil.
+func NewKeyring(p encryption.KeyProvider) *encryption.Keyring {
+	if p == nil {
+		return nil
+	}
+	return encryption.NewKeyring(p, schema.Key.Name(), schema.KeyIndex.Name(), schema.AuthUsers.Name(), schema.AuthRoles.Name())
+}
+
+// LoadKeyring adds the data encryption keys persisted in the backend to the
+// keyring, and persists back all keys of the keyring, wrapped by the current
+// key encryption key. If the keyring has no key in use yet, a new one is put
+// in use.
+//
+// If the keyring is nil, LoadKeyring only checks that the backend holds no
+// encrypted data.
+func LoadKeyring(be backend.Backend, kr *encryption.Keyring) error {
+	tx := be.ReadTx()
+	tx.RLock()
+	keys, current, err := schema.UnsafeReadEncryptionKeys(tx)
+	tx.RUnlock()
+	if err != nil {
+		return err
+	}
+	return loadKeyring(kr, keys, current, backendKeySaver(be))
+}
+
+// LoadKeyringFile is LoadKeyring for a member keeping no database file, such
+// as a witness, whose data encryption keys are persisted in the file at the
+// given path instead.
+func LoadKeyringFile(path string, kr *encryption.Keyring) error {
+	keys, current, err := readKeyringFile(path)
+	if err != nil {
+		return err
+	}
+	return loadKeyring(kr, keys, current, fileKeySaver(path))
+}
+
+func loadKeyring(kr *encryption.Keyring, keys map[uint64][]byte, current uint64, save keySaver) error {
+	if kr == nil {
+		if len(keys) != 0 {
+			return ErrEncryptionKeyRequired
+		}
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), keyProviderTimeout)
+	defer cancel()
+	if err := kr.Load(ctx, keys, current); err != nil {
+		return err
+	}
+	if kr.Current() == 0 {
+		return rotateKeyring(kr, save)
+	}
+	return saveKeyring(kr, kr.Current(), save)
+}
+
+// ReadKeyring adds the data encryption keys persisted in the database file at
+// the given path to the keyring, without modifying the file. It returns false
+// if the file holds no key.
+//
+// If the keyring is nil, ReadKeyring only checks that the file holds no
+// encrypted data.
+func ReadKeyring(path string, kr *encryption.Keyring) (bool, error) {
+	db, err := bolt.Open(path, 0400, &bolt.Options{ReadOnly: true, Timeout: time.Second})
+	if err != nil {
+		return false, err
+	}
+	defer db.Close()
+
+	var (
+		keys    map[uint64][]byte
+		current uint64
+	)
+	if err = db.View(func(tx *bolt.Tx) error {
+		if b := tx.Bucket(schema.Encryption.Name()); b != nil {
+			keys, current, err = schema.DecodeEncryptionKeys(b.ForEach)
+			return err
+		}
+		return nil
+	}); err != nil {
+		return false, err
+	}
+	if len(keys) == 0 {
+		return false, nil
+	}
+	if kr == nil {
+		return false, ErrEncryptionKeyRequired
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), keyProviderTimeout)
+	defer cancel()
+	return true, kr.Load(ctx, keys, current)
+}
+
+// RotateKeyring puts a new data encryption key in use, once it is persisted
+// in the backend. The keys persisted in the backend are re-wrapped with the
+// current key encryption key.
+func RotateKeyring(be backend.Backend, kr *encryption.Keyring) error {
+	return rotateKeyring(kr, backendKeySaver(be))
+}
+
+// RotateKeyringFile is RotateKeyring for keys persisted in the file at the
+// given path.
+func RotateKeyringFile(path string, kr *encryption.Keyring) error {
+	return rotateKeyring(kr, fileKeySaver(path))
+}
+
+func rotateKeyring(kr *encryption.Keyring, save keySaver) error {
+	id, err := kr.NewKey()
+	if err != nil {
+		return err
+	}
+	if err := saveKeyring(kr, id, save); err != nil {
+		return err
+	}
+	return kr.Use(id)
+}
+
+func saveKeyring(kr *encryption.Keyring, current uint64, save keySaver) error {
+	ctx, cancel := context.WithTimeout(context.Background(), keyProviderTimeout)
+	defer cancel()
+	keys, err := kr.Wrap(ctx)
+	if err != nil {
+		return err
+	}
+	// the keys must be durable before any data is encrypted with them.
+	return save(keys, current)
+}
+
+// keySaver durably persists the given wrapped data encryption keys, along
+// with the ID of the one in use.
+type keySaver func(keys map[uint64][]byte, current uint64) error
+
+func backendKeySaver(be backend.Backend) keySaver {
+	return func(keys map[uint64][]byte, current uint64) error {
+		tx := be.BatchTx()
+		tx.LockOutsideApply()
+		schema.UnsafeCreateEncryptionBucket(tx)
+		schema.UnsafeSaveEncryptionKeys(tx, keys, current)
+		tx.Unlock()
+		be.ForceCommit()
+		return nil
+	}
+}
+
+// keyringFile is the content of a file holding wrapped data encryption keys.
+type keyringFile struct {
+	Current uint64            `json:"current"`
+	Keys    map[uint64][]byte `json:"keys"`
+}
+
+func fileKeySaver(path string) keySaver {
+	return func(keys map[uint64][]byte, current uint64) error {
+		b, err := json.Marshal(keyringFile{Current: current, Keys: keys})
+		if err != nil {
+			return err
+		}
+		// replace the file at once, so that a crash never leaves it torn.
+		tmp := path + ".tmp"
+		if err = ioutil.WriteAndSyncFile(tmp, b, 0600); err != nil {
+			return err
+		}
+		return os.Rename(tmp, path)
+	}
+}
+
+func readKeyringFile(path string) (map[uint64][]byte, uint64, error) {
+	b, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, 0, nil
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	var f keyringFile
+	if err = json.Unmarshal(b, &f); err != nil {
+		return nil, 0, fmt.Errorf("invalid keyring file %s: %v", path, err)
+	}
+	return f.Keys, f.Current, nil
+}
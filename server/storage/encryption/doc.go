@@ -0,0 +1,33 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package encryption implements envelope encryption of the data etcd stores
+at rest.
+
+Values written to the backend and entries written to the WAL are sealed
+with AES-256-GCM using a data encryption key (DEK). DEKs are generated by
+the member itself and are only ever persisted wrapped by a key encryption
+key (KEK), which never leaves its KeyProvider: either a local key file or a
+KMS plugin listening on a unix socket.
+
+Every ciphertext records the ID of the DEK that sealed it, so rotating the
+DEK only changes the key used for new writes; older DEKs are kept to read
+back older data. Rotating the KEK is done by making a new KEK current in the
+provider; the DEKs are re-wrapped with it the next time they are persisted.
+
+Data written before encryption was enabled is read back as is, so that
+encryption can be enabled on an existing member.
+*/
+package encryption
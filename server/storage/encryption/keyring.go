@@ -0,0 +1,275 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+const (
+	// keySize is the size of the data encryption keys, selecting AES-256.
+	keySize = 32
+
+	// magic prefixes every ciphertext. Neither protobuf encoded values nor
+	// raft entries can start with 0xff, which tells them apart from data
+	// written before encryption was enabled.
+	magic = "\xffenc"
+	// headerSize is the size of the magic, the format version and the ID
+	// of the DEK that prefix every ciphertext.
+	headerSize = len(magic) + 1 + 8
+	version    = 1
+)
+
+var (
+	ErrKeyNotFound = errors.New("encryption: data encryption key not found")
+	ErrNoKey       = errors.New("encryption: no data encryption key in use")
+	ErrCorrupt     = errors.New("encryption: corrupt ciphertext")
+)
+
+// Keyring holds the data encryption keys (DEKs) of a member.
+type Keyring struct {
+	provider KeyProvider
+	buckets  map[string]struct{}
+
+	mu      sync.RWMutex
+	keys    map[uint64]*dataKey
+	current uint64
+}
+
+type dataKey struct {
+	key  []byte
+	aead cipher.AEAD
+}
+
+// wrappedKey is the persisted form of a DEK.
+type wrappedKey struct {
+	KEKID string `json:"kek-id"`
+	Key   []byte `json:"key"`
+}
+
+// NewKeyring returns an empty keyring whose keys are wrapped by the given
+// provider. The values of the given backend buckets are encrypted.
+func NewKeyring(provider KeyProvider, buckets ...[]byte) *Keyring {
+	kr := &Keyring{
+		provider: provider,
+		buckets:  make(map[string]struct{}),
+		keys:     make(map[uint64]*dataKey),
+	}
+	for _, b := range buckets {
+		kr.buckets[string(b)] = struct{}{}
+	}
+	return kr
+}
+
+// Encrypts reports whether the values of the given backend bucket are
+// encrypted.
+func (kr *Keyring) Encrypts(bucketName []byte) bool {
+	_, ok := kr.buckets[string(bucketName)]
+	return ok
+}
+
+// Current returns the ID of the DEK used to encrypt new data, or 0 if none
+// is in use yet.
+func (kr *Keyring) Current() uint64 {
+	kr.mu.RLock()
+	defer kr.mu.RUnlock()
+	return kr.current
+}
+
+// NewKey generates a new DEK and returns its ID. The key is not used to
+// encrypt data until it is made current with Use, which must only be done
+// once the key is persisted.
+func (kr *Keyring) NewKey() (uint64, error) {
+	key := make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		return 0, err
+	}
+	dk, err := newDataKey(key)
+	if err != nil {
+		return 0, err
+	}
+
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+	for {
+		var b [8]byte
+		if _, err := rand.Read(b[:]); err != nil {
+			return 0, err
+		}
+		id := binary.BigEndian.Uint64(b[:])
+		if _, ok := kr.keys[id]; id != 0 && !ok {
+			kr.keys[id] = dk
+			return id, nil
+		}
+	}
+}
+
+// Use makes the given DEK the one used to encrypt new data.
+func (kr *Keyring) Use(id uint64) error {
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+	if _, ok := kr.keys[id]; !ok {
+		return ErrKeyNotFound
+	}
+	kr.current = id
+	return nil
+}
+
+// Wrap returns all DEKs wrapped by the current KEK of the provider, keyed by
+// their ID.
+func (kr *Keyring) Wrap(ctx context.Context) (map[uint64][]byte, error) {
+	kr.mu.RLock()
+	keys := make(map[uint64][]byte, len(kr.keys))
+	for id, dk := range kr.keys {
+		keys[id] = dk.key
+	}
+	kr.mu.RUnlock()
+
+	wrapped := make(map[uint64][]byte, len(keys))
+	for id, key := range keys {
+		wk, kekID, err := kr.provider.WrapKey(ctx, key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to wrap data encryption key %016x (%v)", id, err)
+		}
+		if wrapped[id], err = json.Marshal(wrappedKey{KEKID: kekID, Key: wk}); err != nil {
+			return nil, err
+		}
+	}
+	return wrapped, nil
+}
+
+// Load unwraps the given DEKs and adds them to the keyring. Keys already in
+// the keyring are kept. If no DEK is in use yet, current becomes the one in
+// use.
+func (kr *Keyring) Load(ctx context.Context, wrapped map[uint64][]byte, current uint64) error {
+	for id, b := range wrapped {
+		kr.mu.RLock()
+		_, ok := kr.keys[id]
+		kr.mu.RUnlock()
+		if ok {
+			continue
+		}
+
+		var wk wrappedKey
+		if err := json.Unmarshal(b, &wk); err != nil {
+			return fmt.Errorf("failed to decode data encryption key %016x (%v)", id, err)
+		}
+		key, err := kr.provider.UnwrapKey(ctx, wk.Key, wk.KEKID)
+		if err != nil {
+			return fmt.Errorf("failed to unwrap data encryption key %016x with key encryption key %q (%v)", id, wk.KEKID, err)
+		}
+		dk, err := newDataKey(key)
+		if err != nil {
+			return err
+		}
+		kr.mu.Lock()
+		kr.keys[id] = dk
+		kr.mu.Unlock()
+	}
+
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+	if kr.current == 0 && current != 0 {
+		if _, ok := kr.keys[current]; !ok {
+			return ErrKeyNotFound
+		}
+		kr.current = current
+	}
+	return nil
+}
+
+// Encrypt seals the given data with the current DEK.
+func (kr *Keyring) Encrypt(data []byte) ([]byte, error) {
+	kr.mu.RLock()
+	id := kr.current
+	dk := kr.keys[id]
+	kr.mu.RUnlock()
+	if dk == nil {
+		return nil, ErrNoKey
+	}
+
+	out := make([]byte, headerSize, headerSize+dk.aead.NonceSize()+len(data)+dk.aead.Overhead())
+	copy(out, magic)
+	out[len(magic)] = version
+	binary.BigEndian.PutUint64(out[len(magic)+1:], id)
+
+	nonce := out[headerSize : headerSize+dk.aead.NonceSize()]
+	out = out[:headerSize+len(nonce)]
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return dk.aead.Seal(out, nonce, data, out[:headerSize]), nil
+}
+
+// Decrypt opens data sealed by Encrypt. Data that was not encrypted is
+// returned as is.
+func (kr *Keyring) Decrypt(data []byte) ([]byte, error) {
+	if !IsEncrypted(data) {
+		return data, nil
+	}
+	if len(data) < headerSize || data[len(magic)] != version {
+		return nil, ErrCorrupt
+	}
+	id := binary.BigEndian.Uint64(data[len(magic)+1:])
+
+	kr.mu.RLock()
+	dk := kr.keys[id]
+	kr.mu.RUnlock()
+	if dk == nil {
+		return nil, fmt.Errorf("%w (id %016x)", ErrKeyNotFound, id)
+	}
+
+	ns := dk.aead.NonceSize()
+	if len(data) < headerSize+ns {
+		return nil, ErrCorrupt
+	}
+	out, err := dk.aead.Open(nil, data[headerSize:headerSize+ns], data[headerSize+ns:], data[:headerSize])
+	if err != nil {
+		return nil, ErrCorrupt
+	}
+	return out, nil
+}
+
+// IsEncrypted reports whether the given data was sealed by a keyring.
+func IsEncrypted(data []byte) bool {
+	return len(data) >= len(magic) && string(data[:len(magic)]) == magic
+}
+
+func newDataKey(key []byte) (*dataKey, error) {
+	if len(key) != keySize {
+		return nil, fmt.Errorf("invalid data encryption key size %d", len(key))
+	}
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	return &dataKey{key: key, aead: aead}, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,183 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeKeyFile(t *testing.T, path string, ids ...string) {
+	var b bytes.Buffer
+	for _, id := range ids {
+		key := make([]byte, keySize)
+		copy(key, id)
+		b.WriteString(id + ":" + base64.StdEncoding.EncodeToString(key) + "\n")
+	}
+	if err := os.WriteFile(path, b.Bytes(), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func newTestKeyring(t *testing.T) (*Keyring, string) {
+	path := filepath.Join(t.TempDir(), "kek")
+	writeKeyFile(t, path, "kek1")
+	p, err := NewFileKeyProvider(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewKeyring(p, []byte("key")), path
+}
+
+func mustRotate(t *testing.T, kr *Keyring) uint64 {
+	id, err := kr.NewKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = kr.Use(id); err != nil {
+		t.Fatal(err)
+	}
+	return id
+}
+
+func TestKeyringEncryptDecrypt(t *testing.T) {
+	kr, _ := newTestKeyring(t)
+	if _, err := kr.Encrypt([]byte("foo")); err != ErrNoKey {
+		t.Fatalf("err = %v, want %v", err, ErrNoKey)
+	}
+	mustRotate(t, kr)
+
+	for _, data := range [][]byte{nil, []byte("foo"), bytes.Repeat([]byte("bar"), 1024)} {
+		ct, err := kr.Encrypt(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !IsEncrypted(ct) || (len(data) > 0 && bytes.Contains(ct, data)) {
+			t.Errorf("ciphertext %x of %q is not encrypted", ct, data)
+		}
+		pt, err := kr.Decrypt(ct)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(pt, data) {
+			t.Errorf("decrypted %q, want %q", pt, data)
+		}
+	}
+
+	// data written before encryption was enabled is read back as is.
+	if pt, err := kr.Decrypt([]byte("\x0aplain")); err != nil || string(pt) != "\x0aplain" {
+		t.Errorf("decrypted plaintext to %q (%v)", pt, err)
+	}
+
+	ct, _ := kr.Encrypt([]byte("foo"))
+	ct[len(ct)-1] ^= 1
+	if _, err := kr.Decrypt(ct); err != ErrCorrupt {
+		t.Errorf("err = %v, want %v", err, ErrCorrupt)
+	}
+}
+
+func TestKeyringRotate(t *testing.T) {
+	kr, _ := newTestKeyring(t)
+	id1 := mustRotate(t, kr)
+	ct1, _ := kr.Encrypt([]byte("foo"))
+	id2 := mustRotate(t, kr)
+	if id1 == id2 || kr.Current() != id2 {
+		t.Fatalf("current key = %x, want new key %x", kr.Current(), id2)
+	}
+	ct2, _ := kr.Encrypt([]byte("bar"))
+
+	// data encrypted with older keys is still readable.
+	for ct, want := range map[string]string{string(ct1): "foo", string(ct2): "bar"} {
+		if pt, err := kr.Decrypt([]byte(ct)); err != nil || string(pt) != want {
+			t.Errorf("decrypted %q (%v), want %q", pt, err, want)
+		}
+	}
+}
+
+func TestKeyringWrapLoad(t *testing.T) {
+	kr, path := newTestKeyring(t)
+	id1 := mustRotate(t, kr)
+	ct1, _ := kr.Encrypt([]byte("foo"))
+
+	// make a new KEK current; the keys are wrapped by it from now on.
+	writeKeyFile(t, path, "kek2", "kek1")
+	wrapped, err := kr.Wrap(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(wrapped[id1], []byte("kek2")) {
+		t.Errorf("key %x is not wrapped by the current key encryption key: %s", id1, wrapped[id1])
+	}
+
+	// a keyring loading the keys merges them and keeps its current key.
+	kr2, _ := newTestKeyring(t)
+	kr2.provider = kr.provider
+	id2 := mustRotate(t, kr2)
+	if err = kr2.Load(context.TODO(), wrapped, id1); err != nil {
+		t.Fatal(err)
+	}
+	if kr2.Current() != id2 {
+		t.Errorf("current key = %x, want %x", kr2.Current(), id2)
+	}
+	if pt, err := kr2.Decrypt(ct1); err != nil || string(pt) != "foo" {
+		t.Errorf("decrypted %q (%v), want %q", pt, err, "foo")
+	}
+
+	// the keys cannot be unwrapped once their KEK is removed.
+	writeKeyFile(t, path, "kek1")
+	kr3, _ := newTestKeyring(t)
+	kr3.provider = kr.provider
+	if err = kr3.Load(context.TODO(), wrapped, id1); err == nil {
+		t.Error("expected an error loading keys wrapped by a removed key encryption key")
+	}
+}
+
+func TestKeyringUnknownKey(t *testing.T) {
+	kr, _ := newTestKeyring(t)
+	mustRotate(t, kr)
+	ct, _ := kr.Encrypt([]byte("foo"))
+
+	kr2, _ := newTestKeyring(t)
+	if _, err := kr2.Decrypt(ct); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("err = %v, want %v", err, ErrKeyNotFound)
+	}
+}
+
+func TestFileKeyProviderInvalid(t *testing.T) {
+	key := make([]byte, keySize)
+	rand.Read(key)
+	tests := []string{
+		"",
+		"# no key\n",
+		"kek1\n",
+		"kek1:" + base64.StdEncoding.EncodeToString(key[:16]) + "\n",
+		":" + base64.StdEncoding.EncodeToString(key) + "\n",
+	}
+	for i, tt := range tests {
+		path := filepath.Join(t.TempDir(), "kek")
+		if err := os.WriteFile(path, []byte(tt), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := NewFileKeyProvider(path); err == nil {
+			t.Errorf("#%d: expected an error for key file %q", i, tt)
+		}
+	}
+}
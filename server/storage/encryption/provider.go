@@ -0,0 +1,213 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// KeyProvider wraps and unwraps data encryption keys with a key encryption
+// key (KEK) it holds.
+type KeyProvider interface {
+	// WrapKey wraps the given key with the current KEK, and returns the ID
+	// of that KEK along with the wrapped key.
+	WrapKey(ctx context.Context, key []byte) (wrapped []byte, kekID string, err error)
+	// UnwrapKey unwraps a key wrapped by the KEK with the given ID.
+	UnwrapKey(ctx context.Context, wrapped []byte, kekID string) ([]byte, error)
+}
+
+// fileKeyProvider reads the KEKs from a local file. Each line of the file
+// holds a KEK as "<id>:<base64 encoded 32 byte key>"; the first one is the
+// current KEK. The file is read on each use, so that a new KEK can be added
+// in front of the old ones without restarting the member.
+type fileKeyProvider struct {
+	path string
+}
+
+// NewFileKeyProvider returns a KeyProvider reading the KEKs from the given
+// file.
+func NewFileKeyProvider(path string) (KeyProvider, error) {
+	p := &fileKeyProvider{path: path}
+	if _, _, err := p.readKeys(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *fileKeyProvider) readKeys() (keys map[string][]byte, current string, err error) {
+	f, err := os.Open(p.path)
+	if err != nil {
+		return nil, "", err
+	}
+	defer f.Close()
+
+	keys = make(map[string][]byte)
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.SplitN(line, ":", 2)
+		if len(fields) != 2 || fields[0] == "" {
+			return nil, "", fmt.Errorf("invalid key encryption key entry in %q, want <id>:<base64 key>", p.path)
+		}
+		key, err := base64.StdEncoding.DecodeString(fields[1])
+		if err != nil || len(key) != keySize {
+			return nil, "", fmt.Errorf("invalid key encryption key %q in %q, want %d base64 encoded bytes", fields[0], p.path, keySize)
+		}
+		if current == "" {
+			current = fields[0]
+		}
+		keys[fields[0]] = key
+	}
+	if err := s.Err(); err != nil {
+		return nil, "", err
+	}
+	if current == "" {
+		return nil, "", fmt.Errorf("no key encryption key in %q", p.path)
+	}
+	return keys, current, nil
+}
+
+func (p *fileKeyProvider) WrapKey(_ context.Context, key []byte) ([]byte, string, error) {
+	keys, current, err := p.readKeys()
+	if err != nil {
+		return nil, "", err
+	}
+	aead, err := newGCM(keys[current])
+	if err != nil {
+		return nil, "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, "", err
+	}
+	return aead.Seal(nonce, nonce, key, []byte(current)), current, nil
+}
+
+func (p *fileKeyProvider) UnwrapKey(_ context.Context, wrapped []byte, kekID string) ([]byte, error) {
+	keys, _, err := p.readKeys()
+	if err != nil {
+		return nil, err
+	}
+	kek, ok := keys[kekID]
+	if !ok {
+		return nil, fmt.Errorf("key encryption key %q not found in %q", kekID, p.path)
+	}
+	aead, err := newGCM(kek)
+	if err != nil {
+		return nil, err
+	}
+	ns := aead.NonceSize()
+	if len(wrapped) < ns {
+		return nil, ErrCorrupt
+	}
+	return aead.Open(nil, wrapped[:ns], wrapped[ns:], []byte(kekID))
+}
+
+// kmsKeyProvider delegates wrapping to a KMS plugin serving HTTP on a unix
+// socket. The plugin handles
+//
+//	POST /v1/wrap    {"key": <base64>}                  -> {"key": <base64>, "kek-id": <id>}
+//	POST /v1/unwrap  {"key": <base64>, "kek-id": <id>}  -> {"key": <base64>}
+//
+// and answers errors with a non-200 status and the error message as body.
+type kmsKeyProvider struct {
+	c *http.Client
+}
+
+type kmsRequest struct {
+	Key   []byte `json:"key"`
+	KEKID string `json:"kek-id,omitempty"`
+}
+
+type kmsResponse struct {
+	Key   []byte `json:"key"`
+	KEKID string `json:"kek-id,omitempty"`
+}
+
+// NewKMSKeyProvider returns a KeyProvider using the KMS plugin listening on
+// the given unix socket.
+func NewKMSKeyProvider(socket string) KeyProvider {
+	return &kmsKeyProvider{c: &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, "unix", socket)
+			},
+		},
+	}}
+}
+
+func (p *kmsKeyProvider) WrapKey(ctx context.Context, key []byte) ([]byte, string, error) {
+	resp, err := p.call(ctx, "wrap", kmsRequest{Key: key})
+	if err != nil {
+		return nil, "", err
+	}
+	if resp.KEKID == "" {
+		return nil, "", fmt.Errorf("kms plugin returned no key encryption key id")
+	}
+	return resp.Key, resp.KEKID, nil
+}
+
+func (p *kmsKeyProvider) UnwrapKey(ctx context.Context, wrapped []byte, kekID string) ([]byte, error) {
+	resp, err := p.call(ctx, "unwrap", kmsRequest{Key: wrapped, KEKID: kekID})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Key, nil
+}
+
+func (p *kmsKeyProvider) call(ctx context.Context, op string, r kmsRequest) (*kmsResponse, error) {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	// the host is ignored, as the plugin is always dialed on its socket.
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "http://kms/v1/"+op, bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := p.c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach kms plugin (%v)", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("kms plugin failed to %s key: %s", op, strings.TrimSpace(string(body)))
+	}
+	var kr kmsResponse
+	if err := json.Unmarshal(body, &kr); err != nil {
+		return nil, fmt.Errorf("failed to decode kms plugin response (%v)", err)
+	}
+	return &kr, nil
+}
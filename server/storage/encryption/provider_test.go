@@ -0,0 +1,93 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+// newFakeKMS serves a KMS plugin on a unix socket that "wraps" keys by
+// reversing them.
+func newFakeKMS(t *testing.T) string {
+	socket := filepath.Join(t.TempDir(), "kms.sock")
+	l, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reverse := func(b []byte) []byte {
+		r := make([]byte, len(b))
+		for i := range b {
+			r[len(b)-1-i] = b[i]
+		}
+		return r
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/wrap", func(w http.ResponseWriter, r *http.Request) {
+		var req kmsRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		json.NewEncoder(w).Encode(kmsResponse{Key: reverse(req.Key), KEKID: "kms-kek"})
+	})
+	mux.HandleFunc("/v1/unwrap", func(w http.ResponseWriter, r *http.Request) {
+		var req kmsRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		if req.KEKID != "kms-kek" {
+			http.Error(w, "unknown key encryption key", http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(kmsResponse{Key: reverse(req.Key)})
+	})
+	srv := &httptest.Server{Listener: l, Config: &http.Server{Handler: mux}}
+	srv.Start()
+	t.Cleanup(srv.Close)
+	return socket
+}
+
+func TestKMSKeyProvider(t *testing.T) {
+	p := NewKMSKeyProvider(newFakeKMS(t))
+	key := []byte("0123456789abcdef0123456789abcdef")
+
+	wrapped, kekID, err := p.WrapKey(context.TODO(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kekID != "kms-kek" || bytes.Equal(wrapped, key) {
+		t.Fatalf("wrapped key %q with key encryption key %q", wrapped, kekID)
+	}
+	unwrapped, err := p.UnwrapKey(context.TODO(), wrapped, kekID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(unwrapped, key) {
+		t.Errorf("unwrapped key %q, want %q", unwrapped, key)
+	}
+
+	if _, err = p.UnwrapKey(context.TODO(), wrapped, "other"); err == nil {
+		t.Error("expected an error unwrapping a key with an unknown key encryption key")
+	}
+}
+
+func TestKMSKeyProviderUnreachable(t *testing.T) {
+	p := NewKMSKeyProvider(filepath.Join(t.TempDir(), "missing.sock"))
+	if _, _, err := p.WrapKey(context.TODO(), []byte("key")); err == nil {
+		t.Error("expected an error reaching a missing KMS plugin")
+	}
+}
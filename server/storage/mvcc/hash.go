@@ -19,6 +19,7 @@ import (
 	"hash/crc32"
 	"sort"
 	"sync"
+	"time"
 
 	"go.etcd.io/etcd/server/v3/storage/backend"
 	"go.etcd.io/etcd/server/v3/storage/schema"
@@ -29,13 +30,31 @@ const (
 	hashStorageMaxSize = 10
 )
 
+// hashTreeCacheTTL is how long the last HashTree computed is kept once it is
+// no longer used. A drill-down hashes ranges of the tree in quick succession,
+// so the tree needs not outlive it.
+var hashTreeCacheTTL = 30 * time.Second
+
 func unsafeHashByRev(tx backend.ReadTx, compactRevision, revision int64, keep map[revision]struct{}) (KeyValueHash, error) {
 	h := newKVHasher(compactRevision, revision, keep)
-	err := tx.UnsafeForEach(schema.Key, func(k, v []byte) error {
+	err := unsafeWriteKeyValues(tx, &h)
+	return h.Hash(), err
+}
+
+// unsafeHashTreeByRev hashes the revisions hashed by unsafeHashByRev into a
+// HashTree.
+func unsafeHashTreeByRev(tx backend.ReadTx, compactRevision, revision int64, keep map[revision]struct{}) (*HashTree, error) {
+	h := newKVHasher(compactRevision, revision, keep)
+	h.tree = newHashTreeBuilder()
+	err := unsafeWriteKeyValues(tx, &h)
+	return h.tree.build(h.Hash()), err
+}
+
+func unsafeWriteKeyValues(tx backend.ReadTx, h *kvHasher) error {
+	return tx.UnsafeForEach(schema.Key, func(k, v []byte) error {
 		h.WriteKeyValue(k, v)
 		return nil
 	})
-	return h.Hash(), err
 }
 
 type kvHasher struct {
@@ -43,6 +62,8 @@ type kvHasher struct {
 	compactRevision int64
 	revision        int64
 	keep            map[revision]struct{}
+	// tree, if set, collects the leaves of the HashTree of the revisions.
+	tree *hashTreeBuilder
 }
 
 func newKVHasher(compactRev, rev int64, keep map[revision]struct{}) kvHasher {
@@ -70,8 +91,15 @@ func (h *kvHasher) WriteKeyValue(k, v []byte) {
 			return
 		}
 	}
+	v, err := uncompressedKeyValue(v)
+	if err != nil {
+		panic(err)
+	}
 	h.hash.Write(k)
 	h.hash.Write(v)
+	if h.tree != nil {
+		h.tree.add(k, v)
+	}
 }
 
 func (h *kvHasher) Hash() KeyValueHash {
@@ -91,6 +119,9 @@ type HashStorage interface {
 	// HashByRev computes the hash of all MVCC revisions up to a given revision.
 	HashByRev(rev int64) (hash KeyValueHash, currentRev int64, err error)
 
+	// HashTreeByRev computes the HashTree of the MVCC revisions hashed by HashByRev.
+	HashTreeByRev(rev int64) (tree *HashTree, currentRev int64, err error)
+
 	// Store adds hash value in local cache, allowing it can be returned by HashByRev.
 	Store(valueHash KeyValueHash)
 }
@@ -99,7 +130,15 @@ type hashStorage struct {
 	store  *store
 	hashMu sync.RWMutex
 	hashes []KeyValueHash
-	lg     *zap.Logger
+	// treeMu serializes the computation of HashTrees, so that the members of
+	// a drill-down asking for the same tree at once only compute it once.
+	treeMu sync.Mutex
+	// tree is the last HashTree computed, kept for the ranges of a drill-down
+	// to be hashed at the same revision without reading the backend again. It
+	// is dropped hashTreeCacheTTL after it was last used, or on compaction.
+	tree      *HashTree
+	treeTimer *time.Timer
+	lg        *zap.Logger
 }
 
 func newHashStorage(lg *zap.Logger, s *store) *hashStorage {
@@ -130,6 +169,70 @@ func (s *hashStorage) HashByRev(rev int64) (KeyValueHash, int64, error) {
 	return s.store.hashByRev(rev)
 }
 
+func (s *hashStorage) HashTreeByRev(rev int64) (*HashTree, int64, error) {
+	if tree, currentRev, ok := s.cachedTree(rev); ok {
+		return tree, currentRev, nil
+	}
+
+	s.treeMu.Lock()
+	defer s.treeMu.Unlock()
+	if tree, currentRev, ok := s.cachedTree(rev); ok {
+		return tree, currentRev, nil
+	}
+	tree, currentRev, err := s.store.hashTreeByRev(rev)
+	if err != nil {
+		return nil, currentRev, err
+	}
+	s.hashMu.Lock()
+	s.tree = tree
+	s.expireTree()
+	s.hashMu.Unlock()
+	return tree, currentRev, nil
+}
+
+// cachedTree returns the cached HashTree if it is the one at rev, deferring
+// its expiry.
+func (s *hashStorage) cachedTree(rev int64) (*HashTree, int64, bool) {
+	s.store.revMu.RLock()
+	compactRev, currentRev := s.store.compactMainRev, s.store.currentRev
+	s.store.revMu.RUnlock()
+
+	s.hashMu.Lock()
+	defer s.hashMu.Unlock()
+	tree := s.tree
+	if tree == nil || !(rev == tree.Revision || rev == 0 && currentRev == tree.Revision) || compactRev != tree.CompactRevision {
+		return nil, currentRev, false
+	}
+	s.expireTree()
+	return tree, currentRev, true
+}
+
+// expireTree drops the cached HashTree hashTreeCacheTTL from now, unless it
+// is replaced or used again in the meantime. It must be called with hashMu
+// held.
+func (s *hashStorage) expireTree() {
+	if s.treeTimer != nil {
+		s.treeTimer.Stop()
+	}
+	tree := s.tree
+	s.treeTimer = time.AfterFunc(hashTreeCacheTTL, func() {
+		s.hashMu.Lock()
+		defer s.hashMu.Unlock()
+		if s.tree == tree {
+			s.tree = nil
+		}
+	})
+}
+
+// dropTree drops the cached HashTree. It must be called with hashMu held.
+func (s *hashStorage) dropTree() {
+	if s.treeTimer != nil {
+		s.treeTimer.Stop()
+		s.treeTimer = nil
+	}
+	s.tree = nil
+}
+
 func (s *hashStorage) Store(hash KeyValueHash) {
 	s.lg.Info("storing new hash",
 		zap.Uint32("hash", hash.Hash),
@@ -138,6 +241,9 @@ func (s *hashStorage) Store(hash KeyValueHash) {
 	)
 	s.hashMu.Lock()
 	defer s.hashMu.Unlock()
+	// the cached HashTree predates the compaction hashed, so it cannot be
+	// used again.
+	s.dropTree()
 	s.hashes = append(s.hashes, hash)
 	sort.Slice(s.hashes, func(i, j int) bool {
 		return s.hashes[i].Revision < s.hashes[j].Revision
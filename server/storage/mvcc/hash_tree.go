@@ -0,0 +1,133 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"bytes"
+	"hash/fnv"
+	"sort"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+)
+
+// HashTree is a Merkle tree over the key ranges of the revisions hashed by
+// HashByRev, so that members whose hashes differ can tell the keys they
+// differ on by comparing the hashes of ever smaller ranges.
+//
+// The leaves of the tree are the keys, ordered, hashed with their revisions.
+// Its nodes add up the hashes of their children rather than hash them in
+// turn, so that the hash of any key range is the difference of the hashes of
+// two prefixes of the leaves: members compare the same ranges whatever the
+// keys that split their own trees. The tree is thus kept as the prefix sums
+// of its leaves.
+type HashTree struct {
+	KeyValueHash
+	keys   [][]byte
+	hashes []uint64
+	// sums[i] is the sum of the hashes of the first i keys.
+	sums []uint64
+}
+
+// KeyHash is the hash of the revisions of a key hashed by HashByRev.
+type KeyHash struct {
+	Key  []byte
+	Hash uint64
+}
+
+// Len returns the number of keys hashed.
+func (t *HashTree) Len() int {
+	return len(t.keys)
+}
+
+// RangeHash returns the hash of the keys in [key, end) and their number. An
+// empty end is the end of the keyspace.
+func (t *HashTree) RangeHash(key, end []byte) (hash uint64, count int) {
+	i, j := t.search(key, end)
+	return t.sums[j] - t.sums[i], j - i
+}
+
+// Split returns the keys splitting [key, end) into at most n ranges of about
+// as many keys, in order.
+func (t *HashTree) Split(key, end []byte, n int) [][]byte {
+	i, j := t.search(key, end)
+	if j-i < n {
+		n = j - i
+	}
+	var splits [][]byte
+	for k := 1; k < n; k++ {
+		splits = append(splits, t.keys[i+k*(j-i)/n])
+	}
+	return splits
+}
+
+// KeyHashes returns the hashes of the keys in [key, end), in order.
+func (t *HashTree) KeyHashes(key, end []byte) []KeyHash {
+	i, j := t.search(key, end)
+	khs := make([]KeyHash, 0, j-i)
+	for ; i < j; i++ {
+		khs = append(khs, KeyHash{Key: t.keys[i], Hash: t.hashes[i]})
+	}
+	return khs
+}
+
+func (t *HashTree) search(key, end []byte) (i, j int) {
+	i = sort.Search(len(t.keys), func(k int) bool { return bytes.Compare(t.keys[k], key) >= 0 })
+	j = len(t.keys)
+	if len(end) > 0 {
+		j = sort.Search(len(t.keys), func(k int) bool { return bytes.Compare(t.keys[k], end) >= 0 })
+	}
+	if j < i {
+		j = i
+	}
+	return i, j
+}
+
+// hashTreeBuilder collects the leaves of a HashTree while the revisions are
+// hashed, in the order of the revisions.
+type hashTreeBuilder struct {
+	hashes map[string]uint64
+}
+
+func newHashTreeBuilder() *hashTreeBuilder {
+	return &hashTreeBuilder{hashes: make(map[string]uint64)}
+}
+
+// add adds the revision k of the key-value pair v, as hashed by kvHasher, to
+// the leaf of its key.
+func (b *hashTreeBuilder) add(k, v []byte) {
+	var kv mvccpb.KeyValue
+	if err := kv.Unmarshal(v); err != nil {
+		panic(err)
+	}
+	h := fnv.New64a()
+	h.Write(k)
+	h.Write(v)
+	b.hashes[string(kv.Key)] += h.Sum64()
+}
+
+func (b *hashTreeBuilder) build(hash KeyValueHash) *HashTree {
+	t := &HashTree{KeyValueHash: hash, keys: make([][]byte, 0, len(b.hashes))}
+	for key := range b.hashes {
+		t.keys = append(t.keys, []byte(key))
+	}
+	sort.Slice(t.keys, func(i, j int) bool { return bytes.Compare(t.keys[i], t.keys[j]) < 0 })
+	t.hashes = make([]uint64, len(t.keys))
+	t.sums = make([]uint64, len(t.keys)+1)
+	for i, key := range t.keys {
+		t.hashes[i] = b.hashes[string(key)]
+		t.sums[i+1] = t.sums[i] + t.hashes[i]
+	}
+	return t
+}
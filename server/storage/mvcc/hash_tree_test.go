@@ -0,0 +1,134 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/pkg/v3/traceutil"
+	"go.etcd.io/etcd/server/v3/lease"
+	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestHashTreeByRev(t *testing.T) {
+	var stores []*store
+	for i := 0; i < 2; i++ {
+		b, _ := betesting.NewDefaultTmpBackend(t)
+		defer betesting.Close(t, b)
+		s := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+		defer s.Close()
+		for j := 0; j < 300; j++ {
+			s.Put([]byte(fmt.Sprintf("foo%03d", j%100)), []byte(fmt.Sprint(j)), lease.NoLease)
+		}
+		stores = append(stores, s)
+	}
+
+	// corrupt the value of the last revision of foo042 on the second store.
+	corrupt := stores[1]
+	_, rev, _, err := corrupt.kvindex.Get([]byte("foo042"), corrupt.Rev())
+	assert.NoError(t, err)
+	kv := mvccpb.KeyValue{Key: []byte("foo042"), Value: []byte("bar"), CreateRevision: 43, ModRevision: rev.main, Version: 3}
+	v, err := kv.Marshal()
+	assert.NoError(t, err)
+	rb := newRevBytes()
+	revToBytes(rev, rb)
+	tx := corrupt.b.BatchTx()
+	tx.Lock()
+	tx.UnsafePut(schema.Key, rb, v)
+	tx.Unlock()
+	corrupt.b.ForceCommit()
+
+	var trees []*HashTree
+	for _, s := range stores {
+		tree, _, err := s.HashStorage().HashTreeByRev(0)
+		assert.NoError(t, err)
+		hash, _, err := s.HashStorage().HashByRev(0)
+		assert.NoError(t, err)
+		assert.Equal(t, hash, tree.KeyValueHash)
+		assert.Equal(t, 100, tree.Len())
+		trees = append(trees, tree)
+	}
+
+	h0, n0 := trees[0].RangeHash(nil, nil)
+	h1, n1 := trees[1].RangeHash(nil, nil)
+	assert.NotEqual(t, h0, h1)
+	assert.Equal(t, n0, n1)
+
+	splits := trees[0].Split([]byte("foo"), []byte("fop"), 4)
+	assert.Equal(t, [][]byte{[]byte("foo025"), []byte("foo050"), []byte("foo075")}, splits)
+	bounds := append(append([][]byte{[]byte("foo")}, splits...), []byte("fop"))
+	for i := 0; i+1 < len(bounds); i++ {
+		h0, n0 := trees[0].RangeHash(bounds[i], bounds[i+1])
+		h1, n1 := trees[1].RangeHash(bounds[i], bounds[i+1])
+		assert.Equal(t, 25, n0)
+		assert.Equal(t, 25, n1)
+		assert.Equal(t, i != 1, h0 == h1, "range [%s, %s)", bounds[i], bounds[i+1])
+	}
+
+	khs0 := trees[0].KeyHashes(bounds[1], bounds[2])
+	khs1 := trees[1].KeyHashes(bounds[1], bounds[2])
+	var diff []string
+	for i := range khs0 {
+		assert.Equal(t, khs0[i].Key, khs1[i].Key)
+		if khs0[i].Hash != khs1[i].Hash {
+			diff = append(diff, string(khs0[i].Key))
+		}
+	}
+	assert.Equal(t, []string{"foo042"}, diff)
+
+	cached, _, err := stores[0].HashStorage().HashTreeByRev(stores[0].Rev())
+	assert.NoError(t, err)
+	assert.Same(t, trees[0], cached)
+}
+
+func TestHashTreeCacheExpiry(t *testing.T) {
+	defer func(ttl time.Duration) { hashTreeCacheTTL = ttl }(hashTreeCacheTTL)
+	hashTreeCacheTTL = 100 * time.Millisecond
+
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, b)
+	s := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+	defer s.Close()
+	for i := 0; i < 10; i++ {
+		s.Put([]byte(fmt.Sprintf("foo%d", i)), []byte("bar"), lease.NoLease)
+	}
+	hs := s.HashStorage().(*hashStorage)
+
+	tree, _, err := hs.HashTreeByRev(0)
+	assert.NoError(t, err)
+	cached, _, err := hs.HashTreeByRev(0)
+	assert.NoError(t, err)
+	assert.Same(t, tree, cached)
+	assert.Eventually(t, func() bool {
+		hs.hashMu.RLock()
+		defer hs.hashMu.RUnlock()
+		return hs.tree == nil
+	}, time.Second, 10*time.Millisecond, "unused tree not dropped")
+
+	_, _, err = hs.HashTreeByRev(0)
+	assert.NoError(t, err)
+	done, err := s.Compact(traceutil.TODO(), 5)
+	assert.NoError(t, err)
+	<-done
+	hs.hashMu.RLock()
+	assert.Nil(t, hs.tree, "tree not dropped on compaction")
+	hs.hashMu.RUnlock()
+}
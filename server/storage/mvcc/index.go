@@ -25,12 +25,17 @@ type index interface {
 	Get(key []byte, atRev int64) (rev, created revision, ver int64, err error)
 	Range(key, end []byte, atRev int64) ([][]byte, []revision)
 	Revisions(key, end []byte, atRev int64, limit int) ([]revision, int)
+	LimitedRevisions(key, end []byte, atRev int64, limit int) []revision
 	CountRevisions(key, end []byte, atRev int64) int
 	Put(key []byte, rev revision)
 	Tombstone(key []byte, rev revision) error
 	Compact(rev int64) map[revision]struct{}
 	Keep(rev int64) map[revision]struct{}
 	Equal(b index) bool
+	Len() int
+	// Walk calls f on the keyIndex of every key in order, until f returns
+	// false. f must not modify the keyIndex.
+	Walk(f func(ki *keyIndex) bool)
 
 	Insert(ki *keyIndex)
 	KeyIndex(ki *keyIndex) *keyIndex
@@ -40,6 +45,12 @@ type treeIndex struct {
 	sync.RWMutex
 	tree *btree.BTree
 	lg   *zap.Logger
+	// pager, if set, pages out the keyIndexes to bound the memory of the
+	// index, whose tree then only holds the resident ones.
+	pager *indexPager
+	// dirty are the keys whose keyIndex changed since the last call to
+	// trackDirty, or nil if the changes are not tracked.
+	dirty map[string]struct{}
 }
 
 func newTreeIndex(lg *zap.Logger) index {
@@ -49,6 +60,15 @@ func newTreeIndex(lg *zap.Logger) index {
 	}
 }
 
+// newPagedTreeIndex returns a treeIndex whose keys are paged out by pager.
+func newPagedTreeIndex(lg *zap.Logger, pager *indexPager) index {
+	return &treeIndex{
+		tree:  btree.New(32),
+		lg:    lg,
+		pager: pager,
+	}
+}
+
 /***
 treeIndex更新key对应的revision信息
 */
@@ -57,14 +77,17 @@ func (ti *treeIndex) Put(key []byte, rev revision) {
 
 	ti.Lock()
 	defer ti.Unlock()
-	item := ti.tree.Get(keyi)
-	if item == nil {
+	okeyi := ti.unsafeResident(key)
+	if okeyi == nil {
 		keyi.put(ti.lg, rev.main, rev.sub)
 		ti.tree.ReplaceOrInsert(keyi)
+		ti.added(keyi)
+		ti.markDirty(key)
 		return
 	}
-	okeyi := item.(*keyIndex)
 	okeyi.put(ti.lg, rev.main, rev.sub)
+	ti.changed(okeyi)
+	ti.markDirty(key)
 }
 
 /***
@@ -77,8 +100,8 @@ func (ti *treeIndex) Get(key []byte, atRev int64) (modified, created revision, v
 }
 
 func (ti *treeIndex) unsafeGet(key []byte, atRev int64) (modified, created revision, ver int64, err error) {
-	keyi := &keyIndex{key: key}
-	if keyi = ti.keyIndex(keyi); keyi == nil {
+	keyi := ti.lookup(key, true)
+	if keyi == nil {
 		return revision{}, revision{}, 0, ErrRevisionNotFound
 	}
 	return keyi.get(ti.lg, atRev)
@@ -87,23 +110,22 @@ func (ti *treeIndex) unsafeGet(key []byte, atRev int64) (modified, created revis
 /***
 判断keyi是否存在于treeIndex中
 */
+// KeyIndex must not be used to change the keyIndex of a paged index, for
+// which it returns a copy read from the page of a key paged out.
 func (ti *treeIndex) KeyIndex(keyi *keyIndex) *keyIndex {
 	ti.RLock()
 	defer ti.RUnlock()
-	return ti.keyIndex(keyi)
-}
-
-func (ti *treeIndex) keyIndex(keyi *keyIndex) *keyIndex {
-	if item := ti.tree.Get(keyi); item != nil {
-		return item.(*keyIndex)
-	}
-	return nil
+	return ti.lookup(keyi.key, false)
 }
 
 /***
 给定key的范围，升序遍历treeIndex，f函数返回false的时候结束遍历
 */
 func (ti *treeIndex) unsafeVisit(key, end []byte, f func(ki *keyIndex) bool) {
+	if ti.pager != nil {
+		ti.pager.ascend(ti.tree, key, end, true, f)
+		return
+	}
 	keyi, endi := &keyIndex{key: key}, &keyIndex{key: end}
 
 	ti.tree.AscendGreaterOrEqual(keyi, func(item btree.Item) bool {
@@ -147,6 +169,28 @@ func (ti *treeIndex) Revisions(key, end []byte, atRev int64, limit int) (revs []
 	return revs, total
 }
 
+// LimitedRevisions is Revisions without the total: it stops visiting the
+// range once limit revisions are found. There is no limit if limit <= 0.
+func (ti *treeIndex) LimitedRevisions(key, end []byte, atRev int64, limit int) (revs []revision) {
+	ti.RLock()
+	defer ti.RUnlock()
+
+	if end == nil {
+		rev, _, _, err := ti.unsafeGet(key, atRev)
+		if err != nil {
+			return nil
+		}
+		return []revision{rev}
+	}
+	ti.unsafeVisit(key, end, func(ki *keyIndex) bool {
+		if rev, _, _, err := ki.get(ti.lg, atRev); err == nil {
+			revs = append(revs, rev)
+		}
+		return limit <= 0 || len(revs) < limit
+	})
+	return revs
+}
+
 // CountRevisions returns the number of revisions
 // from key(included) to end(excluded) at the given rev.
 /***获取给定范围key的符合条件的revision数量
@@ -206,12 +250,13 @@ func (ti *treeIndex) Tombstone(key []byte, rev revision) error {
 
 	ti.Lock()
 	defer ti.Unlock()
-	item := ti.tree.Get(keyi)
-	if item == nil {
+	ki := ti.unsafeResident(keyi.key)
+	if ki == nil {
 		return ErrRevisionNotFound
 	}
 
-	ki := item.(*keyIndex)
+	defer ti.changed(ki)
+	ti.markDirty(key)
 	return ki.tombstone(ti.lg, rev.main, rev.sub)
 }
 
@@ -222,6 +267,14 @@ func (ti *treeIndex) Tombstone(key []byte, rev revision) error {
 func (ti *treeIndex) Compact(rev int64) map[revision]struct{} {
 	available := make(map[revision]struct{})
 	ti.lg.Info("compact tree index", zap.Int64("revision", rev))
+	// the keyIndexes left are compacted as the checkpoint is loaded, so
+	// only the removed ones are dirty.
+	if ti.pager != nil {
+		ti.pager.compact(ti.tree, rev, available, ti.Lock, ti.Unlock, func(ki *keyIndex) {
+			ti.markDirty(ki.key)
+		})
+		return available
+	}
 	ti.Lock()
 	clone := ti.tree.Clone()
 	ti.Unlock()
@@ -237,6 +290,7 @@ func (ti *treeIndex) Compact(rev int64) map[revision]struct{} {
 			if item == nil {
 				ti.lg.Panic("failed to delete during compaction")
 			}
+			ti.markDirty(keyi.key)
 		}
 		ti.Unlock()
 		return true
@@ -250,10 +304,7 @@ func (ti *treeIndex) Compact(rev int64) map[revision]struct{} {
 */
 func (ti *treeIndex) Keep(rev int64) map[revision]struct{} {
 	available := make(map[revision]struct{})
-	ti.RLock()
-	defer ti.RUnlock()
-	ti.tree.Ascend(func(i btree.Item) bool {
-		keyi := i.(*keyIndex)
+	ti.Walk(func(keyi *keyIndex) bool {
 		keyi.keep(rev, available)
 		return true
 	})
@@ -263,33 +314,162 @@ func (ti *treeIndex) Keep(rev int64) map[revision]struct{} {
 func (ti *treeIndex) Equal(bi index) bool {
 	b := bi.(*treeIndex)
 
-	if ti.tree.Len() != b.tree.Len() {
+	if ti.Len() != b.Len() {
 		return false
 	}
 
-	equal := true
-
-	ti.tree.Ascend(func(item btree.Item) bool {
-		var aki, bki *keyIndex
-		var ok bool
-		if aki, ok = item.(*keyIndex); !ok {
-			return false
-		}
-		if bki, ok = b.tree.Get(item).(*keyIndex); !ok {
-			return false
-		}
-		if !aki.equal(bki) {
+	var bkis []*keyIndex
+	b.Walk(func(ki *keyIndex) bool {
+		bkis = append(bkis, ki)
+		return true
+	})
+	equal, i := true, 0
+	ti.Walk(func(aki *keyIndex) bool {
+		if i >= len(bkis) || !aki.equal(bkis[i]) {
 			equal = false
 			return false
 		}
+		i++
 		return true
 	})
 
 	return equal
 }
 
+// Len returns the number of keys the index holds revisions of.
+func (ti *treeIndex) Len() int {
+	ti.RLock()
+	defer ti.RUnlock()
+	if ti.pager != nil {
+		return ti.tree.Len() + ti.pager.paged
+	}
+	return ti.tree.Len()
+}
+
+func (ti *treeIndex) Walk(f func(ki *keyIndex) bool) {
+	ti.RLock()
+	defer ti.RUnlock()
+	if ti.pager != nil {
+		ti.pager.ascend(ti.tree, nil, nil, false, f)
+		return
+	}
+	ti.tree.Ascend(func(item btree.Item) bool {
+		return f(item.(*keyIndex))
+	})
+}
+
 func (ti *treeIndex) Insert(ki *keyIndex) {
 	ti.Lock()
 	defer ti.Unlock()
+	if ti.pager != nil {
+		if old := ti.unsafeResident(ki.key); old != nil {
+			ti.pager.remove(old)
+		}
+	}
 	ti.tree.ReplaceOrInsert(ki)
+	ti.added(ki)
+	ti.markDirty(ki.key)
+}
+
+// restoreRevision adds a revision of key read back from the key bucket to
+// the index, for a paged index whose keyIndexes are only changed holding
+// its lock, or one tracking the dirty keys.
+func (ti *treeIndex) restoreRevision(key []byte, rev, created revision, ver int64, tombstone bool) {
+	ti.Lock()
+	defer ti.Unlock()
+	ki := ti.unsafeResident(key)
+	if ki == nil {
+		if !tombstone {
+			ki := &keyIndex{key: key}
+			ki.restore(ti.lg, created, rev, ver)
+			ti.tree.ReplaceOrInsert(ki)
+			ti.added(ki)
+			ti.markDirty(key)
+		}
+		return
+	}
+	if tombstone {
+		if err := ki.tombstone(ti.lg, rev.main, rev.sub); err != nil {
+			ti.lg.Warn("tombstone encountered error", zap.Error(err))
+		}
+	} else {
+		ki.put(ti.lg, rev.main, rev.sub)
+	}
+	ti.changed(ki)
+	ti.markDirty(key)
+}
+
+// trackDirty starts tracking the keys whose keyIndex changes, for the index
+// checkpoints, and returns the keys tracked since the previous call, or nil
+// if the changes were not tracked.
+func (ti *treeIndex) trackDirty() map[string]struct{} {
+	ti.Lock()
+	defer ti.Unlock()
+	dirty := ti.dirty
+	ti.dirty = make(map[string]struct{})
+	return dirty
+}
+
+func (ti *treeIndex) markDirty(key []byte) {
+	if ti.dirty != nil {
+		ti.dirty[string(key)] = struct{}{}
+	}
+}
+
+// lookup returns the keyIndex of key, or nil if there is none. The keyIndex
+// of a key paged out is read from its page without making it resident. If
+// touch is set, a resident key is marked as recently used.
+func (ti *treeIndex) lookup(key []byte, touch bool) *keyIndex {
+	if item := ti.tree.Get(&keyIndex{key: key}); item != nil {
+		ki := item.(*keyIndex)
+		if ti.pager != nil && touch {
+			ti.pager.touch(ki)
+		}
+		return ki
+	}
+	if ti.pager == nil {
+		return nil
+	}
+	return ti.pager.read(key)
+}
+
+// unsafeResident returns the keyIndex of key to be changed, paged in if
+// paged out, or nil if there is none.
+func (ti *treeIndex) unsafeResident(key []byte) *keyIndex {
+	if item := ti.tree.Get(&keyIndex{key: key}); item != nil {
+		ki := item.(*keyIndex)
+		if ti.pager != nil {
+			ti.pager.touch(ki)
+		}
+		return ki
+	}
+	if ti.pager == nil {
+		return nil
+	}
+	return ti.pager.pageIn(ti.tree, key)
+}
+
+// added records that ki was inserted and pages out the coldest keys if the
+// index exceeds its budget.
+func (ti *treeIndex) added(ki *keyIndex) {
+	if ti.pager != nil {
+		ti.pager.add(ki)
+		ti.pager.evict(ti.tree)
+	}
+}
+
+// changed records that ki changed and pages out the coldest keys if the
+// index exceeds its budget.
+func (ti *treeIndex) changed(ki *keyIndex) {
+	if ti.pager != nil {
+		ti.pager.changed(ki, true)
+		ti.pager.evict(ti.tree)
+	}
+}
+
+// close releases the pages of the index, if any.
+func (ti *treeIndex) close() {
+	if ti.pager != nil {
+		ti.pager.close()
+	}
 }
@@ -0,0 +1,492 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"go.etcd.io/etcd/server/v3/lease"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+	"go.uber.org/zap"
+)
+
+// The key index checkpoint is stored in the KeyIndex bucket as a header
+// under indexCheckpointHeaderName and the encoded keyIndex of each key under
+// the key prefixed by indexCheckpointKeyPrefix. The checkpoint is written in
+// the same batch tx as the revisions it covers, so it is never ahead of the
+// key bucket.
+//
+// Only the first checkpoint writes the whole index. The index then tracks
+// the keys changed since, so that the following checkpoints only rewrite
+// them while holding the batch tx. The keyIndexes left untouched are not
+// rewritten after a compaction either; they are compacted as they are
+// loaded, at the compaction revision of the header.
+const indexCheckpointVersion = 1
+
+var (
+	indexCheckpointHeaderName = []byte("checkpoint")
+	indexCheckpointKeyPrefix  = []byte("key/")
+
+	errIndexCheckpointCorrupt = errors.New("mvcc: corrupt key index checkpoint")
+)
+
+// indexCheckpoint is a checkpoint of the key index and of the state restored
+// along with it, taken at revision rev.
+type indexCheckpoint struct {
+	rev        int64
+	compactRev int64
+	revisions  int64
+	// keys is the number of keys the checkpoint holds the keyIndex of.
+	keys uint64
+	// quotaPrefixes are the quota prefixes configured when the checkpoint
+	// was taken, for which the usage of the keys is recorded.
+	quotaPrefixes [][]byte
+
+	keyIndexes []*keyIndex
+	keyToLease map[string]lease.LeaseID
+	keyToUsage map[string]int64
+}
+
+// CheckpointIndex persists a checkpoint of the key index if enabled by
+// StoreConfig.IndexCheckpoint. The checkpoint is skipped while a compaction
+// is in progress.
+func (s *store) CheckpointIndex() {
+	ti, ok := s.kvindex.(*treeIndex)
+	if !s.cfg.IndexCheckpoint || !ok {
+		return
+	}
+	start := time.Now()
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	// holding the batch tx blocks the write txns, so that the index, the
+	// current revision and the usage do not change.
+	tx := s.b.BatchTx()
+	tx.LockOutsideApply()
+	defer tx.Unlock()
+
+	s.revMu.RLock()
+	rev, compactRev := s.currentRev, s.compactMainRev
+	s.revMu.RUnlock()
+	if finished, found := UnsafeReadFinishedCompact(tx); !found && compactRev != -1 || found && finished != compactRev {
+		s.lg.Info("skipped key index checkpoint during compaction", zap.Int64("compact-revision", compactRev))
+		return
+	}
+
+	cp := &indexCheckpoint{
+		rev:           rev,
+		compactRev:    compactRev,
+		revisions:     atomic.LoadInt64(&s.revisions),
+		quotaPrefixes: s.cfg.QuotaPrefixes,
+	}
+	var enc checkpointEncoder
+	// the whole index is written unless the changes since the last
+	// checkpoint are tracked.
+	dirty := ti.trackDirty()
+	keys, ok := unsafeReadCheckpointKeys(tx)
+	full := dirty == nil || !ok
+	written := 0
+	s.usageMu.RLock()
+	if full {
+		unsafeDeleteIndexCheckpoint(tx)
+		ti.Walk(func(ki *keyIndex) bool {
+			tx.UnsafePut(schema.KeyIndex, checkpointKeyName(ki.key), s.encodeCheckpointKey(&enc, ki))
+			cp.keys++
+			return true
+		})
+		written = int(cp.keys)
+	} else {
+		cp.keys = keys
+		changed := make([]string, 0, len(dirty))
+		for key := range dirty {
+			changed = append(changed, key)
+		}
+		sort.Strings(changed)
+		for _, key := range changed {
+			name := checkpointKeyName([]byte(key))
+			_, vs := tx.UnsafeRange(schema.KeyIndex, name, nil, 0)
+			ki := ti.KeyIndex(&keyIndex{key: []byte(key)})
+			switch {
+			case ki != nil:
+				tx.UnsafePut(schema.KeyIndex, name, s.encodeCheckpointKey(&enc, ki))
+				if len(vs) == 0 {
+					cp.keys++
+				}
+			case len(vs) != 0:
+				// removed by a compaction
+				tx.UnsafeDelete(schema.KeyIndex, name)
+				cp.keys--
+			}
+		}
+		written = len(changed)
+	}
+	s.usageMu.RUnlock()
+	tx.UnsafePut(schema.KeyIndex, indexCheckpointHeaderName, encodeCheckpointHeader(cp))
+
+	s.lg.Info(
+		"saved key index checkpoint",
+		zap.Int64("revision", rev),
+		zap.Bool("full", full),
+		zap.Int("written-keys", written),
+		zap.Uint64("keys", cp.keys),
+		zap.Duration("took", time.Since(start)),
+	)
+}
+
+// encodeCheckpointKey encodes ki along with the lease attached to the key
+// and its usage. It must be called holding usageMu.
+func (s *store) encodeCheckpointKey(enc *checkpointEncoder, ki *keyIndex) []byte {
+	lid, size := lease.NoLease, int64(-1)
+	if s.le != nil {
+		lid = s.le.GetLease(lease.LeaseItem{Key: string(ki.key)})
+	}
+	if sz, ok := s.usageSizes[string(ki.key)]; ok {
+		size = sz
+	}
+	enc.keyIndex(ki, lid, size)
+	return enc.flushWithChecksum()
+}
+
+// unsafeReadCheckpointKeys returns the number of keys of the checkpoint in
+// tx, or false if its header cannot be read.
+func unsafeReadCheckpointKeys(tx backend.ReadTx) (uint64, bool) {
+	_, vs := tx.UnsafeRange(schema.KeyIndex, indexCheckpointHeaderName, nil, 0)
+	if len(vs) == 0 {
+		return 0, false
+	}
+	cp, err := decodeCheckpointHeader(vs[0])
+	if err != nil {
+		return 0, false
+	}
+	return cp.keys, true
+}
+
+// unsafeDeleteIndexCheckpoint deletes the checkpoint of the key index, so
+// that a checkpoint is not left behind once disabled.
+func unsafeDeleteIndexCheckpoint(tx backend.BatchTx) {
+	var keys [][]byte
+	tx.UnsafeForEach(schema.KeyIndex, func(k, _ []byte) error {
+		keys = append(keys, append([]byte(nil), k...))
+		return nil
+	})
+	for _, k := range keys {
+		tx.UnsafeDelete(schema.KeyIndex, k)
+	}
+}
+
+// unsafeLoadIndexCheckpoint loads the checkpoint of the key index and
+// validates it against the backend, whose compaction must have been
+// restored in s.compactMainRev. It returns nil if there is no checkpoint.
+func (s *store) unsafeLoadIndexCheckpoint(tx backend.ReadTx) (*indexCheckpoint, error) {
+	_, vs := tx.UnsafeRange(schema.KeyIndex, indexCheckpointHeaderName, nil, 0)
+	if len(vs) == 0 {
+		return nil, nil
+	}
+	cp, err := decodeCheckpointHeader(vs[0])
+	if err != nil {
+		return nil, err
+	}
+
+	// the checkpoint is stale if the backend was compacted after it was
+	// taken, or was replaced by one without the revisions it covers.
+	if cp.compactRev != s.compactMainRev {
+		return nil, fmt.Errorf("stale key index checkpoint (compact revision %d, backend compact revision %d)", cp.compactRev, s.compactMainRev)
+	}
+	if cp.rev != cp.compactRev {
+		min, max := newRevBytes(), newRevBytes()
+		revToBytes(revision{main: cp.rev}, min)
+		revToBytes(revision{main: cp.rev + 1}, max)
+		if keys, _ := tx.UnsafeRange(schema.Key, min, max, 1); len(keys) == 0 {
+			return nil, fmt.Errorf("stale key index checkpoint (revision %d is not in the backend)", cp.rev)
+		}
+	}
+	if !equalPrefixes(cp.quotaPrefixes, s.cfg.QuotaPrefixes) {
+		return nil, errors.New("key index checkpoint taken with different quota prefixes")
+	}
+
+	cp.keyToLease = make(map[string]lease.LeaseID)
+	cp.keyToUsage = make(map[string]int64)
+	keys := uint64(0)
+	err = tx.UnsafeForEach(schema.KeyIndex, func(k, v []byte) error {
+		if !bytes.HasPrefix(k, indexCheckpointKeyPrefix) {
+			return nil
+		}
+		keys++
+		return s.decodeCheckpointKey(k[len(indexCheckpointKeyPrefix):], v, cp)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if keys != cp.keys {
+		return nil, fmt.Errorf("%w: %d keys, want %d", errIndexCheckpointCorrupt, keys, cp.keys)
+	}
+	return cp, nil
+}
+
+// decodeCheckpointKey decodes the keyIndex of key into cp, compacting it at
+// the compaction revision of the checkpoint.
+func (s *store) decodeCheckpointKey(key, b []byte, cp *indexCheckpoint) error {
+	if len(b) < 4 || crc32.Checksum(b[:len(b)-4], crc32.MakeTable(crc32.Castagnoli)) != binary.BigEndian.Uint32(b[len(b)-4:]) {
+		return fmt.Errorf("%w: checksum mismatch of key %q", errIndexCheckpointCorrupt, key)
+	}
+	dec := checkpointDecoder{b: b[:len(b)-4]}
+	ki := &keyIndex{key: append([]byte(nil), key...)}
+	ki.modified = dec.revision()
+	lid, size := lease.LeaseID(dec.varint()), dec.varint()
+	ki.generations = dec.generations()
+	if dec.err != nil || len(dec.b) != 0 || len(ki.generations) == 0 {
+		return errIndexCheckpointCorrupt
+	}
+	if cp.compactRev > 0 {
+		ki.compact(s.lg, cp.compactRev, make(map[revision]struct{}))
+		if ki.isEmpty() {
+			return nil
+		}
+	}
+	cp.keyIndexes = append(cp.keyIndexes, ki)
+	if lid != lease.NoLease {
+		cp.keyToLease[string(ki.key)] = lid
+	}
+	if size >= 0 {
+		cp.keyToUsage[string(ki.key)] = size
+	}
+	return nil
+}
+
+// restoreCheckpoint inserts the keyIndexes of the checkpoint into the
+// index, which must be empty.
+func restoreCheckpoint(idx index, cp *indexCheckpoint) {
+	for _, ki := range cp.keyIndexes {
+		if g := ki.generations[len(ki.generations)-1]; !g.isEmpty() {
+			keysGauge.Inc()
+		}
+		idx.Insert(ki)
+	}
+	cp.keyIndexes = nil
+}
+
+func equalPrefixes(a, b [][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !bytes.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func checkpointKeyName(key []byte) []byte {
+	name := make([]byte, len(indexCheckpointKeyPrefix)+len(key))
+	copy(name, indexCheckpointKeyPrefix)
+	copy(name[len(indexCheckpointKeyPrefix):], key)
+	return name
+}
+
+// encodeCheckpointHeader encodes the header of the checkpoint, followed by
+// its checksum.
+func encodeCheckpointHeader(cp *indexCheckpoint) []byte {
+	var enc checkpointEncoder
+	enc.buf.WriteByte(indexCheckpointVersion)
+	enc.varint(cp.rev)
+	enc.varint(cp.compactRev)
+	enc.varint(cp.revisions)
+	enc.uvarint(cp.keys)
+	enc.uvarint(uint64(len(cp.quotaPrefixes)))
+	for _, p := range cp.quotaPrefixes {
+		enc.bytes(p)
+	}
+	return enc.flushWithChecksum()
+}
+
+func decodeCheckpointHeader(b []byte) (*indexCheckpoint, error) {
+	if len(b) < 5 {
+		return nil, errIndexCheckpointCorrupt
+	}
+	if b[0] != indexCheckpointVersion {
+		return nil, fmt.Errorf("unsupported key index checkpoint version %d", b[0])
+	}
+	if crc32.Checksum(b[:len(b)-4], crc32.MakeTable(crc32.Castagnoli)) != binary.BigEndian.Uint32(b[len(b)-4:]) {
+		return nil, fmt.Errorf("%w: checksum mismatch of header", errIndexCheckpointCorrupt)
+	}
+	dec := checkpointDecoder{b: b[1 : len(b)-4]}
+	cp := &indexCheckpoint{
+		rev:        dec.varint(),
+		compactRev: dec.varint(),
+		revisions:  dec.varint(),
+		keys:       dec.uvarint(),
+	}
+	n := dec.uvarint()
+	for i := uint64(0); i < n && dec.err == nil; i++ {
+		cp.quotaPrefixes = append(cp.quotaPrefixes, dec.bytes())
+	}
+	if dec.err != nil || len(dec.b) != 0 {
+		return nil, errIndexCheckpointCorrupt
+	}
+	return cp, nil
+}
+
+type checkpointEncoder struct {
+	buf     bytes.Buffer
+	scratch [binary.MaxVarintLen64]byte
+}
+
+// keyIndex encodes the keyIndex of ki, but for its key, along with the
+// lease attached to the key and its usage, or -1 if the usage of the key is
+// not tracked.
+func (e *checkpointEncoder) keyIndex(ki *keyIndex, lid lease.LeaseID, size int64) {
+	e.revision(ki.modified)
+	e.varint(int64(lid))
+	e.varint(size)
+	e.generations(ki.generations)
+}
+
+func (e *checkpointEncoder) generations(gens []generation) {
+	e.uvarint(uint64(len(gens)))
+	for _, g := range gens {
+		e.varint(g.ver)
+		e.revision(g.created)
+		e.uvarint(uint64(len(g.revs)))
+		for _, r := range g.revs {
+			e.revision(r)
+		}
+	}
+}
+
+func (e *checkpointEncoder) flush() []byte {
+	b := append([]byte(nil), e.buf.Bytes()...)
+	e.buf.Reset()
+	return b
+}
+
+// flushWithChecksum is flush with the checksum of the encoded bytes
+// appended.
+func (e *checkpointEncoder) flushWithChecksum() []byte {
+	var sum [4]byte
+	binary.BigEndian.PutUint32(sum[:], crc32.Checksum(e.buf.Bytes(), crc32.MakeTable(crc32.Castagnoli)))
+	e.buf.Write(sum[:])
+	return e.flush()
+}
+
+func (e *checkpointEncoder) revision(r revision) {
+	e.varint(r.main)
+	e.varint(r.sub)
+}
+
+func (e *checkpointEncoder) bytes(b []byte) {
+	e.uvarint(uint64(len(b)))
+	e.buf.Write(b)
+}
+
+func (e *checkpointEncoder) varint(v int64) {
+	n := binary.PutVarint(e.scratch[:], v)
+	e.buf.Write(e.scratch[:n])
+}
+
+func (e *checkpointEncoder) uvarint(v uint64) {
+	n := binary.PutUvarint(e.scratch[:], v)
+	e.buf.Write(e.scratch[:n])
+}
+
+// checkpointDecoder decodes the checkpoint, keeping the first error, after
+// which it only returns zero values.
+type checkpointDecoder struct {
+	b   []byte
+	err error
+}
+
+func (d *checkpointDecoder) revision() revision {
+	return revision{main: d.varint(), sub: d.varint()}
+}
+
+func (d *checkpointDecoder) generations() []generation {
+	gens := make([]generation, d.count())
+	for i := range gens {
+		g := &gens[i]
+		g.ver = d.varint()
+		g.created = d.revision()
+		g.revs = make([]revision, d.count())
+		for j := range g.revs {
+			g.revs[j] = d.revision()
+		}
+	}
+	return gens
+}
+
+func (d *checkpointDecoder) bytes() []byte {
+	n := d.uvarint()
+	if d.err != nil || n > uint64(len(d.b)) {
+		d.err = errIndexCheckpointCorrupt
+		return nil
+	}
+	b := append([]byte(nil), d.b[:n]...)
+	d.b = d.b[n:]
+	return b
+}
+
+// count decodes the number of the elements that follow, each of which
+// takes at least one byte.
+func (d *checkpointDecoder) count() int {
+	n := d.uvarint()
+	if n > uint64(len(d.b)) {
+		d.err = errIndexCheckpointCorrupt
+		return 0
+	}
+	return int(n)
+}
+
+func (d *checkpointDecoder) varint() int64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(d.b)
+	if n <= 0 {
+		d.err = errIndexCheckpointCorrupt
+		return 0
+	}
+	d.b = d.b[n:]
+	return v
+}
+
+func (d *checkpointDecoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(d.b)
+	if n <= 0 {
+		d.err = errIndexCheckpointCorrupt
+		return 0
+	}
+	d.b = d.b[n:]
+	return v
+}
+
+// unsafeCreateKeyIndexBucket creates the bucket of the key index
+// checkpoint, and deletes the checkpoint if checkpoints are disabled.
+func (s *store) unsafeCreateKeyIndexBucket(tx backend.BatchTx) {
+	tx.UnsafeCreateBucket(schema.KeyIndex)
+	if !s.cfg.IndexCheckpoint {
+		unsafeDeleteIndexCheckpoint(tx)
+	}
+}
@@ -0,0 +1,255 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"go.etcd.io/etcd/pkg/v3/traceutil"
+	"go.etcd.io/etcd/server/v3/lease"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestIndexCheckpointRestore(t *testing.T) {
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, b)
+	cfg := StoreConfig{IndexCheckpoint: true, QuotaPrefixes: [][]byte{[]byte("foo")}}
+
+	s := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, cfg)
+	for i := 0; i < 10; i++ {
+		s.Put([]byte(fmt.Sprintf("foo%d", i%4)), []byte(fmt.Sprintf("bar%d", i)), lease.NoLease)
+	}
+	s.DeleteRange([]byte("foo0"), nil)
+	s.CheckpointIndex()
+	checkpointRev := s.Rev()
+	// revisions after the checkpoint are replayed
+	s.Put([]byte("foo0"), []byte("baz"), lease.NoLease)
+	s.DeleteRange([]byte("foo1"), nil)
+	s.Put([]byte("bar"), []byte("baz"), lease.NoLease)
+	s.Commit()
+	want := s.kvindex
+	wantRev, wantUsage, wantStats := s.Rev(), mustPrefixUsage(t, s, "foo"), s.Stats()
+	// the checkpoint is taken again on close, so take it over
+	s.cfg.IndexCheckpoint = false
+	s.Close()
+
+	s = NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, cfg)
+	if !s.kvindex.Equal(want) {
+		t.Errorf("restored index differs from the index before restart")
+	}
+	if s.Rev() != wantRev {
+		t.Errorf("rev = %d, want %d", s.Rev(), wantRev)
+	}
+	if u := mustPrefixUsage(t, s, "foo"); u != wantUsage {
+		t.Errorf("usage = %+v, want %+v", u, wantUsage)
+	}
+	if st := s.Stats(); st.Revisions != wantStats.Revisions {
+		t.Errorf("revisions = %d, want %d", st.Revisions, wantStats.Revisions)
+	}
+	s.cfg.IndexCheckpoint = false
+	s.Close()
+
+	// removing the revisions covered by the checkpoint, but the last one it
+	// is validated with, shows that they are not read back on restore.
+	tx := b.BatchTx()
+	tx.Lock()
+	for _, rev := range unsafeRevisions(tx, checkpointRev-1) {
+		tx.UnsafeDelete(schema.Key, rev)
+	}
+	tx.Unlock()
+	s = NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, cfg)
+	if !s.kvindex.Equal(want) {
+		t.Errorf("index restored from the checkpoint differs from the index before restart")
+	}
+	s.Close()
+
+	// disabling the checkpoint deletes it
+	s = NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+	defer s.Close()
+	tx = b.BatchTx()
+	tx.Lock()
+	_, vs := tx.UnsafeRange(schema.KeyIndex, indexCheckpointHeaderName, nil, 0)
+	tx.Unlock()
+	if len(vs) != 0 {
+		t.Errorf("key index checkpoint not deleted once disabled")
+	}
+}
+
+func TestIndexCheckpointInvalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		invalidate func(t *testing.T, s *store, b backend.Backend)
+	}{
+		{
+			name: "compacted after checkpoint",
+			invalidate: func(t *testing.T, s *store, b backend.Backend) {
+				done, err := s.Compact(traceutil.TODO(), s.Rev()-1)
+				if err != nil {
+					t.Fatal(err)
+				}
+				<-done
+			},
+		},
+		{
+			name: "corrupt key",
+			invalidate: func(t *testing.T, s *store, b backend.Backend) {
+				tx := b.BatchTx()
+				tx.Lock()
+				defer tx.Unlock()
+				name := checkpointKeyName([]byte("foo1"))
+				_, vs := tx.UnsafeRange(schema.KeyIndex, name, nil, 0)
+				corrupt := append([]byte(nil), vs[0]...)
+				corrupt[len(corrupt)/2]++
+				tx.UnsafePut(schema.KeyIndex, name, corrupt)
+			},
+		},
+		{
+			name: "missing key",
+			invalidate: func(t *testing.T, s *store, b backend.Backend) {
+				tx := b.BatchTx()
+				tx.Lock()
+				defer tx.Unlock()
+				tx.UnsafeDelete(schema.KeyIndex, checkpointKeyName([]byte("foo1")))
+			},
+		},
+		{
+			name: "unknown version",
+			invalidate: func(t *testing.T, s *store, b backend.Backend) {
+				tx := b.BatchTx()
+				tx.Lock()
+				defer tx.Unlock()
+				_, vs := tx.UnsafeRange(schema.KeyIndex, indexCheckpointHeaderName, nil, 0)
+				header := append([]byte(nil), vs[0]...)
+				header[0] = indexCheckpointVersion + 1
+				tx.UnsafePut(schema.KeyIndex, indexCheckpointHeaderName, header)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, _ := betesting.NewDefaultTmpBackend(t)
+			defer betesting.Close(t, b)
+			cfg := StoreConfig{IndexCheckpoint: true}
+
+			s := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, cfg)
+			for i := 0; i < 10; i++ {
+				s.Put([]byte(fmt.Sprintf("foo%d", i%4)), []byte("bar"), lease.NoLease)
+			}
+			s.CheckpointIndex()
+			s.Put([]byte("foo"), []byte("bar"), lease.NoLease)
+			tt.invalidate(t, s, b)
+			s.Commit()
+			want := s.kvindex
+			s.cfg.IndexCheckpoint = false
+			s.Close()
+
+			s = NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, cfg)
+			defer s.Close()
+			if !s.kvindex.Equal(want) {
+				t.Errorf("index rebuilt from an invalid checkpoint differs from the index before restart")
+			}
+		})
+	}
+}
+
+func TestIndexCheckpointIncremental(t *testing.T) {
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, b)
+	cfg := StoreConfig{IndexCheckpoint: true}
+
+	s := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, cfg)
+	for i := 0; i < 10; i++ {
+		s.Put([]byte(fmt.Sprintf("foo%d", i%4)), []byte("bar"), lease.NoLease)
+	}
+	s.DeleteRange([]byte("foo3"), nil)
+	s.CheckpointIndex()
+	if dirty := s.kvindex.(*treeIndex).dirty; len(dirty) != 0 {
+		t.Errorf("dirty keys = %v after a checkpoint, want none", dirty)
+	}
+	// the compaction removes foo3 and compacts foo0 to foo2, of which only
+	// the changed foo0 and the removed foo3 are written.
+	s.Put([]byte("foo0"), []byte("baz"), lease.NoLease)
+	done, err := s.Compact(traceutil.TODO(), s.Rev())
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-done
+	s.Put([]byte("bar"), []byte("baz"), lease.NoLease)
+	tx := b.BatchTx()
+	tx.Lock()
+	_, vs := tx.UnsafeRange(schema.KeyIndex, checkpointKeyName([]byte("foo1")), nil, 0)
+	foo1 := append([]byte(nil), vs[0]...)
+	tx.Unlock()
+	wantDirty := map[string]struct{}{"foo0": {}, "foo3": {}, "bar": {}}
+	if dirty := s.kvindex.(*treeIndex).dirty; !reflect.DeepEqual(dirty, wantDirty) {
+		t.Errorf("dirty keys = %v, want %v", dirty, wantDirty)
+	}
+	s.CheckpointIndex()
+	checkpointRev := s.Rev()
+	s.Commit()
+	want := s.kvindex
+	s.cfg.IndexCheckpoint = false
+	s.Close()
+
+	tx = b.BatchTx()
+	tx.Lock()
+	if _, vs = tx.UnsafeRange(schema.KeyIndex, checkpointKeyName([]byte("foo3")), nil, 0); len(vs) != 0 {
+		t.Errorf("key removed by the compaction is still checkpointed")
+	}
+	if _, vs = tx.UnsafeRange(schema.KeyIndex, checkpointKeyName([]byte("foo1")), nil, 0); !bytes.Equal(vs[0], foo1) {
+		t.Errorf("unchanged key rewritten by the checkpoint")
+	}
+	// the restore must not read back the revisions covered by the
+	// checkpoint, but the last one it is validated with.
+	for _, rev := range unsafeRevisions(tx, checkpointRev-1) {
+		tx.UnsafeDelete(schema.Key, rev)
+	}
+	tx.Unlock()
+
+	s = NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, cfg)
+	defer s.Close()
+	if !s.kvindex.Equal(want) {
+		t.Errorf("index restored from an incremental checkpoint differs from the index before restart")
+	}
+}
+
+func mustPrefixUsage(t *testing.T, s *store, prefix string) PrefixUsage {
+	t.Helper()
+	u, ok := s.PrefixUsage([]byte(prefix))
+	if !ok {
+		t.Fatalf("usage of %q is not tracked", prefix)
+	}
+	return u
+}
+
+// unsafeRevisions returns the keys of the revisions up to rev in the key
+// bucket.
+func unsafeRevisions(tx backend.ReadTx, rev int64) [][]byte {
+	min, max := newRevBytes(), newRevBytes()
+	revToBytes(revision{main: 1}, min)
+	revToBytes(revision{main: rev + 1}, max)
+	keys, _ := tx.UnsafeRange(schema.Key, min, max, 0)
+	var revs [][]byte
+	for _, k := range keys {
+		revs = append(revs, append([]byte(nil), k...))
+	}
+	return revs
+}
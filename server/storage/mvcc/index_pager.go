@@ -0,0 +1,413 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"bytes"
+	"container/list"
+	"errors"
+	"os"
+	"sync/atomic"
+	"unsafe"
+
+	"github.com/google/btree"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+	"go.uber.org/zap"
+)
+
+// indexPageScanKeys is the number of pages read at once by the scans of a
+// paged index.
+var indexPageScanKeys = int64(1000) // non-const for testing
+
+// indexPager bounds the memory held by the keyIndexes of a treeIndex. The
+// tree of the index only holds the resident keyIndexes, which are cached up
+// to the budget. Once they exceed it, the least recently used ones are
+// written whole to the KeyIndex bucket, under the key prefixed as in the
+// checkpoint, and dropped from the tree. A key that is not resident is
+// looked up in its page, and ranges merge the resident keys with the pages.
+//
+// The pages are only a spill area: the index is rebuilt on restore, so they
+// are kept in a backend of their own, recreated when the pager is opened
+// and written without syncing. Being out of the backend of the store, the
+// pages are not part of its snapshots, hash and quota, and they are read
+// back from the batch tx of the pager as soon as they are written.
+//
+// The pager is guarded by the lock of its treeIndex. Readers, holding the
+// lock for reading, read the pages of the keys paged out without making
+// them resident, so that ranges do not evict the working set.
+type indexPager struct {
+	lg     *zap.Logger
+	path   string
+	b      backend.Backend
+	budget int64
+
+	// size is the memory held by the resident keyIndexes.
+	size int64
+	// clock lists the resident keys in the order they are considered for
+	// eviction; a key referenced since it was last considered gets a second
+	// chance.
+	clock    *list.List
+	resident map[*keyIndex]*residentKey
+	// paged is the number of keys that are only held by their page.
+	paged int
+	// compactRev is the revision of the last compaction of the index. The
+	// pages written before it are compacted as they are read.
+	compactRev int64
+}
+
+// residentKey is the paging state of a resident key.
+type residentKey struct {
+	ki   *keyIndex
+	elem *list.Element
+	// size is the memory held by the keyIndex.
+	size int64
+	// referenced is set to 1 when the key is read, which is done holding
+	// the index lock for reading.
+	referenced int32
+	// dirty is whether the keyIndex changed since its page was written.
+	dirty bool
+	// paged is whether the key has a page, stale once dirty.
+	paged bool
+}
+
+// newIndexPager returns a pager writing its pages to a backend at path,
+// replacing the pages of a previous run.
+func newIndexPager(lg *zap.Logger, path string, budget int64) (*indexPager, error) {
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	bcfg := backend.DefaultBackendConfig(lg)
+	bcfg.Path = path
+	bcfg.UnsafeNoFsync = true
+	b := backend.New(bcfg)
+	tx := b.BatchTx()
+	tx.Lock()
+	tx.UnsafeCreateBucket(schema.KeyIndex)
+	tx.Unlock()
+	indexResidentBytes.Set(0)
+	return &indexPager{
+		lg:       lg,
+		path:     path,
+		b:        b,
+		budget:   budget,
+		clock:    list.New(),
+		resident: make(map[*keyIndex]*residentKey),
+	}, nil
+}
+
+// close closes and removes the backend of the pages.
+func (p *indexPager) close() {
+	if err := p.b.Close(); err != nil {
+		p.lg.Warn("failed to close key index pages", zap.String("path", p.path), zap.Error(err))
+	}
+	if err := os.Remove(p.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		p.lg.Warn("failed to remove key index pages", zap.String("path", p.path), zap.Error(err))
+	}
+}
+
+// touch marks the resident ki as recently used.
+func (p *indexPager) touch(ki *keyIndex) {
+	atomic.StoreInt32(&p.resident[ki].referenced, 1)
+}
+
+// read returns the keyIndex of the key paged out, compacted, or nil if it
+// has no page.
+func (p *indexPager) read(key []byte) *keyIndex {
+	tx := p.b.BatchTx()
+	tx.Lock()
+	_, vs := tx.UnsafeRange(schema.KeyIndex, checkpointKeyName(key), nil, 0)
+	var ki *keyIndex
+	if len(vs) != 0 {
+		ki = p.decode(key, vs[0])
+	}
+	tx.Unlock()
+	return p.compacted(ki)
+}
+
+// readRange returns the keyIndexes of at most limit pages of the keys from
+// from to end, or to the last key if end is empty, as they were written.
+// next is the key after the last page read if there may be more.
+func (p *indexPager) readRange(from, end []byte, limit int64) (kis []*keyIndex, next []byte) {
+	endName := checkpointKeyName(end)
+	if len(end) == 0 {
+		endName = checkpointKeyName(nil)
+		endName[len(endName)-1]++
+	}
+	tx := p.b.BatchTx()
+	tx.Lock()
+	names, vs := tx.UnsafeRange(schema.KeyIndex, checkpointKeyName(from), endName, limit)
+	for i, name := range names {
+		key := append([]byte(nil), name[len(indexCheckpointKeyPrefix):]...)
+		kis = append(kis, p.decode(key, vs[i]))
+	}
+	tx.Unlock()
+	if int64(len(kis)) == limit {
+		next = append(append([]byte(nil), kis[len(kis)-1].key...), 0)
+	}
+	return kis, next
+}
+
+func (p *indexPager) decode(key, b []byte) *keyIndex {
+	dec := checkpointDecoder{b: b}
+	ki := &keyIndex{key: key, modified: dec.revision(), generations: dec.generations()}
+	if dec.err != nil || len(dec.b) != 0 || len(ki.generations) == 0 {
+		p.lg.Panic("failed to read key index page", zap.String("key", string(key)), zap.Error(errIndexCheckpointCorrupt))
+	}
+	return ki
+}
+
+// compacted compacts ki, read from its page, at the last compaction, and
+// returns nil if it is removed by it.
+func (p *indexPager) compacted(ki *keyIndex) *keyIndex {
+	if ki == nil || p.compactRev <= 0 {
+		return ki
+	}
+	ki.compact(p.lg, p.compactRev, make(map[revision]struct{}))
+	if ki.isEmpty() {
+		return nil
+	}
+	return ki
+}
+
+// ascendChunk calls f in order on the keyIndexes of the keys from from to
+// end, or to the last key if end is empty, up to those of a chunk of pages.
+// The resident ones are passed as is, with resident set; the paged out ones
+// as read from their page, to be compacted. It returns the key to continue
+// from, or nil once f returns false or all the keys are visited.
+func (p *indexPager) ascendChunk(tree *btree.BTree, from, end []byte, f func(ki *keyIndex, resident bool) bool) []byte {
+	pages, next := p.readRange(from, end, indexPageScanKeys)
+	i, stopped := 0, false
+	tree.AscendGreaterOrEqual(&keyIndex{key: from}, func(item btree.Item) bool {
+		ki := item.(*keyIndex)
+		if next != nil && bytes.Compare(ki.key, next) >= 0 || len(end) > 0 && bytes.Compare(ki.key, end) >= 0 {
+			return false
+		}
+		for ; i < len(pages) && bytes.Compare(pages[i].key, ki.key) < 0; i++ {
+			if !f(pages[i], false) {
+				stopped = true
+				return false
+			}
+		}
+		// the page of a resident key is stale.
+		if i < len(pages) && bytes.Equal(pages[i].key, ki.key) {
+			i++
+		}
+		if !f(ki, true) {
+			stopped = true
+			return false
+		}
+		return true
+	})
+	if stopped {
+		return nil
+	}
+	for ; i < len(pages); i++ {
+		if !f(pages[i], false) {
+			return nil
+		}
+	}
+	return next
+}
+
+// ascend calls f in order on the keyIndexes of the keys from from to end,
+// or to the last key if end is empty, until f returns false. If touch is
+// set, the resident keys are marked as recently used.
+func (p *indexPager) ascend(tree *btree.BTree, from, end []byte, touch bool, f func(ki *keyIndex) bool) {
+	for from = append([]byte{}, from...); from != nil; {
+		from = p.ascendChunk(tree, from, end, func(ki *keyIndex, resident bool) bool {
+			if resident {
+				if touch {
+					p.touch(ki)
+				}
+				return f(ki)
+			}
+			if ki = p.compacted(ki); ki == nil {
+				return true
+			}
+			return f(ki)
+		})
+	}
+}
+
+// pageIn reads back the keyIndex of key into tree, before it is changed,
+// and returns it, or nil if key has no page or its page is removed by the
+// last compaction.
+func (p *indexPager) pageIn(tree *btree.BTree, key []byte) *keyIndex {
+	tx := p.b.BatchTx()
+	tx.Lock()
+	defer tx.Unlock()
+	name := checkpointKeyName(key)
+	_, vs := tx.UnsafeRange(schema.KeyIndex, name, nil, 0)
+	if len(vs) == 0 {
+		return nil
+	}
+	ki := p.compacted(p.decode(key, vs[0]))
+	p.paged--
+	if ki == nil {
+		tx.UnsafeDelete(schema.KeyIndex, name)
+		return nil
+	}
+	tree.ReplaceOrInsert(ki)
+	rk := &residentKey{ki: ki, size: keyIndexSize(ki), referenced: 1, paged: true}
+	rk.elem = p.clock.PushBack(rk)
+	p.resident[ki] = rk
+	p.resize(rk.size)
+	indexPageInCounter.Inc()
+	return ki
+}
+
+// add adds ki, inserted in the index as resident.
+func (p *indexPager) add(ki *keyIndex) {
+	rk := &residentKey{ki: ki, size: keyIndexSize(ki), referenced: 1, dirty: true}
+	rk.elem = p.clock.PushBack(rk)
+	p.resident[ki] = rk
+	p.resize(rk.size)
+}
+
+// changed records that the resident ki changed. Unless dirty is set, the
+// change is one its page yields once compacted.
+func (p *indexPager) changed(ki *keyIndex, dirty bool) {
+	rk := p.resident[ki]
+	rk.dirty = rk.dirty || dirty
+	size := keyIndexSize(ki)
+	p.resize(size - rk.size)
+	rk.size = size
+}
+
+// remove removes the resident ki, deleted from the index, along with its
+// page.
+func (p *indexPager) remove(ki *keyIndex) {
+	rk := p.resident[ki]
+	p.clock.Remove(rk.elem)
+	p.resize(-rk.size)
+	delete(p.resident, ki)
+	if rk.paged {
+		p.deletePages([][]byte{ki.key})
+	}
+}
+
+// compact compacts the keyIndexes of the index at rev, a chunk at a time
+// so that the lock of the index is released in between. lock and unlock
+// lock and unlock the index.
+func (p *indexPager) compact(tree *btree.BTree, rev int64, available map[revision]struct{}, lock, unlock func(), removed func(ki *keyIndex)) {
+	lock()
+	// the keys paged in from now on are compacted as they are read.
+	p.compactRev = rev
+	unlock()
+	for from := []byte{}; from != nil; {
+		var (
+			emptied []*keyIndex
+			freed   [][]byte
+		)
+		lock()
+		from = p.ascendChunk(tree, from, nil, func(ki *keyIndex, resident bool) bool {
+			ki.compact(p.lg, rev, available)
+			switch {
+			case ki.isEmpty() && resident:
+				emptied = append(emptied, ki)
+			case ki.isEmpty():
+				freed = append(freed, ki.key)
+			case resident:
+				p.changed(ki, false)
+			}
+			return true
+		})
+		for _, ki := range emptied {
+			if tree.Delete(ki) == nil {
+				p.lg.Panic("failed to delete during compaction")
+			}
+			p.remove(ki)
+			removed(ki)
+		}
+		if len(freed) > 0 {
+			p.deletePages(freed)
+			p.paged -= len(freed)
+			for _, key := range freed {
+				removed(&keyIndex{key: key})
+			}
+		}
+		unlock()
+	}
+}
+
+func (p *indexPager) deletePages(keys [][]byte) {
+	tx := p.b.BatchTx()
+	tx.Lock()
+	for _, key := range keys {
+		tx.UnsafeDelete(schema.KeyIndex, checkpointKeyName(key))
+	}
+	tx.Unlock()
+}
+
+// evict pages out the least recently used keys from tree once the resident
+// keys exceed the budget, down to 7/8 of it so that the pages are written
+// in batches.
+func (p *indexPager) evict(tree *btree.BTree) {
+	if p.size <= p.budget {
+		return
+	}
+	var (
+		victims []*residentKey
+		size    = p.size
+		target  = p.budget - p.budget/8
+	)
+	for size > target && p.clock.Len() > 0 {
+		e := p.clock.Front()
+		rk := e.Value.(*residentKey)
+		if atomic.SwapInt32(&rk.referenced, 0) == 1 {
+			p.clock.MoveToBack(e)
+			continue
+		}
+		p.clock.Remove(e)
+		victims = append(victims, rk)
+		size -= rk.size
+	}
+
+	tx := p.b.BatchTx()
+	tx.Lock()
+	var enc checkpointEncoder
+	for _, rk := range victims {
+		if !rk.dirty {
+			continue
+		}
+		enc.revision(rk.ki.modified)
+		enc.generations(rk.ki.generations)
+		tx.UnsafePut(schema.KeyIndex, checkpointKeyName(rk.ki.key), enc.flush())
+	}
+	tx.Unlock()
+	for _, rk := range victims {
+		tree.Delete(rk.ki)
+		delete(p.resident, rk.ki)
+		p.resize(-rk.size)
+	}
+	p.paged += len(victims)
+	indexPageOutCounter.Add(float64(len(victims)))
+}
+
+func (p *indexPager) resize(delta int64) {
+	p.size += delta
+	indexResidentBytes.Add(float64(delta))
+}
+
+// keyIndexSize estimates the memory held by the resident ki.
+func keyIndexSize(ki *keyIndex) int64 {
+	size := int64(unsafe.Sizeof(keyIndex{}) + unsafe.Sizeof(residentKey{}) + unsafe.Sizeof(list.Element{}))
+	size += int64(cap(ki.key)) + int64(cap(ki.generations))*int64(unsafe.Sizeof(generation{}))
+	for _, g := range ki.generations {
+		size += int64(cap(g.revs)) * int64(unsafe.Sizeof(revision{}))
+	}
+	return size
+}
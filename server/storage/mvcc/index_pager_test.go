@@ -0,0 +1,156 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"go.etcd.io/etcd/pkg/v3/traceutil"
+	"go.etcd.io/etcd/server/v3/lease"
+	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestPagedIndexMatchesTreeIndex(t *testing.T) {
+	// scan the pages in chunks smaller than the ranges
+	defer func(n int64) { indexPageScanKeys = n }(indexPageScanKeys)
+	indexPageScanKeys = 4
+	lg := zaptest.NewLogger(t)
+	budget := int64(1024)
+	pager, err := newIndexPager(lg, filepath.Join(t.TempDir(), "keyindex"), budget)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pi := newPagedTreeIndex(lg, pager).(*treeIndex)
+	defer pi.close()
+	ti := newTreeIndex(lg)
+
+	r := rand.New(rand.NewSource(1))
+	key := func() []byte { return []byte(fmt.Sprintf("foo%02d", r.Intn(64))) }
+	check := func(rev, compactRev int64) {
+		for atRev := compactRev; atRev <= rev; atRev++ {
+			wkeys, wrevs := ti.Range([]byte("foo"), []byte("fop"), atRev)
+			keys, revs := pi.Range([]byte("foo"), []byte("fop"), atRev)
+			if !reflect.DeepEqual(keys, wkeys) || !reflect.DeepEqual(revs, wrevs) {
+				t.Fatalf("#%d: range at %d = %v %v, want %v %v", rev, atRev, keys, revs, wkeys, wrevs)
+			}
+			k := key()
+			wr, wc, wv, werr := ti.Get(k, atRev)
+			gr, gc, gv, gerr := pi.Get(k, atRev)
+			if gr != wr || gc != wc || gv != wv || gerr != werr {
+				t.Fatalf("#%d: get %q at %d = %v %v %d %v, want %v %v %d %v", rev, k, atRev, gr, gc, gv, gerr, wr, wc, wv, werr)
+			}
+		}
+	}
+
+	compactRev := int64(1)
+	for rev := int64(2); rev <= 1000; rev++ {
+		k := key()
+		if r.Intn(4) == 0 {
+			werr := ti.Tombstone(k, revision{main: rev})
+			if err := pi.Tombstone(k, revision{main: rev}); err != werr {
+				t.Fatalf("#%d: tombstone %q = %v, want %v", rev, k, err, werr)
+			}
+		} else {
+			ti.Put(k, revision{main: rev})
+			pi.Put(k, revision{main: rev})
+		}
+		if pager.size > budget {
+			t.Fatalf("#%d: resident size = %d, want <= %d", rev, pager.size, budget)
+		}
+		if rev%100 == 0 {
+			compactRev = rev - int64(r.Intn(50))
+			if !reflect.DeepEqual(pi.Keep(compactRev), ti.Keep(compactRev)) {
+				t.Fatalf("#%d: keep at %d differs", rev, compactRev)
+			}
+			if !reflect.DeepEqual(pi.Compact(compactRev), ti.Compact(compactRev)) {
+				t.Fatalf("#%d: compact at %d differs", rev, compactRev)
+			}
+			if pi.Len() != ti.Len() {
+				t.Fatalf("#%d: len = %d, want %d", rev, pi.Len(), ti.Len())
+			}
+		}
+		if rev%50 == 0 {
+			check(rev, compactRev)
+		}
+	}
+	if !pi.Equal(ti) {
+		t.Errorf("paged index differs from tree index")
+	}
+	if pager.paged == 0 {
+		t.Errorf("no key paged out")
+	}
+}
+
+func TestStoreIndexMemoryBudget(t *testing.T) {
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, b)
+	path := filepath.Join(t.TempDir(), "keyindex")
+	cfg := StoreConfig{IndexMemoryBudget: 512, IndexPagesPath: path}
+
+	s := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, cfg)
+	for i := 0; i < 200; i++ {
+		s.Put([]byte(fmt.Sprintf("foo%02d", i%40)), []byte(fmt.Sprintf("bar%d", i)), lease.NoLease)
+		if i%7 == 0 {
+			s.DeleteRange([]byte(fmt.Sprintf("foo%02d", i%40)), nil)
+		}
+	}
+	rev := s.Rev()
+	ch, err := s.Compact(traceutil.TODO(), rev/2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-ch
+	s.Commit()
+
+	want := make(map[int64]*RangeResult)
+	for atRev := rev / 2; atRev <= rev; atRev++ {
+		r, err := s.Range(context.TODO(), []byte("foo"), []byte("fop"), RangeOptions{Rev: atRev})
+		if err != nil {
+			t.Fatal(err)
+		}
+		want[atRev] = r
+	}
+	if s.kvindex.(*treeIndex).pager.paged == 0 {
+		t.Errorf("no key paged out")
+	}
+	s.Close()
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("pages not removed on close, stat error %v", err)
+	}
+
+	// the restored index is paged again, while reading back the same
+	// revisions.
+	s = NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, cfg)
+	defer s.Close()
+	for atRev, w := range want {
+		r, err := s.Range(context.TODO(), []byte("foo"), []byte("fop"), RangeOptions{Rev: atRev})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(r, w) {
+			t.Errorf("range at %d = %+v, want %+v", atRev, r, w)
+		}
+	}
+	if p := s.kvindex.(*treeIndex).pager; p.size > cfg.IndexMemoryBudget {
+		t.Errorf("resident size = %d, want <= %d", p.size, cfg.IndexMemoryBudget)
+	}
+}
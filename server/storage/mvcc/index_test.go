@@ -226,6 +226,9 @@ func TestIndexRevision(t *testing.T) {
 		if !reflect.DeepEqual(revs, tt.wrevs) {
 			t.Errorf("#%d limit %d: revs = %+v, want %+v", i, tt.limit, revs, tt.wrevs)
 		}
+		if revs = ti.LimitedRevisions(tt.key, tt.end, tt.atRev, tt.limit); !reflect.DeepEqual(revs, tt.wrevs) {
+			t.Errorf("#%d limit %d: limited revs = %+v, want %+v", i, tt.limit, revs, tt.wrevs)
+		}
 		count := ti.CountRevisions(tt.key, tt.end, tt.atRev)
 		if count != tt.wcounts {
 			t.Errorf("#%d: count = %d, want %v", i, count, tt.wcounts)
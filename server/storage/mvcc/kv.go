@@ -37,6 +37,14 @@ type RangeOptions struct {
 	Limit int64
 	Rev   int64
 	Count bool
+	// SkipCount stops reading the index at Limit instead of counting the
+	// whole range, so Count of the result is the number of returned pairs.
+	SkipCount bool
+	// Filter, if not nil, drops the key-value pairs for which it returns false.
+	// Limit and Count are applied to the pairs that pass the filter. With a
+	// Limit, reading stops at the Limit-th matching pair, so Count is capped
+	// at Limit.
+	Filter func(kv *mvccpb.KeyValue) bool
 }
 
 type RangeResult struct {
@@ -132,6 +140,20 @@ type KV interface {
 	// HashStorage returns HashStorage interface for KV storage.
 	HashStorage() HashStorage
 
+	// PrefixUsage returns the storage used under a quota prefix, or false
+	// if the usage of the prefix is not tracked.
+	PrefixUsage(prefix []byte) (PrefixUsage, bool)
+
+	// KeyUsage returns the storage used by a live key under a quota prefix,
+	// or false if the key does not exist or is not under a quota prefix.
+	KeyUsage(key []byte) (int64, bool)
+
+	// CheckpointIndex persists a checkpoint of the key index if enabled.
+	CheckpointIndex()
+
+	// Stats returns the statistics of the history held by the store.
+	Stats() Stats
+
 	// Compact frees all superseded keys with revisions less than rev.
 	Compact(trace *traceutil.Trace, rev int64) (<-chan struct{}, error)
 
@@ -255,6 +255,45 @@ func testKVRangeLimit(t *testing.T, f rangeFunc) {
 	}
 }
 
+func TestKVRangeFilter(t *testing.T)    { testKVRangeFilter(t, normalRangeFunc) }
+func TestKVTxnRangeFilter(t *testing.T) { testKVRangeFilter(t, txnRangeFunc) }
+
+func testKVRangeFilter(t *testing.T, f rangeFunc) {
+	b, tmpPath := betesting.NewDefaultTmpBackend(t)
+	s := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+	defer cleanup(s, b, tmpPath)
+
+	kvs := put3TestKVs(s)
+	notFoo := func(kv *mvccpb.KeyValue) bool { return string(kv.Value) != "bar" }
+
+	wrev := int64(4)
+	tests := []struct {
+		ro      RangeOptions
+		wcounts int
+		wkvs    []mvccpb.KeyValue
+	}{
+		{RangeOptions{Filter: notFoo}, 2, kvs[1:]},
+		{RangeOptions{Filter: notFoo, Limit: 1}, 1, kvs[1:2]},
+		{RangeOptions{Filter: notFoo, Count: true}, 2, nil},
+		{RangeOptions{Filter: func(*mvccpb.KeyValue) bool { return false }}, 0, nil},
+	}
+	for i, tt := range tests {
+		r, err := f(s, []byte("foo"), []byte("foo3"), tt.ro)
+		if err != nil {
+			t.Fatalf("#%d: range error (%v)", i, err)
+		}
+		if !reflect.DeepEqual(r.KVs, tt.wkvs) {
+			t.Errorf("#%d: kvs = %+v, want %+v", i, r.KVs, tt.wkvs)
+		}
+		if r.Rev != wrev {
+			t.Errorf("#%d: rev = %d, want %d", i, r.Rev, wrev)
+		}
+		if r.Count != tt.wcounts {
+			t.Errorf("#%d: count = %d, want %d", i, r.Count, tt.wcounts)
+		}
+	}
+}
+
 func TestKVPutMultipleTimes(t *testing.T)    { testKVPutMultipleTimes(t, normalPutFunc) }
 func TestKVTxnPutMultipleTimes(t *testing.T) { testKVPutMultipleTimes(t, txnPutFunc) }
 
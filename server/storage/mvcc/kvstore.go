@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"math"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.etcd.io/etcd/api/v3/mvccpb"
@@ -53,6 +54,18 @@ var minimumBatchInterval = 10 * time.Millisecond
 type StoreConfig struct {
 	CompactionBatchLimit    int
 	CompactionSleepInterval time.Duration
+	// QuotaPrefixes are the key prefixes whose usage is tracked.
+	QuotaPrefixes [][]byte
+	// CompressionPrefixes are the key prefixes whose values are compressed.
+	CompressionPrefixes [][]byte
+	// IndexCheckpoint enables persisting checkpoints of the key index, from
+	// which the store is restored without reading the whole key bucket.
+	IndexCheckpoint bool
+	// IndexMemoryBudget, if positive, is the memory in bytes the keys of the
+	// key index may hold before the least recently used ones are paged out
+	// to a backend at IndexPagesPath.
+	IndexMemoryBudget int64
+	IndexPagesPath    string
 }
 
 type store struct {
@@ -77,6 +90,24 @@ type store struct {
 	currentRev int64
 	// compactMainRev is the main revision of the last compaction.
 	compactMainRev int64
+	// revisions is the number of revisions held in the key bucket.
+	revisions int64
+
+	// usageMu protects usage and usageSizes.
+	usageMu sync.RWMutex
+	// usage is the storage used under each of cfg.QuotaPrefixes.
+	usage map[string]*PrefixUsage
+	// usageSizes is the storage used by each live key under the quota
+	// prefixes, so that the usage of a key being overwritten or deleted is
+	// known without reading it back from the backend. It costs roughly the
+	// length of the key plus 40 bytes of memory per live key under the quota
+	// prefixes, on top of the key index.
+	usageSizes map[string]int64
+
+	// compressedValues is whether the backend has been marked as holding
+	// compressed values since the store was restored. It is only accessed
+	// with the backend batch tx locked.
+	compressedValues bool
 
 	fifoSched schedule.Scheduler
 
@@ -100,9 +131,8 @@ func NewStore(lg *zap.Logger, b backend.Backend, le lease.Lessor, cfg StoreConfi
 		cfg.CompactionSleepInterval = minimumBatchInterval
 	}
 	s := &store{
-		cfg:     cfg,
-		b:       b,
-		kvindex: newTreeIndex(lg),
+		cfg: cfg,
+		b:   b,
 
 		le: le,
 
@@ -115,6 +145,7 @@ func NewStore(lg *zap.Logger, b backend.Backend, le lease.Lessor, cfg StoreConfi
 
 		lg: lg,
 	}
+	s.kvindex = s.newIndex()
 	s.hashes = newHashStorage(lg, s)
 	s.ReadView = &readView{s}
 	s.WriteView = &writeView{s}
@@ -125,6 +156,8 @@ func NewStore(lg *zap.Logger, b backend.Backend, le lease.Lessor, cfg StoreConfi
 	tx := s.b.BatchTx()
 	tx.LockOutsideApply()
 	tx.UnsafeCreateBucket(schema.Key)
+	tx.UnsafeCreateBucket(schema.Quota)
+	s.unsafeCreateKeyIndexBucket(tx)
 	schema.UnsafeCreateMetaBucket(tx)
 	tx.Unlock()
 	s.b.ForceCommit()
@@ -179,8 +212,29 @@ func (s *store) hash() (hash uint32, revision int64, err error) {
 计算rev版本对应数据的hash值
 */
 func (s *store) hashByRev(rev int64) (hash KeyValueHash, currentRev int64, err error) {
-	var compactRev int64
 	start := time.Now()
+	currentRev, err = s.readHashedRevisions(rev, func(tx backend.ReadTx, compactRev, rev int64, keep map[revision]struct{}) error {
+		hash, err = unsafeHashByRev(tx, compactRev, rev, keep)
+		hashRevSec.Observe(time.Since(start).Seconds())
+		return err
+	})
+	return hash, currentRev, err
+}
+
+// hashTreeByRev computes the HashTree of the revisions hashed by hashByRev.
+func (s *store) hashTreeByRev(rev int64) (tree *HashTree, currentRev int64, err error) {
+	currentRev, err = s.readHashedRevisions(rev, func(tx backend.ReadTx, compactRev, rev int64, keep map[revision]struct{}) error {
+		tree, err = unsafeHashTreeByRev(tx, compactRev, rev, keep)
+		return err
+	})
+	return tree, currentRev, err
+}
+
+// readHashedRevisions calls f with the backend read, at the compaction and the
+// revision rev, or the current one if rev is 0, and the revisions to keep
+// below the compaction, to hash the revisions at rev.
+func (s *store) readHashedRevisions(rev int64, f func(tx backend.ReadTx, compactRev, rev int64, keep map[revision]struct{}) error) (currentRev int64, err error) {
+	var compactRev int64
 
 	s.mu.RLock()
 	s.revMu.RLock()
@@ -189,10 +243,10 @@ func (s *store) hashByRev(rev int64) (hash KeyValueHash, currentRev int64, err e
 
 	if rev > 0 && rev <= compactRev {
 		s.mu.RUnlock()
-		return KeyValueHash{}, 0, ErrCompacted
+		return 0, ErrCompacted
 	} else if rev > 0 && rev > currentRev {
 		s.mu.RUnlock()
-		return KeyValueHash{}, currentRev, ErrFutureRev
+		return currentRev, ErrFutureRev
 	}
 	if rev == 0 {
 		rev = currentRev
@@ -203,9 +257,7 @@ func (s *store) hashByRev(rev int64) (hash KeyValueHash, currentRev int64, err e
 	tx.RLock()
 	defer tx.RUnlock()
 	s.mu.RUnlock()
-	hash, err = unsafeHashByRev(tx, compactRev, rev, keep)
-	hashRevSec.Observe(time.Since(start).Seconds())
-	return hash, currentRev, err
+	return currentRev, f(tx, compactRev, rev, keep)
 }
 
 /***
@@ -213,8 +265,6 @@ func (s *store) hashByRev(rev int64) (hash KeyValueHash, currentRev int64, err e
 */
 func (s *store) updateCompactRev(rev int64) (<-chan struct{}, int64, error) {
 	s.revMu.Lock()
-	// TODO simfg pr 将unlock操作提取
-	defer s.revMu.Unlock()
 	if rev <= s.compactMainRev {
 		/***
 		TODO simfg 为什么这部分最后结果报错了，还需要执行这样的逻辑
@@ -314,7 +364,8 @@ func (s *store) Restore(b backend.Backend) error {
 	s.fifoSched.Stop()
 
 	s.b = b
-	s.kvindex = newTreeIndex(s.lg)
+	s.closeIndex()
+	s.kvindex = s.newIndex()
 
 	{
 		// During restore the metrics might report 'special' values
@@ -323,10 +374,18 @@ func (s *store) Restore(b backend.Backend) error {
 		s.compactMainRev = -1
 		s.revMu.Unlock()
 	}
+	atomic.StoreInt64(&s.revisions, 0)
 
 	s.fifoSched = schedule.NewFIFOScheduler(s.lg)
 	s.stopc = make(chan struct{})
 
+	tx := s.b.BatchTx()
+	tx.LockOutsideApply()
+	// the snapshot may come from a member that predates prefix quotas
+	tx.UnsafeCreateBucket(schema.Quota)
+	s.unsafeCreateKeyIndexBucket(tx)
+	tx.Unlock()
+
 	return s.restore()
 }
 
@@ -346,6 +405,7 @@ func (s *store) restore() error {
 	revToBytes(revision{main: math.MaxInt64, sub: math.MaxInt64}, max)
 
 	keyToLease := make(map[string]lease.LeaseID)
+	keyToUsage := make(map[string]int64)
 
 	// restore index
 	tx := s.b.ReadTx()
@@ -364,8 +424,38 @@ func (s *store) restore() error {
 		s.revMu.Unlock()
 	}
 	scheduledCompact, _ := UnsafeReadScheduledCompact(tx)
+
+	// load the index checkpoint, if any, and only replay the revisions
+	// after it.
+	var cp *indexCheckpoint
+	if s.cfg.IndexCheckpoint {
+		var err error
+		if cp, err = s.unsafeLoadIndexCheckpoint(tx); err != nil {
+			s.lg.Warn("failed to load key index checkpoint; rebuilding key index", zap.Error(err))
+			cp = nil
+		}
+	}
+	// the backend may have been replaced, so mark it again on the next
+	// compressed value.
+	s.compressedValues = false
 	// index keys concurrently as they're loaded in from tx
 	keysGauge.Set(0)
+	if cp != nil {
+		restoreCheckpoint(s.kvindex, cp)
+		keyToLease, keyToUsage = cp.keyToLease, cp.keyToUsage
+		atomic.StoreInt64(&s.revisions, cp.revisions)
+		revToBytes(revision{main: cp.rev + 1}, min)
+		s.lg.Info(
+			"loaded key index checkpoint",
+			zap.Int64("checkpoint-revision", cp.rev),
+			zap.Int("keys", s.kvindex.Len()),
+		)
+	}
+	if ti, ok := s.kvindex.(*treeIndex); ok && cp != nil {
+		// the checkpoint matches the index restored from it, so the next
+		// one only writes the keys changed since.
+		ti.trackDirty()
+	}
 	rkvc, revc := restoreIntoIndex(s.lg, s.kvindex)
 	for {
 		keys, vals := tx.UnsafeRange(schema.Key, min, max, int64(restoreChunkKeys))
@@ -374,7 +464,8 @@ func (s *store) restore() error {
 		}
 		// rkvc blocks if the total pending keys exceeds the restore
 		// chunk size to keep keys from consuming too much memory.
-		restoreChunk(s.lg, rkvc, keys, vals, keyToLease)
+		restoreChunk(s.lg, rkvc, keys, vals, keyToLease, keyToUsage, s.tracksUsage)
+		atomic.AddInt64(&s.revisions, int64(len(keys)))
 		if len(keys) < restoreChunkKeys {
 			// partial set implies final set
 			break
@@ -389,6 +480,9 @@ func (s *store) restore() error {
 	{
 		s.revMu.Lock()
 		s.currentRev = <-revc
+		if cp != nil && s.currentRev < cp.rev {
+			s.currentRev = cp.rev
+		}
 
 		// keys in the range [compacted revision -N, compaction] might all be deleted due to compaction.
 		// the correct revision should be set to compaction revision in the case, not the largest revision
@@ -418,8 +512,13 @@ func (s *store) restore() error {
 		}
 	}
 
+	s.restoreUsage(tx, keyToUsage)
+
 	tx.Unlock()
 
+	s.saveUsage()
+	s.verifyUsage()
+
 	s.lg.Info("kvstore restored", zap.Int64("current-rev", s.currentRev))
 
 	if scheduledCompact != 0 {
@@ -462,6 +561,14 @@ func restoreIntoIndex(lg *zap.Logger, idx index) (chan<- revKeyValue, <-chan int
 	go func() {
 		currentRev := int64(1)
 		defer func() { revc <- currentRev }()
+		if ti, ok := idx.(*treeIndex); ok && (ti.pager != nil || ti.dirty != nil) {
+			for rkv := range rkvc {
+				rev := bytesToRev(rkv.key)
+				currentRev = rev.main
+				ti.restoreRevision(rkv.kv.Key, rev, revision{rkv.kv.CreateRevision, 0}, rkv.kv.Version, isTombstone(rkv.key))
+			}
+			return
+		}
 		// restore the tree index from streaming the unordered index.
 		kiCache := make(map[string]*keyIndex, restoreChunkKeys)
 		for rkv := range rkvc {
@@ -507,11 +614,15 @@ func restoreIntoIndex(lg *zap.Logger, idx index) (chan<- revKeyValue, <-chan int
 /*** 将数据发送到kvc的channel中
 其中keys表示revision数组，vals包含了用户输入的key和value信息
 keyToLease是一个map，其中key是用户输入的key，就是键值对中的key
+keyToUsage collects the usage of the live keys for which tracksUsage is true.
 */
-func restoreChunk(lg *zap.Logger, kvc chan<- revKeyValue, keys, vals [][]byte, keyToLease map[string]lease.LeaseID) {
+func restoreChunk(lg *zap.Logger, kvc chan<- revKeyValue, keys, vals [][]byte, keyToLease map[string]lease.LeaseID, keyToUsage map[string]int64, tracksUsage func([]byte) bool) {
 	for i, key := range keys {
 		rkv := revKeyValue{key: key}
-		if err := rkv.kv.Unmarshal(vals[i]); err != nil {
+		// the value is not needed to restore the index, so it is only
+		// decompressed to account the usage by its uncompressed size.
+		compressed, err := unmarshalKeyValue(vals[i], &rkv.kv)
+		if err != nil {
 			lg.Fatal("failed to unmarshal mvccpb.KeyValue", zap.Error(err))
 		}
 		rkv.kstr = string(rkv.kv.Key)
@@ -522,6 +633,21 @@ func restoreChunk(lg *zap.Logger, kvc chan<- revKeyValue, keys, vals [][]byte, k
 		} else {
 			delete(keyToLease, rkv.kstr)
 		}
+		if tracksUsage(rkv.kv.Key) {
+			if isTombstone(key) {
+				delete(keyToUsage, rkv.kstr)
+			} else {
+				size := kvUsageBytes(&rkv.kv)
+				if compressed {
+					v, err := decompressValue(rkv.kv.Value)
+					if err != nil {
+						lg.Fatal("failed to decompress mvccpb.KeyValue value", zap.Error(err))
+					}
+					size = int64(len(rkv.kv.Key) + len(v))
+				}
+				keyToUsage[rkv.kstr] = size
+			}
+		}
 		kvc <- rkv
 	}
 }
@@ -529,9 +655,32 @@ func restoreChunk(lg *zap.Logger, kvc chan<- revKeyValue, keys, vals [][]byte, k
 func (s *store) Close() error {
 	close(s.stopc)
 	s.fifoSched.Stop()
+	// checkpoint the index on shutdown, so that the next restore replays as
+	// few revisions as possible.
+	s.CheckpointIndex()
+	s.closeIndex()
 	return nil
 }
 
+// newIndex returns an empty key index, paged if the memory of the index is
+// bounded by StoreConfig.IndexMemoryBudget.
+func (s *store) newIndex() index {
+	if s.cfg.IndexMemoryBudget <= 0 {
+		return newTreeIndex(s.lg)
+	}
+	pager, err := newIndexPager(s.lg, s.cfg.IndexPagesPath, s.cfg.IndexMemoryBudget)
+	if err != nil {
+		s.lg.Fatal("failed to open key index pages", zap.String("path", s.cfg.IndexPagesPath), zap.Error(err))
+	}
+	return newPagedTreeIndex(s.lg, pager)
+}
+
+func (s *store) closeIndex() {
+	if ti, ok := s.kvindex.(*treeIndex); ok {
+		ti.close()
+	}
+}
+
 /***
 监控函数初始化
 */
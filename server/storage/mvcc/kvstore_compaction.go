@@ -17,6 +17,7 @@ package mvcc
 import (
 	"encoding/binary"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"go.etcd.io/etcd/server/v3/storage/schema"
@@ -59,6 +60,7 @@ func (s *store) scheduleCompaction(compactMainRev, prevCompactRev int64) (KeyVal
 			rev = bytesToRev(keys[i])
 			if _, ok := keep[rev]; !ok {
 				tx.UnsafeDelete(schema.Key, keys[i])
+				atomic.AddInt64(&s.revisions, -1)
 				keyCompactions++
 			}
 			h.WriteKeyValue(keys[i], values[i])
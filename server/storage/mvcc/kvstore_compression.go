@@ -0,0 +1,151 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"bytes"
+	"compress/flate"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+)
+
+const (
+	// markCompressed prefixes the key-value pairs whose value is compressed
+	// in the key bucket. It is followed by the compression of the value and
+	// the marshaled mvccpb.KeyValue. A marshaled mvccpb.KeyValue starts with
+	// a field tag, which is never 0xfe, so that the pairs written without
+	// compression are read back as is.
+	markCompressed byte = 0xfe
+	// compressionDeflate compresses values with raw deflate.
+	compressionDeflate byte = 1
+
+	// minCompressValueSize is the size of the smallest value compressed,
+	// since smaller values rarely shrink.
+	minCompressValueSize = 256
+)
+
+var errCorruptCompressedValue = errors.New("mvcc: corrupt compressed value")
+
+var (
+	deflateWriterPool = sync.Pool{New: func() interface{} {
+		// values are compressed while applying, so favour speed.
+		w, _ := flate.NewWriter(nil, flate.BestSpeed)
+		return w
+	}}
+	deflateReaderPool = sync.Pool{New: func() interface{} {
+		return flate.NewReader(nil)
+	}}
+)
+
+// compresses reports whether the values of key are compressed.
+func (s *store) compresses(key []byte) bool {
+	for _, p := range s.cfg.CompressionPrefixes {
+		if bytes.HasPrefix(key, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// compressValue returns the compressed value, or false if the value is too
+// small to be compressed or does not shrink.
+func compressValue(value []byte) ([]byte, bool) {
+	if len(value) < minCompressValueSize {
+		return nil, false
+	}
+	var buf bytes.Buffer
+	w := deflateWriterPool.Get().(*flate.Writer)
+	defer deflateWriterPool.Put(w)
+	w.Reset(&buf)
+	if _, err := w.Write(value); err != nil {
+		return nil, false
+	}
+	if err := w.Close(); err != nil {
+		return nil, false
+	}
+	if buf.Len() >= len(value) {
+		return nil, false
+	}
+	return buf.Bytes(), true
+}
+
+func decompressValue(value []byte) ([]byte, error) {
+	r := deflateReaderPool.Get().(io.ReadCloser)
+	defer deflateReaderPool.Put(r)
+	if err := r.(flate.Resetter).Reset(bytes.NewReader(value), nil); err != nil {
+		return nil, err
+	}
+	out, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w (%v)", errCorruptCompressedValue, err)
+	}
+	return out, nil
+}
+
+// marshalKeyValue marshals a key-value pair for the key bucket, marking it
+// if its value is compressed.
+func marshalKeyValue(kv *mvccpb.KeyValue, compressed bool) ([]byte, error) {
+	if !compressed {
+		return kv.Marshal()
+	}
+	d := make([]byte, 2+kv.Size())
+	d[0], d[1] = markCompressed, compressionDeflate
+	if _, err := kv.MarshalTo(d[2:]); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// unmarshalKeyValue unmarshals a key-value pair read from the key bucket
+// without decompressing its value, and reports whether the value is
+// compressed.
+func unmarshalKeyValue(data []byte, kv *mvccpb.KeyValue) (compressed bool, err error) {
+	if len(data) == 0 || data[0] != markCompressed {
+		return false, kv.Unmarshal(data)
+	}
+	if len(data) < 2 || data[1] != compressionDeflate {
+		return false, errCorruptCompressedValue
+	}
+	return true, kv.Unmarshal(data[2:])
+}
+
+// decodeKeyValue unmarshals a key-value pair read from the key bucket and
+// decompresses its value.
+func decodeKeyValue(data []byte, kv *mvccpb.KeyValue) error {
+	compressed, err := unmarshalKeyValue(data, kv)
+	if err != nil || !compressed {
+		return err
+	}
+	kv.Value, err = decompressValue(kv.Value)
+	return err
+}
+
+// uncompressedKeyValue returns the key-value pair read from the key bucket
+// as it is stored without compression, so that members compressing
+// different prefixes hash their key-value pairs alike.
+func uncompressedKeyValue(data []byte) ([]byte, error) {
+	if len(data) == 0 || data[0] != markCompressed {
+		return data, nil
+	}
+	var kv mvccpb.KeyValue
+	if err := decodeKeyValue(data, &kv); err != nil {
+		return nil, err
+	}
+	return kv.Marshal()
+}
@@ -0,0 +1,136 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"go.etcd.io/etcd/pkg/v3/traceutil"
+	"go.etcd.io/etcd/server/v3/lease"
+	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestStoreValueCompression(t *testing.T) {
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, b)
+	cfg := StoreConfig{
+		QuotaPrefixes:       [][]byte{[]byte("a"), []byte("z/")},
+		CompressionPrefixes: [][]byte{[]byte("z/")},
+	}
+	s := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, cfg)
+
+	big := bytes.Repeat([]byte(`{"field":"value"}`), 64)
+	s.Put([]byte("a"), big, lease.NoLease)
+	s.Put([]byte("z/big"), big, lease.NoLease)
+	s.Put([]byte("z/small"), []byte("small"), lease.NoLease)
+
+	checkRange := func(step string) {
+		t.Helper()
+		for key, want := range map[string][]byte{"a": big, "z/big": big, "z/small": []byte("small")} {
+			r, err := s.Range(context.TODO(), []byte(key), nil, RangeOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(r.KVs) != 1 || !bytes.Equal(r.KVs[0].Value, want) {
+				t.Errorf("%s: range %q returned an unexpected value", step, key)
+			}
+		}
+	}
+	checkRange("put")
+
+	// the usage of compressed values is their uncompressed size
+	if size, _ := s.KeyUsage([]byte("z/big")); size != int64(len("z/big")+len(big)) {
+		t.Errorf("usage of %q = %d, want %d", "z/big", size, len("z/big")+len(big))
+	}
+	if size, _ := s.KeyUsage([]byte("a")); size != int64(1+len(big)) {
+		t.Errorf("usage of %q = %d, want %d", "a", size, 1+len(big))
+	}
+
+	tx := b.BatchTx()
+	tx.Lock()
+	compressed := schema.UnsafeReadCompressedValues(tx)
+	tx.Unlock()
+	if !compressed {
+		t.Errorf("backend is not marked as holding compressed values")
+	}
+
+	// the hash does not depend on which values are compressed
+	s.Commit()
+	h, _, err := s.HashStorage().HashByRev(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pb, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, pb)
+	ps := NewStore(zaptest.NewLogger(t), pb, &lease.FakeLessor{}, StoreConfig{})
+	defer ps.Close()
+	ps.Put([]byte("a"), big, lease.NoLease)
+	ps.Put([]byte("z/big"), big, lease.NoLease)
+	ps.Put([]byte("z/small"), []byte("small"), lease.NoLease)
+	ps.Commit()
+	ph, _, err := ps.HashStorage().HashByRev(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.Hash != ph.Hash {
+		t.Errorf("hash = %d, want %d as without compression", h.Hash, ph.Hash)
+	}
+
+	// compressed values are still read once compression is disabled
+	s.Close()
+	cfg.CompressionPrefixes = nil
+	s = NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, cfg)
+	defer s.Close()
+	checkRange("recreate")
+	if got, _ := s.KeyUsage([]byte("z/big")); got != int64(len("z/big")+len(big)) {
+		t.Errorf("usage of %q after recreate = %d, want %d", "z/big", got, len("z/big")+len(big))
+	}
+}
+
+func TestWatchableStoreValueCompression(t *testing.T) {
+	b, tmpPath := betesting.NewDefaultTmpBackend(t)
+	s := newWatchableStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{CompressionPrefixes: [][]byte{[]byte("")}})
+	defer cleanup(s, b, tmpPath)
+
+	big := bytes.Repeat([]byte("value"), 100)
+	s.Put([]byte("foo"), big, lease.NoLease)
+	txn := s.Write(traceutil.TODO())
+	txn.Put([]byte("foo"), big, lease.NoLease)
+	txn.End()
+
+	// an unsynced watcher reads the events back from the backend
+	w := s.NewWatchStream()
+	defer w.Close()
+	w.Watch(0, []byte("foo"), nil, 1)
+	var got int
+	for got < 2 {
+		select {
+		case resp := <-w.Chan():
+			for _, ev := range resp.Events {
+				if !bytes.Equal(ev.Kv.Value, big) {
+					t.Fatalf("event value = %q, want %q", ev.Kv.Value, big)
+				}
+				got++
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for events, got %d", got)
+		}
+	}
+}
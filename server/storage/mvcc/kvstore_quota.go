@@ -0,0 +1,212 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/client/pkg/v3/verify"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.uber.org/zap"
+)
+
+// PrefixUsage is the storage used by the live keys under a key prefix.
+type PrefixUsage struct {
+	// Bytes is the total size of the keys and values under the prefix.
+	Bytes int64
+	// Keys is the number of keys under the prefix.
+	Keys int64
+}
+
+// PrefixUsage returns the storage used under the given prefix. It returns
+// false if the usage of the prefix is not tracked.
+func (s *store) PrefixUsage(prefix []byte) (PrefixUsage, bool) {
+	s.usageMu.RLock()
+	defer s.usageMu.RUnlock()
+	u, ok := s.usage[string(prefix)]
+	if !ok {
+		return PrefixUsage{}, false
+	}
+	return *u, true
+}
+
+// KeyUsage returns the storage used by the given key. It returns false if
+// the key does not exist or its usage is not tracked.
+func (s *store) KeyUsage(key []byte) (int64, bool) {
+	s.usageMu.RLock()
+	defer s.usageMu.RUnlock()
+	size, ok := s.usageSizes[string(key)]
+	return size, ok
+}
+
+func kvUsageBytes(kv *mvccpb.KeyValue) int64 { return int64(len(kv.Key) + len(kv.Value)) }
+
+// tracksUsage reports whether key is under any of the quota prefixes.
+func (s *store) tracksUsage(key []byte) bool {
+	for _, p := range s.cfg.QuotaPrefixes {
+		if bytes.HasPrefix(key, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// restoreUsage loads the persisted usage of the quota prefixes. Only the
+// prefixes that have not been tracked before are counted from sizes, the
+// usage of the live keys under the quota prefixes. tx must be locked.
+func (s *store) restoreUsage(tx backend.ReadTx, sizes map[string]int64) {
+	persisted := UnsafeReadPrefixUsages(s.lg, tx)
+	usage := make(map[string]*PrefixUsage, len(s.cfg.QuotaPrefixes))
+	for _, p := range s.cfg.QuotaPrefixes {
+		if u, ok := persisted[string(p)]; ok {
+			usage[string(p)] = &u
+			continue
+		}
+		counted := countUsage(sizes, p)
+		usage[string(p)] = counted
+		s.lg.Info(
+			"counted storage usage of quota prefix",
+			zap.String("prefix", string(p)),
+			zap.Int64("bytes", counted.Bytes),
+			zap.Int64("keys", counted.Keys),
+		)
+	}
+	s.usageMu.Lock()
+	s.usage, s.usageSizes = usage, sizes
+	s.usageMu.Unlock()
+}
+
+// verifyUsage checks that the usage of each quota prefix is the usage of the
+// live keys under it, if verification is enabled.
+func (s *store) verifyUsage() {
+	verify.Verify(func() {
+		s.usageMu.RLock()
+		defer s.usageMu.RUnlock()
+		for p, u := range s.usage {
+			if counted := countUsage(s.usageSizes, []byte(p)); *u != *counted {
+				panic(fmt.Sprintf("storage usage of quota prefix %q (%+v) isn't equal to the usage of its keys (%+v)", p, *u, *counted))
+			}
+		}
+	})
+}
+
+func countUsage(sizes map[string]int64, prefix []byte) *PrefixUsage {
+	u := &PrefixUsage{}
+	for key, size := range sizes {
+		if strings.HasPrefix(key, string(prefix)) {
+			u.Bytes += size
+			u.Keys++
+		}
+	}
+	return u
+}
+
+// saveUsage persists the usage of the quota prefixes and drops the usage
+// of the prefixes that are no longer configured, so that it is recounted
+// if they are configured again.
+func (s *store) saveUsage() {
+	tx := s.b.BatchTx()
+	tx.LockOutsideApply()
+	defer tx.Unlock()
+	s.usageMu.RLock()
+	defer s.usageMu.RUnlock()
+	for p := range UnsafeReadPrefixUsages(s.lg, tx) {
+		if _, ok := s.usage[p]; !ok {
+			UnsafeDeletePrefixUsage(tx, []byte(p))
+		}
+	}
+	s.unsafeSaveUsage(tx)
+}
+
+// unsafeSaveUsage persists the usage of the quota prefixes. s.usageMu
+// must be held.
+func (s *store) unsafeSaveUsage(tx backend.BatchTx) {
+	for p, u := range s.usage {
+		UnsafeSetPrefixUsage(tx, []byte(p), *u)
+	}
+}
+
+// updateUsage sets the usage of key to size, or removes it if size is
+// negative, and adds the change to the usage of each quota prefix of key.
+// The changes are applied to the store when the txn ends.
+func (tw *storeTxnWrite) updateUsage(key []byte, size int64) {
+	prev, exists := tw.keyUsage(key)
+	dbytes, dkeys := size-prev, int64(0)
+	switch {
+	case size < 0 && !exists:
+		return
+	case size < 0:
+		dbytes, dkeys = -prev, -1
+	case !exists:
+		dkeys = 1
+	}
+	if tw.usageSizes == nil {
+		tw.usageSizes = make(map[string]int64)
+	}
+	tw.usageSizes[string(key)] = size
+
+	for _, p := range tw.s.cfg.QuotaPrefixes {
+		if !bytes.HasPrefix(key, p) {
+			continue
+		}
+		if tw.usageDeltas == nil {
+			tw.usageDeltas = make(map[string]*PrefixUsage)
+		}
+		d, ok := tw.usageDeltas[string(p)]
+		if !ok {
+			d = &PrefixUsage{}
+			tw.usageDeltas[string(p)] = d
+		}
+		d.Bytes += dbytes
+		d.Keys += dkeys
+	}
+}
+
+// keyUsage returns the usage of key as seen by the txn.
+func (tw *storeTxnWrite) keyUsage(key []byte) (int64, bool) {
+	if size, ok := tw.usageSizes[string(key)]; ok {
+		if size < 0 {
+			return 0, false
+		}
+		return size, true
+	}
+	return tw.s.KeyUsage(key)
+}
+
+// applyUsage applies the usage changes of the txn to the store and
+// persists the usage in the txn's batch tx.
+func (tw *storeTxnWrite) applyUsage() {
+	if len(tw.usageSizes) == 0 {
+		return
+	}
+	tw.s.usageMu.Lock()
+	defer tw.s.usageMu.Unlock()
+	for key, size := range tw.usageSizes {
+		if size < 0 {
+			delete(tw.s.usageSizes, key)
+		} else {
+			tw.s.usageSizes[key] = size
+		}
+	}
+	for p, d := range tw.usageDeltas {
+		u := tw.s.usage[p]
+		u.Bytes += d.Bytes
+		u.Keys += d.Keys
+	}
+	tw.s.unsafeSaveUsage(tw.tx)
+}
@@ -0,0 +1,105 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"testing"
+
+	"go.etcd.io/etcd/client/pkg/v3/verify"
+	"go.etcd.io/etcd/pkg/v3/traceutil"
+	"go.etcd.io/etcd/server/v3/lease"
+	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestStorePrefixUsage(t *testing.T) {
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, b)
+	cfg := StoreConfig{QuotaPrefixes: [][]byte{[]byte("a/"), []byte("a/b/")}}
+	s := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, cfg)
+
+	checkUsage := func(step string, prefix string, wbytes, wkeys int64) {
+		t.Helper()
+		u, ok := s.PrefixUsage([]byte(prefix))
+		if !ok {
+			t.Fatalf("%s: usage of %q is not tracked", step, prefix)
+		}
+		if u.Bytes != wbytes || u.Keys != wkeys {
+			t.Errorf("%s: usage of %q = %+v, want {Bytes:%d Keys:%d}", step, prefix, u, wbytes, wkeys)
+		}
+	}
+
+	s.Put([]byte("a/x"), []byte("123"), lease.NoLease)
+	s.Put([]byte("a/b/y"), []byte("4567"), lease.NoLease)
+	s.Put([]byte("c"), []byte("untracked"), lease.NoLease)
+	checkUsage("put", "a/", 15, 2)
+	checkUsage("put", "a/b/", 9, 1)
+
+	s.Put([]byte("a/x"), []byte("1"), lease.NoLease)
+	checkUsage("overwrite", "a/", 13, 2)
+	checkUsage("overwrite", "a/b/", 9, 1)
+
+	txn := s.Write(traceutil.TODO())
+	txn.Put([]byte("a/b/z"), []byte("89"), lease.NoLease)
+	txn.DeleteRange([]byte("a/x"), nil)
+	// the usage only changes when the txn ends
+	checkUsage("txn pending", "a/", 13, 2)
+	txn.End()
+	checkUsage("txn", "a/", 16, 2)
+	checkUsage("txn", "a/b/", 16, 2)
+
+	if _, ok := s.PrefixUsage([]byte("c")); ok {
+		t.Errorf("usage of %q is tracked, want untracked", "c")
+	}
+
+	// the usage is persisted and loaded on recreate
+	s.Close()
+	s = NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, cfg)
+	checkUsage("recreate", "a/", 16, 2)
+	checkUsage("recreate", "a/b/", 16, 2)
+	if size, ok := s.KeyUsage([]byte("a/b/z")); !ok || size != 7 {
+		t.Errorf("usage of %q = %d, %v, want 7, true", "a/b/z", size, ok)
+	}
+	if _, ok := s.KeyUsage([]byte("a/x")); ok {
+		t.Errorf("usage of deleted %q is tracked", "a/x")
+	}
+
+	// a newly configured prefix is counted from the existing keys
+	s.Close()
+	cfg.QuotaPrefixes = append(cfg.QuotaPrefixes, []byte("c"))
+	s = NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, cfg)
+	checkUsage("reconfigure", "a/", 16, 2)
+	checkUsage("reconfigure", "c", 10, 1)
+
+	// the persisted usage is loaded as is, without recounting it
+	s.Close()
+	tx := b.BatchTx()
+	tx.Lock()
+	UnsafeSetPrefixUsage(tx, []byte("a/"), PrefixUsage{Bytes: 1000, Keys: 100})
+	tx.Unlock()
+	b.ForceCommit()
+	s = NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, cfg)
+	checkUsage("persisted", "a/", 1000, 100)
+	s.Close()
+
+	// and only checked against the usage of the keys by the verification
+	defer verify.EnableVerifications(verify.ENV_VERIFY_VALUE_ASSERT)()
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected a wrong persisted usage to fail the verification")
+		}
+	}()
+	NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, cfg)
+}
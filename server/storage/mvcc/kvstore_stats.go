@@ -0,0 +1,48 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import "sync/atomic"
+
+// Stats describes the history held by the store.
+type Stats struct {
+	// Rev is the current revision of the store.
+	Rev int64
+	// CompactRev is the revision of the last compaction, or -1 if the
+	// store has never been compacted.
+	CompactRev int64
+	// Keys is the number of keys the store holds revisions of, including
+	// the deleted keys whose revisions are not compacted yet.
+	Keys int64
+	// Revisions is the number of revisions of all the keys the store holds.
+	Revisions int64
+	// SizeInUse is the size in bytes of the backend that is in use.
+	SizeInUse int64
+}
+
+func (s *store) Stats() Stats {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s.revMu.RLock()
+	rev, compactRev := s.currentRev, s.compactMainRev
+	s.revMu.RUnlock()
+	return Stats{
+		Rev:        rev,
+		CompactRev: compactRev,
+		Keys:       int64(s.kvindex.Len()),
+		Revisions:  atomic.LoadInt64(&s.revisions),
+		SizeInUse:  s.b.SizeInUse(),
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"testing"
+
+	"go.etcd.io/etcd/pkg/v3/traceutil"
+	"go.etcd.io/etcd/server/v3/lease"
+	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestStoreStats(t *testing.T) {
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, b)
+	s := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+
+	checkStats := func(step string, wrev, wcompactRev, wkeys, wrevs int64) {
+		t.Helper()
+		st := s.Stats()
+		if st.Rev != wrev || st.CompactRev != wcompactRev || st.Keys != wkeys || st.Revisions != wrevs {
+			t.Errorf("%s: stats = %+v, want {Rev:%d CompactRev:%d Keys:%d Revisions:%d}", step, st, wrev, wcompactRev, wkeys, wrevs)
+		}
+		if st.SizeInUse <= 0 {
+			t.Errorf("%s: size in use = %d, want > 0", step, st.SizeInUse)
+		}
+	}
+
+	s.Put([]byte("a"), []byte("1"), lease.NoLease)
+	s.Put([]byte("a"), []byte("2"), lease.NoLease)
+	s.Put([]byte("b"), []byte("1"), lease.NoLease)
+	s.DeleteRange([]byte("b"), nil)
+	checkStats("put", 5, -1, 2, 4)
+
+	txn := s.Write(traceutil.TODO())
+	txn.Put([]byte("c"), []byte("1"), lease.NoLease)
+	txn.Put([]byte("c"), []byte("2"), lease.NoLease)
+	txn.End()
+	checkStats("txn", 6, -1, 3, 6)
+
+	done, err := s.Compact(traceutil.TODO(), 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-done
+	checkStats("compact", 6, 5, 2, 3)
+
+	// the revisions are counted on recreate
+	s.Close()
+	s = NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+	defer s.Close()
+	checkStats("recreate", 6, 5, 2, 3)
+}
@@ -364,6 +364,43 @@ func TestStoreCompact(t *testing.T) {
 	}
 }
 
+// TestStoreUpdateCompactRevUnlock ensures updateCompactRev releases revMu
+// exactly once on every return path.
+func TestStoreUpdateCompactRevUnlock(t *testing.T) {
+	b, tmpPath := betesting.NewDefaultTmpBackend(t)
+	s := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+	defer cleanup(s, b, tmpPath)
+
+	for i := 0; i < 3; i++ {
+		s.Put([]byte("foo"), []byte("bar"), lease.NoLease)
+	}
+	tests := []struct {
+		rev  int64
+		werr error
+	}{
+		{2, nil},
+		{2, ErrCompacted},
+		{100, ErrFutureRev},
+	}
+	for i, tt := range tests {
+		if _, _, err := s.updateCompactRev(tt.rev); err != tt.werr {
+			t.Fatalf("#%d: err = %v, want %v", i, err, tt.werr)
+		}
+		// a double unlock panics, so only check that revMu is released
+		donec := make(chan struct{})
+		go func() {
+			s.revMu.Lock()
+			s.revMu.Unlock()
+			close(donec)
+		}()
+		select {
+		case <-donec:
+		case <-time.After(time.Second):
+			t.Fatalf("#%d: revMu is still locked", i)
+		}
+	}
+}
+
 func TestStoreRestore(t *testing.T) {
 	lg := zaptest.NewLogger(t)
 	s := newFakeStore(lg)
@@ -927,6 +964,7 @@ func (b *fakeBackend) OpenReadTxN() int64
 func (b *fakeBackend) Snapshot() backend.Snapshot                                 { return nil }
 func (b *fakeBackend) ForceCommit()                                               {}
 func (b *fakeBackend) Defrag() error                                              { return nil }
+func (b *fakeBackend) OnlineDefrag() error                                        { return nil }
 func (b *fakeBackend) Close() error                                               { return nil }
 func (b *fakeBackend) SetTxPostLockInsideApplyHook(func())                        {}
 
@@ -962,6 +1000,11 @@ func (i *fakeIndex) Revisions(key, end []byte, atRev int64, limit int) ([]revisi
 	return rev, len(rev)
 }
 
+func (i *fakeIndex) LimitedRevisions(key, end []byte, atRev int64, limit int) []revision {
+	rev, _ := i.Revisions(key, end, atRev, limit)
+	return rev
+}
+
 func (i *fakeIndex) CountRevisions(key, end []byte, atRev int64) int {
 	_, rev := i.Range(key, end, atRev)
 	return len(rev)
@@ -998,6 +1041,9 @@ func (i *fakeIndex) Keep(rev int64) map[revision]struct{} {
 	return <-i.indexCompactRespc
 }
 func (i *fakeIndex) Equal(b index) bool { return false }
+func (i *fakeIndex) Len() int           { return 0 }
+
+func (i *fakeIndex) Walk(f func(ki *keyIndex) bool) {}
 
 func (i *fakeIndex) Insert(ki *keyIndex) {
 	i.Recorder.Record(testutil.Action{Name: "insert", Params: []interface{}{ki}})
@@ -16,6 +16,7 @@ package mvcc
 
 import (
 	"context"
+	"sync/atomic"
 
 	"go.etcd.io/etcd/api/v3/mvccpb"
 	"go.etcd.io/etcd/pkg/v3/traceutil"
@@ -76,12 +77,24 @@ func (tr *storeTxnRead) rangeKeys(ctx context.Context, key, end []byte, curRev i
 	if rev < tr.s.compactMainRev {
 		return &RangeResult{KVs: nil, Count: -1, Rev: 0}, ErrCompacted
 	}
+	if ro.Filter != nil {
+		return tr.rangeKeysFiltered(ctx, key, end, rev, curRev, ro)
+	}
 	if ro.Count {
 		total := tr.s.kvindex.CountRevisions(key, end, rev)
 		tr.trace.Step("count revisions from in-memory index tree")
 		return &RangeResult{KVs: nil, Count: total, Rev: curRev}, nil
 	}
-	revpairs, total := tr.s.kvindex.Revisions(key, end, rev, int(ro.Limit))
+	var (
+		revpairs []revision
+		total    int
+	)
+	if ro.SkipCount {
+		revpairs = tr.s.kvindex.LimitedRevisions(key, end, rev, int(ro.Limit))
+		total = len(revpairs)
+	} else {
+		revpairs, total = tr.s.kvindex.Revisions(key, end, rev, int(ro.Limit))
+	}
 	tr.trace.Step("range keys from in-memory index tree")
 	if len(revpairs) == 0 {
 		return &RangeResult{KVs: nil, Count: total, Rev: curRev}, nil
@@ -100,26 +113,65 @@ func (tr *storeTxnRead) rangeKeys(ctx context.Context, key, end []byte, curRev i
 			return nil, ctx.Err()
 		default:
 		}
-		revToBytes(revpair, revBytes)
-		_, vs := tr.tx.UnsafeRange(schema.Key, revBytes, nil, 0)
-		if len(vs) != 1 {
-			tr.s.lg.Fatal(
-				"range failed to find revision pair",
-				zap.Int64("revision-main", revpair.main),
-				zap.Int64("revision-sub", revpair.sub),
-			)
+		tr.readKeyValue(revpair, revBytes, &kvs[i])
+	}
+	tr.trace.Step("range keys from bolt db")
+	return &RangeResult{KVs: kvs, Count: total, Rev: curRev}, nil
+}
+
+// rangeKeysFiltered reads the key-value pairs in the range from the backend
+// and keeps the ones accepted by ro.Filter. Since the index knows nothing
+// about values, the limit can only be applied after filtering, and the count
+// is the number of pairs that pass the filter. Reading stops once ro.Limit
+// pairs have passed, so the count never exceeds the limit.
+func (tr *storeTxnRead) rangeKeysFiltered(ctx context.Context, key, end []byte, rev, curRev int64, ro RangeOptions) (*RangeResult, error) {
+	revpairs, _ := tr.s.kvindex.Revisions(key, end, rev, 0)
+	tr.trace.Step("range keys from in-memory index tree")
+
+	var kvs []mvccpb.KeyValue
+	total := 0
+	revBytes := newRevBytes()
+	for _, revpair := range revpairs {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
 		}
-		if err := kvs[i].Unmarshal(vs[0]); err != nil {
-			tr.s.lg.Fatal(
-				"failed to unmarshal mvccpb.KeyValue",
-				zap.Error(err),
-			)
+		var kv mvccpb.KeyValue
+		tr.readKeyValue(revpair, revBytes, &kv)
+		if !ro.Filter(&kv) {
+			continue
+		}
+		total++
+		if !ro.Count {
+			kvs = append(kvs, kv)
+			if ro.Limit > 0 && int64(len(kvs)) >= ro.Limit {
+				break
+			}
 		}
 	}
-	tr.trace.Step("range keys from bolt db")
+	tr.trace.Step("filter keys from bolt db")
 	return &RangeResult{KVs: kvs, Count: total, Rev: curRev}, nil
 }
 
+func (tr *storeTxnRead) readKeyValue(revpair revision, revBytes []byte, kv *mvccpb.KeyValue) {
+	revToBytes(revpair, revBytes)
+	_, vs := tr.tx.UnsafeRange(schema.Key, revBytes, nil, 0)
+	if len(vs) != 1 {
+		tr.s.lg.Fatal(
+			"range failed to find revision pair",
+			zap.Int64("revision-main", revpair.main),
+			zap.Int64("revision-sub", revpair.sub),
+		)
+	}
+	if err := decodeKeyValue(vs[0], kv); err != nil {
+		tr.s.lg.Fatal(
+			"failed to unmarshal mvccpb.KeyValue",
+			zap.Error(err),
+		)
+	}
+}
+
 func (tr *storeTxnRead) End() {
 	tr.tx.RUnlock() // RUnlock signals the end of concurrentReadTx.
 	tr.s.mu.RUnlock()
@@ -131,6 +183,11 @@ type storeTxnWrite struct {
 	// beginRev is the revision where the txn begins; it will write to the next revision.
 	beginRev int64
 	changes  []mvccpb.KeyValue
+	// usageSizes is the usage of the tracked keys written by the txn, with
+	// -1 for deleted keys, and usageDeltas the changes of the txn to the
+	// usage of the quota prefixes. Both are applied when the txn ends.
+	usageSizes  map[string]int64
+	usageDeltas map[string]*PrefixUsage
 }
 
 func (s *store) Write(trace *traceutil.Trace) TxnWrite {
@@ -169,11 +226,13 @@ func (tw *storeTxnWrite) Put(key, value []byte, lease lease.LeaseID) int64 {
 }
 
 func (tw *storeTxnWrite) End() {
+	tw.applyUsage()
 	// only update index if the txn modifies the mvcc state.
 	if len(tw.changes) != 0 {
 		// hold revMu lock to prevent new read txns from opening until writeback.
 		tw.s.revMu.Lock()
 		tw.s.currentRev++
+		atomic.AddInt64(&tw.s.revisions, int64(len(tw.changes)))
 	}
 	tw.tx.Unlock()
 	if len(tw.changes) != 0 {
@@ -212,13 +271,28 @@ func (tw *storeTxnWrite) put(key, value []byte, leaseID lease.LeaseID) {
 		Lease:          int64(leaseID),
 	}
 
-	d, err := kv.Marshal()
+	// the usage is accounted by the uncompressed size, as the compression
+	// prefixes may differ between members.
+	if tw.s.tracksUsage(key) {
+		tw.updateUsage(key, kvUsageBytes(&kv))
+	}
+	stored, compressed := kv, false
+	if tw.s.compresses(key) {
+		if cv, ok := compressValue(value); ok {
+			stored.Value, compressed = cv, true
+		}
+	}
+	d, err := marshalKeyValue(&stored, compressed)
 	if err != nil {
 		tw.storeTxnRead.s.lg.Fatal(
 			"failed to marshal mvccpb.KeyValue",
 			zap.Error(err),
 		)
 	}
+	if compressed && !tw.s.compressedValues {
+		schema.UnsafeSetCompressedValues(tw.tx)
+		tw.s.compressedValues = true
+	}
 
 	tw.trace.Step("marshal mvccpb.KeyValue")
 	tw.tx.UnsafeSeqPut(schema.Key, ibytes, d)
@@ -271,6 +345,10 @@ func (tw *storeTxnWrite) deleteRange(key, end []byte) int64 {
 }
 
 func (tw *storeTxnWrite) delete(key []byte) {
+	if tw.s.tracksUsage(key) {
+		tw.updateUsage(key, -1)
+	}
+
 	ibytes := newRevBytes()
 	idxRev := revision{main: tw.beginRev + 1, sub: int64(len(tw.changes))}
 	revToBytes(idxRev, ibytes)
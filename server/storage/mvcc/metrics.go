@@ -265,6 +265,30 @@ var (
 			Name:      "total_put_size_in_bytes",
 			Help:      "The total size of put kv pairs seen by this member.",
 		})
+
+	indexResidentBytes = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "etcd_debugging",
+			Subsystem: "mvcc",
+			Name:      "index_resident_bytes",
+			Help:      "The estimated memory held by the keys resident in a paged key index.",
+		})
+
+	indexPageInCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "etcd_debugging",
+			Subsystem: "mvcc",
+			Name:      "index_page_ins_total",
+			Help:      "Total number of keys paged in to the key index.",
+		})
+
+	indexPageOutCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "etcd_debugging",
+			Subsystem: "mvcc",
+			Name:      "index_page_outs_total",
+			Help:      "Total number of keys paged out of the key index.",
+		})
 )
 
 func init() {
@@ -291,6 +315,9 @@ func init() {
 	prometheus.MustRegister(currentRev)
 	prometheus.MustRegister(compactRev)
 	prometheus.MustRegister(totalPutSizeGauge)
+	prometheus.MustRegister(indexResidentBytes)
+	prometheus.MustRegister(indexPageInCounter)
+	prometheus.MustRegister(indexPageOutCounter)
 }
 
 // ReportEventReceived reports that an event is received.
@@ -15,8 +15,11 @@
 package mvcc
 
 import (
+	"encoding/binary"
+
 	"go.etcd.io/etcd/server/v3/storage/backend"
 	"go.etcd.io/etcd/server/v3/storage/schema"
+	"go.uber.org/zap"
 )
 
 /***
@@ -64,3 +67,33 @@ func UnsafeSetFinishedCompact(tx backend.BatchTx, value int64) {
 	revToBytes(revision{main: value}, rbytes)
 	tx.UnsafePut(schema.Meta, schema.FinishedCompactKeyName, rbytes)
 }
+
+// UnsafeReadPrefixUsages returns the persisted usage of quota prefixes.
+func UnsafeReadPrefixUsages(lg *zap.Logger, tx backend.ReadTx) map[string]PrefixUsage {
+	usage := make(map[string]PrefixUsage)
+	err := tx.UnsafeForEach(schema.Quota, func(k, v []byte) error {
+		if len(v) != 16 {
+			lg.Panic("invalid prefix usage", zap.String("prefix", string(k)), zap.Int("size", len(v)))
+		}
+		usage[string(k)] = PrefixUsage{
+			Bytes: int64(binary.BigEndian.Uint64(v[:8])),
+			Keys:  int64(binary.BigEndian.Uint64(v[8:])),
+		}
+		return nil
+	})
+	if err != nil {
+		lg.Panic("failed to read prefix usage", zap.Error(err))
+	}
+	return usage
+}
+
+func UnsafeSetPrefixUsage(tx backend.BatchTx, prefix []byte, u PrefixUsage) {
+	v := make([]byte, 16)
+	binary.BigEndian.PutUint64(v[:8], uint64(u.Bytes))
+	binary.BigEndian.PutUint64(v[8:], uint64(u.Keys))
+	tx.UnsafePut(schema.Quota, prefix, v)
+}
+
+func UnsafeDeletePrefixUsage(tx backend.BatchTx, prefix []byte) {
+	tx.UnsafeDelete(schema.Quota, prefix)
+}
@@ -475,7 +475,7 @@ func (s *watchableStore) syncWatchers() int {
 func kvsToEvents(lg *zap.Logger, wg *watcherGroup, revs, vals [][]byte) (evs []mvccpb.Event) {
 	for i, v := range vals {
 		var kv mvccpb.KeyValue
-		if err := kv.Unmarshal(v); err != nil {
+		if err := decodeKeyValue(v, &kv); err != nil {
 			lg.Panic("failed to unmarshal mvccpb.KeyValue", zap.Error(err))
 		}
 
@@ -584,35 +584,21 @@ type watcher struct {
 	ch chan<- WatchResponse
 }
 
+// filter returns true if the event should not be sent to the watcher.
+func (w *watcher) filter(ev mvccpb.Event) bool {
+	for _, filter := range w.fcs {
+		if filter(ev) {
+			return true
+		}
+	}
+	return false
+}
+
 /*** 发送watcher应答
-- 查看watcher中是否有过滤函数列表，如果有，将需要过滤的事件进行删除
-- 查看是否还有事件需要发送
+- 事件在生成watcherBatch时已经根据watcher的过滤函数列表过滤过了
 - 通过携程发送应答
 */
 func (w *watcher) send(wr WatchResponse) bool {
-	progressEvent := len(wr.Events) == 0
-
-	if len(w.fcs) != 0 {
-		ne := make([]mvccpb.Event, 0, len(wr.Events))
-		for i := range wr.Events {
-			filtered := false
-			for _, filter := range w.fcs {
-				if filter(wr.Events[i]) {
-					filtered = true
-					break
-				}
-			}
-			if !filtered {
-				ne = append(ne, wr.Events[i])
-			}
-		}
-		wr.Events = ne
-	}
-
-	// if all events are filtered out, we should send nothing.
-	if !progressEvent && len(wr.Events) == 0 {
-		return true
-	}
 	select {
 	case w.ch <- wr:
 		return true
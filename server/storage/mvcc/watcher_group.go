@@ -102,8 +102,9 @@ func newWatcherBatch(wg *watcherGroup, evs []mvccpb.Event) watcherBatch {
 	wb := make(watcherBatch)
 	for _, ev := range evs {
 		for w := range wg.watcherSetByKey(string(ev.Kv.Key)) {
-			if ev.Kv.ModRevision >= w.minRev {
-				// don't double notify
+			// don't double notify, and drop filtered events before
+			// they take up room in the batch
+			if ev.Kv.ModRevision >= w.minRev && !w.filter(ev) {
 				wb.add(w, ev)
 			}
 		}
@@ -0,0 +1,180 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"math"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/config"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+)
+
+// PrefixQuota limits the bytes and keys stored under key prefixes. Unlike
+// BackendQuota, it only rejects the requests writing under a prefix that
+// is over its quota, so it never needs to raise an alarm.
+//
+// The quotas are checked against the applied usage before the writes are
+// proposed, like BackendQuota, and never when they are applied, so that
+// whether a write is applied only depends on the replicated log. Writes
+// admitted concurrently may thus exceed a quota by the size of the writes
+// in flight.
+type PrefixQuota struct {
+	kv     mvcc.KV
+	quotas []config.PrefixQuota
+}
+
+// prefixCharge is the change of usage a request makes under a quota prefix.
+type prefixCharge struct {
+	bytes int64
+	keys  int64
+}
+
+// NewPrefixQuota creates a quota layer with the given prefix quotas. The
+// usage of each prefix must be tracked by kv.
+func NewPrefixQuota(quotas []config.PrefixQuota, kv mvcc.KV) *PrefixQuota {
+	return &PrefixQuota{kv: kv, quotas: quotas}
+}
+
+func (q *PrefixQuota) Available(v interface{}) bool {
+	if len(q.quotas) == 0 {
+		return true
+	}
+	for i, c := range q.charges(v) {
+		pq := q.quotas[i]
+		u, _ := q.kv.PrefixUsage([]byte(pq.Prefix))
+		if pq.MaxBytes > 0 && c.bytes > 0 && u.Bytes+c.bytes > pq.MaxBytes {
+			return false
+		}
+		if pq.MaxKeys > 0 && c.keys > 0 && u.Keys+c.keys > pq.MaxKeys {
+			return false
+		}
+	}
+	return true
+}
+
+func (q *PrefixQuota) Cost(v interface{}) int {
+	cost := int64(0)
+	for _, c := range q.charges(v) {
+		if c.bytes > 0 {
+			cost += c.bytes
+		}
+	}
+	return int(cost)
+}
+
+// Remaining is the smallest number of bytes left under any of the prefixes.
+func (q *PrefixQuota) Remaining() int64 {
+	remaining := int64(math.MaxInt64)
+	for _, pq := range q.quotas {
+		if pq.MaxBytes == 0 {
+			continue
+		}
+		u, _ := q.kv.PrefixUsage([]byte(pq.Prefix))
+		if r := pq.MaxBytes - u.Bytes; r < remaining {
+			remaining = r
+		}
+	}
+	return remaining
+}
+
+// Status returns the limits and the current usage of every prefix quota.
+func (q *PrefixQuota) Status() []*pb.PrefixQuotaUsage {
+	usages := make([]*pb.PrefixQuotaUsage, 0, len(q.quotas))
+	for _, pq := range q.quotas {
+		u, _ := q.kv.PrefixUsage([]byte(pq.Prefix))
+		usages = append(usages, &pb.PrefixQuotaUsage{
+			Prefix:    []byte(pq.Prefix),
+			MaxBytes:  pq.MaxBytes,
+			MaxKeys:   pq.MaxKeys,
+			UsedBytes: u.Bytes,
+			UsedKeys:  u.Keys,
+		})
+	}
+	return usages
+}
+
+// charges returns the change of usage the request makes under each of
+// the quota prefixes.
+func (q *PrefixQuota) charges(v interface{}) []prefixCharge {
+	switch r := v.(type) {
+	case *pb.PutRequest:
+		c := make([]prefixCharge, len(q.quotas))
+		q.chargePut(r, c)
+		return c
+	case *pb.TxnRequest:
+		return q.chargeTxn(r)
+	default:
+		return nil
+	}
+}
+
+func (q *PrefixQuota) chargePut(r *pb.PutRequest, c []prefixCharge) {
+	matched := false
+	for _, pq := range q.quotas {
+		if bytes.HasPrefix(r.Key, []byte(pq.Prefix)) {
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		return
+	}
+
+	size, keys := int64(len(r.Key)+len(r.Value)), int64(1)
+	if prevSize, ok := q.kv.KeyUsage(r.Key); ok {
+		if r.IgnoreValue {
+			size = prevSize
+		}
+		size, keys = size-prevSize, 0
+	}
+	for i, pq := range q.quotas {
+		if bytes.HasPrefix(r.Key, []byte(pq.Prefix)) {
+			c[i].bytes += size
+			c[i].keys += keys
+		}
+	}
+}
+
+// chargeTxn charges the larger of the success and failure branches to each
+// prefix, since it is not known yet which one will be taken.
+func (q *PrefixQuota) chargeTxn(r *pb.TxnRequest) []prefixCharge {
+	success, failure := q.chargeOps(r.Success), q.chargeOps(r.Failure)
+	for i := range success {
+		if failure[i].bytes > success[i].bytes {
+			success[i].bytes = failure[i].bytes
+		}
+		if failure[i].keys > success[i].keys {
+			success[i].keys = failure[i].keys
+		}
+	}
+	return success
+}
+
+func (q *PrefixQuota) chargeOps(ops []*pb.RequestOp) []prefixCharge {
+	c := make([]prefixCharge, len(q.quotas))
+	for _, op := range ops {
+		if r := op.GetRequestPut(); r != nil {
+			q.chargePut(r, c)
+		} else if r := op.GetRequestTxn(); r != nil {
+			for i, tc := range q.chargeTxn(r) {
+				c[i].bytes += tc.bytes
+				c[i].keys += tc.keys
+			}
+		}
+	}
+	return c
+}
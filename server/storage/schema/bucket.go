@@ -26,6 +26,9 @@ var (
 	metaBucketName  = []byte("meta")
 	leaseBucketName = []byte("lease")
 	alarmBucketName = []byte("alarm")
+	quotaBucketName = []byte("quota")
+
+	keyIndexBucketName = []byte("keyIndex")
 
 	clusterBucketName = []byte("cluster")
 
@@ -36,6 +39,8 @@ var (
 	authUsersBucketName = []byte("authUsers")
 	authRolesBucketName = []byte("authRoles")
 
+	encryptionBucketName = []byte("encryption")
+
 	testBucketName = []byte("test")
 )
 
@@ -45,6 +50,9 @@ var (
 	Lease   = backend.Bucket(bucket{id: 3, name: leaseBucketName, safeRangeBucket: false})
 	Alarm   = backend.Bucket(bucket{id: 4, name: alarmBucketName, safeRangeBucket: false})
 	Cluster = backend.Bucket(bucket{id: 5, name: clusterBucketName, safeRangeBucket: false})
+	Quota   = backend.Bucket(bucket{id: 6, name: quotaBucketName, safeRangeBucket: false})
+
+	KeyIndex = backend.Bucket(bucket{id: 7, name: keyIndexBucketName, safeRangeBucket: false})
 
 	Members        = backend.Bucket(bucket{id: 10, name: membersBucketName, safeRangeBucket: false})
 	MembersRemoved = backend.Bucket(bucket{id: 11, name: membersRemovedBucketName, safeRangeBucket: false})
@@ -53,6 +61,8 @@ var (
 	AuthUsers = backend.Bucket(bucket{id: 21, name: authUsersBucketName, safeRangeBucket: false})
 	AuthRoles = backend.Bucket(bucket{id: 22, name: authRolesBucketName, safeRangeBucket: false})
 
+	Encryption = backend.Bucket(bucket{id: 30, name: encryptionBucketName, safeRangeBucket: false})
+
 	Test = backend.Bucket(bucket{id: 100, name: testBucketName, safeRangeBucket: false})
 )
 
@@ -81,6 +91,9 @@ var (
 	ClusterDowngradeKeyName      = []byte("downgrade")
 	// Since v3.6
 	MetaStorageVersionName = []byte("storageVersion")
+	// MetaCompressedValuesName marks backends holding compressed values,
+	// which versions before v3.6 cannot read.
+	MetaCompressedValuesName = []byte("compressedValues")
 	// Before adding new meta key please update server/etcdserver/version
 )
 
@@ -89,8 +102,17 @@ func DefaultIgnores(bucket, key []byte) bool {
 	// consistent index & term might be changed due to v2 internal sync, which
 	// is not controllable by the user.
 	// storage version might change after wal snapshot and is not controller by user.
+	// prefix usage depends on the quota prefixes configured on each member.
+	// data encryption keys are generated and wrapped by each member.
+	// values are compressed under the prefixes configured on each member.
+	// key index checkpoints are taken by each member.
+	if bytes.Compare(bucket, Quota.Name()) == 0 || bytes.Compare(bucket, Encryption.Name()) == 0 ||
+		bytes.Compare(bucket, KeyIndex.Name()) == 0 {
+		return true
+	}
 	return bytes.Compare(bucket, Meta.Name()) == 0 &&
-		(bytes.Compare(key, MetaTermKeyName) == 0 || bytes.Compare(key, MetaConsistentIndexKeyName) == 0 || bytes.Compare(key, MetaStorageVersionName) == 0)
+		(bytes.Compare(key, MetaTermKeyName) == 0 || bytes.Compare(key, MetaConsistentIndexKeyName) == 0 || bytes.Compare(key, MetaStorageVersionName) == 0 ||
+			bytes.Compare(key, MetaCompressedValuesName) == 0)
 }
 
 func BackendMemberKey(id types.ID) []byte {
@@ -0,0 +1,33 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"go.etcd.io/etcd/server/v3/storage/backend"
+)
+
+// UnsafeSetCompressedValues marks the backend as holding compressed values
+// in the key bucket. The mark is never cleared, as compressed values are
+// only dropped by compaction.
+func UnsafeSetCompressedValues(tx backend.BatchTx) {
+	tx.UnsafePut(Meta, MetaCompressedValuesName, []byte("true"))
+}
+
+// UnsafeReadCompressedValues reports whether the backend is marked as
+// holding compressed values in the key bucket.
+func UnsafeReadCompressedValues(tx backend.ReadTx) bool {
+	_, vs := tx.UnsafeRange(Meta, MetaCompressedValuesName, nil, 0)
+	return len(vs) != 0
+}
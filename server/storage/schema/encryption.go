@@ -0,0 +1,87 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"go.etcd.io/etcd/server/v3/storage/backend"
+)
+
+var (
+	// EncryptionCurrentKeyName holds the ID of the data encryption key in
+	// use.
+	EncryptionCurrentKeyName = []byte("current")
+	// encryptionKeyPrefix prefixes the wrapped data encryption keys, each
+	// stored under its big-endian ID.
+	encryptionKeyPrefix = []byte("dek/")
+)
+
+// UnsafeCreateEncryptionBucket creates the bucket holding the wrapped data
+// encryption keys.
+func UnsafeCreateEncryptionBucket(tx backend.BatchTx) {
+	tx.UnsafeCreateBucket(Encryption)
+}
+
+// UnsafeSaveEncryptionKeys persists the given wrapped data encryption keys,
+// keyed by their ID, along with the ID of the one in use.
+func UnsafeSaveEncryptionKeys(tx backend.BatchTx, keys map[uint64][]byte, current uint64) {
+	for id, key := range keys {
+		tx.UnsafePut(Encryption, encryptionKeyName(id), key)
+	}
+	tx.UnsafePut(Encryption, EncryptionCurrentKeyName, encryptionKeyID(current))
+}
+
+// UnsafeReadEncryptionKeys returns the wrapped data encryption keys stored
+// in the backend, keyed by their ID, along with the ID of the one in use.
+func UnsafeReadEncryptionKeys(tx backend.ReadTx) (keys map[uint64][]byte, current uint64, err error) {
+	return DecodeEncryptionKeys(func(visitor func(k, v []byte) error) error {
+		return tx.UnsafeForEach(Encryption, visitor)
+	})
+}
+
+// DecodeEncryptionKeys returns the wrapped data encryption keys, keyed by
+// their ID, along with the ID of the one in use, from the key-values of the
+// Encryption bucket that forEach visits.
+func DecodeEncryptionKeys(forEach func(visitor func(k, v []byte) error) error) (keys map[uint64][]byte, current uint64, err error) {
+	keys = make(map[uint64][]byte)
+	err = forEach(func(k, v []byte) error {
+		switch {
+		case bytes.Equal(k, EncryptionCurrentKeyName):
+			if len(v) != 8 {
+				return fmt.Errorf("invalid data encryption key id %x", v)
+			}
+			current = binary.BigEndian.Uint64(v)
+		case bytes.HasPrefix(k, encryptionKeyPrefix) && len(k) == len(encryptionKeyPrefix)+8:
+			keys[binary.BigEndian.Uint64(k[len(encryptionKeyPrefix):])] = append([]byte(nil), v...)
+		default:
+			return fmt.Errorf("unexpected key %q in bucket %q", k, Encryption)
+		}
+		return nil
+	})
+	return keys, current, err
+}
+
+func encryptionKeyName(id uint64) []byte {
+	return append(append([]byte(nil), encryptionKeyPrefix...), encryptionKeyID(id)...)
+}
+
+func encryptionKeyID(id uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, id)
+	return b
+}
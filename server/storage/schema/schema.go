@@ -84,6 +84,9 @@ func UnsafeMigrate(lg *zap.Logger, tx backend.BatchTx, w WALVersion, target semv
 		if minVersion != nil && target.LessThan(*minVersion) {
 			return fmt.Errorf("cannot downgrade storage, WAL contains newer entries")
 		}
+		if target.LessThan(version.V3_6) && UnsafeReadCompressedValues(tx) {
+			return fmt.Errorf("cannot downgrade storage, backend contains compressed values")
+		}
 	}
 	return plan.unsafeExecute(lg, tx)
 }
@@ -190,6 +190,20 @@ func TestMigrate(t *testing.T) {
 			expectError:    true,
 			expectErrorMsg: "cannot downgrade storage, WAL contains newer entries",
 		},
+		{
+			name:          "Downgrading v3.6 to v3.5 fails if the backend contains compressed values",
+			version:       version.V3_6,
+			targetVersion: version.V3_5,
+			overrideKeys: func(tx backend.BatchTx) {
+				MustUnsafeSaveConfStateToBackend(zap.NewNop(), tx, &raftpb.ConfState{})
+				UnsafeUpdateConsistentIndex(tx, 1, 1)
+				UnsafeSetStorageVersion(tx, &version.V3_6)
+				UnsafeSetCompressedValues(tx)
+			},
+			expectVersion:  &version.V3_6,
+			expectError:    true,
+			expectErrorMsg: "cannot downgrade storage, backend contains compressed values",
+		},
 		{
 			name:           "Downgrading v3.5 to v3.4 is not supported as schema was introduced in v3.6",
 			version:        version.V3_5,
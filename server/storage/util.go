@@ -115,30 +115,34 @@ func CreateConfigChangeEnts(lg *zap.Logger, ids []uint64, self uint64, term, ind
 // - ConfChangeAddNode, in which case the contained ID will Be added into the set.
 // - ConfChangeRemoveNode, in which case the contained ID will Be removed from the set.
 // - ConfChangeAddLearnerNode, in which the contained ID will Be added into the set.
+// - ConfChangeAddWitnessNode, in which the contained ID will Be added into the set.
+// The changes of a ConfChangeV2 entry are handled in the same way.
 func GetEffectiveNodeIDsFromWalEntries(lg *zap.Logger, snap *raftpb.Snapshot, ents []raftpb.Entry) []uint64 {
 	ids := make(map[uint64]bool)
 	if snap != nil {
 		for _, id := range snap.Metadata.ConfState.Voters {
 			ids[id] = true
 		}
+		for _, id := range snap.Metadata.ConfState.VotersOutgoing {
+			ids[id] = true
+		}
 	}
 	for _, e := range ents {
-		if e.Type != raftpb.EntryConfChange {
+		var changes []raftpb.ConfChangeSingle
+		switch e.Type {
+		case raftpb.EntryConfChange:
+			var cc raftpb.ConfChange
+			pbutil.MustUnmarshal(&cc, e.Data)
+			changes = cc.AsV2().Changes
+		case raftpb.EntryConfChangeV2:
+			var cc raftpb.ConfChangeV2
+			pbutil.MustUnmarshal(&cc, e.Data)
+			changes = cc.Changes
+		default:
 			continue
 		}
-		var cc raftpb.ConfChange
-		pbutil.MustUnmarshal(&cc, e.Data)
-		switch cc.Type {
-		case raftpb.ConfChangeAddLearnerNode:
-			ids[cc.NodeID] = true
-		case raftpb.ConfChangeAddNode:
-			ids[cc.NodeID] = true
-		case raftpb.ConfChangeRemoveNode:
-			delete(ids, cc.NodeID)
-		case raftpb.ConfChangeUpdateNode:
-			// do nothing
-		default:
-			lg.Panic("unknown ConfChange Type", zap.String("type", cc.Type.String()))
+		for _, cc := range changes {
+			applyConfChangeSingle(lg, ids, cc)
 		}
 	}
 	sids := make(types.Uint64Slice, 0, len(ids))
@@ -148,3 +152,20 @@ func GetEffectiveNodeIDsFromWalEntries(lg *zap.Logger, snap *raftpb.Snapshot, en
 	sort.Sort(sids)
 	return []uint64(sids)
 }
+
+func applyConfChangeSingle(lg *zap.Logger, ids map[uint64]bool, cc raftpb.ConfChangeSingle) {
+	switch cc.Type {
+	case raftpb.ConfChangeAddLearnerNode:
+		ids[cc.NodeID] = true
+	case raftpb.ConfChangeAddNode:
+		ids[cc.NodeID] = true
+	case raftpb.ConfChangeAddWitnessNode:
+		ids[cc.NodeID] = true
+	case raftpb.ConfChangeRemoveNode:
+		delete(ids, cc.NodeID)
+	case raftpb.ConfChangeUpdateNode:
+		// do nothing
+	default:
+		lg.Panic("unknown ConfChange Type", zap.String("type", cc.Type.String()))
+	}
+}
@@ -59,6 +59,7 @@ var (
 	ErrCRCMismatch      = errors.New("wal: crc mismatch")
 	ErrSnapshotMismatch = errors.New("wal: snapshot mismatch")
 	ErrSnapshotNotFound = errors.New("wal: snapshot not found")
+	ErrMetadataNotFound = errors.New("wal: metadata not found")
 	ErrSliceOutOfRange  = errors.New("wal: slice bounds out of range")
 	ErrDecoderNotFound  = errors.New("wal: decoder not found")
 	crcTable            = crc32.MakeTable(crc32.Castagnoli)
@@ -92,6 +93,8 @@ type WAL struct {
 
 	unsafeNoSync bool // if set, do not fsync
 
+	enc Encryptor // if set, encrypts the entries
+
 	mu      sync.Mutex
 	enti    uint64   // index of the last entry saved to the wal
 	encoder *encoder // encoder to encode records
@@ -257,13 +260,33 @@ func (w *WAL) Reopen(lg *zap.Logger, snap walpb.Snapshot) (*WAL, error) {
 	if err != nil {
 		lg.Panic("failed to close WAL during reopen", zap.Error(err))
 	}
-	return Open(lg, w.dir, snap)
+	nw, err := Open(lg, w.dir, snap)
+	if err != nil {
+		return nil, err
+	}
+	nw.enc = w.enc
+	return nw, nil
 }
 
 func (w *WAL) SetUnsafeNoFsync() {
 	w.unsafeNoSync = true
 }
 
+// Encryptor encrypts the entries saved to the WAL, and decrypts them when
+// they are read back.
+type Encryptor interface {
+	Encrypt(data []byte) ([]byte, error)
+	Decrypt(data []byte) ([]byte, error)
+}
+
+// SetEncryptor sets the encryptor of the entries. It must be set before
+// ReadAll is called or any entry is saved.
+func (w *WAL) SetEncryptor(enc Encryptor) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.enc = enc
+}
+
 /***
 关闭当前WAL目录，然后将目录重命名
 */
@@ -516,7 +539,14 @@ func (w *WAL) ReadAll() (metadata []byte, state raftpb.HardState, ents []raftpb.
 	for err = decoder.decode(rec); err == nil; err = decoder.decode(rec) {
 		switch rec.Type {
 		case entryType:
-			e := mustUnmarshalEntry(rec.Data)
+			data := rec.Data
+			if w.enc != nil {
+				if data, err = w.enc.Decrypt(data); err != nil {
+					state.Reset()
+					return nil, state, nil, fmt.Errorf("wal: failed to decrypt entry (%v)", err)
+				}
+			}
+			e := mustUnmarshalEntry(data)
 			// 0 <= e.Index-w.start.Index - 1 < len(ents)
 			if e.Index > w.start.Index {
 				// prevent "panic: runtime error: slice bounds out of range [:13038096702221461992] with capacity 0"
@@ -697,6 +727,38 @@ func ValidSnapshotEntries(lg *zap.Logger, walDir string) ([]walpb.Snapshot, erro
 	return snaps, nil
 }
 
+// ReadMetadata returns the metadata of the WAL in walDir, read from its last
+// file, without reading its entries.
+func ReadMetadata(lg *zap.Logger, walDir string) ([]byte, error) {
+	names, err := readWALNames(lg, walDir)
+	if err != nil {
+		return nil, err
+	}
+
+	// open the last wal file in read mode, so that there is no conflict
+	// when the same WAL is opened elsewhere in write mode
+	rs, _, closer, err := openWALFiles(lg, walDir, names, len(names)-1, false)
+	if err != nil {
+		return nil, err
+	}
+	defer closer()
+
+	rec := &walpb.Record{}
+	decoder := newDecoder(rs...)
+	for err = decoder.decode(rec); err == nil; err = decoder.decode(rec) {
+		switch rec.Type {
+		case metadataType:
+			return rec.Data, nil
+		case crcType:
+			decoder.updateCRC(rec.Crc)
+		}
+	}
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		err = ErrMetadataNotFound
+	}
+	return nil, err
+}
+
 // Verify reads through the given WAL and verifies that it is not corrupted.
 // It creates a new decoder to read through the records of the given WAL.
 // It does not conflict with any open WAL, but it is recommended not to
@@ -1005,6 +1067,12 @@ func (w *WAL) Close() error {
 func (w *WAL) saveEntry(e *raftpb.Entry) error {
 	// TODO: add MustMarshalTo to reduce one allocation.
 	b := pbutil.MustMarshal(e)
+	if w.enc != nil {
+		var err error
+		if b, err = w.enc.Encrypt(b); err != nil {
+			return err
+		}
+	}
 	rec := &walpb.Record{Type: entryType, Data: b}
 	if err := w.encoder.encode(rec); err != nil {
 		return err
@@ -443,6 +443,59 @@ func TestRecover(t *testing.T) {
 	}
 }
 
+// xorEncryptor "encrypts" data by flipping all its bits.
+type xorEncryptor struct{}
+
+func (xorEncryptor) Encrypt(data []byte) ([]byte, error) {
+	out := make([]byte, len(data))
+	for i := range data {
+		out[i] = ^data[i]
+	}
+	return out, nil
+}
+
+func (e xorEncryptor) Decrypt(data []byte) ([]byte, error) { return e.Encrypt(data) }
+
+// TestEncryptor ensures that the entries are saved encrypted, and are read
+// back decrypted, also after the WAL is reopened.
+func TestEncryptor(t *testing.T) {
+	p := t.TempDir()
+
+	w, err := Create(zaptest.NewLogger(t), p, []byte("metadata"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.SetEncryptor(xorEncryptor{})
+	if err = w.SaveSnapshot(walpb.Snapshot{}); err != nil {
+		t.Fatal(err)
+	}
+	data := []byte("plaintext entry data")
+	ents := []raftpb.Entry{{Index: 1, Term: 1, Data: data}, {Index: 2, Term: 1, Data: data}}
+	if err = w.Save(raftpb.HardState{Term: 1, Commit: 2}, ents); err != nil {
+		t.Fatal(err)
+	}
+	w, err = w.Reopen(zaptest.NewLogger(t), walpb.Snapshot{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, gents, err := w.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Close()
+	if !reflect.DeepEqual(gents, ents) {
+		t.Errorf("ents = %+v, want %+v", gents, ents)
+	}
+
+	b, err := os.ReadFile(filepath.Join(p, walName(0, 0)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(b, data) {
+		t.Errorf("WAL file holds the entry data in plaintext")
+	}
+}
+
 func TestSearchIndex(t *testing.T) {
 	tests := []struct {
 		names []string
@@ -959,6 +1012,31 @@ func TestReadAllFail(t *testing.T) {
 
 // TestValidSnapshotEntries ensures ValidSnapshotEntries returns all valid wal snapshot entries, accounting
 // for hardstate
+func TestReadMetadata(t *testing.T) {
+	p := t.TempDir()
+	metadata := []byte("metadata")
+	w, err := Create(zaptest.NewLogger(t), p, metadata)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	if err = w.Save(raftpb.HardState{Term: 1, Commit: 1}, []raftpb.Entry{{Index: 1, Term: 1, Data: []byte{1}}}); err != nil {
+		t.Fatal(err)
+	}
+	if err = w.cut(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the metadata is read from the last file, while the WAL is open for writing
+	got, err := ReadMetadata(zaptest.NewLogger(t), p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, metadata) {
+		t.Errorf("metadata = %q, want %q", got, metadata)
+	}
+}
+
 func TestValidSnapshotEntries(t *testing.T) {
 	p := t.TempDir()
 	snap0 := walpb.Snapshot{}
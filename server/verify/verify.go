@@ -17,6 +17,7 @@ package verify
 import (
 	"fmt"
 
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/client/pkg/v3/verify"
 	"go.etcd.io/etcd/raft/v3/raftpb"
 	"go.etcd.io/etcd/server/v3/storage/backend"
@@ -68,6 +69,17 @@ func Verify(cfg Config) error {
 		}
 	}()
 
+	snapshot, hardstate, err := validateWal(cfg)
+	if err != nil {
+		return err
+	}
+
+	// a witness keeps no database file, so there is no consistent index to check
+	witness, err := isWitness(cfg)
+	if err != nil || witness {
+		return err
+	}
+
 	beConfig := backend.DefaultBackendConfig(lg)
 	beConfig.Path = datadir.ToBackendFileName(cfg.DataDir)
 	beConfig.Logger = cfg.Logger
@@ -75,11 +87,6 @@ func Verify(cfg Config) error {
 	be := backend.New(beConfig)
 	defer be.Close()
 
-	snapshot, hardstate, err := validateWal(cfg)
-	if err != nil {
-		return err
-	}
-
 	// TODO: Perform validation of consistency of membership between
 	// backend/members & WAL confstate (and maybe storev2 if still exists).
 
@@ -144,3 +151,15 @@ func validateWal(cfg Config) (*walpb.Snapshot, *raftpb.HardState, error) {
 	}
 	return &snapshot, hardstate, nil
 }
+
+func isWitness(cfg Config) (bool, error) {
+	wmetadata, err := wal2.ReadMetadata(cfg.Logger, datadir.ToWalDir(cfg.DataDir))
+	if err != nil {
+		return false, err
+	}
+	var metadata etcdserverpb.Metadata
+	if err = metadata.Unmarshal(wmetadata); err != nil {
+		return false, err
+	}
+	return metadata.Witness, nil
+}
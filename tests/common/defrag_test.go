@@ -24,10 +24,17 @@ import (
 )
 
 func TestDefragOnline(t *testing.T) {
+	testDefrag(t, config.DefragOption{Timeout: 10 * time.Second})
+}
+
+func TestDefragOnlineMode(t *testing.T) {
+	testDefrag(t, config.DefragOption{Timeout: 10 * time.Second, Online: true})
+}
+
+func testDefrag(t *testing.T, options config.DefragOption) {
 	testRunner.BeforeTest(t)
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
-	options := config.DefragOption{Timeout: 10 * time.Second}
 	clus := testRunner.NewCluster(ctx, t, config.ClusterConfig{ClusterSize: 3})
 	testutils.ExecuteUntil(ctx, t, func() {
 		defer clus.Close()
@@ -54,6 +54,7 @@ type CompactOption struct {
 
 type DefragOption struct {
 	Timeout time.Duration
+	Online  bool
 }
 
 type LeaseOption struct {
@@ -369,6 +369,9 @@ func (ctl *EtcdctlV3) Defragment(o config.DefragOption) error {
 	if o.Timeout != 0 {
 		args = append(args, fmt.Sprintf("--command-timeout=%s", o.Timeout))
 	}
+	if o.Online {
+		args = append(args, "--online")
+	}
 	lines := make([]string, len(ctl.endpoints))
 	for i := range lines {
 		lines[i] = "Finished defragmenting etcd member"
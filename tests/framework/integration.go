@@ -253,8 +253,12 @@ func (c integrationClient) Defragment(o config.DefragOption) error {
 		ctx, cancel = context.WithTimeout(ctx, o.Timeout)
 		defer cancel()
 	}
+	var opts []clientv3.DefragmentOption
+	if o.Online {
+		opts = append(opts, clientv3.WithOnlineDefragment())
+	}
 	for _, ep := range c.Endpoints() {
-		_, err := c.Client.Defragment(ctx, ep)
+		_, err := c.Client.Defragment(ctx, ep, opts...)
 		if err != nil {
 			return err
 		}
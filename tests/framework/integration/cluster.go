@@ -170,6 +170,14 @@ type ClusterConfig struct {
 	ExperimentalMaxLearners     int
 	StrictReconfigCheck         bool
 	CorruptCheckTime            time.Duration
+	LeaderPriorityCheckTime     time.Duration
+	LeaderPriorityCooldown      time.Duration
+	QuiesceTimeout              time.Duration
+	SnapshotDelegation          bool
+	PeerCompression             string
+	// EncryptionKeyFile enables encryption at rest with the key encryption
+	// keys of the given file.
+	EncryptionKeyFile string
 }
 
 type Cluster struct {
@@ -284,6 +292,12 @@ func (c *Cluster) mustNewMember(t testutil.TB) *Member {
 			ExperimentalMaxLearners:     c.Cfg.ExperimentalMaxLearners,
 			StrictReconfigCheck:         c.Cfg.StrictReconfigCheck,
 			CorruptCheckTime:            c.Cfg.CorruptCheckTime,
+			LeaderPriorityCheckTime:     c.Cfg.LeaderPriorityCheckTime,
+			LeaderPriorityCooldown:      c.Cfg.LeaderPriorityCooldown,
+			QuiesceTimeout:              c.Cfg.QuiesceTimeout,
+			SnapshotDelegation:          c.Cfg.SnapshotDelegation,
+			PeerCompression:             c.Cfg.PeerCompression,
+			EncryptionKeyFile:           c.Cfg.EncryptionKeyFile,
 		})
 	m.DiscoveryURL = c.Cfg.DiscoveryURL
 	return m
@@ -571,6 +585,7 @@ type Member struct {
 	UseTCP                   bool
 
 	IsLearner bool
+	IsWitness bool
 	Closed    bool
 
 	GrpcServerRecorder *grpc_testing.GrpcRecorder
@@ -605,6 +620,14 @@ type MemberConfig struct {
 	ExperimentalMaxLearners     int
 	StrictReconfigCheck         bool
 	CorruptCheckTime            time.Duration
+	LeaderPriorityCheckTime     time.Duration
+	LeaderPriorityCooldown      time.Duration
+	QuiesceTimeout              time.Duration
+	SnapshotDelegation          bool
+	PeerCompression             string
+	// EncryptionKeyFile enables encryption at rest with the key encryption
+	// keys of the given file.
+	EncryptionKeyFile string
 }
 
 // MustNewMember return an inited member with the given name. If peerTLS is
@@ -720,6 +743,14 @@ func MustNewMember(t testutil.TB, mcfg MemberConfig) *Member {
 	m.GrpcServerRecorder = &grpc_testing.GrpcRecorder{}
 	m.Logger = memberLogger(t, mcfg.Name)
 	m.StrictReconfigCheck = mcfg.StrictReconfigCheck
+	m.LeaderPriorityCheckTime = mcfg.LeaderPriorityCheckTime
+	m.LeaderPriorityCooldown = mcfg.LeaderPriorityCooldown
+	m.QuiesceTimeout = mcfg.QuiesceTimeout
+	m.SnapshotDelegation = mcfg.SnapshotDelegation
+	if mcfg.PeerCompression != "" {
+		m.PeerCompression = []config.PeerCompression{{Compression: mcfg.PeerCompression}}
+	}
+	m.EncryptionKeyFile = mcfg.EncryptionKeyFile
 	if err := m.listenGRPC(); err != nil {
 		t.Fatalf("listenGRPC FAILED: %v", err)
 	}
@@ -1544,6 +1575,37 @@ func (c *Cluster) AddAndLaunchLearnerMember(t testutil.TB) {
 	c.waitMembersMatch(t)
 }
 
+// AddAndLaunchWitnessMember creates a witness member, adds it to Cluster
+// via v3 MemberAdd API, and then launches the new member.
+func (c *Cluster) AddAndLaunchWitnessMember(t testutil.TB) {
+	m := c.mustNewMember(t)
+	m.IsWitness = true
+
+	scheme := SchemeFromTLSInfo(c.Cfg.PeerTLS)
+	peerURLs := []string{scheme + "://" + m.PeerListeners[0].Addr().String()}
+
+	cli := c.Client(0)
+	_, err := cli.MemberAddAsWitness(context.Background(), peerURLs)
+	if err != nil {
+		t.Fatalf("failed to add witness member %v", err)
+	}
+
+	m.InitialPeerURLsMap = types.URLsMap{}
+	for _, mm := range c.Members {
+		m.InitialPeerURLsMap[mm.Name] = mm.PeerURLs
+	}
+	m.InitialPeerURLsMap[m.Name] = m.PeerURLs
+	m.NewCluster = false
+
+	if err := m.Launch(); err != nil {
+		t.Fatal(err)
+	}
+
+	c.Members = append(c.Members, m)
+
+	c.waitMembersMatch(t)
+}
+
 // getMembers returns a list of members in Cluster, in format of etcdserverpb.Member
 func (c *Cluster) getMembers() []*pb.Member {
 	var mems []*pb.Member
@@ -1553,6 +1615,7 @@ func (c *Cluster) getMembers() []*pb.Member {
 			PeerURLs:   m.PeerURLs.StringSlice(),
 			ClientURLs: m.ClientURLs.StringSlice(),
 			IsLearner:  m.IsLearner,
+			IsWitness:  m.IsWitness,
 		}
 		mems = append(mems, mem)
 	}
@@ -1619,6 +1682,7 @@ func (p SortableProtoMemberSliceByPeerURLs) Swap(i, j int) { p[i], p[j] = p[j],
 func (c *Cluster) MustNewMember(t testutil.TB, resp *clientv3.MemberAddResponse) *Member {
 	m := c.mustNewMember(t)
 	m.IsLearner = resp.Member.IsLearner
+	m.IsWitness = resp.Member.IsWitness
 	m.NewCluster = false
 
 	m.InitialPeerURLsMap = types.URLsMap{}
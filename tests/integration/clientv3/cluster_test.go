@@ -23,7 +23,11 @@ import (
 	"testing"
 	"time"
 
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	"go.etcd.io/etcd/client/pkg/v3/fileutil"
 	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
 	integration2 "go.etcd.io/etcd/tests/v3/framework/integration"
 )
 
@@ -154,6 +158,45 @@ func TestMemberUpdate(t *testing.T) {
 	}
 }
 
+// TestMemberUpdateAutoPromotingLearner ensures that updating the peer URLs of
+// an auto-promoting learner keeps it an auto-promoting learner.
+func TestMemberUpdateAutoPromotingLearner(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	capi := clus.RandClient()
+	addResp, err := capi.MemberAddAsAutoPromotingLearner(context.Background(), []string{"http://127.0.0.1:1234"})
+	if err != nil {
+		t.Fatalf("failed to add member %v", err)
+	}
+	id := addResp.Member.ID
+
+	urls := []string{"http://127.0.0.1:1235"}
+	if _, err = capi.MemberUpdate(context.Background(), id, urls); err != nil {
+		t.Fatalf("failed to update member %v", err)
+	}
+
+	resp, err := capi.MemberList(context.Background())
+	if err != nil {
+		t.Fatalf("failed to list member %v", err)
+	}
+	for _, m := range resp.Members {
+		if m.ID != id {
+			continue
+		}
+		if !reflect.DeepEqual(m.PeerURLs, urls) {
+			t.Errorf("urls = %v, want %v", m.PeerURLs, urls)
+		}
+		if !m.IsLearner || !m.AutoPromote {
+			t.Errorf("member = %+v, want an auto-promoting learner", m)
+		}
+		return
+	}
+	t.Fatalf("member %x not found", id)
+}
+
 func TestMemberAddUpdateWrongURLs(t *testing.T) {
 	integration2.BeforeTest(t)
 
@@ -216,6 +259,97 @@ func TestMemberAddForLearner(t *testing.T) {
 	}
 }
 
+func TestMemberAddForWitness(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 2})
+	defer clus.Terminate(t)
+
+	clus.AddAndLaunchWitnessMember(t)
+
+	resp, err := clus.Client(0).MemberList(context.Background())
+	if err != nil {
+		t.Fatalf("failed to list member %v", err)
+	}
+	numberOfWitnesses := 0
+	for _, m := range resp.Members {
+		if m.IsWitness {
+			numberOfWitnesses++
+		}
+	}
+	if numberOfWitnesses != 1 {
+		t.Fatalf("added 1 witness node to cluster, got %d", numberOfWitnesses)
+	}
+
+	if _, err = clus.Client(0).Put(context.Background(), "foo", "bar"); err != nil {
+		t.Fatal(err)
+	}
+
+	// the witness serves no key-value requests and stores no key-value data
+	witness := clus.Members[2]
+	_, err = witness.Client.Get(context.Background(), "foo")
+	if err == nil || rpctypes.ErrorDesc(err) != rpctypes.ErrorDesc(rpctypes.ErrGRPCNotSupportedForWitness) {
+		t.Fatalf("expecting error %v, got %v", rpctypes.ErrGRPCNotSupportedForWitness, err)
+	}
+	rr, err := witness.Server.KV().Range(context.Background(), []byte("foo"), nil, mvcc.RangeOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rr.KVs) != 0 {
+		t.Fatalf("witness has %d keys, want 0", len(rr.KVs))
+	}
+
+	// the remaining data member and the witness still form a quorum
+	clus.Members[1].Stop(t)
+	clus.WaitMembersForLeader(t, []*integration2.Member{clus.Members[0], witness})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if _, err = clus.Client(0).Put(ctx, "foo", "baz"); err != nil {
+		t.Fatalf("failed to put with one data member stopped %v", err)
+	}
+}
+
+// TestWitnessRestart ensures a witness keeps no database file and recovers its
+// cluster membership from the snapshot and the WAL when it restarts.
+func TestWitnessRestart(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 2, SnapshotCount: 10})
+	defer clus.Terminate(t)
+
+	clus.AddAndLaunchWitnessMember(t)
+	witness := clus.Members[2]
+
+	// trigger snapshots on every member
+	for i := 0; i < 30; i++ {
+		if _, err := clus.Client(0).Put(context.Background(), "foo", fmt.Sprint(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	witness.Stop(t)
+	if err := witness.Restart(t); err != nil {
+		t.Fatal(err)
+	}
+	clus.WaitMembersForLeader(t, clus.Members)
+
+	if !witness.Server.IsWitness() {
+		t.Fatal("restarted member is not a witness")
+	}
+	if fileutil.Exist(witness.Server.Cfg.BackendPath()) {
+		t.Fatalf("witness has a database file %s", witness.Server.Cfg.BackendPath())
+	}
+
+	// the restarted witness still counts towards the quorum
+	clus.Members[1].Stop(t)
+	clus.WaitMembersForLeader(t, []*integration2.Member{clus.Members[0], witness})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if _, err := clus.Client(0).Put(ctx, "foo", "bar"); err != nil {
+		t.Fatalf("failed to put with one data member stopped %v", err)
+	}
+}
+
 func TestMemberPromote(t *testing.T) {
 	integration2.BeforeTest(t)
 
@@ -292,6 +426,56 @@ func TestMemberPromote(t *testing.T) {
 	}
 }
 
+// TestMemberAutoPromote ensures that the leader promotes a learner member added
+// with auto promotion once it has caught up.
+func TestMemberAutoPromote(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	capi := clus.RandClient()
+
+	urls := []string{"http://127.0.0.1:1234"}
+	memberAddResp, err := capi.MemberAddAsAutoPromotingLearner(context.Background(), urls)
+	if err != nil {
+		t.Fatalf("failed to add member %v", err)
+	}
+	if !memberAddResp.Member.IsLearner || !memberAddResp.Member.AutoPromote {
+		t.Fatalf("added an auto-promoting learner, got resp.Member = %+v", memberAddResp.Member)
+	}
+	learnerID := memberAddResp.Member.ID
+
+	learnerMember := clus.MustNewMember(t, memberAddResp)
+	if err := learnerMember.Launch(); err != nil {
+		t.Fatal(err)
+	}
+
+	// wait for the leader to promote the learner
+	timeout := time.After(10 * time.Second)
+	for {
+		select {
+		case <-time.After(500 * time.Millisecond):
+		case <-timeout:
+			t.Fatalf("learner member %x was not auto-promoted", learnerID)
+		}
+
+		resp, err := capi.MemberList(context.Background())
+		if err != nil {
+			t.Fatalf("failed to list member %v", err)
+		}
+		for _, m := range resp.Members {
+			if m.ID != learnerID || m.IsLearner {
+				continue
+			}
+			if m.AutoPromote {
+				t.Fatalf("promoted member %x is still marked for auto promotion", learnerID)
+			}
+			return
+		}
+	}
+}
+
 // TestMemberPromoteMemberNotLearner ensures that promoting a voting member fails.
 func TestMemberPromoteMemberNotLearner(t *testing.T) {
 	integration2.BeforeTest(t)
@@ -378,6 +562,74 @@ func TestMemberPromoteMemberNotExist(t *testing.T) {
 }
 
 // TestMaxLearnerInCluster verifies that the maximum number of learners allowed in a cluster
+func TestMemberReconfigure(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	capi := clus.Client(1)
+	resp, err := capi.MemberList(context.Background())
+	if err != nil {
+		t.Fatalf("failed to list member %v", err)
+	}
+
+	// find member that is not the client to replace
+	var rmvID uint64
+	for _, m := range resp.Members {
+		mURLs, _ := types.NewURLs(m.PeerURLs)
+		if !reflect.DeepEqual(mURLs, clus.Members[1].ServerConfig.PeerURLs) {
+			rmvID = m.ID
+			break
+		}
+	}
+
+	// promoting a voting member fails the whole reconfiguration;
+	// promotions are checked by the leader.
+	leaderIdx := clus.WaitLeader(t)
+	_, err = clus.Client(leaderIdx).MemberReconfigure(context.Background(),
+		clientv3.ReconfigureAdd([]string{"http://127.0.0.1:1234"}),
+		clientv3.ReconfigurePromote(rmvID),
+	)
+	if err == nil || !strings.Contains(err.Error(), "can only promote a learner member") {
+		t.Fatalf("expecting reconfiguration to fail, got %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	rcResp, err := capi.MemberReconfigure(ctx,
+		clientv3.ReconfigureAdd([]string{"http://127.0.0.1:1234"}),
+		clientv3.ReconfigureAddAsLearner([]string{"http://127.0.0.1:1235"}),
+		clientv3.ReconfigureRemove(rmvID),
+	)
+	if err != nil {
+		t.Fatalf("failed to reconfigure members %v", err)
+	}
+	if len(rcResp.Added) != 2 {
+		t.Fatalf("number of added members = %d, want %d", len(rcResp.Added), 2)
+	}
+
+	resp, err = capi.MemberList(context.Background())
+	if err != nil {
+		t.Fatalf("failed to list member %v", err)
+	}
+	if len(resp.Members) != 4 {
+		t.Fatalf("number of members = %d, want %d", len(resp.Members), 4)
+	}
+	ids := make(map[uint64]bool)
+	for _, m := range resp.Members {
+		ids[m.ID] = true
+	}
+	if ids[rmvID] {
+		t.Errorf("removed member %x is still in the cluster", rmvID)
+	}
+	for _, m := range rcResp.Added {
+		if !ids[m.ID] {
+			t.Errorf("added member %x is not in the cluster", m.ID)
+		}
+	}
+}
+
 func TestMaxLearnerInCluster(t *testing.T) {
 	integration2.BeforeTest(t)
 
@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"reflect"
 	"strconv"
@@ -107,6 +108,60 @@ func TestKVPutWithLease(t *testing.T) {
 	}
 }
 
+// TestKVPutWithTTL ensures that Put and Txn with WithTTL attach the key to a
+// lease with the given TTL.
+func TestKVPutWithTTL(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clus.RandClient()
+	ctx := context.TODO()
+
+	_, err := kv.Put(ctx, "foo", "", clientv3.WithIgnoreValue(), clientv3.WithTTL(10))
+	if err != rpctypes.ErrKeyNotFound {
+		t.Fatalf("err expected %v, got %v", rpctypes.ErrKeyNotFound, err)
+	}
+
+	if _, err = kv.Put(ctx, "foo", "bar", clientv3.WithTTL(10)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = kv.Txn(ctx).Then(clientv3.OpPut("foo2", "bar", clientv3.WithTTL(10))).Commit(); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := kv.Get(ctx, "foo", clientv3.WithPrefix())
+	if err != nil {
+		t.Fatalf("couldn't get key (%v)", err)
+	}
+	if len(resp.Kvs) != 2 || len(resp.RemainingTtls) != 2 {
+		t.Fatalf("expected 2 keys with remaining TTLs, got %+v", resp)
+	}
+	for i, kv := range resp.Kvs {
+		if kv.Lease == 0 {
+			t.Errorf("%q has no lease", kv.Key)
+		}
+		if ttl := resp.RemainingTtls[i]; ttl < 1 || ttl > 10 {
+			t.Errorf("remaining TTL of %q = %d, want 1..10", kv.Key, ttl)
+		}
+	}
+	if resp.Kvs[0].Lease == resp.Kvs[1].Lease {
+		t.Errorf("keys share lease %x", resp.Kvs[0].Lease)
+	}
+
+	// a put with WithIgnoreLease keeps the key's TTL
+	if _, err = kv.Put(ctx, "foo", "baz", clientv3.WithIgnoreLease()); err != nil {
+		t.Fatal(err)
+	}
+	lresp, err := clus.RandClient().TimeToLive(ctx, clientv3.LeaseID(resp.Kvs[0].Lease), clientv3.WithAttachedKeys())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lresp.Keys) != 1 || string(lresp.Keys[0]) != "foo" {
+		t.Errorf("keys of lease %x = %q, want [foo]", resp.Kvs[0].Lease, lresp.Keys)
+	}
+}
+
 // TestKVPutWithIgnoreValue ensures that Put with WithIgnoreValue does not clobber the old value.
 func TestKVPutWithIgnoreValue(t *testing.T) {
 	integration2.BeforeTest(t)
@@ -287,6 +342,218 @@ func TestKVRange(t *testing.T) {
 	}
 }
 
+func TestKVRangeValueFilter(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clus.RandClient()
+	ctx := context.TODO()
+
+	kvSet := [][2]string{
+		{"pods/a", `{"metadata":{"name":"a"},"spec":{"node":"n1"}}`},
+		{"pods/b", `{"metadata":{"name":"b"},"spec":{"node":"n2"}}`},
+		{"pods/c", `{"metadata":{"name":"c"},"spec":{"node":"n1"}}`},
+		{"raw", "bar"},
+	}
+	for i, kvp := range kvSet {
+		if _, err := kv.Put(ctx, kvp[0], kvp[1]); err != nil {
+			t.Fatalf("#%d: couldn't put %q (%v)", i, kvp[0], err)
+		}
+	}
+
+	tests := []struct {
+		key  string
+		opts []clientv3.OpOption
+
+		wkeys   []string
+		wvalues []string
+		wcount  int64
+	}{
+		{
+			"pods/",
+			[]clientv3.OpOption{clientv3.WithPrefix(), clientv3.WithValueJSONField("spec.node", "n1")},
+			[]string{"pods/a", "pods/c"},
+			[]string{kvSet[0][1], kvSet[2][1]},
+			2,
+		},
+		{
+			"pods/",
+			[]clientv3.OpOption{clientv3.WithPrefix(), clientv3.WithValueJSONField("spec.node", "n1"), clientv3.WithLimit(1)},
+			[]string{"pods/a"},
+			[]string{kvSet[0][1]},
+			2,
+		},
+		{
+			"pods/",
+			[]clientv3.OpOption{clientv3.WithPrefix(), clientv3.WithValueRegex(`"node":"n2"`), clientv3.WithValueFields("metadata.name")},
+			[]string{"pods/b"},
+			[]string{`{"metadata":{"name":"b"}}`},
+			1,
+		},
+		{
+			"",
+			[]clientv3.OpOption{clientv3.WithFromKey(), clientv3.WithValuePrefix("ba")},
+			[]string{"raw"},
+			[]string{"bar"},
+			1,
+		},
+		{
+			"pods/",
+			[]clientv3.OpOption{clientv3.WithPrefix(), clientv3.WithValueEqual("bar"), clientv3.WithCountOnly()},
+			nil,
+			nil,
+			0,
+		},
+	}
+
+	for i, tt := range tests {
+		resp, err := kv.Get(ctx, tt.key, tt.opts...)
+		if err != nil {
+			t.Fatalf("#%d: couldn't range (%v)", i, err)
+		}
+		var keys, values []string
+		for _, kv := range resp.Kvs {
+			keys = append(keys, string(kv.Key))
+			values = append(values, string(kv.Value))
+		}
+		if !reflect.DeepEqual(tt.wkeys, keys) {
+			t.Errorf("#%d: keys expected %v, got %v", i, tt.wkeys, keys)
+		}
+		if !reflect.DeepEqual(tt.wvalues, values) {
+			t.Errorf("#%d: values expected %v, got %v", i, tt.wvalues, values)
+		}
+		if resp.Count != tt.wcount {
+			t.Errorf("#%d: count expected %d, got %d", i, tt.wcount, resp.Count)
+		}
+	}
+
+	if _, err := kv.Get(ctx, "pods/", clientv3.WithPrefix(), clientv3.WithValueRegex("(")); err != rpctypes.ErrInvalidValueFilter {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrInvalidValueFilter, err)
+	}
+}
+
+// TestKVRangeContinueToken ensures paginated ranges read every page at the
+// revision of the first page and fail once that revision is compacted.
+func TestKVRangeContinueToken(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clus.RandClient()
+	ctx := context.TODO()
+
+	keySet := []string{"foo/a", "foo/b", "foo/c", "foo/d", "foo/e"}
+	for i, key := range keySet {
+		if _, err := kv.Put(ctx, key, ""); err != nil {
+			t.Fatalf("#%d: couldn't put %q (%v)", i, key, err)
+		}
+	}
+
+	var keys []string
+	it := clientv3.NewRangeIterator(kv, "foo/", 2, clientv3.WithPrefix())
+	for i := 0; it.Next(ctx); i++ {
+		for _, kv := range it.Response().Kvs {
+			keys = append(keys, string(kv.Key))
+		}
+		if i == 0 {
+			// keys written after the first page must not show up
+			if _, err := kv.Put(ctx, "foo/f", ""); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(keySet, keys) {
+		t.Fatalf("keys expected %v, got %v", keySet, keys)
+	}
+
+	resp, err := kv.Get(ctx, "foo/", clientv3.WithPrefix(), clientv3.WithLimit(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.More || len(resp.ContinueToken) == 0 {
+		t.Fatalf("expected continue token, got more %v, token %q", resp.More, resp.ContinueToken)
+	}
+	if _, err = kv.Get(ctx, "bar/", clientv3.WithPrefix(), clientv3.WithLimit(2), clientv3.WithContinueToken(resp.ContinueToken)); err != rpctypes.ErrInvalidContinueToken {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrInvalidContinueToken, err)
+	}
+
+	if _, err = kv.Put(ctx, "foo/g", ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = kv.Compact(ctx, resp.Header.Revision+1); err != nil {
+		t.Fatal(err)
+	}
+	_, err = kv.Get(ctx, "foo/", clientv3.WithPrefix(), clientv3.WithLimit(2), clientv3.WithContinueToken(resp.ContinueToken))
+	if err != rpctypes.ErrExpiredContinueToken {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrExpiredContinueToken, err)
+	}
+}
+
+// TestKVGetStream ensures a streamed range returns every key of the range
+// in chunks read at the revision of the first chunk.
+func TestKVGetStream(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clus.RandClient()
+	ctx := context.TODO()
+
+	var keySet []string
+	for i := 0; i < 1500; i++ {
+		key := fmt.Sprintf("foo/%04d", i)
+		if _, err := kv.Put(ctx, key, ""); err != nil {
+			t.Fatalf("#%d: couldn't put %q (%v)", i, key, err)
+		}
+		keySet = append(keySet, key)
+	}
+
+	rs, err := kv.GetStream(ctx, "foo/", clientv3.WithPrefix())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var (
+		keys   []string
+		chunks int
+		last   *clientv3.GetResponse
+	)
+	for {
+		resp, err := rs.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if chunks == 0 {
+			// keys written after the first chunk must not show up
+			if _, err = kv.Put(ctx, "foo/9999", ""); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for _, kv := range resp.Kvs {
+			keys = append(keys, string(kv.Key))
+		}
+		chunks, last = chunks+1, resp
+	}
+	if chunks < 2 {
+		t.Fatalf("expected the range to be streamed in more than one chunk, got %d", chunks)
+	}
+	if !reflect.DeepEqual(keySet, keys) {
+		t.Fatalf("expected %d keys, got %d", len(keySet), len(keys))
+	}
+	if last.More || last.Count != int64(len(keySet)) {
+		t.Fatalf("expected more false and count %d, got %v and %d", len(keySet), last.More, last.Count)
+	}
+}
+
 func TestKVGetErrConnClosed(t *testing.T) {
 	integration2.BeforeTest(t)
 
@@ -918,6 +918,69 @@ func TestWatchWithFilter(t *testing.T) {
 	}
 }
 
+// TestWatchWithValueFilters checks that the value prefix, lease and key regex
+// filters drop events that do not match them.
+func TestWatchWithValueFilters(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	cluster := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer cluster.Terminate(t)
+
+	client := cluster.RandClient()
+	ctx := context.Background()
+
+	lresp, err := client.Grant(ctx, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wcValue := client.Watch(ctx, "a", clientv3.WithPrefix(), clientv3.WithFilterValuePrefix("v1"))
+	wcLease := client.Watch(ctx, "a", clientv3.WithPrefix(), clientv3.WithFilterLease(lresp.ID))
+	wcRegex := client.Watch(ctx, "a", clientv3.WithPrefix(), clientv3.WithFilterKeyRegex("^a[0-9]+$"))
+
+	if _, err = client.Put(ctx, "ab", "v2"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.Put(ctx, "a1", "v1", clientv3.WithLease(lresp.ID)); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, wc := range []clientv3.WatchChan{wcValue, wcLease, wcRegex} {
+		resp := <-wc
+		if len(resp.Events) != 1 || string(resp.Events[0].Kv.Key) != "a1" {
+			t.Fatalf("#%d: expected put event on a1, got %+v", i, resp.Events)
+		}
+	}
+
+	select {
+	case resp := <-wcValue:
+		t.Fatalf("unexpected event on value prefix filter (%+v)", resp)
+	case resp := <-wcLease:
+		t.Fatalf("unexpected event on lease filter (%+v)", resp)
+	case resp := <-wcRegex:
+		t.Fatalf("unexpected event on key regex filter (%+v)", resp)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// the lease filter keeps the deletes of the keys attached to the lease
+	if _, err = client.Delete(ctx, "ab"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.Delete(ctx, "a1"); err != nil {
+		t.Fatal(err)
+	}
+	dresp := <-wcLease
+	if len(dresp.Events) != 1 || dresp.Events[0].Type != clientv3.EventTypeDelete || string(dresp.Events[0].Kv.Key) != "a1" {
+		t.Fatalf("expected delete event on a1, got %+v", dresp.Events)
+	}
+
+	wcBad := client.Watch(ctx, "a", clientv3.WithFilterKeyRegex("("))
+	resp, ok := <-wcBad
+	if !ok || !resp.Canceled || resp.Err() != rpctypes.ErrInvalidWatchFilter {
+		t.Fatalf("expected canceled watch with %v, got %+v (err %v)", rpctypes.ErrInvalidWatchFilter, resp, resp.Err())
+	}
+}
+
 // TestWatchWithCreatedNotification checks that WithCreatedNotify returns a
 // Created watch response.
 func TestWatchWithCreatedNotification(t *testing.T) {
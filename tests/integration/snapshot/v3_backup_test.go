@@ -0,0 +1,172 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.etcd.io/etcd/client/pkg/v3/fileutil"
+	"go.etcd.io/etcd/client/pkg/v3/testutil"
+	"go.etcd.io/etcd/client/v3"
+	clientsnapshot "go.etcd.io/etcd/client/v3/snapshot"
+	"go.etcd.io/etcd/etcdutl/v3/snapshot"
+	"go.etcd.io/etcd/server/v3/embed"
+	integration2 "go.etcd.io/etcd/tests/v3/framework/integration"
+	"go.uber.org/zap/zaptest"
+)
+
+// TestSnapshotV3RestoreBackup ensures that a member restored from a
+// continuous backup holds the data as of the requested revision.
+func TestSnapshotV3RestoreBackup(t *testing.T) {
+	integration2.BeforeTest(t)
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+	cli := clus.Client(0)
+
+	put := func(k, v string) int64 {
+		ctx, cancel := context.WithTimeout(context.Background(), testutil.RequestTimeout)
+		defer cancel()
+		resp, err := cli.Put(ctx, k, v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.Header.Revision
+	}
+	put("foo1", "bar1")
+
+	dir := filepath.Join(t.TempDir(), "backup")
+	ctx, cancel := context.WithCancel(context.Background())
+	donec := make(chan error, 1)
+	go func() {
+		ccfg := clientv3.Config{Endpoints: []string{clus.Members[0].GRPCURL()}}
+		donec <- clientsnapshot.StreamBackup(ctx, zaptest.NewLogger(t), ccfg, dir)
+	}()
+	defer func() {
+		cancel()
+		<-donec
+	}()
+
+	// wait for the base snapshot, later changes are replayed from segments
+	for !fileutil.Exist(clientsnapshot.BackupSnapshotPath(dir)) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	put("foo2", "bar2")
+	rev := put("foo1", "baz1")
+	if _, err := cli.Delete(context.Background(), "foo2"); err != nil {
+		t.Fatal(err)
+	}
+	last := put("foo3", "bar3")
+
+	// wait for the backup to save every change
+	for {
+		var got int64
+		if err := clientsnapshot.ReadBackup(dir, func(r clientsnapshot.BackupRecord) error {
+			got = r.Revision
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if got >= last {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	cURLs, pURLs := newEmbedURLs(t, 1), newEmbedURLs(t, 1)
+	cfg := integration2.NewEmbedConfig(t, "s1")
+	cfg.InitialClusterToken = testClusterTkn
+	cfg.ClusterState = "existing"
+	cfg.LCUrls, cfg.ACUrls = cURLs, cURLs
+	cfg.LPUrls, cfg.APUrls = pURLs, pURLs
+	cfg.InitialCluster = fmt.Sprintf("%s=%s", cfg.Name, pURLs[0].String())
+
+	sp := snapshot.NewV3(zaptest.NewLogger(t))
+	if err := sp.Restore(snapshot.RestoreConfig{
+		SnapshotPath:        dir,
+		Name:                cfg.Name,
+		OutputDataDir:       cfg.Dir,
+		InitialCluster:      cfg.InitialCluster,
+		InitialClusterToken: cfg.InitialClusterToken,
+		PeerURLs:            []string{pURLs[0].String()},
+		ToRevision:          rev,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	srv, err := embed.StartEtcd(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer srv.Close()
+	select {
+	case <-srv.Server.ReadyNotify():
+	case <-time.After(3 * time.Second):
+		t.Fatalf("failed to start restored etcd member")
+	}
+
+	rcli, err := integration2.NewClient(t, clientv3.Config{Endpoints: []string{cfg.ACUrls[0].String()}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rcli.Close()
+	gresp, err := rcli.Get(context.Background(), "foo", clientv3.WithPrefix())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gresp.Header.Revision != rev {
+		t.Fatalf("revision expected %d, got %d", rev, gresp.Header.Revision)
+	}
+	wkvs := []kv{{"foo1", "baz1"}, {"foo2", "bar2"}}
+	if len(gresp.Kvs) != len(wkvs) {
+		t.Fatalf("keys expected %v, got %v", wkvs, gresp.Kvs)
+	}
+	for i := range wkvs {
+		if string(gresp.Kvs[i].Key) != wkvs[i].k || string(gresp.Kvs[i].Value) != wkvs[i].v {
+			t.Fatalf("#%d: expected %v, got %s=%s", i, wkvs[i], gresp.Kvs[i].Key, gresp.Kvs[i].Value)
+		}
+	}
+}
+
+// TestSnapshotV3BackupEncryptedAtRest ensures that no continuous backup is
+// taken of a member encrypting its data at rest, since the segments would
+// hold its changes in plaintext.
+func TestSnapshotV3BackupEncryptedAtRest(t *testing.T) {
+	integration2.BeforeTest(t)
+	keyFile := filepath.Join(t.TempDir(), "kek")
+	kek := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32))
+	if err := os.WriteFile(keyFile, []byte("kek1:"+kek+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1, EncryptionKeyFile: keyFile})
+	defer clus.Terminate(t)
+
+	dir := filepath.Join(t.TempDir(), "backup")
+	ctx, cancel := context.WithTimeout(context.Background(), testutil.RequestTimeout)
+	defer cancel()
+	ccfg := clientv3.Config{Endpoints: []string{clus.Members[0].GRPCURL()}}
+	if err := clientsnapshot.StreamBackup(ctx, zaptest.NewLogger(t), ccfg, dir); err != clientsnapshot.ErrBackupEncryptedAtRest {
+		t.Fatalf("StreamBackup error = %v, want %v", err, clientsnapshot.ErrBackupEncryptedAtRest)
+	}
+	if fileutil.Exist(clientsnapshot.BackupSnapshotPath(dir)) {
+		t.Errorf("expected no base snapshot to be saved")
+	}
+}
@@ -16,6 +16,7 @@ package integration
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 	"sync"
@@ -23,8 +24,10 @@ import (
 	"time"
 
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
 	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	"go.etcd.io/etcd/pkg/v3/traceutil"
+	"go.etcd.io/etcd/server/v3/config"
 	"go.etcd.io/etcd/server/v3/lease/leasepb"
 	"go.etcd.io/etcd/server/v3/storage/backend"
 	"go.etcd.io/etcd/server/v3/storage/mvcc"
@@ -137,6 +140,72 @@ func TestV3StorageQuotaApply(t *testing.T) {
 	}
 }
 
+// TestV3PrefixQuota ensures that writes over a prefix quota are rejected
+// without raising an alarm and that the prefix usage survives a restart.
+func TestV3PrefixQuota(t *testing.T) {
+	integration.BeforeTest(t)
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	clus.Members[0].ExperimentalPrefixQuotas = []config.PrefixQuota{{Prefix: "quota/", MaxBytes: 64, MaxKeys: 2}}
+	clus.Members[0].Stop(t)
+	clus.Members[0].Restart(t)
+	clus.WaitMembersForLeader(t, clus.Members)
+	kvc := integration.ToGRPC(clus.Client(0)).KV
+	waitForRestart(t, kvc)
+
+	ctx := context.TODO()
+	if _, err := kvc.Put(ctx, &pb.PutRequest{Key: []byte("quota/a"), Value: []byte("abc")}); err != nil {
+		t.Fatal(err)
+	}
+	// the value is over the byte limit
+	if _, err := kvc.Put(ctx, &pb.PutRequest{Key: []byte("quota/b"), Value: make([]byte, 64)}); !eqErrGRPC(err, rpctypes.ErrGRPCPrefixQuotaExceeded) {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrGRPCPrefixQuotaExceeded, err)
+	}
+	// keys outside of the prefix are not limited
+	if _, err := kvc.Put(ctx, &pb.PutRequest{Key: []byte("other"), Value: make([]byte, 64)}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := kvc.Put(ctx, &pb.PutRequest{Key: []byte("quota/b"), Value: []byte("abc")}); err != nil {
+		t.Fatal(err)
+	}
+	// the third key is over the key limit, but overwriting a key is not
+	txn := &pb.TxnRequest{Success: []*pb.RequestOp{{Request: &pb.RequestOp_RequestPut{
+		RequestPut: &pb.PutRequest{Key: []byte("quota/c"), Value: []byte("abc")}}}}}
+	if _, err := kvc.Txn(ctx, txn); !eqErrGRPC(err, rpctypes.ErrGRPCPrefixQuotaExceeded) {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrGRPCPrefixQuotaExceeded, err)
+	}
+	if _, err := kvc.Put(ctx, &pb.PutRequest{Key: []byte("quota/b"), Value: []byte("abcdef")}); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := clus.Members[0].Server.Alarm(ctx, &pb.AlarmRequest{Action: pb.AlarmRequest_GET})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Alarms) != 0 {
+		t.Fatalf("expected no alarms, got %+v", resp.Alarms)
+	}
+
+	clus.Members[0].Stop(t)
+	clus.Members[0].Restart(t)
+	clus.WaitMembersForLeader(t, clus.Members)
+	waitForRestart(t, integration.ToGRPC(clus.Client(0)).KV)
+
+	sresp, err := integration.ToGRPC(clus.Client(0)).Maintenance.PrefixQuotaStatus(ctx, &pb.PrefixQuotaStatusRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sresp.Usages) != 1 {
+		t.Fatalf("expected 1 prefix quota, got %d", len(sresp.Usages))
+	}
+	u := sresp.Usages[0]
+	if string(u.Prefix) != "quota/" || u.UsedBytes != 23 || u.UsedKeys != 2 {
+		t.Fatalf("unexpected prefix quota usage %+v", u)
+	}
+}
+
 // TestV3AlarmDeactivate ensures that space alarms can be deactivated so puts go through.
 func TestV3AlarmDeactivate(t *testing.T) {
 	integration.BeforeTest(t)
@@ -352,3 +421,72 @@ func TestV3CorruptAlarmWithLeaseCorrupted(t *testing.T) {
 		}
 	}
 }
+
+func TestV3CorruptionCheck(t *testing.T) {
+	integration.BeforeTest(t)
+	lg := zaptest.NewLogger(t)
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	for i := 0; i < 200; i++ {
+		if _, err := clus.Client(0).Put(context.TODO(), fmt.Sprintf("k%03d", i), "v"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	clus.WaitMembersForLeader(t, clus.Members)
+	resp, err := clus.Client(0).CorruptionCheck(context.TODO(), 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Members) != 3 || len(resp.Ranges) != 0 {
+		t.Fatalf("expected 3 members without divergent ranges, got %+v", resp)
+	}
+
+	// Corrupt the value of k042 on member 0 by modifying backend offline.
+	clus.Members[0].Stop(t)
+	fp := filepath.Join(clus.Members[0].DataDir, "member", "snap", "db")
+	be := backend.NewDefaultBackend(lg, fp)
+	tx := be.BatchTx()
+	tx.LockOutsideApply()
+	keys, vals := tx.UnsafeRange(schema.Key, []byte{0}, []byte{0xff}, 0)
+	for i := range keys {
+		var kv mvccpb.KeyValue
+		if err := kv.Unmarshal(vals[i]); err != nil {
+			t.Fatal(err)
+		}
+		if string(kv.Key) == "k042" {
+			kv.Value = []byte("corrupt")
+			v, err := kv.Marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+			tx.UnsafePut(schema.Key, keys[i], v)
+		}
+	}
+	tx.Unlock()
+	be.ForceCommit()
+	be.Close()
+	if err := clus.Members[0].Restart(t); err != nil {
+		t.Fatal(err)
+	}
+	clus.WaitMembersForLeader(t, clus.Members)
+
+	resp, err = clus.Client(1).CorruptionCheck(context.TODO(), 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Ranges) != 1 || string(resp.Ranges[0].Key) != "k042" || len(resp.Ranges[0].RangeEnd) != 0 {
+		t.Fatalf("expected divergent key k042, got %+v", resp.Ranges)
+	}
+	hashes := make(map[uint64]uint64)
+	for _, h := range resp.Ranges[0].Hashes {
+		if h.Count != 1 {
+			t.Errorf("expected k042 on member %x, got %+v", h.MemberID, h)
+		}
+		hashes[h.MemberID] = h.Hash
+	}
+	id0, id1, id2 := uint64(clus.Members[0].ID()), uint64(clus.Members[1].ID()), uint64(clus.Members[2].ID())
+	if len(hashes) != 3 || hashes[id1] != hashes[id2] || hashes[id0] == hashes[id1] {
+		t.Errorf("expected member %x to diverge from the others, got %+v", id0, resp.Ranges[0].Hashes)
+	}
+}
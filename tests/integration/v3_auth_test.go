@@ -421,3 +421,143 @@ func TestV3AuthOldRevConcurrent(t *testing.T) {
 	}
 	wg.Wait()
 }
+
+func TestV3AuthRateLimit(t *testing.T) {
+	integration.BeforeTest(t)
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	users := []user{
+		{
+			name:     "user1",
+			password: "user1-123",
+			role:     "role1",
+			key:      "k1",
+			end:      "k2",
+		},
+	}
+	authSetupUsers(t, integration.ToGRPC(clus.Client(0)).Auth, users)
+	authSetupRoot(t, integration.ToGRPC(clus.Client(0)).Auth)
+
+	rootc, cerr := integration.NewClient(t, clientv3.Config{Endpoints: clus.Client(0).Endpoints(), Username: "root", Password: "123"})
+	if cerr != nil {
+		t.Fatal(cerr)
+	}
+	defer rootc.Close()
+
+	if _, err := rootc.UserSetRateLimit(context.TODO(), "user1", &clientv3.RateLimit{RequestsPerSecond: 5, Burst: 1}); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := rootc.UserGet(context.TODO(), "user1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.RateLimit == nil || resp.RateLimit.RequestsPerSecond != 5 || resp.RateLimit.Burst != 1 {
+		t.Fatalf("unexpected rate limit %v", resp.RateLimit)
+	}
+
+	userc, cerr := integration.NewClient(t, clientv3.Config{Endpoints: clus.Client(0).Endpoints(), Username: "user1", Password: "user1-123"})
+	if cerr != nil {
+		t.Fatal(cerr)
+	}
+	defer userc.Close()
+
+	// rate limited reads are retried by the client after a backoff
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		if _, err = userc.Get(context.TODO(), "k1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 500*time.Millisecond {
+		t.Fatalf("expected rate limited gets to take at least 500ms, took %v", elapsed)
+	}
+
+	// the limit of a role is shared by its users
+	if _, err = rootc.UserSetRateLimit(context.TODO(), "user1", nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = rootc.RoleSetRateLimit(context.TODO(), "role1", &clientv3.RateLimit{RequestsPerSecond: 0.001, Burst: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = userc.Put(context.TODO(), "k1", "val"); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.TODO(), 500*time.Millisecond)
+	_, err = userc.Get(ctx, "k1")
+	cancel()
+	if err != context.DeadlineExceeded {
+		t.Fatalf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+	// writes are not retried by the client
+	if _, err = userc.Put(context.TODO(), "k1", "val"); err != rpctypes.ErrRateLimitExceeded {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrRateLimitExceeded, err)
+	}
+
+	// root is not limited by the role of user1
+	if _, err = rootc.Put(context.TODO(), "k1", "val"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestV3AuthRateLimitStream(t *testing.T) {
+	integration.BeforeTest(t)
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	users := []user{
+		{
+			name:     "user1",
+			password: "user1-123",
+			role:     "role1",
+			key:      "k1",
+			end:      "k2",
+		},
+	}
+	authSetupUsers(t, integration.ToGRPC(clus.Client(0)).Auth, users)
+	authSetupRoot(t, integration.ToGRPC(clus.Client(0)).Auth)
+
+	rootc, cerr := integration.NewClient(t, clientv3.Config{Endpoints: clus.Client(0).Endpoints(), Username: "root", Password: "123"})
+	if cerr != nil {
+		t.Fatal(cerr)
+	}
+	defer rootc.Close()
+
+	userc, cerr := integration.NewClient(t, clientv3.Config{Endpoints: clus.Client(0).Endpoints(), Username: "user1", Password: "user1-123"})
+	if cerr != nil {
+		t.Fatal(cerr)
+	}
+	defer userc.Close()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	defer cancel()
+	ws, err := pb.NewWatchClient(userc.ActiveConnection()).Watch(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = rootc.UserSetRateLimit(context.TODO(), "user1", &clientv3.RateLimit{RequestsPerSecond: 0.001, Burst: 2}); err != nil {
+		t.Fatal(err)
+	}
+
+	// every request sent on the open stream is charged, so the third one
+	// exceeds the burst of the user.
+	for i := 0; i < 3; i++ {
+		req := &pb.WatchRequest{RequestUnion: &pb.WatchRequest_CreateRequest{
+			CreateRequest: &pb.WatchCreateRequest{Key: []byte("k1")}}}
+		if err = ws.Send(req); err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+	}
+	for i := 0; i < 2; i++ {
+		resp, rerr := ws.Recv()
+		if rerr != nil {
+			t.Fatalf("#%d: %v", i, rerr)
+		}
+		if !resp.Created {
+			t.Fatalf("#%d: expected created watch response, got %v", i, resp)
+		}
+	}
+	if _, err = ws.Recv(); rpctypes.Error(err) != rpctypes.ErrRateLimitExceeded {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrRateLimitExceeded, err)
+	}
+}
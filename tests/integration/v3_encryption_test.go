@@ -0,0 +1,152 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integration
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/tests/v3/framework/integration"
+)
+
+// TestV3EncryptionAtRest ensures that an encrypted cluster stores no value in
+// plaintext, and that a member restarted behind a compacted log recovers
+// from the encrypted snapshot of the leader.
+func TestV3EncryptionAtRest(t *testing.T) {
+	integration.BeforeTest(t)
+
+	keyFile := filepath.Join(t.TempDir(), "kek")
+	kek := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32))
+	if err := os.WriteFile(keyFile, []byte("kek1:"+kek+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{
+		Size:                   3,
+		SnapshotCount:          10,
+		SnapshotCatchUpEntries: 5,
+		EncryptionKeyFile:      keyFile,
+	})
+	defer clus.Terminate(t)
+
+	val := "secret-value-of-TestV3EncryptionAtRest"
+	kvc := integration.ToGRPC(clus.RandClient()).KV
+	if _, err := kvc.Put(context.TODO(), &pb.PutRequest{Key: []byte("foo"), Value: []byte(val)}); err != nil {
+		t.Fatalf("couldn't put key (%v)", err)
+	}
+
+	lead := clus.WaitLeader(t)
+	follower := (lead + 1) % 3
+	clus.Members[follower].Stop(t)
+
+	// compact the log of the leader past the entries the follower misses.
+	kvc = integration.ToGRPC(clus.Client(lead)).KV
+	for i := 0; i < 30; i++ {
+		if _, err := kvc.Put(context.TODO(), &pb.PutRequest{Key: []byte(fmt.Sprintf("k%d", i)), Value: []byte(val)}); err != nil {
+			t.Fatalf("couldn't put key (%v)", err)
+		}
+	}
+	if err := clus.Members[follower].Restart(t); err != nil {
+		t.Fatal(err)
+	}
+
+	kvc = integration.ToGRPC(clus.Client(follower)).KV
+	timeout := time.After(10 * time.Second)
+	for {
+		resp, err := kvc.Range(context.TODO(), &pb.RangeRequest{Key: []byte("k29"), Serializable: true})
+		if err == nil && len(resp.Kvs) == 1 && string(resp.Kvs[0].Value) == val {
+			break
+		}
+		select {
+		case <-timeout:
+			t.Fatalf("restarted member did not catch up (%v)", err)
+		case <-time.After(integration.TickDuration):
+		}
+	}
+
+	for _, m := range clus.Members {
+		m.Server.Backend().ForceCommit()
+		filepath.Walk(m.DataDir, func(path string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() {
+				return err
+			}
+			b, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			if bytes.Contains(b, []byte(val)) {
+				t.Errorf("%s holds a value in plaintext", path)
+			}
+			return nil
+		})
+	}
+}
+
+// TestV3EncryptionAtRestWitness ensures that a witness, which keeps no
+// database file, persists its data encryption keys and can decrypt its WAL
+// when it restarts.
+func TestV3EncryptionAtRestWitness(t *testing.T) {
+	integration.BeforeTest(t)
+
+	keyFile := filepath.Join(t.TempDir(), "kek")
+	kek := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32))
+	if err := os.WriteFile(keyFile, []byte("kek1:"+kek+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 2, EncryptionKeyFile: keyFile})
+	defer clus.Terminate(t)
+
+	clus.AddAndLaunchWitnessMember(t)
+	witness := clus.Members[2]
+
+	val := "secret-value-of-TestV3EncryptionAtRestWitness"
+	kvc := integration.ToGRPC(clus.Client(0)).KV
+	for i := 0; i < 5; i++ {
+		if _, err := kvc.Put(context.TODO(), &pb.PutRequest{Key: []byte(fmt.Sprintf("k%d", i)), Value: []byte(val)}); err != nil {
+			t.Fatalf("couldn't put key (%v)", err)
+		}
+	}
+
+	witness.Stop(t)
+	if err := witness.Restart(t); err != nil {
+		t.Fatal(err)
+	}
+	clus.WaitMembersForLeader(t, clus.Members)
+
+	if _, err := os.Stat(witness.Server.Cfg.KeyringPath()); err != nil {
+		t.Fatalf("witness has no keyring file (%v)", err)
+	}
+	filepath.Walk(witness.DataDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if bytes.Contains(b, []byte(val)) {
+			t.Errorf("%s holds a value in plaintext", path)
+		}
+		return nil
+	})
+}
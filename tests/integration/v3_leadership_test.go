@@ -272,3 +272,92 @@ func checkFirstCommitNotification(
 
 	return nil
 }
+
+// TestLeaderPriority ensures that the leader hands leadership over to the member
+// with the highest leader priority, and that updating the member's peer URLs keeps it.
+func TestLeaderPriority(t *testing.T) {
+	integration.BeforeTest(t)
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{
+		Size:                    3,
+		LeaderPriorityCheckTime: 100 * time.Millisecond,
+		LeaderPriorityCooldown:  100 * time.Millisecond,
+	})
+	defer clus.Terminate(t)
+
+	leadIdx := clus.WaitLeader(t)
+	targetIdx := (leadIdx + 1) % 3
+	target := clus.Members[targetIdx]
+
+	cc := integration.ToGRPC(clus.Client(leadIdx)).Cluster
+	resp, err := cc.MemberSetLeaderPriority(context.TODO(), &pb.MemberSetLeaderPriorityRequest{ID: uint64(target.Server.MemberId()), LeaderPriority: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range resp.Members {
+		if m.ID == uint64(target.Server.MemberId()) && m.LeaderPriority != 10 {
+			t.Fatalf("leader priority = %d, want 10", m.LeaderPriority)
+		}
+	}
+
+	deadline := time.Now().Add(10 * time.Second)
+	for clus.WaitLeader(t) != targetIdx {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for member %s to become leader", target.Server.MemberId())
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	_, err = cc.MemberUpdate(context.TODO(), &pb.MemberUpdateRequest{ID: uint64(target.Server.MemberId()), PeerURLs: target.PeerURLs.StringSlice()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p := target.Server.Cluster().Member(target.Server.MemberId()).LeaderPriority; p != 10 {
+		t.Fatalf("leader priority after member update = %d, want 10", p)
+	}
+}
+
+func TestQuiesce(t *testing.T) {
+	integration.BeforeTest(t)
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 3, QuiesceTimeout: 100 * time.Millisecond})
+	defer clus.Terminate(t)
+
+	waitQuiesced := func(membs []*integration.Member, want bool) {
+		deadline := time.Now().Add(10 * time.Second)
+		for _, m := range membs {
+			for m.Server.IsQuiesced() != want {
+				if time.Now().After(deadline) {
+					t.Fatalf("member %s quiesced = %v, want %v", m.Server.MemberId(), !want, want)
+				}
+				time.Sleep(10 * time.Millisecond)
+			}
+		}
+	}
+
+	clus.WaitLeader(t)
+	waitQuiesced(clus.Members, true)
+
+	// a proposal wakes the group up, which quiesces again once idle
+	if _, err := clus.Client(0).Put(context.TODO(), "foo", "bar"); err != nil {
+		t.Fatal(err)
+	}
+	waitQuiesced(clus.Members, true)
+
+	// the followers notice that the quiesced leader is gone and elect a new one
+	leadIdx := clus.WaitLeader(t)
+	clus.Members[leadIdx].Stop(t)
+	var membs []*integration.Member
+	for i, m := range clus.Members {
+		if i != leadIdx {
+			membs = append(membs, m)
+		}
+	}
+	clus.WaitMembersForLeader(t, membs)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
+	defer cancel()
+	if _, err := membs[0].Client.Put(ctx, "foo", "baz"); err != nil {
+		t.Fatal(err)
+	}
+}
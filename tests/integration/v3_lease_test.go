@@ -281,6 +281,119 @@ func TestV3LeaseExpire(t *testing.T) {
 	})
 }
 
+// TestV3PutTTL ensures a key put with a ttl reports its remaining TTL and
+// is deleted with a DELETE event once the TTL passes, and that overwriting
+// a key revokes its implicit lease.
+func TestV3PutTTL(t *testing.T) {
+	integration.BeforeTest(t)
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	kvc := integration.ToGRPC(clus.Client(clus.WaitLeader(t))).KV
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, err := kvc.Put(ctx, &pb.PutRequest{Key: []byte("foo"), Ttl: 5, Lease: 1})
+	if !eqErrGRPC(err, rpctypes.ErrGRPCLeaseProvided) {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrGRPCLeaseProvided, err)
+	}
+	_, err = kvc.Put(ctx, &pb.PutRequest{Key: []byte("foo"), Ttl: -1})
+	if !eqErrGRPC(err, rpctypes.ErrGRPCInvalidTTL) {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrGRPCInvalidTTL, err)
+	}
+
+	if _, err = kvc.Put(ctx, &pb.PutRequest{Key: []byte("bar"), Value: []byte("v")}); err != nil {
+		t.Fatal(err)
+	}
+	presp, err := kvc.Put(ctx, &pb.PutRequest{Key: []byte("foo"), Value: []byte("v"), Ttl: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rresp, err := kvc.Range(ctx, &pb.RangeRequest{Key: []byte("bar"), RangeEnd: []byte("goo")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rresp.Kvs) != 2 || len(rresp.RemainingTtls) != 2 {
+		t.Fatalf("expected 2 keys with remaining TTLs, got %+v", rresp)
+	}
+	if rresp.Kvs[1].Lease == 0 {
+		t.Fatalf("expected an implicit lease on %q", rresp.Kvs[1].Key)
+	}
+	if ttl := rresp.RemainingTtls[0]; ttl != 0 {
+		t.Errorf("remaining TTL of %q = %d, want 0", rresp.Kvs[0].Key, ttl)
+	}
+	if ttl := rresp.RemainingTtls[1]; ttl < 1 || ttl > 3 {
+		t.Errorf("remaining TTL of %q = %d, want 1..3", rresp.Kvs[1].Key, ttl)
+	}
+
+	// a follower estimates the remaining TTL from the time the lease was granted
+	time.Sleep(1100 * time.Millisecond)
+	fkvc := integration.ToGRPC(clus.Client((clus.WaitLeader(t) + 1) % 3)).KV
+	rresp, err = fkvc.Range(ctx, &pb.RangeRequest{Key: []byte("foo")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rresp.RemainingTtls) != 1 || rresp.RemainingTtls[0] < 1 || rresp.RemainingTtls[0] > 2 {
+		t.Errorf("remaining TTLs on follower = %v, want [1..2]", rresp.RemainingTtls)
+	}
+
+	// overwriting the key revokes its implicit lease
+	if _, err = kvc.Put(ctx, &pb.PutRequest{Key: []byte("bar"), Value: []byte("v"), Ttl: 100}); err != nil {
+		t.Fatal(err)
+	}
+	rresp, err = kvc.Range(ctx, &pb.RangeRequest{Key: []byte("bar")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	barLease := rresp.Kvs[0].Lease
+	if _, err = kvc.Put(ctx, &pb.PutRequest{Key: []byte("bar"), Value: []byte("v")}); err != nil {
+		t.Fatal(err)
+	}
+
+	wStream, err := integration.ToGRPC(clus.RandClient()).Watch.Watch(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wreq := &pb.WatchRequest{RequestUnion: &pb.WatchRequest_CreateRequest{
+		CreateRequest: &pb.WatchCreateRequest{
+			Key: []byte("foo"), StartRevision: presp.Header.Revision + 1}}}
+	if err = wStream.Send(wreq); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = wStream.Recv(); err != nil {
+		// the 'created' message
+		t.Fatal(err)
+	}
+
+	errc := make(chan error, 1)
+	go func() {
+		resp, err := wStream.Recv()
+		switch {
+		case err != nil:
+			errc <- err
+		case len(resp.Events) != 1:
+			fallthrough
+		case resp.Events[0].Type != mvccpb.DELETE:
+			errc <- fmt.Errorf("expected key delete, got %v", resp)
+		default:
+			errc <- nil
+		}
+	}()
+	select {
+	case <-time.After(15 * time.Second):
+		t.Fatalf("key expiration too slow")
+	case err = <-errc:
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if leaseExist(t, clus, barLease) {
+		t.Errorf("implicit lease %x of an overwritten key still exists", barLease)
+	}
+}
+
 // TestV3LeaseKeepAlive ensures keepalive keeps the lease alive.
 func TestV3LeaseKeepAlive(t *testing.T) {
 	integration.BeforeTest(t)
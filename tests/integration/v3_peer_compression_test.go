@@ -0,0 +1,63 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integration
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/tests/v3/framework/integration"
+)
+
+// TestV3PeerCompression ensures that members replicate to each other over
+// compressed peer traffic and report the compression ratio.
+func TestV3PeerCompression(t *testing.T) {
+	integration.BeforeTest(t)
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 3, PeerCompression: "gzip"})
+	defer clus.Terminate(t)
+
+	val := strings.Repeat("a", 64*1024)
+	kvc := integration.ToGRPC(clus.RandClient()).KV
+	if _, err := kvc.Put(context.TODO(), &pb.PutRequest{Key: []byte("foo"), Value: []byte(val)}); err != nil {
+		t.Fatalf("couldn't put key (%v)", err)
+	}
+
+	for i := range clus.Members {
+		kvci := integration.ToGRPC(clus.Client(i)).KV
+		timeout := time.After(10 * time.Second)
+		for {
+			resp, err := kvci.Range(context.TODO(), &pb.RangeRequest{Key: []byte("foo"), Serializable: true})
+			if err == nil && len(resp.Kvs) == 1 && string(resp.Kvs[0].Value) == val {
+				break
+			}
+			select {
+			case <-timeout:
+				t.Fatalf("member %d did not receive the value", i)
+			case <-time.After(integration.TickDuration):
+			}
+		}
+	}
+
+	lead := clus.WaitLeader(t)
+	ratio := MustFetchNotEmptyMetric(t, clus.Members[lead], "etcd_network_peer_compression_ratio", time.After(10*time.Second))
+	if r, err := strconv.ParseFloat(ratio, 64); err != nil || r <= 1 {
+		t.Errorf("compression ratio = %q, want > 1", ratio)
+	}
+}
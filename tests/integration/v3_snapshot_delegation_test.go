@@ -0,0 +1,69 @@
+// Copyright 2022 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/tests/v3/framework/integration"
+)
+
+// TestV3SnapshotDelegation ensures that the leader has an up-to-date follower
+// send the snapshot a slow follower needs, and that the slow follower catches
+// up from it.
+func TestV3SnapshotDelegation(t *testing.T) {
+	integration.BeforeTest(t)
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{
+		Size:                   3,
+		SnapshotCount:          10,
+		SnapshotCatchUpEntries: 5,
+		SnapshotDelegation:     true,
+	})
+	defer clus.Terminate(t)
+
+	clus.Members[0].InjectPartition(t, clus.Members[1:]...)
+	clus.WaitMembersForLeader(t, clus.Members[1:])
+
+	kvc := integration.ToGRPC(clus.Client(1)).KV
+	// to trigger snapshot from the leader to the partitioned follower
+	for i := 0; i < 15; i++ {
+		if _, err := kvc.Put(context.TODO(), &pb.PutRequest{Key: []byte("foo"), Value: []byte(fmt.Sprintf("bar%d", i))}); err != nil {
+			t.Fatalf("#%d: couldn't put key (%v)", i, err)
+		}
+	}
+
+	clus.Members[0].RecoverPartition(t, clus.Members[1:]...)
+
+	MustFetchNotEmptyMetric(t, clus.Members[0], "etcd_network_snapshot_delegate_success", time.After(10*time.Second))
+
+	kvc0 := integration.ToGRPC(clus.Client(0)).KV
+	timeout := time.After(10 * time.Second)
+	for {
+		resp, err := kvc0.Range(context.TODO(), &pb.RangeRequest{Key: []byte("foo"), Serializable: true})
+		if err == nil && len(resp.Kvs) == 1 && string(resp.Kvs[0].Value) == "bar14" {
+			break
+		}
+		select {
+		case <-timeout:
+			t.Fatalf("slow follower did not catch up (%v, %v)", resp, err)
+		case <-time.After(integration.TickDuration):
+		}
+	}
+}
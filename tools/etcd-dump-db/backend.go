@@ -15,8 +15,11 @@
 package main
 
 import (
+	"bytes"
+	"compress/flate"
 	"encoding/binary"
 	"fmt"
+	"io"
 	"path/filepath"
 
 	"go.etcd.io/etcd/api/v3/authpb"
@@ -82,10 +85,22 @@ func defaultDecoder(k, v []byte) {
 
 func keyDecoder(k, v []byte) {
 	rev := bytesToRev(k)
+	// values compressed by mvcc are marked with 0xfe and their compression.
+	compressed := len(v) > 1 && v[0] == 0xfe
+	if compressed {
+		v = v[2:]
+	}
 	var kv mvccpb.KeyValue
 	if err := kv.Unmarshal(v); err != nil {
 		panic(err)
 	}
+	if compressed {
+		val, err := io.ReadAll(flate.NewReader(bytes.NewReader(kv.Value)))
+		if err != nil {
+			panic(err)
+		}
+		kv.Value = val
+	}
 	fmt.Printf("rev=%+v, value=[key %q | val %q | created %d | mod %d | ver %d]\n", rev, string(kv.Key), string(kv.Value), kv.CreateRevision, kv.ModRevision, kv.Version)
 }
 